@@ -89,7 +89,7 @@ func GenerateKey(rand io.Reader) (*PrivateKey, error) {
 
 	privateKey := new(PrivateKey)
 	k.FillBytes(privateKey.scalar[:sizeFr])
-	privateKey.PublicKey.A.ScalarMultiplication(&g, k)
+	privateKey.PublicKey.A.ScalarMultiplicationCT(&g, k)
 	return privateKey, nil
 }
 
@@ -193,8 +193,12 @@ func (privKey *PrivateKey) Public() signature.PublicKey {
 //
 // SEC 1, Version 2.0, Section 4.1.3
 func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
-	scalar, r, s, kInv := new(big.Int), new(big.Int), new(big.Int), new(big.Int)
-	scalar.SetBytes(privKey.scalar[:sizeFr])
+	r, s := new(big.Int), new(big.Int)
+
+	// the secret values sk and k⁻¹ are kept in fr.Element: the multiplications are reduced with masks
+	// and the additions use AddCT, so that s is computed without branching on them
+	var sk, kInv, rFr, mFr, sFr fr.Element
+	sk.SetBytes(privKey.scalar[:sizeFr])
 	for {
 		for {
 			csprng, err := nonce(privKey, message)
@@ -207,8 +211,9 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 			}
 
 			var P bls12377.G1Affine
-			P.ScalarMultiplicationBase(k)
-			kInv.ModInverse(k, order)
+			P.ScalarMultiplicationBaseCT(k)
+			// k is secret, invert it in constant time
+			kInv.SetBigInt(k).InverseCT(&kInv)
 
 			P.X.BigInt(r)
 
@@ -217,7 +222,8 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 				break
 			}
 		}
-		s.Mul(r, scalar)
+		rFr.SetBigInt(r)
+		sFr.Mul(&rFr, &sk)
 
 		var m *big.Int
 		if hFunc != nil {
@@ -235,10 +241,11 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 			m = HashToInt(message)
 		}
 
-		s.Add(m, s).
-			Mul(kInv, s).
-			Mod(s, order) // order != 0
-		if s.Sign() != 0 {
+		mFr.SetBigInt(m)
+		sFr.AddCT(&mFr, &sFr).
+			Mul(&kInv, &sFr)
+		if !sFr.IsZero() {
+			sFr.BigInt(s)
 			break
 		}
 	}
@@ -316,6 +316,270 @@ func (z *Element) expBySqrtExp(x Element) *Element {
 	return z
 }
 
+// vectorExpBySqrtExpNbTemporaries is the number of temporary vectors used by vectorExpBySqrtExp
+const vectorExpBySqrtExpNbTemporaries = 17
+
+// vectorExpBySqrtExp sets z[i] = x[i]^12ab655e9a2ca55660b44d1e5c37b00159aa76fed00000010a11 for all i, with the addition chain of expBySqrtExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpBySqrtExpNbTemporaries * len(x).
+func vectorExpBySqrtExp(z, x, scratch Vector) {
+	n := len(x)
+	var (
+		t0  = scratch[0*n : 0*n+n]
+		t1  = scratch[1*n : 1*n+n]
+		t2  = scratch[2*n : 2*n+n]
+		t3  = scratch[3*n : 3*n+n]
+		t4  = scratch[4*n : 4*n+n]
+		t5  = scratch[5*n : 5*n+n]
+		t6  = scratch[6*n : 6*n+n]
+		t7  = scratch[7*n : 7*n+n]
+		t8  = scratch[8*n : 8*n+n]
+		t9  = scratch[9*n : 9*n+n]
+		t10 = scratch[10*n : 10*n+n]
+		t11 = scratch[11*n : 11*n+n]
+		t12 = scratch[12*n : 12*n+n]
+		t13 = scratch[13*n : 13*n+n]
+		t14 = scratch[14*n : 14*n+n]
+		t15 = scratch[15*n : 15*n+n]
+		t16 = scratch[16*n : 16*n+n]
+	)
+
+	// Step 1: t4 = x^0x2
+	t4.Mul(x, x)
+
+	// Step 2: z = x^0x3
+	z.Mul(x, t4)
+
+	// Step 3: t1 = x^0x5
+	t1.Mul(t4, z)
+
+	// Step 4: t3 = x^0x8
+	t3.Mul(z, t1)
+
+	// Step 5: t0 = x^0xb
+	t0.Mul(z, t3)
+
+	// Step 6: t2 = x^0x10
+	t2.Mul(t1, t0)
+
+	// Step 7: z = x^0x11
+	z.Mul(x, t2)
+
+	// Step 8: t7 = x^0x16
+	t7.Mul(t1, z)
+
+	// Step 9: t8 = x^0x20
+	t8.Mul(t2, t2)
+
+	// Step 10: t5 = x^0x2b
+	t5.Mul(t0, t8)
+
+	// Step 11: t0 = x^0x2d
+	t0.Mul(t4, t5)
+
+	// Step 12: t0 = x^0x5a
+	t0.Mul(t0, t0)
+
+	// Step 13: t15 = x^0x5b
+	t15.Mul(x, t0)
+
+	// Step 14: t6 = x^0x7b
+	t6.Mul(t8, t15)
+
+	// Step 15: t0 = x^0x85
+	t0.Mul(t5, t0)
+
+	// Step 16: t12 = x^0x8b
+	t12.Mul(t2, t6)
+
+	// Step 17: t11 = x^0xa5
+	t11.Mul(t8, t0)
+
+	// Step 18: t14 = x^0xab
+	t14.Mul(t8, t12)
+
+	// Step 19: t10 = x^0xc1
+	t10.Mul(t7, t14)
+
+	// Step 20: t7 = x^0xc3
+	t7.Mul(t4, t10)
+
+	// Step 21: t9 = x^0xd1
+	t9.Mul(t2, t10)
+
+	// Step 22: t13 = x^0xd3
+	t13.Mul(t4, t9)
+
+	// Step 23: t4 = x^0xd5
+	t4.Mul(t4, t13)
+
+	// Step 24: t8 = x^0xe5
+	t8.Mul(t2, t4)
+
+	// Step 25: t3 = x^0xed
+	t3.Mul(t3, t8)
+
+	// Step 26: t16 = x^0x12a
+	t16.Mul(t0, t11)
+
+	// Step 33: t16 = x^0x9500
+	for s := 0; s < 7; s++ {
+		t16.Mul(t16, t16)
+	}
+
+	// Step 34: t15 = x^0x955b
+	t15.Mul(t15, t16)
+
+	// Step 44: t15 = x^0x2556c00
+	for s := 0; s < 10; s++ {
+		t15.Mul(t15, t15)
+	}
+
+	// Step 45: t14 = x^0x2556cab
+	t14.Mul(t14, t15)
+
+	// Step 53: t14 = x^0x2556cab00
+	for s := 0; s < 8; s++ {
+		t14.Mul(t14, t14)
+	}
+
+	// Step 54: t13 = x^0x2556cabd3
+	t13.Mul(t13, t14)
+
+	// Step 63: t13 = x^0x4aad957a600
+	for s := 0; s < 9; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 64: t12 = x^0x4aad957a68b
+	t12.Mul(t12, t13)
+
+	// Step 74: t12 = x^0x12ab655e9a2c00
+	for s := 0; s < 10; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 75: t11 = x^0x12ab655e9a2ca5
+	t11.Mul(t11, t12)
+
+	// Step 82: t11 = x^0x955b2af4d165280
+	for s := 0; s < 7; s++ {
+		t11.Mul(t11, t11)
+	}
+
+	// Step 83: t11 = x^0x955b2af4d1652ab
+	t11.Mul(t5, t11)
+
+	// Step 93: t11 = x^0x2556cabd34594aac00
+	for s := 0; s < 10; s++ {
+		t11.Mul(t11, t11)
+	}
+
+	// Step 94: t10 = x^0x2556cabd34594aacc1
+	t10.Mul(t10, t11)
+
+	// Step 103: t10 = x^0x4aad957a68b295598200
+	for s := 0; s < 9; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 104: t10 = x^0x4aad957a68b2955982d1
+	t10.Mul(t9, t10)
+
+	// Step 114: t10 = x^0x12ab655e9a2ca55660b4400
+	for s := 0; s < 10; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 115: t9 = x^0x12ab655e9a2ca55660b44d1
+	t9.Mul(t9, t10)
+
+	// Step 123: t9 = x^0x12ab655e9a2ca55660b44d100
+	for s := 0; s < 8; s++ {
+		t9.Mul(t9, t9)
+	}
+
+	// Step 124: t8 = x^0x12ab655e9a2ca55660b44d1e5
+	t8.Mul(t8, t9)
+
+	// Step 132: t8 = x^0x12ab655e9a2ca55660b44d1e500
+	for s := 0; s < 8; s++ {
+		t8.Mul(t8, t8)
+	}
+
+	// Step 133: t7 = x^0x12ab655e9a2ca55660b44d1e5c3
+	t7.Mul(t7, t8)
+
+	// Step 141: t7 = x^0x12ab655e9a2ca55660b44d1e5c300
+	for s := 0; s < 8; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 142: t6 = x^0x12ab655e9a2ca55660b44d1e5c37b
+	t6.Mul(t6, t7)
+
+	// Step 159: t6 = x^0x2556cabd34594aacc1689a3cb86f60000
+	for s := 0; s < 17; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 160: t5 = x^0x2556cabd34594aacc1689a3cb86f6002b
+	t5.Mul(t5, t6)
+
+	// Step 170: t5 = x^0x955b2af4d1652ab305a268f2e1bd800ac00
+	for s := 0; s < 10; s++ {
+		t5.Mul(t5, t5)
+	}
+
+	// Step 171: t4 = x^0x955b2af4d1652ab305a268f2e1bd800acd5
+	t4.Mul(t4, t5)
+
+	// Step 181: t4 = x^0x2556cabd34594aacc1689a3cb86f6002b35400
+	for s := 0; s < 10; s++ {
+		t4.Mul(t4, t4)
+	}
+
+	// Step 182: t4 = x^0x2556cabd34594aacc1689a3cb86f6002b354ed
+	t4.Mul(t3, t4)
+
+	// Step 190: t4 = x^0x2556cabd34594aacc1689a3cb86f6002b354ed00
+	for s := 0; s < 8; s++ {
+		t4.Mul(t4, t4)
+	}
+
+	// Step 191: t3 = x^0x2556cabd34594aacc1689a3cb86f6002b354eded
+	t3.Mul(t3, t4)
+
+	// Step 192: t2 = x^0x2556cabd34594aacc1689a3cb86f6002b354edfd
+	t2.Mul(t2, t3)
+
+	// Step 195: t2 = x^0x12ab655e9a2ca55660b44d1e5c37b00159aa76fe8
+	for s := 0; s < 3; s++ {
+		t2.Mul(t2, t2)
+	}
+
+	// Step 196: t1 = x^0x12ab655e9a2ca55660b44d1e5c37b00159aa76fed
+	t1.Mul(t1, t2)
+
+	// Step 231: t1 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f6800000000
+	for s := 0; s < 35; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 232: t0 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f6800000085
+	t0.Mul(t0, t1)
+
+	// Step 241: t0 = x^0x12ab655e9a2ca55660b44d1e5c37b00159aa76fed00000010a00
+	for s := 0; s < 9; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 242: z = x^0x12ab655e9a2ca55660b44d1e5c37b00159aa76fed00000010a11
+	z.Mul(z, t0)
+
+}
+
 // expByLegendreExp is equivalent to z.Exp(x, 955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508c00000000000)
 //
 // uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
@@ -621,3 +885,633 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	return z
 }
+
+// vectorExpByLegendreExpNbTemporaries is the number of temporary vectors used by vectorExpByLegendreExp
+const vectorExpByLegendreExpNbTemporaries = 17
+
+// vectorExpByLegendreExp sets z[i] = x[i]^955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508c00000000000 for all i, with the addition chain of expByLegendreExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpByLegendreExpNbTemporaries * len(x).
+func vectorExpByLegendreExp(z, x, scratch Vector) {
+	n := len(x)
+	var (
+		t0  = scratch[0*n : 0*n+n]
+		t1  = scratch[1*n : 1*n+n]
+		t2  = scratch[2*n : 2*n+n]
+		t3  = scratch[3*n : 3*n+n]
+		t4  = scratch[4*n : 4*n+n]
+		t5  = scratch[5*n : 5*n+n]
+		t6  = scratch[6*n : 6*n+n]
+		t7  = scratch[7*n : 7*n+n]
+		t8  = scratch[8*n : 8*n+n]
+		t9  = scratch[9*n : 9*n+n]
+		t10 = scratch[10*n : 10*n+n]
+		t11 = scratch[11*n : 11*n+n]
+		t12 = scratch[12*n : 12*n+n]
+		t13 = scratch[13*n : 13*n+n]
+		t14 = scratch[14*n : 14*n+n]
+		t15 = scratch[15*n : 15*n+n]
+		t16 = scratch[16*n : 16*n+n]
+	)
+
+	// Step 1: t4 = x^0x2
+	t4.Mul(x, x)
+
+	// Step 2: z = x^0x3
+	z.Mul(x, t4)
+
+	// Step 3: t1 = x^0x5
+	t1.Mul(t4, z)
+
+	// Step 4: t8 = x^0x6
+	t8.Mul(x, t1)
+
+	// Step 5: t3 = x^0x8
+	t3.Mul(t4, t8)
+
+	// Step 6: t2 = x^0x10
+	t2.Mul(t3, t3)
+
+	// Step 7: t7 = x^0x16
+	t7.Mul(t8, t2)
+
+	// Step 8: t9 = x^0x20
+	t9.Mul(t2, t2)
+
+	// Step 9: z = x^0x23
+	z.Mul(z, t9)
+
+	// Step 10: t5 = x^0x2b
+	t5.Mul(t3, z)
+
+	// Step 11: t0 = x^0x2d
+	t0.Mul(t4, t5)
+
+	// Step 12: t0 = x^0x5a
+	t0.Mul(t0, t0)
+
+	// Step 13: t15 = x^0x5b
+	t15.Mul(x, t0)
+
+	// Step 14: t6 = x^0x7b
+	t6.Mul(t9, t15)
+
+	// Step 15: t0 = x^0x85
+	t0.Mul(t5, t0)
+
+	// Step 16: t12 = x^0x8b
+	t12.Mul(t8, t0)
+
+	// Step 17: t11 = x^0xa5
+	t11.Mul(t9, t0)
+
+	// Step 18: t14 = x^0xab
+	t14.Mul(t8, t11)
+
+	// Step 19: t10 = x^0xc1
+	t10.Mul(t7, t14)
+
+	// Step 20: t7 = x^0xc3
+	t7.Mul(t4, t10)
+
+	// Step 21: t9 = x^0xd1
+	t9.Mul(t2, t10)
+
+	// Step 22: t13 = x^0xd3
+	t13.Mul(t4, t9)
+
+	// Step 23: t4 = x^0xd5
+	t4.Mul(t4, t13)
+
+	// Step 24: t8 = x^0xe5
+	t8.Mul(t2, t4)
+
+	// Step 25: t3 = x^0xed
+	t3.Mul(t3, t8)
+
+	// Step 26: t16 = x^0x12a
+	t16.Mul(t0, t11)
+
+	// Step 33: t16 = x^0x9500
+	for s := 0; s < 7; s++ {
+		t16.Mul(t16, t16)
+	}
+
+	// Step 34: t15 = x^0x955b
+	t15.Mul(t15, t16)
+
+	// Step 44: t15 = x^0x2556c00
+	for s := 0; s < 10; s++ {
+		t15.Mul(t15, t15)
+	}
+
+	// Step 45: t14 = x^0x2556cab
+	t14.Mul(t14, t15)
+
+	// Step 53: t14 = x^0x2556cab00
+	for s := 0; s < 8; s++ {
+		t14.Mul(t14, t14)
+	}
+
+	// Step 54: t13 = x^0x2556cabd3
+	t13.Mul(t13, t14)
+
+	// Step 63: t13 = x^0x4aad957a600
+	for s := 0; s < 9; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 64: t12 = x^0x4aad957a68b
+	t12.Mul(t12, t13)
+
+	// Step 74: t12 = x^0x12ab655e9a2c00
+	for s := 0; s < 10; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 75: t11 = x^0x12ab655e9a2ca5
+	t11.Mul(t11, t12)
+
+	// Step 82: t11 = x^0x955b2af4d165280
+	for s := 0; s < 7; s++ {
+		t11.Mul(t11, t11)
+	}
+
+	// Step 83: t11 = x^0x955b2af4d1652ab
+	t11.Mul(t5, t11)
+
+	// Step 93: t11 = x^0x2556cabd34594aac00
+	for s := 0; s < 10; s++ {
+		t11.Mul(t11, t11)
+	}
+
+	// Step 94: t10 = x^0x2556cabd34594aacc1
+	t10.Mul(t10, t11)
+
+	// Step 103: t10 = x^0x4aad957a68b295598200
+	for s := 0; s < 9; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 104: t10 = x^0x4aad957a68b2955982d1
+	t10.Mul(t9, t10)
+
+	// Step 114: t10 = x^0x12ab655e9a2ca55660b4400
+	for s := 0; s < 10; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 115: t9 = x^0x12ab655e9a2ca55660b44d1
+	t9.Mul(t9, t10)
+
+	// Step 123: t9 = x^0x12ab655e9a2ca55660b44d100
+	for s := 0; s < 8; s++ {
+		t9.Mul(t9, t9)
+	}
+
+	// Step 124: t8 = x^0x12ab655e9a2ca55660b44d1e5
+	t8.Mul(t8, t9)
+
+	// Step 132: t8 = x^0x12ab655e9a2ca55660b44d1e500
+	for s := 0; s < 8; s++ {
+		t8.Mul(t8, t8)
+	}
+
+	// Step 133: t7 = x^0x12ab655e9a2ca55660b44d1e5c3
+	t7.Mul(t7, t8)
+
+	// Step 141: t7 = x^0x12ab655e9a2ca55660b44d1e5c300
+	for s := 0; s < 8; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 142: t6 = x^0x12ab655e9a2ca55660b44d1e5c37b
+	t6.Mul(t6, t7)
+
+	// Step 159: t6 = x^0x2556cabd34594aacc1689a3cb86f60000
+	for s := 0; s < 17; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 160: t5 = x^0x2556cabd34594aacc1689a3cb86f6002b
+	t5.Mul(t5, t6)
+
+	// Step 170: t5 = x^0x955b2af4d1652ab305a268f2e1bd800ac00
+	for s := 0; s < 10; s++ {
+		t5.Mul(t5, t5)
+	}
+
+	// Step 171: t4 = x^0x955b2af4d1652ab305a268f2e1bd800acd5
+	t4.Mul(t4, t5)
+
+	// Step 181: t4 = x^0x2556cabd34594aacc1689a3cb86f6002b35400
+	for s := 0; s < 10; s++ {
+		t4.Mul(t4, t4)
+	}
+
+	// Step 182: t4 = x^0x2556cabd34594aacc1689a3cb86f6002b354ed
+	t4.Mul(t3, t4)
+
+	// Step 190: t4 = x^0x2556cabd34594aacc1689a3cb86f6002b354ed00
+	for s := 0; s < 8; s++ {
+		t4.Mul(t4, t4)
+	}
+
+	// Step 191: t3 = x^0x2556cabd34594aacc1689a3cb86f6002b354eded
+	t3.Mul(t3, t4)
+
+	// Step 192: t2 = x^0x2556cabd34594aacc1689a3cb86f6002b354edfd
+	t2.Mul(t2, t3)
+
+	// Step 195: t2 = x^0x12ab655e9a2ca55660b44d1e5c37b00159aa76fe8
+	for s := 0; s < 3; s++ {
+		t2.Mul(t2, t2)
+	}
+
+	// Step 196: t1 = x^0x12ab655e9a2ca55660b44d1e5c37b00159aa76fed
+	t1.Mul(t1, t2)
+
+	// Step 231: t1 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f6800000000
+	for s := 0; s < 35; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 232: t0 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f6800000085
+	t0.Mul(t0, t1)
+
+	// Step 242: t0 = x^0x2556cabd34594aacc1689a3cb86f6002b354edfda00000021400
+	for s := 0; s < 10; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 243: z = x^0x2556cabd34594aacc1689a3cb86f6002b354edfda00000021423
+	z.Mul(z, t0)
+
+	// Step 289: z = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508c00000000000
+	for s := 0; s < 46; s++ {
+		z.Mul(z, z)
+	}
+
+}
+
+// expByInverseExp is equivalent to z.Exp(x, 12ab655e9a2ca55660b44d1e5c37b00159aa76fed00000010a117fffffffffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_101      = _10 + _11
+	//	_1000     = _11 + _101
+	//	_1001     = 1 + _1000
+	//	_1011     = _10 + _1001
+	//	_10000    = _101 + _1011
+	//	_10110    = 2*_1011
+	//	_11111    = _1001 + _10110
+	//	_100000   = 1 + _11111
+	//	_101011   = _1011 + _100000
+	//	_101101   = _10 + _101011
+	//	_1011010  = 2*_101101
+	//	_1011011  = 1 + _1011010
+	//	_1111011  = _100000 + _1011011
+	//	_10000101 = _101011 + _1011010
+	//	_10001011 = _10000 + _1111011
+	//	_10100101 = _100000 + _10000101
+	//	_10101011 = _100000 + _10001011
+	//	_11000001 = _10110 + _10101011
+	//	_11000011 = _10 + _11000001
+	//	_11010001 = _10000 + _11000001
+	//	_11010011 = _10 + _11010001
+	//	_11010101 = _10 + _11010011
+	//	_11100101 = _10000 + _11010101
+	//	_11101101 = _1000 + _11100101
+	//	_11111101 = _10000 + _11101101
+	//	_11111111 = _10 + _11111101
+	//	i48       = ((_101011 + _11111111) << 7 + _1011011) << 10 + _10101011
+	//	i77       = ((i48 << 8 + _11010011) << 9 + _10001011) << 10
+	//	i97       = ((_10100101 + i77) << 7 + _101011) << 10 + _11000001
+	//	i126      = ((i97 << 9 + _11010001) << 10 + _11010001) << 8
+	//	i145      = ((_11100101 + i126) << 8 + _11000011) << 8 + _1111011
+	//	i184      = ((i145 << 17 + _101011) << 10 + _11010101) << 10
+	//	i198      = ((_11101101 + i184) << 8 + _11111101) << 3 + _101
+	//	i255      = ((i198 << 35 + _10000101) << 12 + _10001011) << 8
+	//	i274      = ((_11111111 + i255) << 8 + _11111111) << 8 + _11111111
+	//	i297      = ((i274 << 8 + _11111111) << 8 + _11111111) << 5
+	//	return      _11111 + i297
+	//
+	// Operations: 247 squares 51 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17 Element
+	// Step 1: t0 = x^0x2
+	t0.Square(&x)
+
+	// Step 2: z = x^0x3
+	z.Mul(&x, t0)
+
+	// Step 3: t3 = x^0x5
+	t3.Mul(t0, z)
+
+	// Step 4: t5 = x^0x8
+	t5.Mul(z, t3)
+
+	// Step 5: z = x^0x9
+	z.Mul(&x, t5)
+
+	// Step 6: t1 = x^0xb
+	t1.Mul(t0, z)
+
+	// Step 7: t4 = x^0x10
+	t4.Mul(t3, t1)
+
+	// Step 8: t6 = x^0x16
+	t6.Square(t1)
+
+	// Step 9: z = x^0x1f
+	z.Mul(z, t6)
+
+	// Step 10: t9 = x^0x20
+	t9.Mul(&x, z)
+
+	// Step 11: t7 = x^0x2b
+	t7.Mul(t1, t9)
+
+	// Step 12: t1 = x^0x2d
+	t1.Mul(t0, t7)
+
+	// Step 13: t1 = x^0x5a
+	t1.Square(t1)
+
+	// Step 14: t16 = x^0x5b
+	t16.Mul(&x, t1)
+
+	// Step 15: t8 = x^0x7b
+	t8.Mul(t9, t16)
+
+	// Step 16: t2 = x^0x85
+	t2.Mul(t7, t1)
+
+	// Step 17: t1 = x^0x8b
+	t1.Mul(t4, t8)
+
+	// Step 18: t13 = x^0xa5
+	t13.Mul(t9, t2)
+
+	// Step 19: t15 = x^0xab
+	t15.Mul(t9, t1)
+
+	// Step 20: t12 = x^0xc1
+	t12.Mul(t6, t15)
+
+	// Step 21: t9 = x^0xc3
+	t9.Mul(t0, t12)
+
+	// Step 22: t11 = x^0xd1
+	t11.Mul(t4, t12)
+
+	// Step 23: t14 = x^0xd3
+	t14.Mul(t0, t11)
+
+	// Step 24: t6 = x^0xd5
+	t6.Mul(t0, t14)
+
+	// Step 25: t10 = x^0xe5
+	t10.Mul(t4, t6)
+
+	// Step 26: t5 = x^0xed
+	t5.Mul(t5, t10)
+
+	// Step 27: t4 = x^0xfd
+	t4.Mul(t4, t5)
+
+	// Step 28: t0 = x^0xff
+	t0.Mul(t0, t4)
+
+	// Step 29: t17 = x^0x12a
+	t17.Mul(t7, t0)
+
+	// Step 36: t17 = x^0x9500
+	for s := 0; s < 7; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 37: t16 = x^0x955b
+	t16.Mul(t16, t17)
+
+	// Step 47: t16 = x^0x2556c00
+	for s := 0; s < 10; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 48: t15 = x^0x2556cab
+	t15.Mul(t15, t16)
+
+	// Step 56: t15 = x^0x2556cab00
+	for s := 0; s < 8; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 57: t14 = x^0x2556cabd3
+	t14.Mul(t14, t15)
+
+	// Step 66: t14 = x^0x4aad957a600
+	for s := 0; s < 9; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 67: t14 = x^0x4aad957a68b
+	t14.Mul(t1, t14)
+
+	// Step 77: t14 = x^0x12ab655e9a2c00
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 78: t13 = x^0x12ab655e9a2ca5
+	t13.Mul(t13, t14)
+
+	// Step 85: t13 = x^0x955b2af4d165280
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 86: t13 = x^0x955b2af4d1652ab
+	t13.Mul(t7, t13)
+
+	// Step 96: t13 = x^0x2556cabd34594aac00
+	for s := 0; s < 10; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 97: t12 = x^0x2556cabd34594aacc1
+	t12.Mul(t12, t13)
+
+	// Step 106: t12 = x^0x4aad957a68b295598200
+	for s := 0; s < 9; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 107: t12 = x^0x4aad957a68b2955982d1
+	t12.Mul(t11, t12)
+
+	// Step 117: t12 = x^0x12ab655e9a2ca55660b4400
+	for s := 0; s < 10; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 118: t11 = x^0x12ab655e9a2ca55660b44d1
+	t11.Mul(t11, t12)
+
+	// Step 126: t11 = x^0x12ab655e9a2ca55660b44d100
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 127: t10 = x^0x12ab655e9a2ca55660b44d1e5
+	t10.Mul(t10, t11)
+
+	// Step 135: t10 = x^0x12ab655e9a2ca55660b44d1e500
+	for s := 0; s < 8; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 136: t9 = x^0x12ab655e9a2ca55660b44d1e5c3
+	t9.Mul(t9, t10)
+
+	// Step 144: t9 = x^0x12ab655e9a2ca55660b44d1e5c300
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 145: t8 = x^0x12ab655e9a2ca55660b44d1e5c37b
+	t8.Mul(t8, t9)
+
+	// Step 162: t8 = x^0x2556cabd34594aacc1689a3cb86f60000
+	for s := 0; s < 17; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 163: t7 = x^0x2556cabd34594aacc1689a3cb86f6002b
+	t7.Mul(t7, t8)
+
+	// Step 173: t7 = x^0x955b2af4d1652ab305a268f2e1bd800ac00
+	for s := 0; s < 10; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 174: t6 = x^0x955b2af4d1652ab305a268f2e1bd800acd5
+	t6.Mul(t6, t7)
+
+	// Step 184: t6 = x^0x2556cabd34594aacc1689a3cb86f6002b35400
+	for s := 0; s < 10; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 185: t5 = x^0x2556cabd34594aacc1689a3cb86f6002b354ed
+	t5.Mul(t5, t6)
+
+	// Step 193: t5 = x^0x2556cabd34594aacc1689a3cb86f6002b354ed00
+	for s := 0; s < 8; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 194: t4 = x^0x2556cabd34594aacc1689a3cb86f6002b354edfd
+	t4.Mul(t4, t5)
+
+	// Step 197: t4 = x^0x12ab655e9a2ca55660b44d1e5c37b00159aa76fe8
+	for s := 0; s < 3; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 198: t3 = x^0x12ab655e9a2ca55660b44d1e5c37b00159aa76fed
+	t3.Mul(t3, t4)
+
+	// Step 233: t3 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f6800000000
+	for s := 0; s < 35; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 234: t2 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f6800000085
+	t2.Mul(t2, t3)
+
+	// Step 246: t2 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f6800000085000
+	for s := 0; s < 12; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 247: t1 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508b
+	t1.Mul(t1, t2)
+
+	// Step 255: t1 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508b00
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 256: t1 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508bff
+	t1.Mul(t0, t1)
+
+	// Step 264: t1 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508bff00
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 265: t1 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508bffff
+	t1.Mul(t0, t1)
+
+	// Step 273: t1 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508bffff00
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 274: t1 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508bffffff
+	t1.Mul(t0, t1)
+
+	// Step 282: t1 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508bffffff00
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 283: t1 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508bffffffff
+	t1.Mul(t0, t1)
+
+	// Step 291: t1 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508bffffffff00
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 292: t0 = x^0x955b2af4d1652ab305a268f2e1bd800acd53b7f680000008508bffffffffff
+	t0.Mul(t0, t1)
+
+	// Step 297: t0 = x^0x12ab655e9a2ca55660b44d1e5c37b00159aa76fed00000010a117fffffffffe0
+	for s := 0; s < 5; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 298: z = x^0x12ab655e9a2ca55660b44d1e5c37b00159aa76fed00000010a117fffffffffff
+	z.Mul(z, t0)
+
+	return z
+}
@@ -228,3 +228,896 @@ TEXT ·Butterfly(SB), NOSPLIT, $0-16
 	MOVQ SI, 16(AX)
 	MOVQ DI, 24(AX)
 	RET
+
+// modulus q in 5 limbs of 52 bits
+DATA q52<>+0(SB)/8, $0x0001800000000001
+DATA q52<>+8(SB)/8, $0x000fed00000010a1
+DATA q52<>+16(SB)/8, $0x000c37b00159aa76
+DATA q52<>+24(SB)/8, $0x000a55660b44d1e5
+DATA q52<>+32(SB)/8, $0x000012ab655e9a2c
+GLOBL q52<>(SB), (RODATA+NOPTR), $40
+
+// qInv52 = -q⁻¹ mod 2⁵²
+DATA qInv52<>(SB)/8, $0x00017fffffffffff
+GLOBL qInv52<>(SB), (RODATA+NOPTR), $8
+
+DATA permuteWordsLo<>+0(SB)/8, $0
+DATA permuteWordsLo<>+8(SB)/8, $0x0000000000000004
+DATA permuteWordsLo<>+16(SB)/8, $0x0000000000000008
+DATA permuteWordsLo<>+24(SB)/8, $0x000000000000000c
+DATA permuteWordsLo<>+32(SB)/8, $1
+DATA permuteWordsLo<>+40(SB)/8, $0x0000000000000005
+DATA permuteWordsLo<>+48(SB)/8, $0x0000000000000009
+DATA permuteWordsLo<>+56(SB)/8, $0x000000000000000d
+GLOBL permuteWordsLo<>(SB), (RODATA+NOPTR), $64
+DATA permuteWordsHi<>+0(SB)/8, $0x0000000000000002
+DATA permuteWordsHi<>+8(SB)/8, $0x0000000000000006
+DATA permuteWordsHi<>+16(SB)/8, $0x000000000000000a
+DATA permuteWordsHi<>+24(SB)/8, $0x000000000000000e
+DATA permuteWordsHi<>+32(SB)/8, $0x0000000000000003
+DATA permuteWordsHi<>+40(SB)/8, $0x0000000000000007
+DATA permuteWordsHi<>+48(SB)/8, $0x000000000000000b
+DATA permuteWordsHi<>+56(SB)/8, $0x000000000000000f
+GLOBL permuteWordsHi<>(SB), (RODATA+NOPTR), $64
+DATA permuteHalvesLo<>+0(SB)/8, $0
+DATA permuteHalvesLo<>+8(SB)/8, $1
+DATA permuteHalvesLo<>+16(SB)/8, $0x0000000000000002
+DATA permuteHalvesLo<>+24(SB)/8, $0x0000000000000003
+DATA permuteHalvesLo<>+32(SB)/8, $0x0000000000000008
+DATA permuteHalvesLo<>+40(SB)/8, $0x0000000000000009
+DATA permuteHalvesLo<>+48(SB)/8, $0x000000000000000a
+DATA permuteHalvesLo<>+56(SB)/8, $0x000000000000000b
+GLOBL permuteHalvesLo<>(SB), (RODATA+NOPTR), $64
+DATA permuteHalvesHi<>+0(SB)/8, $0x0000000000000004
+DATA permuteHalvesHi<>+8(SB)/8, $0x0000000000000005
+DATA permuteHalvesHi<>+16(SB)/8, $0x0000000000000006
+DATA permuteHalvesHi<>+24(SB)/8, $0x0000000000000007
+DATA permuteHalvesHi<>+32(SB)/8, $0x000000000000000c
+DATA permuteHalvesHi<>+40(SB)/8, $0x000000000000000d
+DATA permuteHalvesHi<>+48(SB)/8, $0x000000000000000e
+DATA permuteHalvesHi<>+56(SB)/8, $0x000000000000000f
+GLOBL permuteHalvesHi<>(SB), (RODATA+NOPTR), $64
+DATA interleaveLo<>+0(SB)/8, $0
+DATA interleaveLo<>+8(SB)/8, $0x0000000000000008
+DATA interleaveLo<>+16(SB)/8, $1
+DATA interleaveLo<>+24(SB)/8, $0x0000000000000009
+DATA interleaveLo<>+32(SB)/8, $0x0000000000000002
+DATA interleaveLo<>+40(SB)/8, $0x000000000000000a
+DATA interleaveLo<>+48(SB)/8, $0x0000000000000003
+DATA interleaveLo<>+56(SB)/8, $0x000000000000000b
+GLOBL interleaveLo<>(SB), (RODATA+NOPTR), $64
+DATA interleaveHi<>+0(SB)/8, $0x0000000000000004
+DATA interleaveHi<>+8(SB)/8, $0x000000000000000c
+DATA interleaveHi<>+16(SB)/8, $0x0000000000000005
+DATA interleaveHi<>+24(SB)/8, $0x000000000000000d
+DATA interleaveHi<>+32(SB)/8, $0x0000000000000006
+DATA interleaveHi<>+40(SB)/8, $0x000000000000000e
+DATA interleaveHi<>+48(SB)/8, $0x0000000000000007
+DATA interleaveHi<>+56(SB)/8, $0x000000000000000f
+GLOBL interleaveHi<>(SB), (RODATA+NOPTR), $64
+DATA interleavePairsLo<>+0(SB)/8, $0
+DATA interleavePairsLo<>+8(SB)/8, $1
+DATA interleavePairsLo<>+16(SB)/8, $0x0000000000000008
+DATA interleavePairsLo<>+24(SB)/8, $0x0000000000000009
+DATA interleavePairsLo<>+32(SB)/8, $0x0000000000000002
+DATA interleavePairsLo<>+40(SB)/8, $0x0000000000000003
+DATA interleavePairsLo<>+48(SB)/8, $0x000000000000000a
+DATA interleavePairsLo<>+56(SB)/8, $0x000000000000000b
+GLOBL interleavePairsLo<>(SB), (RODATA+NOPTR), $64
+DATA interleavePairsHi<>+0(SB)/8, $0x0000000000000004
+DATA interleavePairsHi<>+8(SB)/8, $0x0000000000000005
+DATA interleavePairsHi<>+16(SB)/8, $0x000000000000000c
+DATA interleavePairsHi<>+24(SB)/8, $0x000000000000000d
+DATA interleavePairsHi<>+32(SB)/8, $0x0000000000000006
+DATA interleavePairsHi<>+40(SB)/8, $0x0000000000000007
+DATA interleavePairsHi<>+48(SB)/8, $0x000000000000000e
+DATA interleavePairsHi<>+56(SB)/8, $0x000000000000000f
+GLOBL interleavePairsHi<>(SB), (RODATA+NOPTR), $64
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+
+l1:
+	TESTQ BX, BX
+	JEQ   l2         // n == 0, we are done
+	MOVQ  0(AX), SI
+	MOVQ  8(AX), DI
+	MOVQ  16(AX), R8
+	MOVQ  24(AX), R9
+	ADDQ  0(DX), SI
+	ADCQ  8(DX), DI
+	ADCQ  16(DX), R8
+	ADCQ  24(DX), R9
+
+	// reduce element(SI,DI,R8,R9) using temp registers (R10,R11,R12,R13)
+	REDUCE(SI,DI,R8,R9,R10,R11,R12,R13)
+
+	MOVQ SI, 0(CX)
+	MOVQ DI, 8(CX)
+	MOVQ R8, 16(CX)
+	MOVQ R9, 24(CX)
+
+	// increment pointers to visit next element
+	ADDQ $32, AX
+	ADDQ $32, DX
+	ADDQ $32, CX
+	DECQ BX      // decrement n
+	JMP  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+	XORQ SI, SI
+
+l3:
+	TESTQ   BX, BX
+	JEQ     l4                       // n == 0, we are done
+	MOVQ    0(AX), DI
+	MOVQ    8(AX), R8
+	MOVQ    16(AX), R9
+	MOVQ    24(AX), R10
+	SUBQ    0(DX), DI
+	SBBQ    8(DX), R8
+	SBBQ    16(DX), R9
+	SBBQ    24(DX), R10
+	MOVQ    $0x0a11800000000001, R11
+	MOVQ    $0x59aa76fed0000001, R12
+	MOVQ    $0x60b44d1e5c37b001, R13
+	MOVQ    $0x12ab655e9a2ca556, R14
+	CMOVQCC SI, R11
+	CMOVQCC SI, R12
+	CMOVQCC SI, R13
+	CMOVQCC SI, R14
+	ADDQ    R11, DI
+	ADCQ    R12, R8
+	ADCQ    R13, R9
+	ADCQ    R14, R10
+	MOVQ    DI, 0(CX)
+	MOVQ    R8, 8(CX)
+	MOVQ    R9, 16(CX)
+	MOVQ    R10, 24(CX)
+
+	// increment pointers to visit next element
+	ADDQ $32, AX
+	ADDQ $32, DX
+	ADDQ $32, CX
+	DECQ BX      // decrement n
+	JMP  l3
+
+l4:
+	RET
+
+// sumVec(res *[16]uint64, a *Element, n uint64) res = ∑ a[0...n], split in 32-bit halves
+TEXT ·sumVec(SB), NOSPLIT, $0-24
+	MOVQ a+8(FP), AX
+	MOVQ n+16(FP), DX
+	SHRQ $3, DX       // we process 8 elements per iteration
+
+	// Z0, Z2: accumulate the low 32 bits; Z1, Z3: accumulate the high 32 bits
+	VPXORQ       Z0, Z0, Z0
+	VPXORQ       Z1, Z1, Z1
+	VPXORQ       Z2, Z2, Z2
+	VPXORQ       Z3, Z3, Z3
+	MOVQ         $0xffffffff, CX
+	VPBROADCASTQ CX, Z31
+
+l5:
+	TESTQ     DX, DX
+	JEQ       l6           // n == 0, we are done
+	VMOVDQU64 0(AX), Z4
+	VMOVDQU64 64(AX), Z5
+	VMOVDQU64 128(AX), Z6
+	VMOVDQU64 192(AX), Z7
+	VPANDQ    Z31, Z4, Z8
+	VPSRLQ    $32, Z4, Z9
+	VPADDQ    Z8, Z0, Z0
+	VPADDQ    Z9, Z1, Z1
+	VPANDQ    Z31, Z5, Z10
+	VPSRLQ    $32, Z5, Z11
+	VPADDQ    Z10, Z2, Z2
+	VPADDQ    Z11, Z3, Z3
+	VPANDQ    Z31, Z6, Z12
+	VPSRLQ    $32, Z6, Z13
+	VPADDQ    Z12, Z0, Z0
+	VPADDQ    Z13, Z1, Z1
+	VPANDQ    Z31, Z7, Z14
+	VPSRLQ    $32, Z7, Z15
+	VPADDQ    Z14, Z2, Z2
+	VPADDQ    Z15, Z3, Z3
+
+	// increment pointers to visit next elements
+	ADDQ $256, AX
+	DECQ DX       // decrement n
+	JMP  l5
+
+l6:
+	VPADDQ    Z2, Z0, Z0
+	VPADDQ    Z3, Z1, Z1
+	MOVQ      res+0(FP), CX
+	VMOVDQU64 Z0, 0(CX)
+	VMOVDQU64 Z1, 64(CX)
+	VZEROUPPER
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+TEXT ·mulVec(SB), NOSPLIT, $0-32
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), AX
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	SHRQ         $3, BX               // we process 8 elements per iteration
+	MOVQ         $0xfffffffffffff, SI
+	VPBROADCASTQ SI, Z26
+	VPBROADCASTQ qInv52<>(SB), Z25
+	VPBROADCASTQ q52<>+0(SB), Z20
+	VPBROADCASTQ q52<>+8(SB), Z21
+	VPBROADCASTQ q52<>+16(SB), Z22
+	VPBROADCASTQ q52<>+24(SB), Z23
+	VPBROADCASTQ q52<>+32(SB), Z24
+
+l7:
+	TESTQ BX, BX
+	JEQ   l8     // n == 0, we are done
+
+	// load 8 elements of a and split them in limbs
+	VMOVDQU64 0(AX), Z28
+	VMOVDQU64 64(AX), Z29
+	VMOVDQU64 128(AX), Z30
+	VMOVDQU64 192(AX), Z31
+	VMOVDQU64 permuteWordsLo<>(SB), Z0
+	VPERMI2Q  Z29, Z28, Z0
+	VMOVDQU64 permuteWordsLo<>(SB), Z1
+	VPERMI2Q  Z31, Z30, Z1
+	VMOVDQU64 permuteWordsHi<>(SB), Z2
+	VPERMI2Q  Z29, Z28, Z2
+	VMOVDQU64 permuteWordsHi<>(SB), Z3
+	VPERMI2Q  Z31, Z30, Z3
+	VMOVDQU64 permuteHalvesLo<>(SB), Z28
+	VPERMI2Q  Z1, Z0, Z28
+	VMOVDQU64 permuteHalvesHi<>(SB), Z29
+	VPERMI2Q  Z1, Z0, Z29
+	VMOVDQU64 permuteHalvesLo<>(SB), Z30
+	VPERMI2Q  Z3, Z2, Z30
+	VMOVDQU64 permuteHalvesHi<>(SB), Z31
+	VPERMI2Q  Z3, Z2, Z31
+	VMOVDQA64 Z28, Z0
+	VMOVDQA64 Z29, Z1
+	VMOVDQA64 Z30, Z2
+	VMOVDQA64 Z31, Z3
+	VMOVDQA64 Z0, Z4
+	VPANDQ    Z26, Z4, Z4
+	VPSRLQ    $52, Z0, Z5
+	VPSLLQ    $12, Z1, Z28
+	VPORQ     Z28, Z5, Z5
+	VPANDQ    Z26, Z5, Z5
+	VPSRLQ    $40, Z1, Z6
+	VPSLLQ    $24, Z2, Z28
+	VPORQ     Z28, Z6, Z6
+	VPANDQ    Z26, Z6, Z6
+	VPSRLQ    $28, Z2, Z7
+	VPSLLQ    $36, Z3, Z28
+	VPORQ     Z28, Z7, Z7
+	VPANDQ    Z26, Z7, Z7
+	VPSRLQ    $16, Z3, Z8
+
+	// load 8 elements of b and split them in limbs, shifted by 4 bits
+	VMOVDQU64 0(DX), Z28
+	VMOVDQU64 64(DX), Z29
+	VMOVDQU64 128(DX), Z30
+	VMOVDQU64 192(DX), Z31
+	VMOVDQU64 permuteWordsLo<>(SB), Z0
+	VPERMI2Q  Z29, Z28, Z0
+	VMOVDQU64 permuteWordsLo<>(SB), Z1
+	VPERMI2Q  Z31, Z30, Z1
+	VMOVDQU64 permuteWordsHi<>(SB), Z2
+	VPERMI2Q  Z29, Z28, Z2
+	VMOVDQU64 permuteWordsHi<>(SB), Z3
+	VPERMI2Q  Z31, Z30, Z3
+	VMOVDQU64 permuteHalvesLo<>(SB), Z28
+	VPERMI2Q  Z1, Z0, Z28
+	VMOVDQU64 permuteHalvesHi<>(SB), Z29
+	VPERMI2Q  Z1, Z0, Z29
+	VMOVDQU64 permuteHalvesLo<>(SB), Z30
+	VPERMI2Q  Z3, Z2, Z30
+	VMOVDQU64 permuteHalvesHi<>(SB), Z31
+	VPERMI2Q  Z3, Z2, Z31
+	VMOVDQA64 Z28, Z0
+	VMOVDQA64 Z29, Z1
+	VMOVDQA64 Z30, Z2
+	VMOVDQA64 Z31, Z3
+	VPSLLQ    $4, Z0, Z9
+	VPANDQ    Z26, Z9, Z9
+	VPSRLQ    $48, Z0, Z10
+	VPSLLQ    $16, Z1, Z28
+	VPORQ     Z28, Z10, Z10
+	VPANDQ    Z26, Z10, Z10
+	VPSRLQ    $36, Z1, Z11
+	VPSLLQ    $28, Z2, Z28
+	VPORQ     Z28, Z11, Z11
+	VPANDQ    Z26, Z11, Z11
+	VPSRLQ    $24, Z2, Z12
+	VPSLLQ    $40, Z3, Z28
+	VPORQ     Z28, Z12, Z12
+	VPANDQ    Z26, Z12, Z12
+	VPSRLQ    $12, Z3, Z13
+	VPXORQ    Z14, Z14, Z14
+	VPXORQ    Z15, Z15, Z15
+	VPXORQ    Z16, Z16, Z16
+	VPXORQ    Z17, Z17, Z17
+	VPXORQ    Z18, Z18, Z18
+	VPXORQ    Z19, Z19, Z19
+
+	// t += a * b[0]
+	VPMADD52LUQ Z9, Z4, Z14
+	VPMADD52HUQ Z9, Z4, Z15
+	VPMADD52LUQ Z9, Z5, Z15
+	VPMADD52HUQ Z9, Z5, Z16
+	VPMADD52LUQ Z9, Z6, Z16
+	VPMADD52HUQ Z9, Z6, Z17
+	VPMADD52LUQ Z9, Z7, Z17
+	VPMADD52HUQ Z9, Z7, Z18
+	VPMADD52LUQ Z9, Z8, Z18
+	VPMADD52HUQ Z9, Z8, Z19
+
+	// m = t[0] * qInv52 mod 2⁵²; t += m * q
+	VPXORQ      Z27, Z27, Z27
+	VPMADD52LUQ Z25, Z14, Z27
+	VPMADD52LUQ Z27, Z20, Z14
+	VPMADD52HUQ Z27, Z20, Z15
+	VPMADD52LUQ Z27, Z21, Z15
+	VPMADD52HUQ Z27, Z21, Z16
+	VPMADD52LUQ Z27, Z22, Z16
+	VPMADD52HUQ Z27, Z22, Z17
+	VPMADD52LUQ Z27, Z23, Z17
+	VPMADD52HUQ Z27, Z23, Z18
+	VPMADD52LUQ Z27, Z24, Z18
+	VPMADD52HUQ Z27, Z24, Z19
+
+	// t[0] = 0 mod 2⁵²; shift t by one limb
+	VPSRLQ $52, Z14, Z28
+	VPADDQ Z28, Z15, Z15
+	VPXORQ Z14, Z14, Z14
+
+	// t += a * b[1]
+	VPMADD52LUQ Z10, Z4, Z15
+	VPMADD52HUQ Z10, Z4, Z16
+	VPMADD52LUQ Z10, Z5, Z16
+	VPMADD52HUQ Z10, Z5, Z17
+	VPMADD52LUQ Z10, Z6, Z17
+	VPMADD52HUQ Z10, Z6, Z18
+	VPMADD52LUQ Z10, Z7, Z18
+	VPMADD52HUQ Z10, Z7, Z19
+	VPMADD52LUQ Z10, Z8, Z19
+	VPMADD52HUQ Z10, Z8, Z14
+
+	// m = t[0] * qInv52 mod 2⁵²; t += m * q
+	VPXORQ      Z27, Z27, Z27
+	VPMADD52LUQ Z25, Z15, Z27
+	VPMADD52LUQ Z27, Z20, Z15
+	VPMADD52HUQ Z27, Z20, Z16
+	VPMADD52LUQ Z27, Z21, Z16
+	VPMADD52HUQ Z27, Z21, Z17
+	VPMADD52LUQ Z27, Z22, Z17
+	VPMADD52HUQ Z27, Z22, Z18
+	VPMADD52LUQ Z27, Z23, Z18
+	VPMADD52HUQ Z27, Z23, Z19
+	VPMADD52LUQ Z27, Z24, Z19
+	VPMADD52HUQ Z27, Z24, Z14
+
+	// t[0] = 0 mod 2⁵²; shift t by one limb
+	VPSRLQ $52, Z15, Z28
+	VPADDQ Z28, Z16, Z16
+	VPXORQ Z15, Z15, Z15
+
+	// t += a * b[2]
+	VPMADD52LUQ Z11, Z4, Z16
+	VPMADD52HUQ Z11, Z4, Z17
+	VPMADD52LUQ Z11, Z5, Z17
+	VPMADD52HUQ Z11, Z5, Z18
+	VPMADD52LUQ Z11, Z6, Z18
+	VPMADD52HUQ Z11, Z6, Z19
+	VPMADD52LUQ Z11, Z7, Z19
+	VPMADD52HUQ Z11, Z7, Z14
+	VPMADD52LUQ Z11, Z8, Z14
+	VPMADD52HUQ Z11, Z8, Z15
+
+	// m = t[0] * qInv52 mod 2⁵²; t += m * q
+	VPXORQ      Z27, Z27, Z27
+	VPMADD52LUQ Z25, Z16, Z27
+	VPMADD52LUQ Z27, Z20, Z16
+	VPMADD52HUQ Z27, Z20, Z17
+	VPMADD52LUQ Z27, Z21, Z17
+	VPMADD52HUQ Z27, Z21, Z18
+	VPMADD52LUQ Z27, Z22, Z18
+	VPMADD52HUQ Z27, Z22, Z19
+	VPMADD52LUQ Z27, Z23, Z19
+	VPMADD52HUQ Z27, Z23, Z14
+	VPMADD52LUQ Z27, Z24, Z14
+	VPMADD52HUQ Z27, Z24, Z15
+
+	// t[0] = 0 mod 2⁵²; shift t by one limb
+	VPSRLQ $52, Z16, Z28
+	VPADDQ Z28, Z17, Z17
+	VPXORQ Z16, Z16, Z16
+
+	// t += a * b[3]
+	VPMADD52LUQ Z12, Z4, Z17
+	VPMADD52HUQ Z12, Z4, Z18
+	VPMADD52LUQ Z12, Z5, Z18
+	VPMADD52HUQ Z12, Z5, Z19
+	VPMADD52LUQ Z12, Z6, Z19
+	VPMADD52HUQ Z12, Z6, Z14
+	VPMADD52LUQ Z12, Z7, Z14
+	VPMADD52HUQ Z12, Z7, Z15
+	VPMADD52LUQ Z12, Z8, Z15
+	VPMADD52HUQ Z12, Z8, Z16
+
+	// m = t[0] * qInv52 mod 2⁵²; t += m * q
+	VPXORQ      Z27, Z27, Z27
+	VPMADD52LUQ Z25, Z17, Z27
+	VPMADD52LUQ Z27, Z20, Z17
+	VPMADD52HUQ Z27, Z20, Z18
+	VPMADD52LUQ Z27, Z21, Z18
+	VPMADD52HUQ Z27, Z21, Z19
+	VPMADD52LUQ Z27, Z22, Z19
+	VPMADD52HUQ Z27, Z22, Z14
+	VPMADD52LUQ Z27, Z23, Z14
+	VPMADD52HUQ Z27, Z23, Z15
+	VPMADD52LUQ Z27, Z24, Z15
+	VPMADD52HUQ Z27, Z24, Z16
+
+	// t[0] = 0 mod 2⁵²; shift t by one limb
+	VPSRLQ $52, Z17, Z28
+	VPADDQ Z28, Z18, Z18
+	VPXORQ Z17, Z17, Z17
+
+	// t += a * b[4]
+	VPMADD52LUQ Z13, Z4, Z18
+	VPMADD52HUQ Z13, Z4, Z19
+	VPMADD52LUQ Z13, Z5, Z19
+	VPMADD52HUQ Z13, Z5, Z14
+	VPMADD52LUQ Z13, Z6, Z14
+	VPMADD52HUQ Z13, Z6, Z15
+	VPMADD52LUQ Z13, Z7, Z15
+	VPMADD52HUQ Z13, Z7, Z16
+	VPMADD52LUQ Z13, Z8, Z16
+	VPMADD52HUQ Z13, Z8, Z17
+
+	// m = t[0] * qInv52 mod 2⁵²; t += m * q
+	VPXORQ      Z27, Z27, Z27
+	VPMADD52LUQ Z25, Z18, Z27
+	VPMADD52LUQ Z27, Z20, Z18
+	VPMADD52HUQ Z27, Z20, Z19
+	VPMADD52LUQ Z27, Z21, Z19
+	VPMADD52HUQ Z27, Z21, Z14
+	VPMADD52LUQ Z27, Z22, Z14
+	VPMADD52HUQ Z27, Z22, Z15
+	VPMADD52LUQ Z27, Z23, Z15
+	VPMADD52HUQ Z27, Z23, Z16
+	VPMADD52LUQ Z27, Z24, Z16
+	VPMADD52HUQ Z27, Z24, Z17
+
+	// t[0] = 0 mod 2⁵²; shift t by one limb
+	VPSRLQ $52, Z18, Z28
+	VPADDQ Z28, Z19, Z19
+	VPXORQ Z18, Z18, Z18
+
+	// normalize the limbs of t
+	VPSRLQ $52, Z19, Z28
+	VPANDQ Z26, Z19, Z19
+	VPADDQ Z28, Z14, Z14
+	VPSRLQ $52, Z14, Z28
+	VPANDQ Z26, Z14, Z14
+	VPADDQ Z28, Z15, Z15
+	VPSRLQ $52, Z15, Z28
+	VPANDQ Z26, Z15, Z15
+	VPADDQ Z28, Z16, Z16
+	VPSRLQ $52, Z16, Z28
+	VPANDQ Z26, Z16, Z16
+	VPADDQ Z28, Z17, Z17
+
+	// a = t - q; keep t if the subtraction borrowed
+	VPSUBQ    Z20, Z19, Z4
+	VPSRAQ    $52, Z4, Z28
+	VPANDQ    Z26, Z4, Z4
+	VPSUBQ    Z21, Z14, Z5
+	VPADDQ    Z28, Z5, Z5
+	VPSRAQ    $52, Z5, Z28
+	VPANDQ    Z26, Z5, Z5
+	VPSUBQ    Z22, Z15, Z6
+	VPADDQ    Z28, Z6, Z6
+	VPSRAQ    $52, Z6, Z28
+	VPANDQ    Z26, Z6, Z6
+	VPSUBQ    Z23, Z16, Z7
+	VPADDQ    Z28, Z7, Z7
+	VPSRAQ    $52, Z7, Z28
+	VPANDQ    Z26, Z7, Z7
+	VPSUBQ    Z24, Z17, Z8
+	VPADDQ    Z28, Z8, Z8
+	VPMOVQ2M  Z8, K1
+	VMOVDQA64 Z19, K1, Z4
+	VMOVDQA64 Z14, K1, Z5
+	VMOVDQA64 Z15, K1, Z6
+	VMOVDQA64 Z16, K1, Z7
+	VMOVDQA64 Z17, K1, Z8
+
+	// convert the result back to 64-bit words and store it
+	VMOVDQA64 Z4, Z0
+	VPSLLQ    $52, Z5, Z28
+	VPORQ     Z28, Z0, Z0
+	VPSRLQ    $12, Z5, Z1
+	VPSLLQ    $40, Z6, Z28
+	VPORQ     Z28, Z1, Z1
+	VPSRLQ    $24, Z6, Z2
+	VPSLLQ    $28, Z7, Z28
+	VPORQ     Z28, Z2, Z2
+	VPSRLQ    $36, Z7, Z3
+	VPSLLQ    $16, Z8, Z28
+	VPORQ     Z28, Z3, Z3
+	VMOVDQU64 interleaveLo<>(SB), Z28
+	VPERMI2Q  Z1, Z0, Z28
+	VMOVDQU64 interleaveLo<>(SB), Z29
+	VPERMI2Q  Z3, Z2, Z29
+	VMOVDQU64 interleaveHi<>(SB), Z30
+	VPERMI2Q  Z1, Z0, Z30
+	VMOVDQU64 interleaveHi<>(SB), Z31
+	VPERMI2Q  Z3, Z2, Z31
+	VMOVDQU64 interleavePairsLo<>(SB), Z14
+	VPERMI2Q  Z29, Z28, Z14
+	VMOVDQU64 interleavePairsHi<>(SB), Z15
+	VPERMI2Q  Z29, Z28, Z15
+	VMOVDQU64 interleavePairsLo<>(SB), Z16
+	VPERMI2Q  Z31, Z30, Z16
+	VMOVDQU64 interleavePairsHi<>(SB), Z17
+	VPERMI2Q  Z31, Z30, Z17
+	VMOVDQU64 Z14, 0(CX)
+	VMOVDQU64 Z15, 64(CX)
+	VMOVDQU64 Z16, 128(CX)
+	VMOVDQU64 Z17, 192(CX)
+
+	// increment pointers to visit next elements
+	ADDQ $256, AX
+	ADDQ $256, DX
+	ADDQ $256, CX
+	DECQ BX       // decrement n
+	JMP  l7
+
+l8:
+	VZEROUPPER
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+TEXT ·scalarMulVec(SB), NOSPLIT, $0-32
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), AX
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	SHRQ         $3, BX               // we process 8 elements per iteration
+	MOVQ         $0xfffffffffffff, SI
+	VPBROADCASTQ SI, Z26
+	VPBROADCASTQ qInv52<>(SB), Z25
+	VPBROADCASTQ q52<>+0(SB), Z20
+	VPBROADCASTQ q52<>+8(SB), Z21
+	VPBROADCASTQ q52<>+16(SB), Z22
+	VPBROADCASTQ q52<>+24(SB), Z23
+	VPBROADCASTQ q52<>+32(SB), Z24
+
+	// b is the same for all lanes; we split it in limbs once
+	VPBROADCASTQ 0(DX), Z0
+	VPBROADCASTQ 8(DX), Z1
+	VPBROADCASTQ 16(DX), Z2
+	VPBROADCASTQ 24(DX), Z3
+	VPSLLQ       $4, Z0, Z9
+	VPANDQ       Z26, Z9, Z9
+	VPSRLQ       $48, Z0, Z10
+	VPSLLQ       $16, Z1, Z28
+	VPORQ        Z28, Z10, Z10
+	VPANDQ       Z26, Z10, Z10
+	VPSRLQ       $36, Z1, Z11
+	VPSLLQ       $28, Z2, Z28
+	VPORQ        Z28, Z11, Z11
+	VPANDQ       Z26, Z11, Z11
+	VPSRLQ       $24, Z2, Z12
+	VPSLLQ       $40, Z3, Z28
+	VPORQ        Z28, Z12, Z12
+	VPANDQ       Z26, Z12, Z12
+	VPSRLQ       $12, Z3, Z13
+
+l9:
+	TESTQ BX, BX
+	JEQ   l10    // n == 0, we are done
+
+	// load 8 elements of a and split them in limbs
+	VMOVDQU64 0(AX), Z28
+	VMOVDQU64 64(AX), Z29
+	VMOVDQU64 128(AX), Z30
+	VMOVDQU64 192(AX), Z31
+	VMOVDQU64 permuteWordsLo<>(SB), Z0
+	VPERMI2Q  Z29, Z28, Z0
+	VMOVDQU64 permuteWordsLo<>(SB), Z1
+	VPERMI2Q  Z31, Z30, Z1
+	VMOVDQU64 permuteWordsHi<>(SB), Z2
+	VPERMI2Q  Z29, Z28, Z2
+	VMOVDQU64 permuteWordsHi<>(SB), Z3
+	VPERMI2Q  Z31, Z30, Z3
+	VMOVDQU64 permuteHalvesLo<>(SB), Z28
+	VPERMI2Q  Z1, Z0, Z28
+	VMOVDQU64 permuteHalvesHi<>(SB), Z29
+	VPERMI2Q  Z1, Z0, Z29
+	VMOVDQU64 permuteHalvesLo<>(SB), Z30
+	VPERMI2Q  Z3, Z2, Z30
+	VMOVDQU64 permuteHalvesHi<>(SB), Z31
+	VPERMI2Q  Z3, Z2, Z31
+	VMOVDQA64 Z28, Z0
+	VMOVDQA64 Z29, Z1
+	VMOVDQA64 Z30, Z2
+	VMOVDQA64 Z31, Z3
+	VMOVDQA64 Z0, Z4
+	VPANDQ    Z26, Z4, Z4
+	VPSRLQ    $52, Z0, Z5
+	VPSLLQ    $12, Z1, Z28
+	VPORQ     Z28, Z5, Z5
+	VPANDQ    Z26, Z5, Z5
+	VPSRLQ    $40, Z1, Z6
+	VPSLLQ    $24, Z2, Z28
+	VPORQ     Z28, Z6, Z6
+	VPANDQ    Z26, Z6, Z6
+	VPSRLQ    $28, Z2, Z7
+	VPSLLQ    $36, Z3, Z28
+	VPORQ     Z28, Z7, Z7
+	VPANDQ    Z26, Z7, Z7
+	VPSRLQ    $16, Z3, Z8
+	VPXORQ    Z14, Z14, Z14
+	VPXORQ    Z15, Z15, Z15
+	VPXORQ    Z16, Z16, Z16
+	VPXORQ    Z17, Z17, Z17
+	VPXORQ    Z18, Z18, Z18
+	VPXORQ    Z19, Z19, Z19
+
+	// t += a * b[0]
+	VPMADD52LUQ Z9, Z4, Z14
+	VPMADD52HUQ Z9, Z4, Z15
+	VPMADD52LUQ Z9, Z5, Z15
+	VPMADD52HUQ Z9, Z5, Z16
+	VPMADD52LUQ Z9, Z6, Z16
+	VPMADD52HUQ Z9, Z6, Z17
+	VPMADD52LUQ Z9, Z7, Z17
+	VPMADD52HUQ Z9, Z7, Z18
+	VPMADD52LUQ Z9, Z8, Z18
+	VPMADD52HUQ Z9, Z8, Z19
+
+	// m = t[0] * qInv52 mod 2⁵²; t += m * q
+	VPXORQ      Z27, Z27, Z27
+	VPMADD52LUQ Z25, Z14, Z27
+	VPMADD52LUQ Z27, Z20, Z14
+	VPMADD52HUQ Z27, Z20, Z15
+	VPMADD52LUQ Z27, Z21, Z15
+	VPMADD52HUQ Z27, Z21, Z16
+	VPMADD52LUQ Z27, Z22, Z16
+	VPMADD52HUQ Z27, Z22, Z17
+	VPMADD52LUQ Z27, Z23, Z17
+	VPMADD52HUQ Z27, Z23, Z18
+	VPMADD52LUQ Z27, Z24, Z18
+	VPMADD52HUQ Z27, Z24, Z19
+
+	// t[0] = 0 mod 2⁵²; shift t by one limb
+	VPSRLQ $52, Z14, Z28
+	VPADDQ Z28, Z15, Z15
+	VPXORQ Z14, Z14, Z14
+
+	// t += a * b[1]
+	VPMADD52LUQ Z10, Z4, Z15
+	VPMADD52HUQ Z10, Z4, Z16
+	VPMADD52LUQ Z10, Z5, Z16
+	VPMADD52HUQ Z10, Z5, Z17
+	VPMADD52LUQ Z10, Z6, Z17
+	VPMADD52HUQ Z10, Z6, Z18
+	VPMADD52LUQ Z10, Z7, Z18
+	VPMADD52HUQ Z10, Z7, Z19
+	VPMADD52LUQ Z10, Z8, Z19
+	VPMADD52HUQ Z10, Z8, Z14
+
+	// m = t[0] * qInv52 mod 2⁵²; t += m * q
+	VPXORQ      Z27, Z27, Z27
+	VPMADD52LUQ Z25, Z15, Z27
+	VPMADD52LUQ Z27, Z20, Z15
+	VPMADD52HUQ Z27, Z20, Z16
+	VPMADD52LUQ Z27, Z21, Z16
+	VPMADD52HUQ Z27, Z21, Z17
+	VPMADD52LUQ Z27, Z22, Z17
+	VPMADD52HUQ Z27, Z22, Z18
+	VPMADD52LUQ Z27, Z23, Z18
+	VPMADD52HUQ Z27, Z23, Z19
+	VPMADD52LUQ Z27, Z24, Z19
+	VPMADD52HUQ Z27, Z24, Z14
+
+	// t[0] = 0 mod 2⁵²; shift t by one limb
+	VPSRLQ $52, Z15, Z28
+	VPADDQ Z28, Z16, Z16
+	VPXORQ Z15, Z15, Z15
+
+	// t += a * b[2]
+	VPMADD52LUQ Z11, Z4, Z16
+	VPMADD52HUQ Z11, Z4, Z17
+	VPMADD52LUQ Z11, Z5, Z17
+	VPMADD52HUQ Z11, Z5, Z18
+	VPMADD52LUQ Z11, Z6, Z18
+	VPMADD52HUQ Z11, Z6, Z19
+	VPMADD52LUQ Z11, Z7, Z19
+	VPMADD52HUQ Z11, Z7, Z14
+	VPMADD52LUQ Z11, Z8, Z14
+	VPMADD52HUQ Z11, Z8, Z15
+
+	// m = t[0] * qInv52 mod 2⁵²; t += m * q
+	VPXORQ      Z27, Z27, Z27
+	VPMADD52LUQ Z25, Z16, Z27
+	VPMADD52LUQ Z27, Z20, Z16
+	VPMADD52HUQ Z27, Z20, Z17
+	VPMADD52LUQ Z27, Z21, Z17
+	VPMADD52HUQ Z27, Z21, Z18
+	VPMADD52LUQ Z27, Z22, Z18
+	VPMADD52HUQ Z27, Z22, Z19
+	VPMADD52LUQ Z27, Z23, Z19
+	VPMADD52HUQ Z27, Z23, Z14
+	VPMADD52LUQ Z27, Z24, Z14
+	VPMADD52HUQ Z27, Z24, Z15
+
+	// t[0] = 0 mod 2⁵²; shift t by one limb
+	VPSRLQ $52, Z16, Z28
+	VPADDQ Z28, Z17, Z17
+	VPXORQ Z16, Z16, Z16
+
+	// t += a * b[3]
+	VPMADD52LUQ Z12, Z4, Z17
+	VPMADD52HUQ Z12, Z4, Z18
+	VPMADD52LUQ Z12, Z5, Z18
+	VPMADD52HUQ Z12, Z5, Z19
+	VPMADD52LUQ Z12, Z6, Z19
+	VPMADD52HUQ Z12, Z6, Z14
+	VPMADD52LUQ Z12, Z7, Z14
+	VPMADD52HUQ Z12, Z7, Z15
+	VPMADD52LUQ Z12, Z8, Z15
+	VPMADD52HUQ Z12, Z8, Z16
+
+	// m = t[0] * qInv52 mod 2⁵²; t += m * q
+	VPXORQ      Z27, Z27, Z27
+	VPMADD52LUQ Z25, Z17, Z27
+	VPMADD52LUQ Z27, Z20, Z17
+	VPMADD52HUQ Z27, Z20, Z18
+	VPMADD52LUQ Z27, Z21, Z18
+	VPMADD52HUQ Z27, Z21, Z19
+	VPMADD52LUQ Z27, Z22, Z19
+	VPMADD52HUQ Z27, Z22, Z14
+	VPMADD52LUQ Z27, Z23, Z14
+	VPMADD52HUQ Z27, Z23, Z15
+	VPMADD52LUQ Z27, Z24, Z15
+	VPMADD52HUQ Z27, Z24, Z16
+
+	// t[0] = 0 mod 2⁵²; shift t by one limb
+	VPSRLQ $52, Z17, Z28
+	VPADDQ Z28, Z18, Z18
+	VPXORQ Z17, Z17, Z17
+
+	// t += a * b[4]
+	VPMADD52LUQ Z13, Z4, Z18
+	VPMADD52HUQ Z13, Z4, Z19
+	VPMADD52LUQ Z13, Z5, Z19
+	VPMADD52HUQ Z13, Z5, Z14
+	VPMADD52LUQ Z13, Z6, Z14
+	VPMADD52HUQ Z13, Z6, Z15
+	VPMADD52LUQ Z13, Z7, Z15
+	VPMADD52HUQ Z13, Z7, Z16
+	VPMADD52LUQ Z13, Z8, Z16
+	VPMADD52HUQ Z13, Z8, Z17
+
+	// m = t[0] * qInv52 mod 2⁵²; t += m * q
+	VPXORQ      Z27, Z27, Z27
+	VPMADD52LUQ Z25, Z18, Z27
+	VPMADD52LUQ Z27, Z20, Z18
+	VPMADD52HUQ Z27, Z20, Z19
+	VPMADD52LUQ Z27, Z21, Z19
+	VPMADD52HUQ Z27, Z21, Z14
+	VPMADD52LUQ Z27, Z22, Z14
+	VPMADD52HUQ Z27, Z22, Z15
+	VPMADD52LUQ Z27, Z23, Z15
+	VPMADD52HUQ Z27, Z23, Z16
+	VPMADD52LUQ Z27, Z24, Z16
+	VPMADD52HUQ Z27, Z24, Z17
+
+	// t[0] = 0 mod 2⁵²; shift t by one limb
+	VPSRLQ $52, Z18, Z28
+	VPADDQ Z28, Z19, Z19
+	VPXORQ Z18, Z18, Z18
+
+	// normalize the limbs of t
+	VPSRLQ $52, Z19, Z28
+	VPANDQ Z26, Z19, Z19
+	VPADDQ Z28, Z14, Z14
+	VPSRLQ $52, Z14, Z28
+	VPANDQ Z26, Z14, Z14
+	VPADDQ Z28, Z15, Z15
+	VPSRLQ $52, Z15, Z28
+	VPANDQ Z26, Z15, Z15
+	VPADDQ Z28, Z16, Z16
+	VPSRLQ $52, Z16, Z28
+	VPANDQ Z26, Z16, Z16
+	VPADDQ Z28, Z17, Z17
+
+	// a = t - q; keep t if the subtraction borrowed
+	VPSUBQ    Z20, Z19, Z4
+	VPSRAQ    $52, Z4, Z28
+	VPANDQ    Z26, Z4, Z4
+	VPSUBQ    Z21, Z14, Z5
+	VPADDQ    Z28, Z5, Z5
+	VPSRAQ    $52, Z5, Z28
+	VPANDQ    Z26, Z5, Z5
+	VPSUBQ    Z22, Z15, Z6
+	VPADDQ    Z28, Z6, Z6
+	VPSRAQ    $52, Z6, Z28
+	VPANDQ    Z26, Z6, Z6
+	VPSUBQ    Z23, Z16, Z7
+	VPADDQ    Z28, Z7, Z7
+	VPSRAQ    $52, Z7, Z28
+	VPANDQ    Z26, Z7, Z7
+	VPSUBQ    Z24, Z17, Z8
+	VPADDQ    Z28, Z8, Z8
+	VPMOVQ2M  Z8, K1
+	VMOVDQA64 Z19, K1, Z4
+	VMOVDQA64 Z14, K1, Z5
+	VMOVDQA64 Z15, K1, Z6
+	VMOVDQA64 Z16, K1, Z7
+	VMOVDQA64 Z17, K1, Z8
+
+	// convert the result back to 64-bit words and store it
+	VMOVDQA64 Z4, Z0
+	VPSLLQ    $52, Z5, Z28
+	VPORQ     Z28, Z0, Z0
+	VPSRLQ    $12, Z5, Z1
+	VPSLLQ    $40, Z6, Z28
+	VPORQ     Z28, Z1, Z1
+	VPSRLQ    $24, Z6, Z2
+	VPSLLQ    $28, Z7, Z28
+	VPORQ     Z28, Z2, Z2
+	VPSRLQ    $36, Z7, Z3
+	VPSLLQ    $16, Z8, Z28
+	VPORQ     Z28, Z3, Z3
+	VMOVDQU64 interleaveLo<>(SB), Z28
+	VPERMI2Q  Z1, Z0, Z28
+	VMOVDQU64 interleaveLo<>(SB), Z29
+	VPERMI2Q  Z3, Z2, Z29
+	VMOVDQU64 interleaveHi<>(SB), Z30
+	VPERMI2Q  Z1, Z0, Z30
+	VMOVDQU64 interleaveHi<>(SB), Z31
+	VPERMI2Q  Z3, Z2, Z31
+	VMOVDQU64 interleavePairsLo<>(SB), Z14
+	VPERMI2Q  Z29, Z28, Z14
+	VMOVDQU64 interleavePairsHi<>(SB), Z15
+	VPERMI2Q  Z29, Z28, Z15
+	VMOVDQU64 interleavePairsLo<>(SB), Z16
+	VPERMI2Q  Z31, Z30, Z16
+	VMOVDQU64 interleavePairsHi<>(SB), Z17
+	VPERMI2Q  Z31, Z30, Z17
+	VMOVDQU64 Z14, 0(CX)
+	VMOVDQU64 Z15, 64(CX)
+	VMOVDQU64 Z16, 128(CX)
+	VMOVDQU64 Z17, 192(CX)
+
+	// increment pointers to visit next elements
+	ADDQ $256, AX
+	ADDQ $256, CX
+	DECQ BX       // decrement n
+	JMP  l9
+
+l10:
+	VZEROUPPER
+	RET
@@ -17,16 +17,17 @@
 package fr
 
 import (
+	"bytes"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"math/bits"
-
 	mrand "math/rand"
-
+	"os"
 	"testing"
 
+	"github.com/consensys/gnark-crypto/utils"
 	"github.com/leanovate/gopter"
 	ggen "github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
@@ -110,6 +111,30 @@ func BenchmarkElementInverse(b *testing.B) {
 
 }
 
+func BenchmarkElementInverseCT(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchResElement.InverseCT(&x)
+	}
+}
+
+func BenchmarkElementMulAcc(b *testing.B) {
+	var x, y Element
+	x.SetRandom()
+	y.SetRandom()
+	var acc UnreducedAccumulator
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		acc.MulAcc(&x, &y)
+	}
+	acc.Reduce(&benchResElement)
+}
+
 func BenchmarkElementButterfly(b *testing.B) {
 	var x Element
 	x.SetRandom()
@@ -203,6 +228,36 @@ func BenchmarkElementSqrt(b *testing.B) {
 	}
 }
 
+func BenchmarkElementSqrtCT(b *testing.B) {
+	var a Element
+	a.SetUint64(4)
+	a.Neg(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.SqrtCT(&a)
+	}
+}
+func BenchmarkElementBatchSqrt(b *testing.B) {
+	const n = 1024
+	a := make([]Element, n)
+	for i := range a {
+		a[i].SetRandom()
+	}
+	b.Run("Sqrt", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			for i := range a {
+				benchResElement.Sqrt(&a[i])
+			}
+		}
+	})
+	b.Run("BatchSqrt", func(b *testing.B) {
+		b.ReportAllocs()
+		for j := 0; j < b.N; j++ {
+			BatchSqrt(a)
+		}
+	})
+}
+
 func BenchmarkElementMul(b *testing.B) {
 	x := Element{
 		2726216793283724667,
@@ -262,6 +317,32 @@ func TestElementCmp(t *testing.T) {
 		t.Fatal("x < y")
 	}
 }
+
+func TestElementSetRandomFrom(t *testing.T) {
+	// the same seed yields the same sequence of elements
+	r1, r2 := mrand.New(mrand.NewSource(42)), mrand.New(mrand.NewSource(42)) //#nosec G404 -- deterministic test fixture
+	for i := 0; i < 50; i++ {
+		var x, y Element
+		if _, err := x.SetRandomFrom(r1); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := y.SetRandomFrom(r2); err != nil {
+			t.Fatal(err)
+		}
+		if !x.Equal(&y) {
+			t.Fatal("SetRandomFrom should be deterministic for a given reader")
+		}
+		if !x.smallerThanModulus() {
+			t.Fatal("SetRandomFrom should return a reduced element")
+		}
+	}
+
+	// reading errors are reported
+	var x Element
+	if _, err := x.SetRandomFrom(bytes.NewReader(nil)); err == nil {
+		t.Fatal("SetRandomFrom should fail on an empty reader")
+	}
+}
 func TestElementIsRandom(t *testing.T) {
 	for i := 0; i < 50; i++ {
 		var x, y Element
@@ -500,6 +581,199 @@ func TestElementInverseExp(t *testing.T) {
 
 }
 
+func TestElementInverseCT(t *testing.T) {
+	invCTMatchInv := func(a testPairElement) bool {
+		var b, c Element
+		b.InverseCT(&a.element)
+		c.Inverse(&a.element)
+		return b.Equal(&c)
+	}
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+	genA := gen()
+	properties.Property("InverseCT == Inverse", prop.ForAll(invCTMatchInv, genA))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	parameters.MinSuccessfulTests = 1
+	properties = gopter.NewProperties(parameters)
+	properties.Property("InverseCT(0) == 0", prop.ForAll(invCTMatchInv, ggen.OneConstOf(testPairElement{})))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestElementAddSubCT(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+	genA := gen()
+	genB := gen()
+
+	properties.Property("AddCT == Add", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			c.AddCT(&a.element, &b.element)
+			d.Add(&a.element, &b.element)
+			return c.Equal(&d) && c.smallerThanModulus()
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("SubCT == Sub", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			c.SubCT(&a.element, &b.element)
+			d.Sub(&a.element, &b.element)
+			return c.Equal(&d) && c.smallerThanModulus()
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("AddCT and SubCT: having the receiver as operand should output the same result", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			c.Add(&a.element, &b.element)
+			d.Sub(&a.element, &b.element)
+			x, y := a.element, b.element
+			x.AddCT(&x, &b.element)
+			y.SubCT(&a.element, &y)
+			return x.Equal(&c) && y.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// edge cases
+	var zero, one, qMinusOne, z Element
+	one.SetOne()
+	qMinusOne.Neg(&one)
+	if !z.AddCT(&qMinusOne, &one).IsZero() || !z.AddCT(&qMinusOne, &zero).Equal(&qMinusOne) {
+		t.Fatal("AddCT: wrong result around q")
+	}
+	if !z.SubCT(&zero, &one).Equal(&qMinusOne) || !z.SubCT(&one, &one).IsZero() {
+		t.Fatal("SubCT: wrong result around 0")
+	}
+}
+
+func TestElementAddSubCTTiming(t *testing.T) {
+	// with the fixed input x = 1, x + y is never reduced and x - y always is (for y > 1),
+	// while random inputs are reduced about half the time
+	one := One()
+	var y, z Element
+	y.SetRandom()
+	testDudectElement(t, one, func(x *Element) {
+		x.SetRandom()
+	}, func(x *Element) {
+		z.AddCT(x, &y)
+		z.SubCT(x, &y)
+	})
+}
+
+func TestElementInverseCTTiming(t *testing.T) {
+	var z Element
+	testDudectElement(t, One(), func(x *Element) {
+		x.SetRandom()
+	}, func(x *Element) {
+		z.InverseCT(x)
+	})
+}
+
+func TestElementSqrtCT(t *testing.T) {
+	sqrtCTMatchSqrt := func(a testPairElement) bool {
+		var b, c Element
+		rCT := b.SqrtCT(&a.element)
+		r := c.Sqrt(&a.element)
+		if r == nil || rCT == nil {
+			return r == nil && rCT == nil
+		}
+		// the roots may differ by their sign
+		b.Square(&b)
+		return b.Equal(&a.element)
+	}
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+	genA := gen()
+	properties.Property("SqrtCT matches Sqrt", prop.ForAll(sqrtCTMatchSqrt, genA))
+	properties.Property("SqrtCT(x²) must exist", prop.ForAll(
+		func(a testPairElement) bool {
+			var square, b Element
+			square.Square(&a.element)
+			if b.SqrtCT(&square) == nil {
+				return false
+			}
+			b.Square(&b)
+			return b.Equal(&square)
+		},
+		genA,
+	))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	parameters.MinSuccessfulTests = 1
+	properties = gopter.NewProperties(parameters)
+	properties.Property("SqrtCT(0) == 0", prop.ForAll(sqrtCTMatchSqrt, ggen.OneConstOf(testPairElement{})))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestElementSqrtCTTiming(t *testing.T) {
+	// both classes of inputs are squares, as SqrtCT only hides which square root is computed
+	var fixed, z Element
+	fixed.SetUint64(4)
+	testDudectElement(t, fixed, func(x *Element) {
+		x.SetRandom()
+		x.Square(x)
+	}, func(x *Element) {
+		z.SqrtCT(x)
+	})
+}
+
+// testDudectElement runs a dudect-style timing test of f (see utils.DudectTStatistic),
+// comparing a fixed input with inputs drawn by random.
+// The test is skipped unless the DUDECT environment variable is set, as timing measurements are noisy
+// on shared machines; build with -tags purego to test the generic arithmetic instead of the assembly.
+func testDudectElement(t *testing.T, fixed Element, random, f func(x *Element)) {
+	if os.Getenv("DUDECT") == "" {
+		t.Skip("set DUDECT to run the timing test")
+	}
+	const nbMeasurements = 1 << 14
+	inputs := make([]Element, nbMeasurements)
+	prepare := func(i, class int) {
+		if class == 0 {
+			inputs[i] = fixed
+			return
+		}
+		random(&inputs[i])
+	}
+	run := func(i int) {
+		f(&inputs[i])
+	}
+	tStat := utils.DudectTStatistic(nbMeasurements, prepare, run)
+	t.Logf("t-statistic: %.2f", tStat)
+	if tStat > utils.DudectThreshold || tStat < -utils.DudectThreshold {
+		t.Fatalf("timing leakage detected: |t| = %.2f > %d", tStat, utils.DudectThreshold)
+	}
+}
+
 func mulByConstant(z *Element, c uint8) {
 	var y Element
 	y.SetUint64(uint64(c))
@@ -615,6 +889,78 @@ func TestElementLegendre(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
+func TestElementBatchSqrt(t *testing.T) {
+	assert := require.New(t)
+
+	t.Parallel()
+
+	// cover the empty input, a partial chunk and several chunks
+	for _, n := range []int{0, 1, 7, batchExpSize + 3, 2 * batchExpSize} {
+		a := make([]Element, n)
+		for i := range a {
+			if i%5 == 0 {
+				a[i].SetUint64(uint64(i))
+			} else {
+				a[i].SetRandom()
+			}
+		}
+
+		res, isSquare := BatchSqrt(a)
+		legendre := BatchLegendre(a)
+		assert.Equal(n, len(res))
+		assert.Equal(n, len(isSquare))
+		assert.Equal(n, len(legendre))
+
+		for i := range a {
+			var expected Element
+			ok := expected.Sqrt(&a[i]) != nil
+			assert.Equal(ok, isSquare[i], "BatchSqrt and Sqrt disagree on squareness")
+			assert.Equal(a[i].Legendre(), legendre[i], "BatchLegendre != Legendre")
+			if ok {
+				assert.True(res[i].Equal(&expected), "BatchSqrt != Sqrt")
+			}
+		}
+	}
+}
+
+func TestElementUnreducedAccumulator(t *testing.T) {
+	assert := require.New(t)
+
+	t.Parallel()
+
+	// the largest element (all products of q-1 words) stresses the carries
+	var max Element
+	max = qElement
+	max[0]--
+
+	for _, n := range []int{0, 1, 2, 7, 64, 1000} {
+		var acc UnreducedAccumulator
+		var expected, tmp Element
+		for i := 0; i < n; i++ {
+			var x, y Element
+			if i%3 == 0 {
+				x, y = max, max
+			} else {
+				x.SetRandom()
+				y.SetRandom()
+			}
+			acc.MulAcc(&x, &y)
+			tmp.Mul(&x, &y)
+			expected.Add(&expected, &tmp)
+		}
+		var res Element
+		acc.Reduce(&res)
+		assert.True(res.Equal(&expected), "Reduce(Σ MulAcc) != Σ Mul, n = %d", n)
+
+		// Reduce leaves the accumulator unchanged
+		acc.Reduce(&res)
+		assert.True(res.Equal(&expected), "second Reduce differs, n = %d", n)
+
+		acc.Reset()
+		acc.Reduce(&res)
+		assert.True(res.IsZero(), "Reset accumulator should reduce to 0")
+	}
+}
 
 func TestElementBitLen(t *testing.T) {
 	t.Parallel()
@@ -0,0 +1,357 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"crypto/rand"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// E2 is a degree 2 extension of fr.Element, E2 = 𝔽[u]/(u² - (11))
+type E2 struct {
+	A0, A1 fr.Element
+}
+
+// nonResidueE2 is α such that E2 = 𝔽[u]/(u² - α)
+var nonResidueE2 = func() fr.Element {
+	var alpha fr.Element
+	alpha.SetInt64(11)
+	return alpha
+}()
+
+// mulByNonResidueE2 sets z = α·x
+func mulByNonResidueE2(z, x *fr.Element) {
+	z.Mul(x, &nonResidueE2)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E2) Equal(x *E2) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E2) SetZero() *E2 {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E2) SetOne() *E2 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E2) Set(x *E2) *E2 {
+	*z = *x
+	return z
+}
+
+// SetRandom sets the coordinates of z to random values and returns z
+func (z *E2) SetRandom() (*E2, error) {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom is like SetRandom, reading the randomness from r
+func (z *E2) SetRandomFrom(r io.Reader) (*E2, error) {
+	if _, err := z.A0.SetRandomFrom(r); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandomFrom(r); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *E2) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *E2) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero()
+}
+
+// Add sets z = x + y and returns z
+func (z *E2) Add(x, y *E2) *E2 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E2) Sub(x, y *E2) *E2 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E2) Double(x *E2) *E2 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E2) Neg(x *E2) *E2 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// MulByElement sets z = x·y for y in the base field and returns z
+func (z *E2) MulByElement(x *E2, y *fr.Element) *E2 {
+	var yCopy fr.Element
+	yCopy.Set(y)
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E2) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*u"
+}
+
+// Mul sets z = x·y and returns z
+func (z *E2) Mul(x, y *E2) *E2 {
+	// Karatsuba
+	var a, b, v0, v1 fr.Element
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	v0.Mul(&x.A0, &y.A0)
+	v1.Mul(&x.A1, &y.A1)
+	z.A1.Mul(&a, &b).Sub(&z.A1, &v0).Sub(&z.A1, &v1)
+	mulByNonResidueE2(&v1, &v1)
+	z.A0.Add(&v0, &v1)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *E2) Square(x *E2) *E2 {
+	// complex squaring: (a0 + a1·u)² = (a0 + a1)(a0 + α·a1) - (1 + α)·a0·a1 + 2·a0·a1·u
+	var a, b, v fr.Element
+	mulByNonResidueE2(&b, &x.A1)
+	b.Add(&b, &x.A0)
+	a.Add(&x.A0, &x.A1)
+	v.Mul(&x.A0, &x.A1)
+	a.Mul(&a, &b).Sub(&a, &v)
+	mulByNonResidueE2(&b, &v)
+	z.A0.Sub(&a, &b)
+	z.A1.Double(&v)
+	return z
+}
+
+// Conjugate sets z to the conjugate of x, a0 - a1·u, and returns z
+func (z *E2) Conjugate(x *E2) *E2 {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// norm sets n to the norm of z, a0² - α·a1²
+func (z *E2) norm(n *fr.Element) {
+	var t fr.Element
+	n.Square(&z.A0)
+	t.Square(&z.A1)
+	mulByNonResidueE2(&t, &t)
+	n.Sub(n, &t)
+}
+
+// Inverse sets z to the inverse of x and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E2) Inverse(x *E2) *E2 {
+	// x⁻¹ = conjugate(x) / norm(x)
+	var n fr.Element
+	x.norm(&n)
+	n.Inverse(&n)
+	z.A0.Mul(&x.A0, &n)
+	z.A1.Mul(&x.A1, &n).Neg(&z.A1)
+	return z
+}
+
+// frobeniusCoeffE2 holds the γᵢ such that (aᵢuⁱ)ᵖ = γᵢ·aᵢ·u^σ(i)
+var frobeniusCoeffE2 = func() (gamma [2]fr.Element) {
+	setString(&gamma[1], "8444461749428370424248824938781546531375899335154063827935233455917409239040")
+	return
+}()
+
+// Frobenius sets z = xᵖ, with p the characteristic, and returns z
+func (z *E2) Frobenius(x *E2) *E2 {
+	var c [2]fr.Element
+	c[0] = x.A0
+	c[1].Mul(&x.A1, &frobeniusCoeffE2[1])
+	z.A0 = c[0]
+	z.A1 = c[1]
+	return z
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+//
+// z is a square in E2 if and only if its norm is a square in the base field
+func (z *E2) Legendre() int {
+	var n fr.Element
+	z.norm(&n)
+	return n.Legendre()
+}
+
+// sqrtExponentE2 is (s-1)/2, where p² - 1 = 2ᵉ·s with s odd
+var sqrtExponentE2, _ = new(big.Int).SetString("ae4680f11a03c0eb8bef14e0ce1f62c753b99a2b3c7a730fef5da0a41d384ebf3aabf1f2bfe2b704770dea42c2e801e3eea697f00000010a11", 16)
+
+// sqrtGE2 is cˢ for a non-square c in E2
+var sqrtGE2 = func() (g E2) {
+	setString(&g.A0, "0")
+	setString(&g.A1, "7687255293984182515855825202371059313689740765941366953488849191288775753445")
+	return
+}()
+
+// Sqrt z = √x
+// if the square root doesn't exist (x is not a square in E2)
+// Sqrt leaves z unchanged and returns nil
+func (z *E2) Sqrt(x *E2) *E2 {
+	// Tonelli-Shanks, see Sqrt in the base field
+	var w, y, b, t E2
+
+	// w = x^((s-1)/2)
+	w.Exp(*x, sqrtExponentE2)
+
+	// y = x^((s+1)/2) = w * x
+	y.Mul(x, &w)
+
+	// b = xˢ = w * w * x = y * w
+	b.Mul(&w, &y)
+
+	g := sqrtGE2
+	r := uint64(48)
+
+	// t = x^((p²-1)/2) = r-1 squaring of xˢ
+	t = b
+	for i := uint64(0); i < r-1; i++ {
+		t.Square(&t)
+	}
+	if t.IsZero() {
+		return z.SetZero()
+	}
+	if !t.IsOne() {
+		// t != 1, we don't have a square root
+		return nil
+	}
+	for {
+		var m uint64
+		t = b
+
+		// for t != 1
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1))
+		ge := int(r - m - 1)
+		t = g
+		for ge > 0 {
+			t.Square(&t)
+			ge--
+		}
+
+		g.Square(&t)
+		y.Mul(&y, &t)
+		b.Mul(&b, &g)
+		r = m
+	}
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *E2) Exp(x E2, k *big.Int) *E2 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = bigIntPool.Get().(*big.Int)
+		defer bigIntPool.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// BatchInvertE2 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE2(a []E2) []E2 {
+	res := make([]E2, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E2
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+func TestVectorE3Ops(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{0, 1, 7, 64} {
+		a, b := make(VectorE3, n), make(VectorE3, n)
+		for i := 0; i < n; i++ {
+			a[i].SetRandom()
+			b[i].SetRandom()
+		}
+		var s E3
+		s.SetRandom()
+		var e fr.Element
+		e.SetRandom()
+
+		sum, sub, mul, scalarMul, byElement := make(VectorE3, n), make(VectorE3, n), make(VectorE3, n), make(VectorE3, n), make(VectorE3, n)
+		sum.Add(a, b)
+		sub.Sub(a, b)
+		mul.Mul(a, b)
+		scalarMul.ScalarMul(a, &s)
+		byElement.ScalarMulByElement(a, &e)
+
+		var expectedSum, expectedInnerProduct, tmp E3
+		for i := 0; i < n; i++ {
+			if !sum[i].Equal(tmp.Add(&a[i], &b[i])) ||
+				!sub[i].Equal(tmp.Sub(&a[i], &b[i])) ||
+				!mul[i].Equal(tmp.Mul(&a[i], &b[i])) ||
+				!scalarMul[i].Equal(tmp.Mul(&a[i], &s)) ||
+				!byElement[i].Equal(tmp.MulByElement(&a[i], &e)) {
+				t.Fatalf("element-wise operation mismatch at index %d, n = %d", i, n)
+			}
+			expectedSum.Add(&expectedSum, &a[i])
+			tmp.Mul(&a[i], &b[i])
+			expectedInnerProduct.Add(&expectedInnerProduct, &tmp)
+		}
+
+		s = a.Sum()
+		if !s.Equal(&expectedSum) {
+			t.Fatalf("Sum mismatch, n = %d", n)
+		}
+		s = a.InnerProduct(b)
+		if !s.Equal(&expectedInnerProduct) {
+			t.Fatalf("InnerProduct mismatch, n = %d", n)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// VectorE4 represents a slice of E4.
+type VectorE4 []E4
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE4) Add(a, b VectorE4) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Add(&a[i], &b[i])
+	}
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE4) Sub(a, b VectorE4) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Sub(&a[i], &b[i])
+	}
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE4) ScalarMul(a VectorE4, b *E4) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Mul(&a[i], b)
+	}
+}
+
+// ScalarMulByElement multiplies a vector by a scalar of the base field element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE4) ScalarMulByElement(a VectorE4, b *fr.Element) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMulByElement: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].MulByElement(&a[i], b)
+	}
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE4) Mul(a, b VectorE4) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Mul(&a[i], &b[i])
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *VectorE4) Sum() (res E4) {
+	for i := 0; i < len(*vector); i++ {
+		res.Add(&res, &(*vector)[i])
+	}
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *VectorE4) InnerProduct(other VectorE4) (res E4) {
+	if len(*vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+
+	// the products of coordinates are summed with lazy reduction, keeping apart the terms in u⁴
+	// which are multiplied by α, and each coordinate of the result is reduced once
+	var lo, hi [4]fr.UnreducedAccumulator
+	for i := range other {
+		a, b := &(*vector)[i], &other[i]
+		lo[0].MulAcc(&a.A0, &b.A0)
+		hi[0].MulAcc(&a.A1, &b.A3)
+		hi[0].MulAcc(&a.A2, &b.A2)
+		hi[0].MulAcc(&a.A3, &b.A1)
+		lo[1].MulAcc(&a.A0, &b.A1)
+		lo[1].MulAcc(&a.A1, &b.A0)
+		hi[1].MulAcc(&a.A2, &b.A3)
+		hi[1].MulAcc(&a.A3, &b.A2)
+		lo[2].MulAcc(&a.A0, &b.A2)
+		lo[2].MulAcc(&a.A1, &b.A1)
+		lo[2].MulAcc(&a.A2, &b.A0)
+		hi[2].MulAcc(&a.A3, &b.A3)
+		lo[3].MulAcc(&a.A0, &b.A3)
+		lo[3].MulAcc(&a.A1, &b.A2)
+		lo[3].MulAcc(&a.A2, &b.A1)
+		lo[3].MulAcc(&a.A3, &b.A0)
+	}
+
+	var h fr.Element
+	lo[0].Reduce(&res.A0)
+	hi[0].Reduce(&h)
+	mulByNonResidueE4(&h, &h)
+	res.A0.Add(&res.A0, &h)
+	lo[1].Reduce(&res.A1)
+	hi[1].Reduce(&h)
+	mulByNonResidueE4(&h, &h)
+	res.A1.Add(&res.A1, &h)
+	lo[2].Reduce(&res.A2)
+	hi[2].Reduce(&h)
+	mulByNonResidueE4(&h, &h)
+	res.A2.Add(&res.A2, &h)
+	lo[3].Reduce(&res.A3)
+	return
+}
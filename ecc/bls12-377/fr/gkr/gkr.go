@@ -227,12 +227,9 @@ func (c *eqTimesGateEvalSumcheckClaims) eqAcc(e, m polynomial.MultiLin, q []fr.E
 
 	}
 	c.manager.workers.Submit(len(e), func(start, end int) {
-		for i := start; i < end; i++ {
-			e[i].Add(&e[i], &m[i])
-		}
+		eI := fr.Vector(e[start:end])
+		eI.Add(eI, fr.Vector(m[start:end]))
 	}, 512).Wait()
-
-	// e.Add(e, polynomial.Polynomial(m))
 }
 
 // computeGJ: gⱼ = ∑_{0≤i<2ⁿ⁻ʲ} g(r₁, r₂, ..., rⱼ₋₁, Xⱼ, i...) = ∑_{0≤i<2ⁿ⁻ʲ} E(r₁, ..., X_j, i...) R_v( P_u0(r₁, ..., X_j, i...), ... ) where  E = ∑ eq_k
@@ -257,28 +254,52 @@ func (c *eqTimesGateEvalSumcheckClaims) computeGJ() polynomial.Polynomial {
 	computeAll := func(start, end int) {
 		var step fr.Element
 
+		// the gate evaluations and the E values at the points 1, ..., degGJ are gathered
+		// by chunks, whose inner products are then summed into the evaluations of gⱼ
+		const chunkSize = 256
+		n := end - start
+		if n > chunkSize {
+			n = chunkSize
+		}
+		eqs := make([]fr.Vector, degGJ)
+		gates := make([]fr.Vector, degGJ)
+		for d := range eqs {
+			eqs[d] = make(fr.Vector, n)
+			gates[d] = make(fr.Vector, n)
+		}
 		res := make([]fr.Element, degGJ)
 		operands := make([]fr.Element, degGJ*nbInner)
 
-		for i := start; i < end; i++ {
+		for chunkStart := start; chunkStart < end; chunkStart += chunkSize {
+			chunkEnd := chunkStart + chunkSize
+			if chunkEnd > end {
+				chunkEnd = end
+			}
+
+			for i := chunkStart; i < chunkEnd; i++ {
+				block := nbOuter + i
+				for j := 0; j < nbInner; j++ {
+					step.Set(&s[j][i])
+					operands[j].Set(&s[j][block])
+					step.Sub(&operands[j], &step)
+					for d := 1; d < degGJ; d++ {
+						operands[d*nbInner+j].Add(&operands[(d-1)*nbInner+j], &step)
+					}
+				}
 
-			block := nbOuter + i
-			for j := 0; j < nbInner; j++ {
-				step.Set(&s[j][i])
-				operands[j].Set(&s[j][block])
-				step.Sub(&operands[j], &step)
-				for d := 1; d < degGJ; d++ {
-					operands[d*nbInner+j].Add(&operands[(d-1)*nbInner+j], &step)
+				_s := 0
+				_e := nbInner
+				for d := 0; d < degGJ; d++ {
+					gates[d][i-chunkStart] = c.wire.Gate.Evaluate(operands[_s+1 : _e]...)
+					eqs[d][i-chunkStart].Set(&operands[_s])
+					_s, _e = _e, _e+nbInner
 				}
 			}
 
-			_s := 0
-			_e := nbInner
 			for d := 0; d < degGJ; d++ {
-				summand := c.wire.Gate.Evaluate(operands[_s+1 : _e]...)
-				summand.Mul(&summand, &operands[_s])
-				res[d].Add(&res[d], &summand)
-				_s, _e = _e, _e+nbInner
+				eqD := eqs[d][:chunkEnd-chunkStart]
+				sum := eqD.InnerProduct(gates[d][:chunkEnd-chunkStart])
+				res[d].Add(&res[d], &sum)
 			}
 		}
 		mu.Lock()
@@ -17,9 +17,11 @@
 package kzg
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"github.com/stretchr/testify/assert"
 	"math/big"
+	"math/rand"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
@@ -88,6 +90,31 @@ func TestSerializationSRS(t *testing.T) {
 	t.Run("whole SRS round-trip", utils.SerializationRoundTrip(srs))
 }
 
+func TestNewSRSFrom(t *testing.T) {
+	assert := assert.New(t)
+
+	// the same seed yields the same SRS
+	srs1, err := NewSRSFrom(64, rand.New(rand.NewSource(42))) //#nosec G404 -- deterministic test fixture
+	assert.NoError(err)
+	srs2, err := NewSRSFrom(64, rand.New(rand.NewSource(42))) //#nosec G404 -- deterministic test fixture
+	assert.NoError(err)
+	assert.Equal(srs1.Pk.G1, srs2.Pk.G1)
+	assert.True(srs1.Vk.G2[1].Equal(&srs2.Vk.G2[1]))
+
+	// and the SRS is consistent
+	f := randomPolynomial(60)
+	digest, err := Commit(f, srs1.Pk)
+	assert.NoError(err)
+	var point fr.Element
+	point.SetString("4321")
+	proof, err := Open(f, point, srs1.Pk)
+	assert.NoError(err)
+	assert.NoError(Verify(&digest, &proof, point, srs1.Vk))
+
+	_, err = NewSRSFrom(64, bytes.NewReader(nil))
+	assert.Error(err)
+}
+
 func TestCommit(t *testing.T) {
 
 	// create a polynomial
@@ -152,6 +179,22 @@ func TestVerifySinglePoint(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	{
+		// verifying key built without the precomputed lines
+		vk := VerifyingKey{G2: testSrs.Vk.G2, G1: testSrs.Vk.G1}
+		err = Verify(&digest, &proof, point, vk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var wrong OpeningProof
+		wrong.H = proof.H
+		wrong.ClaimedValue.Double(&proof.ClaimedValue)
+		err = Verify(&digest, &wrong, point, vk)
+		if err == nil {
+			t.Fatal("verifying wrong proof without the precomputed lines should have failed")
+		}
+	}
+
 	{
 		// verify wrong proof
 		proof.ClaimedValue.Double(&proof.ClaimedValue)
@@ -172,6 +215,61 @@ func TestVerifySinglePoint(t *testing.T) {
 	}
 }
 
+func TestVerifyingKeyModifiedG2(t *testing.T) {
+
+	// the lines of testSrs.Vk were precomputed by NewSRS, for α = 42
+	otherSrs, err := NewSRS(64, new(big.Int).SetInt64(43))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := randomPolynomial(60)
+	var point fr.Element
+	point.SetString("4321")
+	digest, err := Commit(f, otherSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := Open(f, point, otherSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// vk shares the lines of testSrs.Vk, which don't match its G2 anymore
+	vk := testSrs.Vk
+	vk.G2 = otherSrs.Vk.G2
+	if err := Verify(&digest, &proof, point, vk); err != nil {
+		t.Fatal(err)
+	}
+
+	// a proof for α = 42 must not verify under α = 43
+	digestSrs, err := Commit(f, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proofSrs, err := Open(f, point, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Verify(&digestSrs, &proofSrs, point, vk); err == nil {
+		t.Fatal("verifying a proof under another G2 should have failed")
+	}
+
+	// with the lines of the new G2
+	vk.PrecomputeLines()
+	if err := Verify(&digest, &proof, point, vk); err != nil {
+		t.Fatal(err)
+	}
+	if err := Verify(&digestSrs, &proofSrs, point, vk); err == nil {
+		t.Fatal("verifying a proof under another G2 should have failed")
+	}
+
+	// testSrs.Vk keeps its own lines
+	if err := Verify(&digestSrs, &proofSrs, point, testSrs.Vk); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestBatchVerifySinglePoint(t *testing.T) {
 
 	size := 40
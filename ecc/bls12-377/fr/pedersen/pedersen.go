@@ -39,30 +39,25 @@ type VerifyingKey struct {
 	gRootSigmaNeg curve.G2Affine //gRootSigmaNeg = g^{-1/σ}
 }
 
-func randomFrSizedBytes() ([]byte, error) {
-	res := make([]byte, fr.Bytes)
-	_, err := rand.Read(res)
-	return res, err
-}
-
-func randomOnG2() (curve.G2Affine, error) { // TODO: Add to G2.go?
-	if gBytes, err := randomFrSizedBytes(); err != nil {
-		return curve.G2Affine{}, err
-	} else {
-		return curve.HashToG2(gBytes, []byte("random on g2"))
-	}
+// Setup generates the proving keys for the given bases, and the verifying key, using crypto/rand
+// as randomness source.
+func Setup(bases ...[]curve.G1Affine) (pk []ProvingKey, vk VerifyingKey, err error) {
+	return SetupFrom(rand.Reader, bases...)
 }
 
-func Setup(bases ...[]curve.G1Affine) (pk []ProvingKey, vk VerifyingKey, err error) {
+// SetupFrom is like Setup, reading the randomness from r. With a deterministic (seeded) reader
+// the keys are reproducible, which is only suitable for tests and benchmarks: anyone knowing r
+// knows the trapdoor σ.
+func SetupFrom(r io.Reader, bases ...[]curve.G1Affine) (pk []ProvingKey, vk VerifyingKey, err error) {
 
-	if vk.g, err = randomOnG2(); err != nil {
+	if _, err = vk.g.RandomPoint(r); err != nil {
 		return
 	}
 
 	var modMinusOne big.Int
 	modMinusOne.Sub(fr.Modulus(), big.NewInt(1))
 	var sigma *big.Int
-	if sigma, err = rand.Int(rand.Reader, &modMinusOne); err != nil {
+	if sigma, err = rand.Int(r, &modMinusOne); err != nil {
 		return
 	}
 	sigma.Add(sigma, big.NewInt(1))
@@ -17,12 +17,17 @@
 package bls12377
 
 import (
+	"crypto/subtle"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"math/bits"
+	"runtime"
+
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark-crypto/internal/parallel"
-	"math/big"
-	"runtime"
 )
 
 // G1Affine point in affine coordinates
@@ -49,8 +54,8 @@ func (p *G1Affine) Set(a *G1Affine) *G1Affine {
 	return p
 }
 
-// setInfinity sets p to O
-func (p *G1Affine) setInfinity() *G1Affine {
+// SetInfinity sets p to the infinity point O, encoded as (0,0)
+func (p *G1Affine) SetInfinity() *G1Affine {
 	p.X.SetZero()
 	p.Y.SetZero()
 	return p
@@ -65,6 +70,24 @@ func (p *G1Affine) ScalarMultiplication(a *G1Affine, s *big.Int) *G1Affine {
 	return p
 }
 
+// ScalarMul computes and returns p = a ⋅ s, for s in the scalar field
+func (p *G1Affine) ScalarMul(a *G1Affine, s *fr.Element) *G1Affine {
+	var bs big.Int
+	s.BigInt(&bs)
+	return p.ScalarMultiplication(a, &bs)
+}
+
+// RandomPoint sets p to a uniformly random point of the prime order subgroup, reading the randomness from r,
+// and returns p. It computes p = [s]g for a random scalar s, so that with a deterministic (seeded) reader
+// the points are reproducible; the discrete logarithm of p is not secret with respect to the reader.
+func (p *G1Affine) RandomPoint(r io.Reader) (*G1Affine, error) {
+	var s fr.Element
+	if _, err := s.SetRandomFrom(r); err != nil {
+		return nil, err
+	}
+	return p.ScalarMul(&g1GenAff, &s), nil
+}
+
 // ScalarMultiplicationAffine computes and returns p = a ⋅ s
 // Takes an affine point and returns a Jacobian point (useful for KZG)
 func (p *G1Jac) ScalarMultiplicationAffine(a *G1Affine, s *big.Int) *G1Jac {
@@ -182,6 +205,19 @@ func (p *G1Jac) Set(a *G1Jac) *G1Jac {
 	return p
 }
 
+// SetInfinity sets p to the infinity point O, encoded as (1,1,0)
+func (p *G1Jac) SetInfinity() *G1Jac {
+	p.X.SetOne()
+	p.Y.SetOne()
+	p.Z.SetZero()
+	return p
+}
+
+// IsInfinity checks if the point is infinity (Z == 0)
+func (p *G1Jac) IsInfinity() bool {
+	return p.Z.IsZero()
+}
+
 // Equal tests if two points (in Jacobian coordinates) are equal
 func (p *G1Jac) Equal(a *G1Jac) bool {
 
@@ -213,6 +249,22 @@ func (p *G1Jac) SubAssign(a *G1Jac) *G1Jac {
 	return p
 }
 
+// Sub sets p to a - b and returns p
+func (p *G1Jac) Sub(a, b *G1Jac) *G1Jac {
+	var tmp G1Jac
+	tmp.Set(a)
+	tmp.SubAssign(b)
+	return p.Set(&tmp)
+}
+
+// Add sets p to a + b and returns p
+func (p *G1Jac) Add(a, b *G1Jac) *G1Jac {
+	var tmp G1Jac
+	tmp.Set(a)
+	tmp.AddAssign(b)
+	return p.Set(&tmp)
+}
+
 // AddAssign point addition in montgomery form
 // https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian-3.html#addition-add-2007-bl
 func (p *G1Jac) AddAssign(a *G1Jac) *G1Jac {
@@ -361,6 +413,13 @@ func (p *G1Jac) ScalarMultiplication(a *G1Jac, s *big.Int) *G1Jac {
 	return p.mulGLV(a, s)
 }
 
+// ScalarMul computes and returns p = a ⋅ s, for s in the scalar field
+func (p *G1Jac) ScalarMul(a *G1Jac, s *fr.Element) *G1Jac {
+	var bs big.Int
+	s.BigInt(&bs)
+	return p.ScalarMultiplication(a, &bs)
+}
+
 // String returns canonical representation of the point in affine coordinates
 func (p *G1Jac) String() string {
 	_p := G1Affine{}
@@ -616,6 +675,253 @@ func (p *G1Jac) JointScalarMultiplicationBase(a *G1Affine, s1, s2 *big.Int) *G1J
 
 }
 
+// -------------------------------------------------------------------------------------------------
+// Constant-time scalar multiplication
+
+// ctWindowSize is the window size of the regular signed recoding used by the constant-time
+// scalar multiplications; the lookup tables hold the 2^{ctWindowSize-1} first odd multiples of the base.
+const ctWindowSize = 4
+
+// ctNbDigits is the number of signed digits of a scalar k < 2r recoded in windows of ctWindowSize bits.
+const ctNbDigits = (fr.Bits+ctWindowSize)/ctWindowSize + 1
+
+// frModulusWords is r, the order of the prime subgroup, in little-endian 64-bit words.
+var frModulusWords = func() (res [fr.Limbs]uint64) {
+	b := fr.Modulus().FillBytes(make([]byte, fr.Limbs*8))
+	for i := 0; i < fr.Limbs; i++ {
+		res[i] = binary.BigEndian.Uint64(b[(fr.Limbs-1-i)*8:])
+	}
+	return
+}()
+
+// oddScalarWords returns k ≡ s (mod r) with k odd, in little-endian 64-bit words.
+// k is either s mod r or (s mod r) + r, the choice is made without branching on s.
+func oddScalarWords(s *big.Int) (k [fr.Limbs + 1]uint64) {
+	var e fr.Element
+	b := e.SetBigInt(s).Bits()
+	mask := (b[0] & 1) - 1 // all ones if b is even, 0 otherwise
+	var carry uint64
+	for i := 0; i < fr.Limbs; i++ {
+		k[i], carry = bits.Add64(b[i], frModulusWords[i]&mask, carry)
+	}
+	k[fr.Limbs] = carry
+	return
+}
+
+// g1ProjComplete is a point in homogeneous projective coordinates (x=X/Z, y=Y/Z), the point at
+// infinity being (0:1:0). It is used with complete addition formulas, which have no exceptional case
+// and thus no branch.
+type g1ProjComplete struct {
+	X, Y, Z fp.Element
+}
+
+// g1InverseExponentCT is q-2, where q is the size of the coordinates field, such that z^(q-2) = z⁻¹ for z ≠ 0
+var g1InverseExponentCT = func() *big.Int {
+	q := fp.Modulus()
+	return q.Sub(q, big.NewInt(2))
+}()
+
+// ScalarMultiplicationCT computes and returns p = a ⋅ s in constant time with respect to s.
+//
+// s mod r is recoded in regular signed digits (cf ecc.RegularSignedDecomposition), and the multiples of a
+// are selected from a lookup table with a constant-time scan, and added with complete formulas; the sequence
+// of group operations and memory accesses doesn't depend on s. The projective coordinates of a are randomized
+// beforehand. It is meant for secret scalars (private keys, nonces, ...) and is slower than ScalarMultiplication.
+//
+// a must be in the prime order subgroup.
+func (p *G1Affine) ScalarMultiplicationCT(a *G1Affine, s *big.Int) *G1Affine {
+	var _a G1Jac
+	var res g1ProjComplete
+	_a.FromAffine(a)
+	res.mulCT(&_a, s)
+	return p.fromProjCompleteCT(&res)
+}
+
+// ScalarMultiplicationCT computes and returns p = a ⋅ s in constant time with respect to s.
+//
+// See G1Affine.ScalarMultiplicationCT; a must be in the prime order subgroup.
+func (p *G1Jac) ScalarMultiplicationCT(a *G1Jac, s *big.Int) *G1Jac {
+	var res g1ProjComplete
+	res.mulCT(a, s)
+	return p.fromProjComplete(&res)
+}
+
+// ScalarMultiplicationBaseCT computes and returns p = g ⋅ s in constant time with respect to s,
+// where g is the prime subgroup generator.
+//
+// See G1Affine.ScalarMultiplicationCT.
+func (p *G1Affine) ScalarMultiplicationBaseCT(s *big.Int) *G1Affine {
+	var res g1ProjComplete
+	res.mulCT(&g1Gen, s)
+	return p.fromProjCompleteCT(&res)
+}
+
+// mulCT sets p = a ⋅ s using a fixed-window regular signed recoding of s mod r.
+func (p *g1ProjComplete) mulCT(a *G1Jac, s *big.Int) *g1ProjComplete {
+	var b3 fp.Element
+	b3.Double(&bCurveCoeff).Add(&b3, &bCurveCoeff)
+
+	// table[i] = (2i+1)⋅a
+	var table [1 << (ctWindowSize - 1)]g1ProjComplete
+	var a2 g1ProjComplete
+	table[0].fromJacobian(a)
+
+	// randomize the projective representative of a, such that the field operations don't operate
+	// on predictable values (the field additions branch on their reductions).
+	var lambda fp.Element
+	if _, err := lambda.SetRandom(); err != nil || lambda.IsZero() {
+		lambda.SetOne()
+	}
+	table[0].X.Mul(&table[0].X, &lambda)
+	table[0].Y.Mul(&table[0].Y, &lambda)
+	table[0].Z.Mul(&table[0].Z, &lambda)
+	a2.double(&table[0], &b3)
+	for i := 1; i < len(table); i++ {
+		table[i].add(&table[i-1], &a2, &b3)
+	}
+
+	// k ≡ s (mod r) and k is odd; since a is in the r-torsion, k⋅a = s⋅a
+	k := oddScalarWords(s)
+	var digits [ctNbDigits]int8
+	ecc.RegularSignedDecomposition(k[:], ctWindowSize, digits[:])
+
+	var res, tmp g1ProjComplete
+	res.lookupCT(&table, digits[len(digits)-1])
+	for i := len(digits) - 2; i >= 0; i-- {
+		for j := 0; j < ctWindowSize; j++ {
+			res.double(&res, &b3)
+		}
+		tmp.lookupCT(&table, digits[i])
+		res.add(&res, &tmp, &b3)
+	}
+	p.Set(&res)
+	return p
+}
+
+// lookupCT sets p = d ⋅ a, d being an odd digit in [-(2^ctWindowSize-1), 2^ctWindowSize-1]
+// and table[i] = (2i+1)⋅a. All the entries of the table are read, and the result is negated
+// or not, without branching on d.
+func (p *g1ProjComplete) lookupCT(table *[1 << (ctWindowSize - 1)]g1ProjComplete, d int8) *g1ProjComplete {
+	sign := int32(d) >> 31                       // -1 if d < 0, 0 otherwise
+	idx := (((int32(d) ^ sign) - sign) - 1) >> 1 // (|d|-1)/2
+	p.Set(&table[0])
+	for i := 1; i < len(table); i++ {
+		c := subtle.ConstantTimeEq(int32(i), idx)
+		p.X.Select(c, &p.X, &table[i].X)
+		p.Y.Select(c, &p.Y, &table[i].Y)
+		p.Z.Select(c, &p.Z, &table[i].Z)
+	}
+	var negY fp.Element
+	negY.Neg(&p.Y)
+	p.Y.Select(int(sign&1), &p.Y, &negY)
+	return p
+}
+
+// Set sets p to the provided point
+func (p *g1ProjComplete) Set(a *g1ProjComplete) *g1ProjComplete {
+	p.X, p.Y, p.Z = a.X, a.Y, a.Z
+	return p
+}
+
+// fromJacobian sets p = Q, p in homogeneous projective, Q in Jacobian
+func (p *g1ProjComplete) fromJacobian(Q *G1Jac) *g1ProjComplete {
+	// (X:Y:Z) in Jacobian is (XZ:Y:Z³) in homogeneous projective
+	p.X.Mul(&Q.X, &Q.Z)
+	p.Y.Set(&Q.Y)
+	p.Z.Square(&Q.Z).Mul(&p.Z, &Q.Z)
+	return p
+}
+
+// fromProjComplete sets p = Q, p in Jacobian, Q in homogeneous projective
+func (p *G1Jac) fromProjComplete(Q *g1ProjComplete) *G1Jac {
+	if Q.Z.IsZero() {
+		return p.Set(&g1Infinity)
+	}
+	// (X:Y:Z) in homogeneous projective is (XZ:YZ²:Z) in Jacobian
+	p.X.Mul(&Q.X, &Q.Z)
+	p.Y.Square(&Q.Z).Mul(&p.Y, &Q.Y)
+	p.Z.Set(&Q.Z)
+	return p
+}
+
+// fromProjCompleteCT sets p = Q, p in affine, Q in homogeneous projective.
+// Z⁻¹ is computed with a fixed exponentiation instead of a (variable-time) inversion;
+// the point at infinity maps to (0,0) without special case.
+func (p *G1Affine) fromProjCompleteCT(Q *g1ProjComplete) *G1Affine {
+	var zInv fp.Element
+	zInv.Exp(Q.Z, g1InverseExponentCT)
+	p.X.Mul(&Q.X, &zInv)
+	p.Y.Mul(&Q.Y, &zInv)
+	return p
+}
+
+// add sets p = a + b, for a, b on the curve y² = x³ + b, with b3 = 3b.
+// Complete formula, https://eprint.iacr.org/2015/1060.pdf (Algorithm 7)
+func (p *g1ProjComplete) add(a, b *g1ProjComplete, b3 *fp.Element) *g1ProjComplete {
+	var t0, t1, t2, t3, t4, X3, Y3, Z3 fp.Element
+	t0.Mul(&a.X, &b.X)
+	t1.Mul(&a.Y, &b.Y)
+	t2.Mul(&a.Z, &b.Z)
+	t3.Add(&a.X, &a.Y)
+	t4.Add(&b.X, &b.Y)
+	t3.Mul(&t3, &t4)
+	t4.Add(&t0, &t1)
+	t3.Sub(&t3, &t4)
+	t4.Add(&a.Y, &a.Z)
+	X3.Add(&b.Y, &b.Z)
+	t4.Mul(&t4, &X3)
+	X3.Add(&t1, &t2)
+	t4.Sub(&t4, &X3)
+	X3.Add(&a.X, &a.Z)
+	Y3.Add(&b.X, &b.Z)
+	X3.Mul(&X3, &Y3)
+	Y3.Add(&t0, &t2)
+	Y3.Sub(&X3, &Y3)
+	X3.Double(&t0)
+	t0.Add(&X3, &t0)
+	t2.Mul(&t2, b3)
+	Z3.Add(&t1, &t2)
+	t1.Sub(&t1, &t2)
+	Y3.Mul(&Y3, b3)
+	X3.Mul(&t4, &Y3)
+	t2.Mul(&t3, &t1)
+	X3.Sub(&t2, &X3)
+	Y3.Mul(&Y3, &t0)
+	t1.Mul(&t1, &Z3)
+	Y3.Add(&t1, &Y3)
+	t0.Mul(&t0, &t3)
+	Z3.Mul(&Z3, &t4)
+	Z3.Add(&Z3, &t0)
+	p.X, p.Y, p.Z = X3, Y3, Z3
+	return p
+}
+
+// double sets p = 2a, for a on the curve y² = x³ + b, with b3 = 3b.
+// Complete formula, https://eprint.iacr.org/2015/1060.pdf (Algorithm 9)
+func (p *g1ProjComplete) double(a *g1ProjComplete, b3 *fp.Element) *g1ProjComplete {
+	var t0, t1, t2, X3, Y3, Z3 fp.Element
+	t0.Square(&a.Y)
+	Z3.Double(&t0)
+	Z3.Double(&Z3)
+	Z3.Double(&Z3)
+	t1.Mul(&a.Y, &a.Z)
+	t2.Square(&a.Z)
+	t2.Mul(&t2, b3)
+	X3.Mul(&t2, &Z3)
+	Y3.Add(&t0, &t2)
+	Z3.Mul(&t1, &Z3)
+	t1.Double(&t2)
+	t2.Add(&t1, &t2)
+	t0.Sub(&t0, &t2)
+	Y3.Mul(&t0, &Y3)
+	Y3.Add(&X3, &Y3)
+	t1.Mul(&a.X, &a.Y)
+	X3.Mul(&t0, &t1)
+	X3.Double(&X3)
+	p.X, p.Y, p.Z = X3, Y3, Z3
+	return p
+}
+
 // -------------------------------------------------------------------------------------------------
 // Jacobian extended
 
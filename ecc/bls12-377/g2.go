@@ -17,12 +17,16 @@
 package bls12377
 
 import (
+	"crypto/subtle"
+	"io"
+	"math/big"
+	"runtime"
+
 	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/internal/fptower"
 	"github.com/consensys/gnark-crypto/internal/parallel"
-	"math/big"
-	"runtime"
 )
 
 // G2Affine point in affine coordinates
@@ -54,8 +58,8 @@ func (p *G2Affine) Set(a *G2Affine) *G2Affine {
 	return p
 }
 
-// setInfinity sets p to O
-func (p *G2Affine) setInfinity() *G2Affine {
+// SetInfinity sets p to the infinity point O, encoded as (0,0)
+func (p *G2Affine) SetInfinity() *G2Affine {
 	p.X.SetZero()
 	p.Y.SetZero()
 	return p
@@ -70,6 +74,24 @@ func (p *G2Affine) ScalarMultiplication(a *G2Affine, s *big.Int) *G2Affine {
 	return p
 }
 
+// ScalarMul computes and returns p = a ⋅ s, for s in the scalar field
+func (p *G2Affine) ScalarMul(a *G2Affine, s *fr.Element) *G2Affine {
+	var bs big.Int
+	s.BigInt(&bs)
+	return p.ScalarMultiplication(a, &bs)
+}
+
+// RandomPoint sets p to a uniformly random point of the prime order subgroup, reading the randomness from r,
+// and returns p. It computes p = [s]g for a random scalar s, so that with a deterministic (seeded) reader
+// the points are reproducible; the discrete logarithm of p is not secret with respect to the reader.
+func (p *G2Affine) RandomPoint(r io.Reader) (*G2Affine, error) {
+	var s fr.Element
+	if _, err := s.SetRandomFrom(r); err != nil {
+		return nil, err
+	}
+	return p.ScalarMul(&g2GenAff, &s), nil
+}
+
 // Add adds two point in affine coordinates.
 // This should rarely be used as it is very inefficient compared to Jacobian
 func (p *G2Affine) Add(a, b *G2Affine) *G2Affine {
@@ -171,6 +193,19 @@ func (p *G2Jac) Set(a *G2Jac) *G2Jac {
 	return p
 }
 
+// SetInfinity sets p to the infinity point O, encoded as (1,1,0)
+func (p *G2Jac) SetInfinity() *G2Jac {
+	p.X.SetOne()
+	p.Y.SetOne()
+	p.Z.SetZero()
+	return p
+}
+
+// IsInfinity checks if the point is infinity (Z == 0)
+func (p *G2Jac) IsInfinity() bool {
+	return p.Z.IsZero()
+}
+
 // Equal tests if two points (in Jacobian coordinates) are equal
 func (p *G2Jac) Equal(a *G2Jac) bool {
 
@@ -202,6 +237,22 @@ func (p *G2Jac) SubAssign(a *G2Jac) *G2Jac {
 	return p
 }
 
+// Sub sets p to a - b and returns p
+func (p *G2Jac) Sub(a, b *G2Jac) *G2Jac {
+	var tmp G2Jac
+	tmp.Set(a)
+	tmp.SubAssign(b)
+	return p.Set(&tmp)
+}
+
+// Add sets p to a + b and returns p
+func (p *G2Jac) Add(a, b *G2Jac) *G2Jac {
+	var tmp G2Jac
+	tmp.Set(a)
+	tmp.AddAssign(b)
+	return p.Set(&tmp)
+}
+
 // AddAssign point addition in montgomery form
 // https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian-3.html#addition-add-2007-bl
 func (p *G2Jac) AddAssign(a *G2Jac) *G2Jac {
@@ -350,6 +401,13 @@ func (p *G2Jac) ScalarMultiplication(a *G2Jac, s *big.Int) *G2Jac {
 	return p.mulGLV(a, s)
 }
 
+// ScalarMul computes and returns p = a ⋅ s, for s in the scalar field
+func (p *G2Jac) ScalarMul(a *G2Jac, s *fr.Element) *G2Jac {
+	var bs big.Int
+	s.BigInt(&bs)
+	return p.ScalarMultiplication(a, &bs)
+}
+
 // String returns canonical representation of the point in affine coordinates
 func (p *G2Jac) String() string {
 	_p := G2Affine{}
@@ -554,6 +612,214 @@ func (p *G2Jac) ClearCofactor(a *G2Jac) *G2Jac {
 
 }
 
+// -------------------------------------------------------------------------------------------------
+// Constant-time scalar multiplication
+
+// g2ProjComplete is a point in homogeneous projective coordinates (x=X/Z, y=Y/Z), the point at
+// infinity being (0:1:0). It is used with complete addition formulas, which have no exceptional case
+// and thus no branch.
+type g2ProjComplete struct {
+	X, Y, Z fptower.E2
+}
+
+// g2InverseExponentCT is q-2, where q is the size of the coordinates field, such that z^(q-2) = z⁻¹ for z ≠ 0
+var g2InverseExponentCT = func() *big.Int {
+	q := fp.Modulus()
+	q.Mul(q, q)
+	return q.Sub(q, big.NewInt(2))
+}()
+
+// ScalarMultiplicationCT computes and returns p = a ⋅ s in constant time with respect to s.
+//
+// s mod r is recoded in regular signed digits (cf ecc.RegularSignedDecomposition), and the multiples of a
+// are selected from a lookup table with a constant-time scan, and added with complete formulas; the sequence
+// of group operations and memory accesses doesn't depend on s. The projective coordinates of a are randomized
+// beforehand. It is meant for secret scalars (private keys, nonces, ...) and is slower than ScalarMultiplication.
+//
+// a must be in the prime order subgroup.
+func (p *G2Affine) ScalarMultiplicationCT(a *G2Affine, s *big.Int) *G2Affine {
+	var _a G2Jac
+	var res g2ProjComplete
+	_a.FromAffine(a)
+	res.mulCT(&_a, s)
+	return p.fromProjCompleteCT(&res)
+}
+
+// ScalarMultiplicationCT computes and returns p = a ⋅ s in constant time with respect to s.
+//
+// See G2Affine.ScalarMultiplicationCT; a must be in the prime order subgroup.
+func (p *G2Jac) ScalarMultiplicationCT(a *G2Jac, s *big.Int) *G2Jac {
+	var res g2ProjComplete
+	res.mulCT(a, s)
+	return p.fromProjComplete(&res)
+}
+
+// mulCT sets p = a ⋅ s using a fixed-window regular signed recoding of s mod r.
+func (p *g2ProjComplete) mulCT(a *G2Jac, s *big.Int) *g2ProjComplete {
+	var b3 fptower.E2
+	b3.Double(&bTwistCurveCoeff).Add(&b3, &bTwistCurveCoeff)
+
+	// table[i] = (2i+1)⋅a
+	var table [1 << (ctWindowSize - 1)]g2ProjComplete
+	var a2 g2ProjComplete
+	table[0].fromJacobian(a)
+
+	// randomize the projective representative of a, such that the field operations don't operate
+	// on predictable values (the field additions branch on their reductions).
+	var lambda fptower.E2
+	if _, err := lambda.SetRandom(); err != nil || lambda.IsZero() {
+		lambda.SetOne()
+	}
+	table[0].X.Mul(&table[0].X, &lambda)
+	table[0].Y.Mul(&table[0].Y, &lambda)
+	table[0].Z.Mul(&table[0].Z, &lambda)
+	a2.double(&table[0], &b3)
+	for i := 1; i < len(table); i++ {
+		table[i].add(&table[i-1], &a2, &b3)
+	}
+
+	// k ≡ s (mod r) and k is odd; since a is in the r-torsion, k⋅a = s⋅a
+	k := oddScalarWords(s)
+	var digits [ctNbDigits]int8
+	ecc.RegularSignedDecomposition(k[:], ctWindowSize, digits[:])
+
+	var res, tmp g2ProjComplete
+	res.lookupCT(&table, digits[len(digits)-1])
+	for i := len(digits) - 2; i >= 0; i-- {
+		for j := 0; j < ctWindowSize; j++ {
+			res.double(&res, &b3)
+		}
+		tmp.lookupCT(&table, digits[i])
+		res.add(&res, &tmp, &b3)
+	}
+	p.Set(&res)
+	return p
+}
+
+// lookupCT sets p = d ⋅ a, d being an odd digit in [-(2^ctWindowSize-1), 2^ctWindowSize-1]
+// and table[i] = (2i+1)⋅a. All the entries of the table are read, and the result is negated
+// or not, without branching on d.
+func (p *g2ProjComplete) lookupCT(table *[1 << (ctWindowSize - 1)]g2ProjComplete, d int8) *g2ProjComplete {
+	sign := int32(d) >> 31                       // -1 if d < 0, 0 otherwise
+	idx := (((int32(d) ^ sign) - sign) - 1) >> 1 // (|d|-1)/2
+	p.Set(&table[0])
+	for i := 1; i < len(table); i++ {
+		c := subtle.ConstantTimeEq(int32(i), idx)
+		p.X.Select(c, &p.X, &table[i].X)
+		p.Y.Select(c, &p.Y, &table[i].Y)
+		p.Z.Select(c, &p.Z, &table[i].Z)
+	}
+	var negY fptower.E2
+	negY.Neg(&p.Y)
+	p.Y.Select(int(sign&1), &p.Y, &negY)
+	return p
+}
+
+// Set sets p to the provided point
+func (p *g2ProjComplete) Set(a *g2ProjComplete) *g2ProjComplete {
+	p.X, p.Y, p.Z = a.X, a.Y, a.Z
+	return p
+}
+
+// fromJacobian sets p = Q, p in homogeneous projective, Q in Jacobian
+func (p *g2ProjComplete) fromJacobian(Q *G2Jac) *g2ProjComplete {
+	// (X:Y:Z) in Jacobian is (XZ:Y:Z³) in homogeneous projective
+	p.X.Mul(&Q.X, &Q.Z)
+	p.Y.Set(&Q.Y)
+	p.Z.Square(&Q.Z).Mul(&p.Z, &Q.Z)
+	return p
+}
+
+// fromProjComplete sets p = Q, p in Jacobian, Q in homogeneous projective
+func (p *G2Jac) fromProjComplete(Q *g2ProjComplete) *G2Jac {
+	if Q.Z.IsZero() {
+		return p.Set(&g2Infinity)
+	}
+	// (X:Y:Z) in homogeneous projective is (XZ:YZ²:Z) in Jacobian
+	p.X.Mul(&Q.X, &Q.Z)
+	p.Y.Square(&Q.Z).Mul(&p.Y, &Q.Y)
+	p.Z.Set(&Q.Z)
+	return p
+}
+
+// fromProjCompleteCT sets p = Q, p in affine, Q in homogeneous projective.
+// Z⁻¹ is computed with a fixed exponentiation instead of a (variable-time) inversion;
+// the point at infinity maps to (0,0) without special case.
+func (p *G2Affine) fromProjCompleteCT(Q *g2ProjComplete) *G2Affine {
+	var zInv fptower.E2
+	zInv.Exp(Q.Z, g2InverseExponentCT)
+	p.X.Mul(&Q.X, &zInv)
+	p.Y.Mul(&Q.Y, &zInv)
+	return p
+}
+
+// add sets p = a + b, for a, b on the curve y² = x³ + b, with b3 = 3b.
+// Complete formula, https://eprint.iacr.org/2015/1060.pdf (Algorithm 7)
+func (p *g2ProjComplete) add(a, b *g2ProjComplete, b3 *fptower.E2) *g2ProjComplete {
+	var t0, t1, t2, t3, t4, X3, Y3, Z3 fptower.E2
+	t0.Mul(&a.X, &b.X)
+	t1.Mul(&a.Y, &b.Y)
+	t2.Mul(&a.Z, &b.Z)
+	t3.Add(&a.X, &a.Y)
+	t4.Add(&b.X, &b.Y)
+	t3.Mul(&t3, &t4)
+	t4.Add(&t0, &t1)
+	t3.Sub(&t3, &t4)
+	t4.Add(&a.Y, &a.Z)
+	X3.Add(&b.Y, &b.Z)
+	t4.Mul(&t4, &X3)
+	X3.Add(&t1, &t2)
+	t4.Sub(&t4, &X3)
+	X3.Add(&a.X, &a.Z)
+	Y3.Add(&b.X, &b.Z)
+	X3.Mul(&X3, &Y3)
+	Y3.Add(&t0, &t2)
+	Y3.Sub(&X3, &Y3)
+	X3.Double(&t0)
+	t0.Add(&X3, &t0)
+	t2.Mul(&t2, b3)
+	Z3.Add(&t1, &t2)
+	t1.Sub(&t1, &t2)
+	Y3.Mul(&Y3, b3)
+	X3.Mul(&t4, &Y3)
+	t2.Mul(&t3, &t1)
+	X3.Sub(&t2, &X3)
+	Y3.Mul(&Y3, &t0)
+	t1.Mul(&t1, &Z3)
+	Y3.Add(&t1, &Y3)
+	t0.Mul(&t0, &t3)
+	Z3.Mul(&Z3, &t4)
+	Z3.Add(&Z3, &t0)
+	p.X, p.Y, p.Z = X3, Y3, Z3
+	return p
+}
+
+// double sets p = 2a, for a on the curve y² = x³ + b, with b3 = 3b.
+// Complete formula, https://eprint.iacr.org/2015/1060.pdf (Algorithm 9)
+func (p *g2ProjComplete) double(a *g2ProjComplete, b3 *fptower.E2) *g2ProjComplete {
+	var t0, t1, t2, X3, Y3, Z3 fptower.E2
+	t0.Square(&a.Y)
+	Z3.Double(&t0)
+	Z3.Double(&Z3)
+	Z3.Double(&Z3)
+	t1.Mul(&a.Y, &a.Z)
+	t2.Square(&a.Z)
+	t2.Mul(&t2, b3)
+	X3.Mul(&t2, &Z3)
+	Y3.Add(&t0, &t2)
+	Z3.Mul(&t1, &Z3)
+	t1.Double(&t2)
+	t2.Add(&t1, &t2)
+	t0.Sub(&t0, &t2)
+	Y3.Mul(&t0, &Y3)
+	Y3.Add(&X3, &Y3)
+	t1.Mul(&a.X, &a.Y)
+	X3.Mul(&t0, &t1)
+	X3.Double(&X3)
+	p.X, p.Y, p.Z = X3, Y3, Z3
+	return p
+}
+
 // -------------------------------------------------------------------------------------------------
 // Jacobian extended
 
@@ -19,6 +19,7 @@ package bls12377
 import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/internal/fptower"
+	"github.com/consensys/gnark-crypto/field/hash"
 
 	"math/big"
 )
@@ -749,11 +750,12 @@ func MapToG2(u fptower.E2) G2Affine {
 // EncodeToG2 hashes a message to a point on the G2 curve using the SSWU map.
 // It is faster than HashToG2, but the result is not uniformly distributed. Unsuitable as a random oracle.
 // dst stands for "domain separation tag", a string unique to the construction using the hash function
+// The ciphersuite defaults to expand_message_xmd with SHA-256, and can be changed with hash.Option.
 // https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#roadmap
-func EncodeToG2(msg, dst []byte) (G2Affine, error) {
+func EncodeToG2(msg, dst []byte, opts ...hash.Option) (G2Affine, error) {
 
 	var res G2Affine
-	u, err := fp.Hash(msg, dst, 2)
+	u, err := fp.Hash(msg, dst, 2, opts...)
 	if err != nil {
 		return res, err
 	}
@@ -772,9 +774,10 @@ func EncodeToG2(msg, dst []byte) (G2Affine, error) {
 // HashToG2 hashes a message to a point on the G2 curve using the SSWU map.
 // Slower than EncodeToG2, but usable as a random oracle.
 // dst stands for "domain separation tag", a string unique to the construction using the hash function
+// The ciphersuite defaults to expand_message_xmd with SHA-256, and can be changed with hash.Option.
 // https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#roadmap
-func HashToG2(msg, dst []byte) (G2Affine, error) {
-	u, err := fp.Hash(msg, dst, 2*2)
+func HashToG2(msg, dst []byte, opts ...hash.Option) (G2Affine, error) {
+	u, err := fp.Hash(msg, dst, 2*2, opts...)
 	if err != nil {
 		return G2Affine{}, err
 	}
@@ -400,6 +400,41 @@ func TestE2Ops(t *testing.T) {
 
 }
 
+func TestBatchSqrtE2(t *testing.T) {
+	t.Parallel()
+
+	// random elements, squares, and the special cases x₁ = 0 and x = 0
+	a := make([]E2, 64)
+	for i := range a {
+		_, _ = a[i].SetRandom()
+		switch i % 4 {
+		case 1:
+			a[i].Square(&a[i])
+		case 2:
+			a[i].A1.SetZero()
+		case 3:
+			a[i].A1.SetZero()
+			a[i].Square(&a[i])
+		}
+	}
+	a[0].SetZero()
+
+	res, isSquare := BatchSqrtE2(a)
+	for i := range a {
+		if isSquare[i] != (a[i].Legendre() != -1) {
+			t.Fatalf("BatchSqrtE2 and Legendre disagree on squareness of a[%d]", i)
+		}
+		var square E2
+		square.Square(&res[i])
+		if isSquare[i] && !square.Equal(&a[i]) {
+			t.Fatalf("BatchSqrtE2 returned a wrong square root of a[%d]", i)
+		}
+		if !isSquare[i] && !res[i].IsZero() {
+			t.Fatalf("BatchSqrtE2 returned a non zero value for the non square a[%d]", i)
+		}
+	}
+}
+
 // ------------------------------------------------------------
 // benches
 
@@ -462,6 +497,29 @@ func BenchmarkE2Sqrt(b *testing.B) {
 	}
 }
 
+func BenchmarkBatchSqrtE2(b *testing.B) {
+	const n = 1024
+	a := make([]E2, n)
+	for i := range a {
+		_, _ = a[i].SetRandom()
+		a[i].Square(&a[i])
+	}
+	b.Run("Sqrt", func(b *testing.B) {
+		var res E2
+		for j := 0; j < b.N; j++ {
+			for i := range a {
+				res.Sqrt(&a[i])
+			}
+		}
+	})
+	b.Run("BatchSqrtE2", func(b *testing.B) {
+		b.ReportAllocs()
+		for j := 0; j < b.N; j++ {
+			BatchSqrtE2(a)
+		}
+	})
+}
+
 func BenchmarkE2Exp(b *testing.B) {
 	var x E2
 	_, _ = x.SetRandom()
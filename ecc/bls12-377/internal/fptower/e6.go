@@ -16,6 +16,11 @@
 
 package fptower
 
+import (
+	"crypto/rand"
+	"io"
+)
+
 // E6 is a degree three finite field extension of fp2
 type E6 struct {
 	B0, B1, B2 E2
@@ -51,13 +56,18 @@ func (z *E6) SetOne() *E6 {
 
 // SetRandom set z to a random elmt
 func (z *E6) SetRandom() (*E6, error) {
-	if _, err := z.B0.SetRandom(); err != nil {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom is like SetRandom, reading the randomness from r
+func (z *E6) SetRandomFrom(r io.Reader) (*E6, error) {
+	if _, err := z.B0.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
-	if _, err := z.B1.SetRandom(); err != nil {
+	if _, err := z.B1.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
-	if _, err := z.B2.SetRandom(); err != nil {
+	if _, err := z.B2.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
 	return z, nil
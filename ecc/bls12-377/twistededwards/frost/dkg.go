@@ -0,0 +1,273 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+)
+
+var (
+	// ErrInvalidProof is returned, wrapped with the identifier of the culprit, when the proof
+	// of knowledge broadcast by a participant during the key generation does not verify.
+	ErrInvalidProof = errors.New("invalid proof of knowledge")
+
+	errInvalidThreshold   = errors.New("threshold must be between 1 and the number of participants")
+	errInvalidParticipant = errors.New("invalid participant identifier")
+	errUnexpectedMessage  = errors.New("unexpected message")
+	errMissingMessage     = errors.New("missing message")
+	errRoundOrder         = errors.New("key generation rounds called out of order")
+)
+
+// identity is the neutral element (0, 1) of the curve
+var identity = twistededwards.PointAffine{Y: fr.One()}
+
+// Round1Message is broadcast by each participant in the first round of the key generation.
+type Round1Message struct {
+	ID          int
+	Commitments []twistededwards.PointAffine // Feldman commitments aₖ⋅G to the coefficients of the secret polynomial
+	ProofR      twistededwards.PointAffine   // Schnorr proof of knowledge of a₀
+	ProofZ      big.Int
+}
+
+// Round2Message is sent privately by participant From to participant To in the second
+// round of the key generation.
+type Round2Message struct {
+	From, To int
+	Share    big.Int // f_From(To)
+}
+
+// KeyGen holds the state of a participant during the distributed key generation.
+type KeyGen struct {
+	id, threshold, nbParticipants int
+	poly                          polynomial                     // secret polynomial f, of degree threshold-1
+	commitments                   [][]twistededwards.PointAffine // commitments[j-1] are the commitments of participant j
+}
+
+// polynomial holds the coefficients of a polynomial modulo the subgroup order, by increasing degree
+type polynomial []big.Int
+
+// eval returns p(x) mod q
+func (p polynomial) eval(x *big.Int) *big.Int {
+	var res big.Int
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, x).Add(&res, &p[i]).Mod(&res, order)
+	}
+	return &res
+}
+
+// NewKeyGen returns the key generation state of participant id, among nbParticipants
+// participants of which threshold will be needed to sign.
+func NewKeyGen(id, threshold, nbParticipants int) (*KeyGen, error) {
+	if threshold < 1 || threshold > nbParticipants {
+		return nil, errInvalidThreshold
+	}
+	if id < 1 || id > nbParticipants {
+		return nil, errInvalidParticipant
+	}
+	return &KeyGen{id: id, threshold: threshold, nbParticipants: nbParticipants}, nil
+}
+
+// Round1 samples the secret polynomial of the participant and returns the message to
+// broadcast to the other participants.
+func (kg *KeyGen) Round1(rand io.Reader) (*Round1Message, error) {
+	kg.poly = make(polynomial, kg.threshold)
+	for i := range kg.poly {
+		if err := randScalar(&kg.poly[i], rand); err != nil {
+			return nil, err
+		}
+	}
+
+	msg := &Round1Message{ID: kg.id, Commitments: make([]twistededwards.PointAffine, kg.threshold)}
+	for i := range kg.poly {
+		msg.Commitments[i].ScalarMultiplicationCT(&curveParams.Base, &kg.poly[i])
+	}
+
+	// proof of knowledge of a₀: R = k⋅G, z = k + a₀⋅c with c = H(id ∥ a₀⋅G ∥ R)
+	var k big.Int
+	if err := randScalar(&k, rand); err != nil {
+		return nil, err
+	}
+	msg.ProofR.ScalarMultiplicationCT(&curveParams.Base, &k)
+	c := dkgChallenge(kg.id, &msg.Commitments[0], &msg.ProofR)
+	msg.ProofZ.Mul(&kg.poly[0], c).Add(&msg.ProofZ, &k).Mod(&msg.ProofZ, order)
+
+	return msg, nil
+}
+
+// Round2 checks the messages broadcast by the other participants in the first round and
+// returns the shares to send them privately, one per participant.
+func (kg *KeyGen) Round2(msgs []Round1Message) ([]Round2Message, error) {
+	if kg.poly == nil {
+		return nil, errRoundOrder
+	}
+	kg.commitments = make([][]twistededwards.PointAffine, kg.nbParticipants)
+	for i := range msgs {
+		id := msgs[i].ID
+		if id < 1 || id > kg.nbParticipants || id == kg.id || kg.commitments[id-1] != nil {
+			return nil, errUnexpectedMessage
+		}
+		if len(msgs[i].Commitments) != kg.threshold || !verifyProofOfKnowledge(&msgs[i]) {
+			return nil, fmt.Errorf("%w: participant %d", ErrInvalidProof, id)
+		}
+		kg.commitments[id-1] = msgs[i].Commitments
+	}
+	if len(msgs) != kg.nbParticipants-1 {
+		return nil, errMissingMessage
+	}
+
+	kg.commitments[kg.id-1] = make([]twistededwards.PointAffine, kg.threshold)
+	for i := range kg.poly {
+		kg.commitments[kg.id-1][i].ScalarMultiplication(&curveParams.Base, &kg.poly[i])
+	}
+
+	res := make([]Round2Message, 0, kg.nbParticipants-1)
+	for j := 1; j <= kg.nbParticipants; j++ {
+		if j == kg.id {
+			continue
+		}
+		res = append(res, Round2Message{From: kg.id, To: j, Share: *kg.poly.eval(big.NewInt(int64(j)))})
+	}
+	return res, nil
+}
+
+// Finalize checks the shares received from the other participants in the second round
+// against their commitments and returns the key share of the participant.
+//
+// If a share does not verify, the returned error wraps ErrInvalidShare and names the sender.
+func (kg *KeyGen) Finalize(shares []Round2Message) (*KeyShare, error) {
+	if kg.commitments == nil {
+		return nil, errRoundOrder
+	}
+	if len(shares) != kg.nbParticipants-1 {
+		return nil, errMissingMessage
+	}
+
+	x := big.NewInt(int64(kg.id))
+	ks := &KeyShare{ID: kg.id}
+	ks.secret.Set(kg.poly.eval(x))
+
+	seen := make([]bool, kg.nbParticipants)
+	for i := range shares {
+		from := shares[i].From
+		if from < 1 || from > kg.nbParticipants || from == kg.id || shares[i].To != kg.id || seen[from-1] {
+			return nil, errUnexpectedMessage
+		}
+		seen[from-1] = true
+
+		// f_j(i)⋅G = ∑ₖ iᵏ⋅φⱼₖ
+		var lhs twistededwards.PointAffine
+		lhs.ScalarMultiplication(&curveParams.Base, &shares[i].Share)
+		rhs := evalCommitments(kg.commitments[from-1], x)
+		if !lhs.Equal(&rhs) {
+			return nil, fmt.Errorf("%w: participant %d", ErrInvalidShare, from)
+		}
+		ks.secret.Add(&ks.secret, &shares[i].Share)
+	}
+	ks.secret.Mod(&ks.secret, order)
+
+	// the commitments to the polynomial ∑ⱼ fⱼ give the group key and the verification shares
+	commitments := make([]twistededwards.PointAffine, kg.threshold)
+	for k := range commitments {
+		commitments[k] = identity
+		for j := range kg.commitments {
+			commitments[k].Add(&commitments[k], &kg.commitments[j][k])
+		}
+	}
+
+	ks.Public.Threshold = kg.threshold
+	ks.Public.Key = commitments[0]
+	ks.Public.Shares = make([]twistededwards.PointAffine, kg.nbParticipants)
+	for j := range ks.Public.Shares {
+		ks.Public.Shares[j] = evalCommitments(commitments, big.NewInt(int64(j+1)))
+	}
+
+	// erase the secret polynomial
+	for i := range kg.poly {
+		kg.poly[i].SetUint64(0)
+	}
+
+	return ks, nil
+}
+
+// evalCommitments returns ∑ₖ xᵏ⋅φₖ, the commitment to the evaluation at x of the
+// polynomial committed to by φ
+func evalCommitments(commitments []twistededwards.PointAffine, x *big.Int) twistededwards.PointAffine {
+	var res twistededwards.PointExtended
+	res.FromAffine(&commitments[len(commitments)-1])
+	for k := len(commitments) - 2; k >= 0; k-- {
+		res.ScalarMultiplication(&res, x)
+		res.MixedAdd(&res, &commitments[k])
+	}
+	var p twistededwards.PointAffine
+	p.FromExtended(&res)
+	return p
+}
+
+// verifyProofOfKnowledge checks that the commitments are in the prime order subgroup
+// and that z⋅G - c⋅φ₀ = R
+func verifyProofOfKnowledge(msg *Round1Message) bool {
+	for i := range msg.Commitments {
+		if !isInSubGroup(&msg.Commitments[i]) {
+			return false
+		}
+	}
+	if msg.Commitments[0].IsZero() || !msg.ProofR.IsOnCurve() || msg.ProofZ.Sign() < 0 || msg.ProofZ.Cmp(order) >= 0 {
+		return false
+	}
+	c := dkgChallenge(msg.ID, &msg.Commitments[0], &msg.ProofR)
+
+	var zG, cPhi twistededwards.PointAffine
+	zG.ScalarMultiplication(&curveParams.Base, &msg.ProofZ)
+	cPhi.ScalarMultiplication(&msg.Commitments[0], c)
+	cPhi.Neg(&cPhi)
+	zG.Add(&zG, &cPhi)
+	return zG.Equal(&msg.ProofR)
+}
+
+// dkgChallenge returns the challenge H(id ∥ φ₀ ∥ R) of the proof of knowledge of participant id
+func dkgChallenge(id int, phi0, R *twistededwards.PointAffine) *big.Int {
+	i := encodeID(id)
+	p := phi0.Bytes()
+	r := R.Bytes()
+	buf := make([]byte, 0, sizeScalar+2*sizePoint)
+	buf = append(buf, i[:]...)
+	buf = append(buf, p[:]...)
+	buf = append(buf, r[:]...)
+	var c big.Int
+	hashToScalar(&c, buf, "dkg")
+	return &c
+}
+
+// randScalar sets k to a uniformly random non-zero scalar read from rand
+func randScalar(k *big.Int, rand io.Reader) error {
+	// 64 more bits than the size of the field make the bias negligible
+	var buf [sizeScalar + 8]byte
+	for {
+		if _, err := io.ReadFull(rand, buf[:]); err != nil {
+			return err
+		}
+		if k.SetBytes(buf[:]).Mod(k, order).Sign() != 0 {
+			return nil
+		}
+	}
+}
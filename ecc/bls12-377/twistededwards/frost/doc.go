@@ -0,0 +1,39 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package frost provides t-of-n threshold Schnorr signatures on the twisted Edwards curve
+// defined on the scalar field of bls12-377.
+//
+// The key is generated without a trusted dealer by Pedersen's distributed key generation
+// with Feldman verifiable secret sharing, as in the key generation of FROST: each participant
+// shares a random polynomial of degree t-1 and proves knowledge of its constant term. No
+// party ever holds the group secret key.
+//
+// Signatures are produced by any t participants with the two-round FROST protocol of RFC 9591.
+// RFC 9591 defines no ciphersuite for this curve, so the one used here is specific to this
+// package and does not interoperate with other implementations. It is modeled on
+// FROST(Ed25519, SHA-512), with the context string "FROST-BLS12_377_EDWARDS-SHA512-v1" chosen by
+// this package: scalars are SHA-512 digests reduced modulo the order of the prime subgroup,
+// points are compressed as in twistededwards.PointAffine.Bytes, and verification is cofactored.
+// A coordinator collects the nonce commitments, then the signature shares, which it checks
+// before aggregating them, so that a misbehaving signer is identified.
+//
+// LocalNetwork is an in-process stand-in for the network connecting the parties.
+//
+// Documentation:
+// - FROST: https://eprint.iacr.org/2020/852.pdf
+// - RFC 9591: https://www.rfc-editor.org/rfc/rfc9591.html
+package frost
@@ -0,0 +1,362 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+)
+
+// contextString is the context string of the ciphersuite; RFC 9591 defines no ciphersuite
+// for this curve, and this one is specific to this package
+const contextString = "FROST-BLS12_377_EDWARDS-SHA512-v1"
+
+const (
+	sizeScalar = fr.Bytes // scalars modulo the subgroup order, in big Endian
+	sizePoint  = fr.Bytes // compressed point
+)
+
+var (
+	// ErrInvalidShare is returned, wrapped with the identifier of the culprit, when a
+	// participant sends a secret share or a signature share which does not verify.
+	ErrInvalidShare = errors.New("invalid share")
+
+	errNonceReused           = errors.New("nonces have already been used")
+	errNotASigner            = errors.New("the signer is not part of the commitment list")
+	errInvalidCommitmentList = errors.New("commitment list must be sorted by identifier, without duplicates nor points out of the subgroup")
+	errNotEnoughSigners      = errors.New("not enough signers")
+	errShareMismatch         = errors.New("signature shares do not match the commitment list")
+)
+
+var curveParams = twistededwards.GetEdwardsCurve()
+var order = &curveParams.Order
+var cofactor = curveParams.Cofactor.BigInt(new(big.Int))
+
+// GroupPublicKey is the public output of the key generation.
+type GroupPublicKey struct {
+	Threshold int                          // minimal number of signers
+	Key       twistededwards.PointAffine   // group public key Y
+	Shares    []twistededwards.PointAffine // Shares[i-1] is the verification share sᵢ⋅G of participant i
+}
+
+// KeyShare is the output of the key generation for a participant.
+type KeyShare struct {
+	ID     int            // identifier of the participant, in 1…n
+	Public GroupPublicKey // copy of the group public key
+	secret big.Int        // secret share sᵢ of the group secret key
+}
+
+// Signature is a Schnorr signature (R, z) such that h⋅z⋅G = h⋅R + h⋅c⋅Y, with c = H2(R ∥ Y ∥ msg)
+// and h the cofactor.
+type Signature struct {
+	R twistededwards.PointAffine
+	Z big.Int
+}
+
+// Commitment holds the commitments of a signer to its nonces, sent to the coordinator
+// in the first round of signing.
+type Commitment struct {
+	ID      int
+	Hiding  twistededwards.PointAffine
+	Binding twistededwards.PointAffine
+}
+
+// Nonces holds the secret nonces of a signer for a single signing session.
+type Nonces struct {
+	hiding, binding big.Int
+}
+
+// SignatureShare is the contribution of a signer to a signature.
+type SignatureShare struct {
+	ID int
+	Z  big.Int
+}
+
+// Commit returns fresh nonces and the matching commitment (first round of signing).
+// The commitment is sent to the coordinator and the nonces are kept for Sign.
+func (ks *KeyShare) Commit(rand io.Reader) (*Nonces, *Commitment, error) {
+	var nonces Nonces
+	if err := nonceGenerate(&nonces.hiding, &ks.secret, rand); err != nil {
+		return nil, nil, err
+	}
+	if err := nonceGenerate(&nonces.binding, &ks.secret, rand); err != nil {
+		return nil, nil, err
+	}
+
+	commitment := &Commitment{ID: ks.ID}
+	commitment.Hiding.ScalarMultiplicationCT(&curveParams.Base, &nonces.hiding)
+	commitment.Binding.ScalarMultiplicationCT(&curveParams.Base, &nonces.binding)
+
+	return &nonces, commitment, nil
+}
+
+// Sign returns the signature share of msg (second round of signing).
+//
+// commitments are the commitments of the signers chosen by the coordinator, sorted
+// by identifier, and must include the one matching nonces. The nonces are erased
+// so that they can not be used twice.
+func (ks *KeyShare) Sign(nonces *Nonces, msg []byte, commitments []Commitment) (*SignatureShare, error) {
+	if nonces.hiding.Sign() == 0 || nonces.binding.Sign() == 0 {
+		return nil, errNonceReused
+	}
+	if err := checkCommitmentList(commitments, ks.Public.Threshold); err != nil {
+		return nil, err
+	}
+	idx := -1
+	for i := range commitments {
+		if commitments[i].ID == ks.ID {
+			idx = i
+		}
+	}
+	if idx == -1 {
+		return nil, errNotASigner
+	}
+
+	rho := bindingFactors(&ks.Public.Key, msg, commitments)
+	R := groupCommitment(commitments, rho)
+	c := challenge(&R, &ks.Public.Key, msg)
+	lambda := lagrangeCoefficient(ks.ID, commitments)
+
+	// z = d + e⋅ρ + λ⋅s⋅c
+	var z, t big.Int
+	z.Mul(&nonces.binding, &rho[idx]).Add(&z, &nonces.hiding)
+	t.Mul(lambda, &ks.secret).Mul(&t, c)
+	z.Add(&z, &t).Mod(&z, order)
+
+	nonces.hiding.SetUint64(0)
+	nonces.binding.SetUint64(0)
+
+	return &SignatureShare{ID: ks.ID, Z: z}, nil
+}
+
+// Aggregate checks the signature shares of msg and combines them into a signature for
+// the group public key (run by the coordinator).
+//
+// commitments are the commitments sent to the signers, and shares their signature shares,
+// in the same order. If a share does not verify, the returned error wraps ErrInvalidShare
+// and names the signer.
+func Aggregate(gpk *GroupPublicKey, msg []byte, commitments []Commitment, shares []SignatureShare) (*Signature, error) {
+	if err := checkCommitmentList(commitments, gpk.Threshold); err != nil {
+		return nil, err
+	}
+	if len(shares) != len(commitments) {
+		return nil, errShareMismatch
+	}
+	for i := range commitments {
+		if shares[i].ID != commitments[i].ID || commitments[i].ID > len(gpk.Shares) {
+			return nil, errShareMismatch
+		}
+	}
+
+	rho := bindingFactors(&gpk.Key, msg, commitments)
+	R := groupCommitment(commitments, rho)
+	c := challenge(&R, &gpk.Key, msg)
+
+	var z big.Int
+	for i := range shares {
+		// zᵢ⋅G = Dᵢ + ρᵢ⋅Eᵢ + (c⋅λᵢ)⋅Yᵢ
+		var lhs, rhs, t twistededwards.PointAffine
+		lhs.ScalarMultiplication(&curveParams.Base, &shares[i].Z)
+
+		lambda := lagrangeCoefficient(shares[i].ID, commitments)
+		lambda.Mul(lambda, c)
+		rhs.ScalarMultiplication(&gpk.Shares[shares[i].ID-1], lambda)
+		t.ScalarMultiplication(&commitments[i].Binding, &rho[i])
+		rhs.Add(&rhs, &t)
+		rhs.Add(&rhs, &commitments[i].Hiding)
+
+		if !lhs.Equal(&rhs) {
+			return nil, fmt.Errorf("%w: signer %d", ErrInvalidShare, shares[i].ID)
+		}
+		z.Add(&z, &shares[i].Z)
+	}
+	z.Mod(&z, order)
+
+	return &Signature{R: R, Z: z}, nil
+}
+
+// Verify checks the signature sig of msg under the group public key.
+func Verify(groupKey *twistededwards.PointAffine, sig *Signature, msg []byte) bool {
+	if !isInSubGroup(groupKey) || groupKey.IsZero() || !sig.R.IsOnCurve() {
+		return false
+	}
+	if sig.Z.Sign() < 0 || sig.Z.Cmp(order) >= 0 {
+		return false
+	}
+	c := challenge(&sig.R, groupKey, msg)
+
+	// h⋅(z⋅G - c⋅Y - R) = 0
+	var zG, cY twistededwards.PointAffine
+	zG.ScalarMultiplication(&curveParams.Base, &sig.Z)
+	cY.ScalarMultiplication(groupKey, c)
+	cY.Add(&cY, &sig.R)
+	cY.Neg(&cY)
+	zG.Add(&zG, &cY)
+	zG.ScalarMultiplication(&zG, cofactor)
+
+	return zG.IsZero()
+}
+
+// checkCommitmentList checks that there are at least threshold commitments, sorted by
+// identifier, and that the commitments are in the prime order subgroup.
+func checkCommitmentList(commitments []Commitment, threshold int) error {
+	if len(commitments) < threshold {
+		return errNotEnoughSigners
+	}
+	for i := range commitments {
+		if commitments[i].ID < 1 || (i > 0 && commitments[i].ID <= commitments[i-1].ID) {
+			return errInvalidCommitmentList
+		}
+		if commitments[i].Hiding.IsZero() || !isInSubGroup(&commitments[i].Hiding) ||
+			commitments[i].Binding.IsZero() || !isInSubGroup(&commitments[i].Binding) {
+			return errInvalidCommitmentList
+		}
+	}
+	return nil
+}
+
+// isInSubGroup returns true if p is on the curve and in the prime order subgroup
+func isInSubGroup(p *twistededwards.PointAffine) bool {
+	if !p.IsOnCurve() {
+		return false
+	}
+	var q twistededwards.PointAffine
+	q.ScalarMultiplication(p, order)
+	return q.IsZero()
+}
+
+// bindingFactors returns the binding factor ρᵢ of each signer in the commitment list
+//
+//	ρᵢ = H1(Y ∥ H4(msg) ∥ H5(commitments) ∥ i)
+func bindingFactors(groupKey *twistededwards.PointAffine, msg []byte, commitments []Commitment) []big.Int {
+	y := groupKey.Bytes()
+	msgHash := h(msg, "msg")
+	commitmentsHash := h(encodeCommitmentList(commitments), "com")
+
+	prefix := make([]byte, 0, sizePoint+2*sha512.Size+sizeScalar)
+	prefix = append(prefix, y[:]...)
+	prefix = append(prefix, msgHash...)
+	prefix = append(prefix, commitmentsHash...)
+
+	res := make([]big.Int, len(commitments))
+	for i := range commitments {
+		id := encodeID(commitments[i].ID)
+		hashToScalar(&res[i], append(prefix, id[:]...), "rho")
+	}
+	return res
+}
+
+// groupCommitment returns R = ∑ Dᵢ + ρᵢ⋅Eᵢ
+func groupCommitment(commitments []Commitment, rho []big.Int) twistededwards.PointAffine {
+	var r, t twistededwards.PointExtended
+	r.FromAffine(&identity)
+	for i := range commitments {
+		t.FromAffine(&commitments[i].Binding)
+		t.ScalarMultiplication(&t, &rho[i])
+		t.MixedAdd(&t, &commitments[i].Hiding)
+		r.Add(&r, &t)
+	}
+	var res twistededwards.PointAffine
+	res.FromExtended(&r)
+	return res
+}
+
+// challenge returns c = H2(R ∥ Y ∥ msg)
+func challenge(R, groupKey *twistededwards.PointAffine, msg []byte) *big.Int {
+	r := R.Bytes()
+	y := groupKey.Bytes()
+	buf := make([]byte, 0, 2*sizePoint+len(msg))
+	buf = append(buf, r[:]...)
+	buf = append(buf, y[:]...)
+	buf = append(buf, msg...)
+	var c big.Int
+	hashToScalar(&c, buf, "chal")
+	return &c
+}
+
+// lagrangeCoefficient returns the Lagrange coefficient λᵢ at 0 of participant id
+// among the signers of the commitment list
+//
+//	λᵢ = ∏ⱼ xⱼ / (xⱼ - xᵢ)
+func lagrangeCoefficient(id int, commitments []Commitment) *big.Int {
+	var num, den, xi, xj, t big.Int
+	num.SetUint64(1)
+	den.SetUint64(1)
+	xi.SetUint64(uint64(id))
+	for i := range commitments {
+		if commitments[i].ID == id {
+			continue
+		}
+		xj.SetUint64(uint64(commitments[i].ID))
+		num.Mul(&num, &xj)
+		t.Sub(&xj, &xi)
+		den.Mul(&den, &t)
+	}
+	den.Mod(&den, order).ModInverse(&den, order)
+	num.Mul(&num, &den).Mod(&num, order)
+	return &num
+}
+
+// nonceGenerate sets k to H3(random_bytes ∥ secret), as in RFC 9591, section 4.1
+func nonceGenerate(k, secret *big.Int, rand io.Reader) error {
+	var buf [32 + sizeScalar]byte
+	if _, err := io.ReadFull(rand, buf[:32]); err != nil {
+		return err
+	}
+	secret.FillBytes(buf[32:])
+	hashToScalar(k, buf[:], "nonce")
+	return nil
+}
+
+// hashToScalar sets k to SHA-512(contextString ∥ tag ∥ msg) mod q
+func hashToScalar(k *big.Int, msg []byte, tag string) {
+	k.SetBytes(h(msg, tag)).Mod(k, order)
+}
+
+// h returns SHA-512(contextString ∥ tag ∥ msg), as H4 and H5 of RFC 9591
+func h(msg []byte, tag string) []byte {
+	md := sha512.New()
+	md.Write([]byte(contextString + tag))
+	md.Write(msg)
+	return md.Sum(nil)
+}
+
+// encodeCommitmentList returns the concatenation of i ∥ Dᵢ ∥ Eᵢ for each signer
+func encodeCommitmentList(commitments []Commitment) []byte {
+	res := make([]byte, 0, len(commitments)*(sizeScalar+2*sizePoint))
+	for i := range commitments {
+		id := encodeID(commitments[i].ID)
+		d := commitments[i].Hiding.Bytes()
+		e := commitments[i].Binding.Bytes()
+		res = append(res, id[:]...)
+		res = append(res, d[:]...)
+		res = append(res, e[:]...)
+	}
+	return res
+}
+
+// encodeID returns the identifier id as a scalar in big Endian
+func encodeID(id int) (res [sizeScalar]byte) {
+	new(big.Int).SetUint64(uint64(id)).FillBytes(res[:])
+	return
+}
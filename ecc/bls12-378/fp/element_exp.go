@@ -523,93 +523,48 @@ func (z *Element) expBySqrtExp(x Element) *Element {
 	return z
 }
 
-// expByLegendreExp is equivalent to z.Exp(x, 1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca4510000000000)
-//
-// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
-func (z *Element) expByLegendreExp(x Element) *Element {
-	// addition chain:
-	//
-	//	_10      = 2*1
-	//	_11      = 1 + _10
-	//	_101     = _10 + _11
-	//	_110     = 1 + _101
-	//	_1001    = _11 + _110
-	//	_1011    = _10 + _1001
-	//	_1100    = 1 + _1011
-	//	_1101    = 1 + _1100
-	//	_10001   = _101 + _1100
-	//	_10011   = _10 + _10001
-	//	_10101   = _10 + _10011
-	//	_11011   = _110 + _10101
-	//	_11101   = _10 + _11011
-	//	_100011  = _110 + _11101
-	//	_100111  = _1100 + _11011
-	//	_101001  = _10 + _100111
-	//	_110101  = _1100 + _101001
-	//	_110111  = _10 + _110101
-	//	_111001  = _10 + _110111
-	//	_111011  = _10 + _111001
-	//	_111101  = _10 + _111011
-	//	_111111  = _10 + _111101
-	//	_1111100 = _111101 + _111111
-	//	_1111111 = _11 + _1111100
-	//	i39      = ((_1111100 << 4 + _11101) << 3 + _11) << 6
-	//	i57      = ((1 + i39) << 9 + _1011) << 6 + _1101
-	//	i78      = ((i57 << 9 + _10011) << 7 + _100011) << 3
-	//	i98      = ((1 + i78) << 11 + _101001) << 6 + _111001
-	//	i117     = ((i98 << 7 + _110101) << 4 + _1101) << 6
-	//	i138     = ((_1001 + i117) << 12 + _111011) << 6 + _10001
-	//	i162     = ((i138 << 11 + _111101) << 6 + _101) << 5
-	//	i184     = ((1 + i162) << 11 + _1011) << 8 + _111101
-	//	i205     = ((i184 << 6 + _11011) << 8 + _100011) << 5
-	//	i227     = ((_10001 + i205) << 12 + _100011) << 7 + _10011
-	//	i257     = ((i227 << 6 + _10011) << 13 + _110111) << 9
-	//	i279     = ((_11011 + i257) << 9 + _1101) << 10 + _101001
-	//	i299     = ((i279 << 8 + _100111) << 2 + 1) << 8
-	//	i311     = ((_1111111 + i299) << 2 + _11) << 7 + _11101
-	//	i331     = ((i311 << 3 + 1) << 8 + _1111111) << 7
-	//	i350     = ((_111011 + i331) << 6 + _10101) << 10 + _10001
-	//	i386     = ((i350 << 3 + _11) << 23 + _10011) << 8
-	//	i399     = ((_101001 + i386) << 6 + _101) << 4 + 1
-	//	return     i399 << 40
-	//
-	// Operations: 371 squares 68 multiplies
-
-	// Allocate Temporaries.
+// vectorExpBySqrtExpNbTemporaries is the number of temporary vectors used by vectorExpBySqrtExp
+const vectorExpBySqrtExpNbTemporaries = 19
+
+// vectorExpBySqrtExp sets z[i] = x[i]^fbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa08b0000265228 for all i, with the addition chain of expBySqrtExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpBySqrtExpNbTemporaries * len(x).
+func vectorExpBySqrtExp(z, x, scratch Vector) {
+	n := len(x)
 	var (
-		t0  = new(Element)
-		t1  = new(Element)
-		t2  = new(Element)
-		t3  = new(Element)
-		t4  = new(Element)
-		t5  = new(Element)
-		t6  = new(Element)
-		t7  = new(Element)
-		t8  = new(Element)
-		t9  = new(Element)
-		t10 = new(Element)
-		t11 = new(Element)
-		t12 = new(Element)
-		t13 = new(Element)
-		t14 = new(Element)
-		t15 = new(Element)
-		t16 = new(Element)
-		t17 = new(Element)
-		t18 = new(Element)
+		t0  = scratch[0*n : 0*n+n]
+		t1  = scratch[1*n : 1*n+n]
+		t2  = scratch[2*n : 2*n+n]
+		t3  = scratch[3*n : 3*n+n]
+		t4  = scratch[4*n : 4*n+n]
+		t5  = scratch[5*n : 5*n+n]
+		t6  = scratch[6*n : 6*n+n]
+		t7  = scratch[7*n : 7*n+n]
+		t8  = scratch[8*n : 8*n+n]
+		t9  = scratch[9*n : 9*n+n]
+		t10 = scratch[10*n : 10*n+n]
+		t11 = scratch[11*n : 11*n+n]
+		t12 = scratch[12*n : 12*n+n]
+		t13 = scratch[13*n : 13*n+n]
+		t14 = scratch[14*n : 14*n+n]
+		t15 = scratch[15*n : 15*n+n]
+		t16 = scratch[16*n : 16*n+n]
+		t17 = scratch[17*n : 17*n+n]
+		t18 = scratch[18*n : 18*n+n]
 	)
 
-	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18 Element
 	// Step 1: t6 = x^0x2
-	t6.Square(&x)
+	t6.Mul(x, x)
 
 	// Step 2: t2 = x^0x3
-	t2.Mul(&x, t6)
+	t2.Mul(x, t6)
 
 	// Step 3: z = x^0x5
 	z.Mul(t6, t2)
 
 	// Step 4: t0 = x^0x6
-	t0.Mul(&x, z)
+	t0.Mul(x, z)
 
 	// Step 5: t15 = x^0x9
 	t15.Mul(t2, t0)
@@ -618,10 +573,10 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 	t14.Mul(t6, t15)
 
 	// Step 7: t5 = x^0xc
-	t5.Mul(&x, t14)
+	t5.Mul(x, t14)
 
 	// Step 8: t9 = x^0xd
-	t9.Mul(&x, t5)
+	t9.Mul(x, t5)
 
 	// Step 9: t3 = x^0x11
 	t3.Mul(z, t5)
@@ -673,7 +628,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 28: t18 = x^0x7c0
 	for s := 0; s < 4; s++ {
-		t18.Square(t18)
+		t18.Mul(t18, t18)
 	}
 
 	// Step 29: t18 = x^0x7dd
@@ -681,7 +636,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 32: t18 = x^0x3ee8
 	for s := 0; s < 3; s++ {
-		t18.Square(t18)
+		t18.Mul(t18, t18)
 	}
 
 	// Step 33: t18 = x^0x3eeb
@@ -689,15 +644,15 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 39: t18 = x^0xfbac0
 	for s := 0; s < 6; s++ {
-		t18.Square(t18)
+		t18.Mul(t18, t18)
 	}
 
 	// Step 40: t18 = x^0xfbac1
-	t18.Mul(&x, t18)
+	t18.Mul(x, t18)
 
 	// Step 49: t18 = x^0x1f758200
 	for s := 0; s < 9; s++ {
-		t18.Square(t18)
+		t18.Mul(t18, t18)
 	}
 
 	// Step 50: t18 = x^0x1f75820b
@@ -705,7 +660,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 56: t18 = x^0x7dd6082c0
 	for s := 0; s < 6; s++ {
-		t18.Square(t18)
+		t18.Mul(t18, t18)
 	}
 
 	// Step 57: t18 = x^0x7dd6082cd
@@ -713,7 +668,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 66: t18 = x^0xfbac1059a00
 	for s := 0; s < 9; s++ {
-		t18.Square(t18)
+		t18.Mul(t18, t18)
 	}
 
 	// Step 67: t18 = x^0xfbac1059a13
@@ -721,7 +676,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 74: t18 = x^0x7dd6082cd0980
 	for s := 0; s < 7; s++ {
-		t18.Square(t18)
+		t18.Mul(t18, t18)
 	}
 
 	// Step 75: t18 = x^0x7dd6082cd09a3
@@ -729,15 +684,15 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 78: t18 = x^0x3eeb0416684d18
 	for s := 0; s < 3; s++ {
-		t18.Square(t18)
+		t18.Mul(t18, t18)
 	}
 
 	// Step 79: t18 = x^0x3eeb0416684d19
-	t18.Mul(&x, t18)
+	t18.Mul(x, t18)
 
 	// Step 90: t18 = x^0x1f75820b34268c800
 	for s := 0; s < 11; s++ {
-		t18.Square(t18)
+		t18.Mul(t18, t18)
 	}
 
 	// Step 91: t18 = x^0x1f75820b34268c829
@@ -745,7 +700,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 97: t18 = x^0x7dd6082cd09a320a40
 	for s := 0; s < 6; s++ {
-		t18.Square(t18)
+		t18.Mul(t18, t18)
 	}
 
 	// Step 98: t17 = x^0x7dd6082cd09a320a79
@@ -753,7 +708,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 105: t17 = x^0x3eeb0416684d19053c80
 	for s := 0; s < 7; s++ {
-		t17.Square(t17)
+		t17.Mul(t17, t17)
 	}
 
 	// Step 106: t16 = x^0x3eeb0416684d19053cb5
@@ -761,7 +716,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 110: t16 = x^0x3eeb0416684d19053cb50
 	for s := 0; s < 4; s++ {
-		t16.Square(t16)
+		t16.Mul(t16, t16)
 	}
 
 	// Step 111: t16 = x^0x3eeb0416684d19053cb5d
@@ -769,7 +724,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 117: t16 = x^0xfbac1059a1346414f2d740
 	for s := 0; s < 6; s++ {
-		t16.Square(t16)
+		t16.Mul(t16, t16)
 	}
 
 	// Step 118: t15 = x^0xfbac1059a1346414f2d749
@@ -777,7 +732,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 130: t15 = x^0xfbac1059a1346414f2d749000
 	for s := 0; s < 12; s++ {
-		t15.Square(t15)
+		t15.Mul(t15, t15)
 	}
 
 	// Step 131: t15 = x^0xfbac1059a1346414f2d74903b
@@ -785,7 +740,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 137: t15 = x^0x3eeb0416684d19053cb5d240ec0
 	for s := 0; s < 6; s++ {
-		t15.Square(t15)
+		t15.Mul(t15, t15)
 	}
 
 	// Step 138: t15 = x^0x3eeb0416684d19053cb5d240ed1
@@ -793,7 +748,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 149: t15 = x^0x1f75820b34268c829e5ae920768800
 	for s := 0; s < 11; s++ {
-		t15.Square(t15)
+		t15.Mul(t15, t15)
 	}
 
 	// Step 150: t15 = x^0x1f75820b34268c829e5ae92076883d
@@ -801,7 +756,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 156: t15 = x^0x7dd6082cd09a320a796ba481da20f40
 	for s := 0; s < 6; s++ {
-		t15.Square(t15)
+		t15.Mul(t15, t15)
 	}
 
 	// Step 157: t15 = x^0x7dd6082cd09a320a796ba481da20f45
@@ -809,15 +764,15 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 162: t15 = x^0xfbac1059a1346414f2d74903b441e8a0
 	for s := 0; s < 5; s++ {
-		t15.Square(t15)
+		t15.Mul(t15, t15)
 	}
 
 	// Step 163: t15 = x^0xfbac1059a1346414f2d74903b441e8a1
-	t15.Mul(&x, t15)
+	t15.Mul(x, t15)
 
 	// Step 174: t15 = x^0x7dd6082cd09a320a796ba481da20f450800
 	for s := 0; s < 11; s++ {
-		t15.Square(t15)
+		t15.Mul(t15, t15)
 	}
 
 	// Step 175: t14 = x^0x7dd6082cd09a320a796ba481da20f45080b
@@ -825,7 +780,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 183: t14 = x^0x7dd6082cd09a320a796ba481da20f45080b00
 	for s := 0; s < 8; s++ {
-		t14.Square(t14)
+		t14.Mul(t14, t14)
 	}
 
 	// Step 184: t13 = x^0x7dd6082cd09a320a796ba481da20f45080b3d
@@ -833,7 +788,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 190: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf40
 	for s := 0; s < 6; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 191: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf5b
@@ -841,7 +796,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 199: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf5b00
 	for s := 0; s < 8; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 200: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23
@@ -849,7 +804,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 205: t13 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6460
 	for s := 0; s < 5; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 206: t13 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471
@@ -857,7 +812,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 218: t13 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471000
 	for s := 0; s < 12; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 219: t12 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471023
@@ -865,7 +820,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 226: t12 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881180
 	for s := 0; s < 7; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 227: t12 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193
@@ -873,7 +828,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 233: t12 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464c0
 	for s := 0; s < 6; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 234: t12 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d3
@@ -881,7 +836,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 247: t12 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a6000
 	for s := 0; s < 13; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 248: t11 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a6037
@@ -889,7 +844,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 257: t11 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e00
 	for s := 0; s < 9; s++ {
-		t11.Square(t11)
+		t11.Mul(t11, t11)
 	}
 
 	// Step 258: t10 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b
@@ -897,7 +852,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 267: t10 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc3600
 	for s := 0; s < 9; s++ {
-		t10.Square(t10)
+		t10.Mul(t10, t10)
 	}
 
 	// Step 268: t9 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d
@@ -905,7 +860,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 278: t9 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83400
 	for s := 0; s < 10; s++ {
-		t9.Square(t9)
+		t9.Mul(t9, t9)
 	}
 
 	// Step 279: t9 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429
@@ -913,7 +868,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 287: t9 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d8342900
 	for s := 0; s < 8; s++ {
-		t9.Square(t9)
+		t9.Mul(t9, t9)
 	}
 
 	// Step 288: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d8342927
@@ -921,15 +876,15 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 290: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49c
 	for s := 0; s < 2; s++ {
-		t8.Square(t8)
+		t8.Mul(t8, t8)
 	}
 
 	// Step 291: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d
-	t8.Mul(&x, t8)
+	t8.Mul(x, t8)
 
 	// Step 299: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d00
 	for s := 0; s < 8; s++ {
-		t8.Square(t8)
+		t8.Mul(t8, t8)
 	}
 
 	// Step 300: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7f
@@ -937,7 +892,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 302: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275fc
 	for s := 0; s < 2; s++ {
-		t8.Square(t8)
+		t8.Mul(t8, t8)
 	}
 
 	// Step 303: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff
@@ -945,7 +900,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 310: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff80
 	for s := 0; s < 7; s++ {
-		t8.Square(t8)
+		t8.Mul(t8, t8)
 	}
 
 	// Step 311: t7 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d
@@ -953,15 +908,15 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 314: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce8
 	for s := 0; s < 3; s++ {
-		t7.Square(t7)
+		t7.Mul(t7, t7)
 	}
 
 	// Step 315: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce9
-	t7.Mul(&x, t7)
+	t7.Mul(x, t7)
 
 	// Step 323: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce900
 	for s := 0; s < 8; s++ {
-		t7.Square(t7)
+		t7.Mul(t7, t7)
 	}
 
 	// Step 324: t6 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f
@@ -969,7 +924,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 331: t6 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bf80
 	for s := 0; s < 7; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 332: t5 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb
@@ -977,7 +932,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 338: t5 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feec0
 	for s := 0; s < 6; s++ {
-		t5.Square(t5)
+		t5.Mul(t5, t5)
 	}
 
 	// Step 339: t4 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed5
@@ -985,7 +940,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 349: t4 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5400
 	for s := 0; s < 10; s++ {
-		t4.Square(t4)
+		t4.Mul(t4, t4)
 	}
 
 	// Step 350: t3 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411
@@ -993,7 +948,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 353: t3 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa088
 	for s := 0; s < 3; s++ {
-		t3.Square(t3)
+		t3.Mul(t3, t3)
 	}
 
 	// Step 354: t2 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa08b
@@ -1001,7 +956,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 377: t2 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed5045800000
 	for s := 0; s < 23; s++ {
-		t2.Square(t2)
+		t2.Mul(t2, t2)
 	}
 
 	// Step 378: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed5045800013
@@ -1009,7 +964,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 386: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001300
 	for s := 0; s < 8; s++ {
-		t1.Square(t1)
+		t1.Mul(t1, t1)
 	}
 
 	// Step 387: t0 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001329
@@ -1017,24 +972,1595 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 393: t0 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca40
 	for s := 0; s < 6; s++ {
-		t0.Square(t0)
+		t0.Mul(t0, t0)
 	}
 
 	// Step 394: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca45
 	z.Mul(z, t0)
 
-	// Step 398: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca450
-	for s := 0; s < 4; s++ {
-		z.Square(z)
+	// Step 397: z = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa08b0000265228
+	for s := 0; s < 3; s++ {
+		z.Mul(z, z)
 	}
 
-	// Step 399: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca451
-	z.Mul(&x, z)
+}
 
-	// Step 439: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca4510000000000
-	for s := 0; s < 40; s++ {
-		z.Square(z)
-	}
+// expByLegendreExp is equivalent to z.Exp(x, 1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca4510000000000)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_101     = _10 + _11
+	//	_110     = 1 + _101
+	//	_1001    = _11 + _110
+	//	_1011    = _10 + _1001
+	//	_1100    = 1 + _1011
+	//	_1101    = 1 + _1100
+	//	_10001   = _101 + _1100
+	//	_10011   = _10 + _10001
+	//	_10101   = _10 + _10011
+	//	_11011   = _110 + _10101
+	//	_11101   = _10 + _11011
+	//	_100011  = _110 + _11101
+	//	_100111  = _1100 + _11011
+	//	_101001  = _10 + _100111
+	//	_110101  = _1100 + _101001
+	//	_110111  = _10 + _110101
+	//	_111001  = _10 + _110111
+	//	_111011  = _10 + _111001
+	//	_111101  = _10 + _111011
+	//	_111111  = _10 + _111101
+	//	_1111100 = _111101 + _111111
+	//	_1111111 = _11 + _1111100
+	//	i39      = ((_1111100 << 4 + _11101) << 3 + _11) << 6
+	//	i57      = ((1 + i39) << 9 + _1011) << 6 + _1101
+	//	i78      = ((i57 << 9 + _10011) << 7 + _100011) << 3
+	//	i98      = ((1 + i78) << 11 + _101001) << 6 + _111001
+	//	i117     = ((i98 << 7 + _110101) << 4 + _1101) << 6
+	//	i138     = ((_1001 + i117) << 12 + _111011) << 6 + _10001
+	//	i162     = ((i138 << 11 + _111101) << 6 + _101) << 5
+	//	i184     = ((1 + i162) << 11 + _1011) << 8 + _111101
+	//	i205     = ((i184 << 6 + _11011) << 8 + _100011) << 5
+	//	i227     = ((_10001 + i205) << 12 + _100011) << 7 + _10011
+	//	i257     = ((i227 << 6 + _10011) << 13 + _110111) << 9
+	//	i279     = ((_11011 + i257) << 9 + _1101) << 10 + _101001
+	//	i299     = ((i279 << 8 + _100111) << 2 + 1) << 8
+	//	i311     = ((_1111111 + i299) << 2 + _11) << 7 + _11101
+	//	i331     = ((i311 << 3 + 1) << 8 + _1111111) << 7
+	//	i350     = ((_111011 + i331) << 6 + _10101) << 10 + _10001
+	//	i386     = ((i350 << 3 + _11) << 23 + _10011) << 8
+	//	i399     = ((_101001 + i386) << 6 + _101) << 4 + 1
+	//	return     i399 << 40
+	//
+	// Operations: 371 squares 68 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18 Element
+	// Step 1: t6 = x^0x2
+	t6.Square(&x)
+
+	// Step 2: t2 = x^0x3
+	t2.Mul(&x, t6)
+
+	// Step 3: z = x^0x5
+	z.Mul(t6, t2)
+
+	// Step 4: t0 = x^0x6
+	t0.Mul(&x, z)
+
+	// Step 5: t15 = x^0x9
+	t15.Mul(t2, t0)
+
+	// Step 6: t14 = x^0xb
+	t14.Mul(t6, t15)
+
+	// Step 7: t5 = x^0xc
+	t5.Mul(&x, t14)
+
+	// Step 8: t9 = x^0xd
+	t9.Mul(&x, t5)
+
+	// Step 9: t3 = x^0x11
+	t3.Mul(z, t5)
+
+	// Step 10: t1 = x^0x13
+	t1.Mul(t6, t3)
+
+	// Step 11: t4 = x^0x15
+	t4.Mul(t6, t1)
+
+	// Step 12: t10 = x^0x1b
+	t10.Mul(t0, t4)
+
+	// Step 13: t7 = x^0x1d
+	t7.Mul(t6, t10)
+
+	// Step 14: t12 = x^0x23
+	t12.Mul(t0, t7)
+
+	// Step 15: t8 = x^0x27
+	t8.Mul(t5, t10)
+
+	// Step 16: t0 = x^0x29
+	t0.Mul(t6, t8)
+
+	// Step 17: t16 = x^0x35
+	t16.Mul(t5, t0)
+
+	// Step 18: t11 = x^0x37
+	t11.Mul(t6, t16)
+
+	// Step 19: t17 = x^0x39
+	t17.Mul(t6, t11)
+
+	// Step 20: t5 = x^0x3b
+	t5.Mul(t6, t17)
+
+	// Step 21: t13 = x^0x3d
+	t13.Mul(t6, t5)
+
+	// Step 22: t6 = x^0x3f
+	t6.Mul(t6, t13)
+
+	// Step 23: t18 = x^0x7c
+	t18.Mul(t13, t6)
+
+	// Step 24: t6 = x^0x7f
+	t6.Mul(t2, t18)
+
+	// Step 28: t18 = x^0x7c0
+	for s := 0; s < 4; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 29: t18 = x^0x7dd
+	t18.Mul(t7, t18)
+
+	// Step 32: t18 = x^0x3ee8
+	for s := 0; s < 3; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 33: t18 = x^0x3eeb
+	t18.Mul(t2, t18)
+
+	// Step 39: t18 = x^0xfbac0
+	for s := 0; s < 6; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 40: t18 = x^0xfbac1
+	t18.Mul(&x, t18)
+
+	// Step 49: t18 = x^0x1f758200
+	for s := 0; s < 9; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 50: t18 = x^0x1f75820b
+	t18.Mul(t14, t18)
+
+	// Step 56: t18 = x^0x7dd6082c0
+	for s := 0; s < 6; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 57: t18 = x^0x7dd6082cd
+	t18.Mul(t9, t18)
+
+	// Step 66: t18 = x^0xfbac1059a00
+	for s := 0; s < 9; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 67: t18 = x^0xfbac1059a13
+	t18.Mul(t1, t18)
+
+	// Step 74: t18 = x^0x7dd6082cd0980
+	for s := 0; s < 7; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 75: t18 = x^0x7dd6082cd09a3
+	t18.Mul(t12, t18)
+
+	// Step 78: t18 = x^0x3eeb0416684d18
+	for s := 0; s < 3; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 79: t18 = x^0x3eeb0416684d19
+	t18.Mul(&x, t18)
+
+	// Step 90: t18 = x^0x1f75820b34268c800
+	for s := 0; s < 11; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 91: t18 = x^0x1f75820b34268c829
+	t18.Mul(t0, t18)
+
+	// Step 97: t18 = x^0x7dd6082cd09a320a40
+	for s := 0; s < 6; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 98: t17 = x^0x7dd6082cd09a320a79
+	t17.Mul(t17, t18)
+
+	// Step 105: t17 = x^0x3eeb0416684d19053c80
+	for s := 0; s < 7; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 106: t16 = x^0x3eeb0416684d19053cb5
+	t16.Mul(t16, t17)
+
+	// Step 110: t16 = x^0x3eeb0416684d19053cb50
+	for s := 0; s < 4; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 111: t16 = x^0x3eeb0416684d19053cb5d
+	t16.Mul(t9, t16)
+
+	// Step 117: t16 = x^0xfbac1059a1346414f2d740
+	for s := 0; s < 6; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 118: t15 = x^0xfbac1059a1346414f2d749
+	t15.Mul(t15, t16)
+
+	// Step 130: t15 = x^0xfbac1059a1346414f2d749000
+	for s := 0; s < 12; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 131: t15 = x^0xfbac1059a1346414f2d74903b
+	t15.Mul(t5, t15)
+
+	// Step 137: t15 = x^0x3eeb0416684d19053cb5d240ec0
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 138: t15 = x^0x3eeb0416684d19053cb5d240ed1
+	t15.Mul(t3, t15)
+
+	// Step 149: t15 = x^0x1f75820b34268c829e5ae920768800
+	for s := 0; s < 11; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 150: t15 = x^0x1f75820b34268c829e5ae92076883d
+	t15.Mul(t13, t15)
+
+	// Step 156: t15 = x^0x7dd6082cd09a320a796ba481da20f40
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 157: t15 = x^0x7dd6082cd09a320a796ba481da20f45
+	t15.Mul(z, t15)
+
+	// Step 162: t15 = x^0xfbac1059a1346414f2d74903b441e8a0
+	for s := 0; s < 5; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 163: t15 = x^0xfbac1059a1346414f2d74903b441e8a1
+	t15.Mul(&x, t15)
+
+	// Step 174: t15 = x^0x7dd6082cd09a320a796ba481da20f450800
+	for s := 0; s < 11; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 175: t14 = x^0x7dd6082cd09a320a796ba481da20f45080b
+	t14.Mul(t14, t15)
+
+	// Step 183: t14 = x^0x7dd6082cd09a320a796ba481da20f45080b00
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 184: t13 = x^0x7dd6082cd09a320a796ba481da20f45080b3d
+	t13.Mul(t13, t14)
+
+	// Step 190: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf40
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 191: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf5b
+	t13.Mul(t10, t13)
+
+	// Step 199: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf5b00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 200: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23
+	t13.Mul(t12, t13)
+
+	// Step 205: t13 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6460
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 206: t13 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471
+	t13.Mul(t3, t13)
+
+	// Step 218: t13 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471000
+	for s := 0; s < 12; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 219: t12 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471023
+	t12.Mul(t12, t13)
+
+	// Step 226: t12 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881180
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 227: t12 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193
+	t12.Mul(t1, t12)
+
+	// Step 233: t12 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464c0
+	for s := 0; s < 6; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 234: t12 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d3
+	t12.Mul(t1, t12)
+
+	// Step 247: t12 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a6000
+	for s := 0; s < 13; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 248: t11 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a6037
+	t11.Mul(t11, t12)
+
+	// Step 257: t11 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e00
+	for s := 0; s < 9; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 258: t10 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b
+	t10.Mul(t10, t11)
+
+	// Step 267: t10 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc3600
+	for s := 0; s < 9; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 268: t9 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d
+	t9.Mul(t9, t10)
+
+	// Step 278: t9 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83400
+	for s := 0; s < 10; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 279: t9 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429
+	t9.Mul(t0, t9)
+
+	// Step 287: t9 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d8342900
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 288: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d8342927
+	t8.Mul(t8, t9)
+
+	// Step 290: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49c
+	for s := 0; s < 2; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 291: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d
+	t8.Mul(&x, t8)
+
+	// Step 299: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d00
+	for s := 0; s < 8; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 300: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7f
+	t8.Mul(t6, t8)
+
+	// Step 302: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275fc
+	for s := 0; s < 2; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 303: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff
+	t8.Mul(t2, t8)
+
+	// Step 310: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff80
+	for s := 0; s < 7; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 311: t7 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d
+	t7.Mul(t7, t8)
+
+	// Step 314: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce8
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 315: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce9
+	t7.Mul(&x, t7)
+
+	// Step 323: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce900
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 324: t6 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f
+	t6.Mul(t6, t7)
+
+	// Step 331: t6 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bf80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 332: t5 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb
+	t5.Mul(t5, t6)
+
+	// Step 338: t5 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feec0
+	for s := 0; s < 6; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 339: t4 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed5
+	t4.Mul(t4, t5)
+
+	// Step 349: t4 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5400
+	for s := 0; s < 10; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 350: t3 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411
+	t3.Mul(t3, t4)
+
+	// Step 353: t3 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa088
+	for s := 0; s < 3; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 354: t2 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa08b
+	t2.Mul(t2, t3)
+
+	// Step 377: t2 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed5045800000
+	for s := 0; s < 23; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 378: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed5045800013
+	t1.Mul(t1, t2)
+
+	// Step 386: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001300
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 387: t0 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001329
+	t0.Mul(t0, t1)
+
+	// Step 393: t0 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca40
+	for s := 0; s < 6; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 394: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca45
+	z.Mul(z, t0)
+
+	// Step 398: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca450
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+
+	// Step 399: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca451
+	z.Mul(&x, z)
+
+	// Step 439: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca4510000000000
+	for s := 0; s < 40; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
+
+// vectorExpByLegendreExpNbTemporaries is the number of temporary vectors used by vectorExpByLegendreExp
+const vectorExpByLegendreExpNbTemporaries = 19
+
+// vectorExpByLegendreExp sets z[i] = x[i]^1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca4510000000000 for all i, with the addition chain of expByLegendreExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpByLegendreExpNbTemporaries * len(x).
+func vectorExpByLegendreExp(z, x, scratch Vector) {
+	n := len(x)
+	var (
+		t0  = scratch[0*n : 0*n+n]
+		t1  = scratch[1*n : 1*n+n]
+		t2  = scratch[2*n : 2*n+n]
+		t3  = scratch[3*n : 3*n+n]
+		t4  = scratch[4*n : 4*n+n]
+		t5  = scratch[5*n : 5*n+n]
+		t6  = scratch[6*n : 6*n+n]
+		t7  = scratch[7*n : 7*n+n]
+		t8  = scratch[8*n : 8*n+n]
+		t9  = scratch[9*n : 9*n+n]
+		t10 = scratch[10*n : 10*n+n]
+		t11 = scratch[11*n : 11*n+n]
+		t12 = scratch[12*n : 12*n+n]
+		t13 = scratch[13*n : 13*n+n]
+		t14 = scratch[14*n : 14*n+n]
+		t15 = scratch[15*n : 15*n+n]
+		t16 = scratch[16*n : 16*n+n]
+		t17 = scratch[17*n : 17*n+n]
+		t18 = scratch[18*n : 18*n+n]
+	)
+
+	// Step 1: t6 = x^0x2
+	t6.Mul(x, x)
+
+	// Step 2: t2 = x^0x3
+	t2.Mul(x, t6)
+
+	// Step 3: z = x^0x5
+	z.Mul(t6, t2)
+
+	// Step 4: t0 = x^0x6
+	t0.Mul(x, z)
+
+	// Step 5: t15 = x^0x9
+	t15.Mul(t2, t0)
+
+	// Step 6: t14 = x^0xb
+	t14.Mul(t6, t15)
+
+	// Step 7: t5 = x^0xc
+	t5.Mul(x, t14)
+
+	// Step 8: t9 = x^0xd
+	t9.Mul(x, t5)
+
+	// Step 9: t3 = x^0x11
+	t3.Mul(z, t5)
+
+	// Step 10: t1 = x^0x13
+	t1.Mul(t6, t3)
+
+	// Step 11: t4 = x^0x15
+	t4.Mul(t6, t1)
+
+	// Step 12: t10 = x^0x1b
+	t10.Mul(t0, t4)
+
+	// Step 13: t7 = x^0x1d
+	t7.Mul(t6, t10)
+
+	// Step 14: t12 = x^0x23
+	t12.Mul(t0, t7)
+
+	// Step 15: t8 = x^0x27
+	t8.Mul(t5, t10)
+
+	// Step 16: t0 = x^0x29
+	t0.Mul(t6, t8)
+
+	// Step 17: t16 = x^0x35
+	t16.Mul(t5, t0)
+
+	// Step 18: t11 = x^0x37
+	t11.Mul(t6, t16)
+
+	// Step 19: t17 = x^0x39
+	t17.Mul(t6, t11)
+
+	// Step 20: t5 = x^0x3b
+	t5.Mul(t6, t17)
+
+	// Step 21: t13 = x^0x3d
+	t13.Mul(t6, t5)
+
+	// Step 22: t6 = x^0x3f
+	t6.Mul(t6, t13)
+
+	// Step 23: t18 = x^0x7c
+	t18.Mul(t13, t6)
+
+	// Step 24: t6 = x^0x7f
+	t6.Mul(t2, t18)
+
+	// Step 28: t18 = x^0x7c0
+	for s := 0; s < 4; s++ {
+		t18.Mul(t18, t18)
+	}
+
+	// Step 29: t18 = x^0x7dd
+	t18.Mul(t7, t18)
+
+	// Step 32: t18 = x^0x3ee8
+	for s := 0; s < 3; s++ {
+		t18.Mul(t18, t18)
+	}
+
+	// Step 33: t18 = x^0x3eeb
+	t18.Mul(t2, t18)
+
+	// Step 39: t18 = x^0xfbac0
+	for s := 0; s < 6; s++ {
+		t18.Mul(t18, t18)
+	}
+
+	// Step 40: t18 = x^0xfbac1
+	t18.Mul(x, t18)
+
+	// Step 49: t18 = x^0x1f758200
+	for s := 0; s < 9; s++ {
+		t18.Mul(t18, t18)
+	}
+
+	// Step 50: t18 = x^0x1f75820b
+	t18.Mul(t14, t18)
+
+	// Step 56: t18 = x^0x7dd6082c0
+	for s := 0; s < 6; s++ {
+		t18.Mul(t18, t18)
+	}
+
+	// Step 57: t18 = x^0x7dd6082cd
+	t18.Mul(t9, t18)
+
+	// Step 66: t18 = x^0xfbac1059a00
+	for s := 0; s < 9; s++ {
+		t18.Mul(t18, t18)
+	}
+
+	// Step 67: t18 = x^0xfbac1059a13
+	t18.Mul(t1, t18)
+
+	// Step 74: t18 = x^0x7dd6082cd0980
+	for s := 0; s < 7; s++ {
+		t18.Mul(t18, t18)
+	}
+
+	// Step 75: t18 = x^0x7dd6082cd09a3
+	t18.Mul(t12, t18)
+
+	// Step 78: t18 = x^0x3eeb0416684d18
+	for s := 0; s < 3; s++ {
+		t18.Mul(t18, t18)
+	}
+
+	// Step 79: t18 = x^0x3eeb0416684d19
+	t18.Mul(x, t18)
+
+	// Step 90: t18 = x^0x1f75820b34268c800
+	for s := 0; s < 11; s++ {
+		t18.Mul(t18, t18)
+	}
+
+	// Step 91: t18 = x^0x1f75820b34268c829
+	t18.Mul(t0, t18)
+
+	// Step 97: t18 = x^0x7dd6082cd09a320a40
+	for s := 0; s < 6; s++ {
+		t18.Mul(t18, t18)
+	}
+
+	// Step 98: t17 = x^0x7dd6082cd09a320a79
+	t17.Mul(t17, t18)
+
+	// Step 105: t17 = x^0x3eeb0416684d19053c80
+	for s := 0; s < 7; s++ {
+		t17.Mul(t17, t17)
+	}
+
+	// Step 106: t16 = x^0x3eeb0416684d19053cb5
+	t16.Mul(t16, t17)
+
+	// Step 110: t16 = x^0x3eeb0416684d19053cb50
+	for s := 0; s < 4; s++ {
+		t16.Mul(t16, t16)
+	}
+
+	// Step 111: t16 = x^0x3eeb0416684d19053cb5d
+	t16.Mul(t9, t16)
+
+	// Step 117: t16 = x^0xfbac1059a1346414f2d740
+	for s := 0; s < 6; s++ {
+		t16.Mul(t16, t16)
+	}
+
+	// Step 118: t15 = x^0xfbac1059a1346414f2d749
+	t15.Mul(t15, t16)
+
+	// Step 130: t15 = x^0xfbac1059a1346414f2d749000
+	for s := 0; s < 12; s++ {
+		t15.Mul(t15, t15)
+	}
+
+	// Step 131: t15 = x^0xfbac1059a1346414f2d74903b
+	t15.Mul(t5, t15)
+
+	// Step 137: t15 = x^0x3eeb0416684d19053cb5d240ec0
+	for s := 0; s < 6; s++ {
+		t15.Mul(t15, t15)
+	}
+
+	// Step 138: t15 = x^0x3eeb0416684d19053cb5d240ed1
+	t15.Mul(t3, t15)
+
+	// Step 149: t15 = x^0x1f75820b34268c829e5ae920768800
+	for s := 0; s < 11; s++ {
+		t15.Mul(t15, t15)
+	}
+
+	// Step 150: t15 = x^0x1f75820b34268c829e5ae92076883d
+	t15.Mul(t13, t15)
+
+	// Step 156: t15 = x^0x7dd6082cd09a320a796ba481da20f40
+	for s := 0; s < 6; s++ {
+		t15.Mul(t15, t15)
+	}
+
+	// Step 157: t15 = x^0x7dd6082cd09a320a796ba481da20f45
+	t15.Mul(z, t15)
+
+	// Step 162: t15 = x^0xfbac1059a1346414f2d74903b441e8a0
+	for s := 0; s < 5; s++ {
+		t15.Mul(t15, t15)
+	}
+
+	// Step 163: t15 = x^0xfbac1059a1346414f2d74903b441e8a1
+	t15.Mul(x, t15)
+
+	// Step 174: t15 = x^0x7dd6082cd09a320a796ba481da20f450800
+	for s := 0; s < 11; s++ {
+		t15.Mul(t15, t15)
+	}
+
+	// Step 175: t14 = x^0x7dd6082cd09a320a796ba481da20f45080b
+	t14.Mul(t14, t15)
+
+	// Step 183: t14 = x^0x7dd6082cd09a320a796ba481da20f45080b00
+	for s := 0; s < 8; s++ {
+		t14.Mul(t14, t14)
+	}
+
+	// Step 184: t13 = x^0x7dd6082cd09a320a796ba481da20f45080b3d
+	t13.Mul(t13, t14)
+
+	// Step 190: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf40
+	for s := 0; s < 6; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 191: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf5b
+	t13.Mul(t10, t13)
+
+	// Step 199: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf5b00
+	for s := 0; s < 8; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 200: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23
+	t13.Mul(t12, t13)
+
+	// Step 205: t13 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6460
+	for s := 0; s < 5; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 206: t13 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471
+	t13.Mul(t3, t13)
+
+	// Step 218: t13 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471000
+	for s := 0; s < 12; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 219: t12 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471023
+	t12.Mul(t12, t13)
+
+	// Step 226: t12 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881180
+	for s := 0; s < 7; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 227: t12 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193
+	t12.Mul(t1, t12)
+
+	// Step 233: t12 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464c0
+	for s := 0; s < 6; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 234: t12 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d3
+	t12.Mul(t1, t12)
+
+	// Step 247: t12 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a6000
+	for s := 0; s < 13; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 248: t11 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a6037
+	t11.Mul(t11, t12)
+
+	// Step 257: t11 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e00
+	for s := 0; s < 9; s++ {
+		t11.Mul(t11, t11)
+	}
+
+	// Step 258: t10 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b
+	t10.Mul(t10, t11)
+
+	// Step 267: t10 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc3600
+	for s := 0; s < 9; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 268: t9 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d
+	t9.Mul(t9, t10)
+
+	// Step 278: t9 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83400
+	for s := 0; s < 10; s++ {
+		t9.Mul(t9, t9)
+	}
+
+	// Step 279: t9 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429
+	t9.Mul(t0, t9)
+
+	// Step 287: t9 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d8342900
+	for s := 0; s < 8; s++ {
+		t9.Mul(t9, t9)
+	}
+
+	// Step 288: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d8342927
+	t8.Mul(t8, t9)
+
+	// Step 290: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49c
+	for s := 0; s < 2; s++ {
+		t8.Mul(t8, t8)
+	}
+
+	// Step 291: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d
+	t8.Mul(x, t8)
+
+	// Step 299: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d00
+	for s := 0; s < 8; s++ {
+		t8.Mul(t8, t8)
+	}
+
+	// Step 300: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7f
+	t8.Mul(t6, t8)
+
+	// Step 302: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275fc
+	for s := 0; s < 2; s++ {
+		t8.Mul(t8, t8)
+	}
+
+	// Step 303: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff
+	t8.Mul(t2, t8)
+
+	// Step 310: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff80
+	for s := 0; s < 7; s++ {
+		t8.Mul(t8, t8)
+	}
+
+	// Step 311: t7 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d
+	t7.Mul(t7, t8)
+
+	// Step 314: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce8
+	for s := 0; s < 3; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 315: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce9
+	t7.Mul(x, t7)
+
+	// Step 323: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce900
+	for s := 0; s < 8; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 324: t6 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f
+	t6.Mul(t6, t7)
+
+	// Step 331: t6 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bf80
+	for s := 0; s < 7; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 332: t5 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb
+	t5.Mul(t5, t6)
+
+	// Step 338: t5 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feec0
+	for s := 0; s < 6; s++ {
+		t5.Mul(t5, t5)
+	}
+
+	// Step 339: t4 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed5
+	t4.Mul(t4, t5)
+
+	// Step 349: t4 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5400
+	for s := 0; s < 10; s++ {
+		t4.Mul(t4, t4)
+	}
+
+	// Step 350: t3 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411
+	t3.Mul(t3, t4)
+
+	// Step 353: t3 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa088
+	for s := 0; s < 3; s++ {
+		t3.Mul(t3, t3)
+	}
+
+	// Step 354: t2 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa08b
+	t2.Mul(t2, t3)
+
+	// Step 377: t2 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed5045800000
+	for s := 0; s < 23; s++ {
+		t2.Mul(t2, t2)
+	}
+
+	// Step 378: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed5045800013
+	t1.Mul(t1, t2)
+
+	// Step 386: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001300
+	for s := 0; s < 8; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 387: t0 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001329
+	t0.Mul(t0, t1)
+
+	// Step 393: t0 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca40
+	for s := 0; s < 6; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 394: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca45
+	z.Mul(z, t0)
+
+	// Step 398: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca450
+	for s := 0; s < 4; s++ {
+		z.Mul(z, z)
+	}
+
+	// Step 399: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca451
+	z.Mul(x, z)
+
+	// Step 439: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca4510000000000
+	for s := 0; s < 40; s++ {
+		z.Mul(z, z)
+	}
+
+}
+
+// expByInverseExp is equivalent to z.Exp(x, 3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009948a1ffffffffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_101     = _10 + _11
+	//	_111     = _10 + _101
+	//	_1001    = _10 + _111
+	//	_1011    = _10 + _1001
+	//	_1101    = _10 + _1011
+	//	_1111    = _10 + _1101
+	//	_11110   = 2*_1111
+	//	_11111   = 1 + _11110
+	//	_1111100 = _11111 << 2
+	//	_1111111 = _11 + _1111100
+	//	i15      = _1111100 << 2
+	//	i16      = _111 + i15
+	//	x9       = _1111 + i15
+	//	i39      = ((i16 << 5 + _1011) << 6 + 1) << 9
+	//	i56      = ((_1011 + i39) << 6 + _1101) << 8 + _1001
+	//	i69      = ((i56 << 3 + _101) << 5 + _11) << 3
+	//	i86      = ((1 + i69) << 8 + _101) << 6 + _1111
+	//	i102     = ((i86 << 6 + _1011) << 5 + _1011) << 3
+	//	i120     = ((_101 + i102) << 6 + _1001) << 9 + _111
+	//	i140     = ((i120 << 5 + _1101) << 4 + 1) << 9
+	//	i151     = ((_1111 + i140) << 2 + 1) << 6 + _101
+	//	i175     = ((i151 << 5 + 1) << 11 + _1011) << 6
+	//	i186     = ((_1111 + i175) << 5 + _1011) << 3 + _11
+	//	i201     = ((i186 << 3 + 1) << 6 + _111) << 4
+	//	i216     = ((1 + i201) << 7 + 1) << 5 + _11
+	//	i231     = ((i216 << 6 + _1001) << 3 + _101) << 4
+	//	i247     = ((_11 + i231) << 11 + _1101) << 2 + _11
+	//	i267     = (2*(i247 << 8 + _1101) + 1) << 9
+	//	i283     = ((_1101 + i267) << 7 + _101) << 6 + _1001
+	//	i302     = ((i283 << 5 + _111) << 2 + 1) << 10
+	//	i315     = ((x9 + i302) << 5 + _111) << 5 + _1001
+	//	i334     = ((i315 << 8 + _1111111) << 4 + _111) << 5
+	//	i347     = ((_1101 + i334) << 4 + _101) << 6 + 1
+	//	i382     = ((i347 << 7 + _1011) << 22 + _1001) << 4
+	//	i396     = ((_1001 + i382) << 5 + _1001) << 6 + _101
+	//	i429     = ((i396 << 13 + x9) << 9 + x9) << 9
+	//	return     ((x9 + i429) << 9 + x9) << 5 + _11111
+	//
+	// Operations: 375 squares 71 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+		t3 = new(Element)
+		t4 = new(Element)
+		t5 = new(Element)
+		t6 = new(Element)
+		t7 = new(Element)
+		t8 = new(Element)
+		t9 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: t7 = x^0x3
+	t7.Mul(&x, z)
+
+	// Step 3: t1 = x^0x5
+	t1.Mul(z, t7)
+
+	// Step 4: t5 = x^0x7
+	t5.Mul(z, t1)
+
+	// Step 5: t2 = x^0x9
+	t2.Mul(z, t5)
+
+	// Step 6: t3 = x^0xb
+	t3.Mul(z, t2)
+
+	// Step 7: t4 = x^0xd
+	t4.Mul(z, t3)
+
+	// Step 8: t8 = x^0xf
+	t8.Mul(z, t4)
+
+	// Step 9: z = x^0x1e
+	z.Square(t8)
+
+	// Step 10: z = x^0x1f
+	z.Mul(&x, z)
+
+	// Step 12: t0 = x^0x7c
+	t0.Square(z)
+	for s := 1; s < 2; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 13: t6 = x^0x7f
+	t6.Mul(t7, t0)
+
+	// Step 15: t0 = x^0x1f0
+	for s := 0; s < 2; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 16: t9 = x^0x1f7
+	t9.Mul(t5, t0)
+
+	// Step 17: t0 = x^0x1ff
+	t0.Mul(t8, t0)
+
+	// Step 22: t9 = x^0x3ee0
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 23: t9 = x^0x3eeb
+	t9.Mul(t3, t9)
+
+	// Step 29: t9 = x^0xfbac0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 30: t9 = x^0xfbac1
+	t9.Mul(&x, t9)
+
+	// Step 39: t9 = x^0x1f758200
+	for s := 0; s < 9; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 40: t9 = x^0x1f75820b
+	t9.Mul(t3, t9)
+
+	// Step 46: t9 = x^0x7dd6082c0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 47: t9 = x^0x7dd6082cd
+	t9.Mul(t4, t9)
+
+	// Step 55: t9 = x^0x7dd6082cd00
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 56: t9 = x^0x7dd6082cd09
+	t9.Mul(t2, t9)
+
+	// Step 59: t9 = x^0x3eeb04166848
+	for s := 0; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 60: t9 = x^0x3eeb0416684d
+	t9.Mul(t1, t9)
+
+	// Step 65: t9 = x^0x7dd6082cd09a0
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 66: t9 = x^0x7dd6082cd09a3
+	t9.Mul(t7, t9)
+
+	// Step 69: t9 = x^0x3eeb0416684d18
+	for s := 0; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 70: t9 = x^0x3eeb0416684d19
+	t9.Mul(&x, t9)
+
+	// Step 78: t9 = x^0x3eeb0416684d1900
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 79: t9 = x^0x3eeb0416684d1905
+	t9.Mul(t1, t9)
+
+	// Step 85: t9 = x^0xfbac1059a13464140
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 86: t9 = x^0xfbac1059a1346414f
+	t9.Mul(t8, t9)
+
+	// Step 92: t9 = x^0x3eeb0416684d19053c0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 93: t9 = x^0x3eeb0416684d19053cb
+	t9.Mul(t3, t9)
+
+	// Step 98: t9 = x^0x7dd6082cd09a320a7960
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 99: t9 = x^0x7dd6082cd09a320a796b
+	t9.Mul(t3, t9)
+
+	// Step 102: t9 = x^0x3eeb0416684d19053cb58
+	for s := 0; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 103: t9 = x^0x3eeb0416684d19053cb5d
+	t9.Mul(t1, t9)
+
+	// Step 109: t9 = x^0xfbac1059a1346414f2d740
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 110: t9 = x^0xfbac1059a1346414f2d749
+	t9.Mul(t2, t9)
+
+	// Step 119: t9 = x^0x1f75820b34268c829e5ae9200
+	for s := 0; s < 9; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 120: t9 = x^0x1f75820b34268c829e5ae9207
+	t9.Mul(t5, t9)
+
+	// Step 125: t9 = x^0x3eeb0416684d19053cb5d240e0
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 126: t9 = x^0x3eeb0416684d19053cb5d240ed
+	t9.Mul(t4, t9)
+
+	// Step 130: t9 = x^0x3eeb0416684d19053cb5d240ed0
+	for s := 0; s < 4; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 131: t9 = x^0x3eeb0416684d19053cb5d240ed1
+	t9.Mul(&x, t9)
+
+	// Step 140: t9 = x^0x7dd6082cd09a320a796ba481da200
+	for s := 0; s < 9; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 141: t9 = x^0x7dd6082cd09a320a796ba481da20f
+	t9.Mul(t8, t9)
+
+	// Step 143: t9 = x^0x1f75820b34268c829e5ae92076883c
+	for s := 0; s < 2; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 144: t9 = x^0x1f75820b34268c829e5ae92076883d
+	t9.Mul(&x, t9)
+
+	// Step 150: t9 = x^0x7dd6082cd09a320a796ba481da20f40
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 151: t9 = x^0x7dd6082cd09a320a796ba481da20f45
+	t9.Mul(t1, t9)
+
+	// Step 156: t9 = x^0xfbac1059a1346414f2d74903b441e8a0
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 157: t9 = x^0xfbac1059a1346414f2d74903b441e8a1
+	t9.Mul(&x, t9)
+
+	// Step 168: t9 = x^0x7dd6082cd09a320a796ba481da20f450800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 169: t9 = x^0x7dd6082cd09a320a796ba481da20f45080b
+	t9.Mul(t3, t9)
+
+	// Step 175: t9 = x^0x1f75820b34268c829e5ae92076883d14202c0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 176: t8 = x^0x1f75820b34268c829e5ae92076883d14202cf
+	t8.Mul(t8, t9)
+
+	// Step 181: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059e0
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 182: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb
+	t8.Mul(t3, t8)
+
+	// Step 185: t8 = x^0x1f75820b34268c829e5ae92076883d14202cf58
+	for s := 0; s < 3; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 186: t8 = x^0x1f75820b34268c829e5ae92076883d14202cf5b
+	t8.Mul(t7, t8)
+
+	// Step 189: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad8
+	for s := 0; s < 3; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 190: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad9
+	t8.Mul(&x, t8)
+
+	// Step 196: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb640
+	for s := 0; s < 6; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 197: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647
+	t8.Mul(t5, t8)
+
+	// Step 201: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6470
+	for s := 0; s < 4; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 202: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471
+	t8.Mul(&x, t8)
+
+	// Step 209: t8 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23880
+	for s := 0; s < 7; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 210: t8 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881
+	t8.Mul(&x, t8)
+
+	// Step 215: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471020
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 216: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471023
+	t8.Mul(t7, t8)
+
+	// Step 222: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c0
+	for s := 0; s < 6; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 223: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9
+	t8.Mul(t2, t8)
+
+	// Step 226: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e204648
+	for s := 0; s < 3; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 227: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d
+	t8.Mul(t1, t8)
+
+	// Step 231: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0
+	for s := 0; s < 4; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 232: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d3
+	t8.Mul(t7, t8)
+
+	// Step 243: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471023269800
+	for s := 0; s < 11; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 244: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980d
+	t8.Mul(t4, t8)
+
+	// Step 246: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a6034
+	for s := 0; s < 2; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 247: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a6037
+	t7.Mul(t7, t8)
+
+	// Step 255: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a603700
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 256: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d
+	t7.Mul(t4, t7)
+
+	// Step 257: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1a
+	t7.Square(t7)
+
+	// Step 258: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b
+	t7.Mul(&x, t7)
+
+	// Step 267: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc3600
+	for s := 0; s < 9; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 268: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d
+	t7.Mul(t4, t7)
+
+	// Step 275: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b0680
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 276: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b0685
+	t7.Mul(t1, t7)
+
+	// Step 282: t7 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a140
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 283: t7 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a149
+	t7.Mul(t2, t7)
+
+	// Step 288: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d8342920
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 289: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d8342927
+	t7.Mul(t5, t7)
+
+	// Step 291: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49c
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 292: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d
+	t7.Mul(&x, t7)
+
+	// Step 302: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d8342927400
+	for s := 0; s < 10; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 303: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff
+	t7.Mul(t0, t7)
+
+	// Step 308: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 309: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe7
+	t7.Mul(t5, t7)
+
+	// Step 314: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 315: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce9
+	t7.Mul(t2, t7)
+
+	// Step 323: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce900
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 324: t6 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f
+	t6.Mul(t6, t7)
+
+	// Step 328: t6 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f0
+	for s := 0; s < 4; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 329: t5 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f7
+	t5.Mul(t5, t6)
+
+	// Step 334: t5 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2fee0
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 335: t4 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed
+	t4.Mul(t4, t5)
+
+	// Step 339: t4 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed0
+	for s := 0; s < 4; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 340: t4 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed5
+	t4.Mul(t1, t4)
+
+	// Step 346: t4 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb540
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 347: t4 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb541
+	t4.Mul(&x, t4)
+
+	// Step 354: t4 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa080
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 355: t3 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa08b
+	t3.Mul(t3, t4)
+
+	// Step 377: t3 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00000
+	for s := 0; s < 22; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 378: t3 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009
+	t3.Mul(t2, t3)
+
+	// Step 382: t3 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c000090
+	for s := 0; s < 4; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 383: t3 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c000099
+	t3.Mul(t2, t3)
+
+	// Step 388: t3 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001320
+	for s := 0; s < 5; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 389: t2 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001329
+	t2.Mul(t2, t3)
+
+	// Step 395: t2 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca40
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 396: t1 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca45
+	t1.Mul(t1, t2)
+
+	// Step 409: t1 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009948a000
+	for s := 0; s < 13; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 410: t1 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009948a1ff
+	t1.Mul(t0, t1)
+
+	// Step 419: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001329143fe00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 420: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001329143ffff
+	t1.Mul(t0, t1)
+
+	// Step 429: t1 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa08b00002652287fffe00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 430: t1 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa08b00002652287ffffff
+	t1.Mul(t0, t1)
+
+	// Step 439: t1 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca450ffffffe00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 440: t0 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca450fffffffff
+	t0.Mul(t0, t1)
+
+	// Step 445: t0 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009948a1ffffffffe0
+	for s := 0; s < 5; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 446: z = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009948a1ffffffffff
+	z.Mul(z, t0)
 
 	return z
 }
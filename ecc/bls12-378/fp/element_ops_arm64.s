@@ -0,0 +1,476 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0x9948a20000000001
+DATA q<>+8(SB)/8, $0xce97f76a822c0000
+DATA q<>+16(SB)/8, $0x980dc360d0a49d7f
+DATA q<>+24(SB)/8, $0x84059eb647102326
+DATA q<>+32(SB)/8, $0x53cb5d240ed107a2
+DATA q<>+40(SB)/8, $0x03eeb0416684d190
+GLOBL q<>(SB), (RODATA+NOPTR), $48
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x9948a1ffffffffff
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+// mul(res, x, y *Element) sets res = x * y * R⁻¹ (mod q)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD $q<>(SB), R12
+	LDP  32(R12), (R16, R17)
+	LDP  16(R12), (R14, R15)
+	LDP  0(R12), (R12, R13)
+	MOVD qInv0<>(SB), R1
+	MOVD x+8(FP), R0
+	LDP  32(R0), (R10, R11)
+	LDP  16(R0), (R8, R9)
+	LDP  0(R0), (R6, R7)
+	MOVD y+16(FP), R0
+	MOVD 0(R0), R2
+
+	// t = t + x * y[0]
+	MUL   R6, R2, R19
+	MUL   R7, R2, R20
+	MUL   R8, R2, R21
+	MUL   R9, R2, R22
+	MUL   R10, R2, R23
+	MUL   R11, R2, R24
+	UMULH R6, R2, R5
+	ADDS  R5, R20, R20
+	UMULH R7, R2, R5
+	ADCS  R5, R21, R21
+	UMULH R8, R2, R5
+	ADCS  R5, R22, R22
+	UMULH R9, R2, R5
+	ADCS  R5, R23, R23
+	UMULH R10, R2, R5
+	ADCS  R5, R24, R24
+	UMULH R11, R2, R5
+	ADC   R5, ZR, R25
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R19, R3
+	MUL   R12, R3, R4
+	ADDS  R4, R19, R19
+	MUL   R13, R3, R4
+	ADCS  R4, R20, R20
+	MUL   R14, R3, R4
+	ADCS  R4, R21, R21
+	MUL   R15, R3, R4
+	ADCS  R4, R22, R22
+	MUL   R16, R3, R4
+	ADCS  R4, R23, R23
+	MUL   R17, R3, R4
+	ADCS  R4, R24, R24
+	ADC   ZR, R25, R25
+	UMULH R12, R3, R5
+	ADDS  R5, R20, R20
+	UMULH R13, R3, R5
+	ADCS  R5, R21, R21
+	UMULH R14, R3, R5
+	ADCS  R5, R22, R22
+	UMULH R15, R3, R5
+	ADCS  R5, R23, R23
+	UMULH R16, R3, R5
+	ADCS  R5, R24, R24
+	UMULH R17, R3, R5
+	ADC   R5, R25, R25
+	MOVD  8(R0), R2
+
+	// t = t + x * y[1]
+	MUL   R6, R2, R4
+	ADDS  R4, R20, R20
+	MUL   R7, R2, R4
+	ADCS  R4, R21, R21
+	MUL   R8, R2, R4
+	ADCS  R4, R22, R22
+	MUL   R9, R2, R4
+	ADCS  R4, R23, R23
+	MUL   R10, R2, R4
+	ADCS  R4, R24, R24
+	MUL   R11, R2, R4
+	ADCS  R4, R25, R25
+	ADC   ZR, ZR, R19
+	UMULH R6, R2, R5
+	ADDS  R5, R21, R21
+	UMULH R7, R2, R5
+	ADCS  R5, R22, R22
+	UMULH R8, R2, R5
+	ADCS  R5, R23, R23
+	UMULH R9, R2, R5
+	ADCS  R5, R24, R24
+	UMULH R10, R2, R5
+	ADCS  R5, R25, R25
+	UMULH R11, R2, R5
+	ADC   R5, R19, R19
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R20, R3
+	MUL   R12, R3, R4
+	ADDS  R4, R20, R20
+	MUL   R13, R3, R4
+	ADCS  R4, R21, R21
+	MUL   R14, R3, R4
+	ADCS  R4, R22, R22
+	MUL   R15, R3, R4
+	ADCS  R4, R23, R23
+	MUL   R16, R3, R4
+	ADCS  R4, R24, R24
+	MUL   R17, R3, R4
+	ADCS  R4, R25, R25
+	ADC   ZR, R19, R19
+	UMULH R12, R3, R5
+	ADDS  R5, R21, R21
+	UMULH R13, R3, R5
+	ADCS  R5, R22, R22
+	UMULH R14, R3, R5
+	ADCS  R5, R23, R23
+	UMULH R15, R3, R5
+	ADCS  R5, R24, R24
+	UMULH R16, R3, R5
+	ADCS  R5, R25, R25
+	UMULH R17, R3, R5
+	ADC   R5, R19, R19
+	MOVD  16(R0), R2
+
+	// t = t + x * y[2]
+	MUL   R6, R2, R4
+	ADDS  R4, R21, R21
+	MUL   R7, R2, R4
+	ADCS  R4, R22, R22
+	MUL   R8, R2, R4
+	ADCS  R4, R23, R23
+	MUL   R9, R2, R4
+	ADCS  R4, R24, R24
+	MUL   R10, R2, R4
+	ADCS  R4, R25, R25
+	MUL   R11, R2, R4
+	ADCS  R4, R19, R19
+	ADC   ZR, ZR, R20
+	UMULH R6, R2, R5
+	ADDS  R5, R22, R22
+	UMULH R7, R2, R5
+	ADCS  R5, R23, R23
+	UMULH R8, R2, R5
+	ADCS  R5, R24, R24
+	UMULH R9, R2, R5
+	ADCS  R5, R25, R25
+	UMULH R10, R2, R5
+	ADCS  R5, R19, R19
+	UMULH R11, R2, R5
+	ADC   R5, R20, R20
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R21, R3
+	MUL   R12, R3, R4
+	ADDS  R4, R21, R21
+	MUL   R13, R3, R4
+	ADCS  R4, R22, R22
+	MUL   R14, R3, R4
+	ADCS  R4, R23, R23
+	MUL   R15, R3, R4
+	ADCS  R4, R24, R24
+	MUL   R16, R3, R4
+	ADCS  R4, R25, R25
+	MUL   R17, R3, R4
+	ADCS  R4, R19, R19
+	ADC   ZR, R20, R20
+	UMULH R12, R3, R5
+	ADDS  R5, R22, R22
+	UMULH R13, R3, R5
+	ADCS  R5, R23, R23
+	UMULH R14, R3, R5
+	ADCS  R5, R24, R24
+	UMULH R15, R3, R5
+	ADCS  R5, R25, R25
+	UMULH R16, R3, R5
+	ADCS  R5, R19, R19
+	UMULH R17, R3, R5
+	ADC   R5, R20, R20
+	MOVD  24(R0), R2
+
+	// t = t + x * y[3]
+	MUL   R6, R2, R4
+	ADDS  R4, R22, R22
+	MUL   R7, R2, R4
+	ADCS  R4, R23, R23
+	MUL   R8, R2, R4
+	ADCS  R4, R24, R24
+	MUL   R9, R2, R4
+	ADCS  R4, R25, R25
+	MUL   R10, R2, R4
+	ADCS  R4, R19, R19
+	MUL   R11, R2, R4
+	ADCS  R4, R20, R20
+	ADC   ZR, ZR, R21
+	UMULH R6, R2, R5
+	ADDS  R5, R23, R23
+	UMULH R7, R2, R5
+	ADCS  R5, R24, R24
+	UMULH R8, R2, R5
+	ADCS  R5, R25, R25
+	UMULH R9, R2, R5
+	ADCS  R5, R19, R19
+	UMULH R10, R2, R5
+	ADCS  R5, R20, R20
+	UMULH R11, R2, R5
+	ADC   R5, R21, R21
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R22, R3
+	MUL   R12, R3, R4
+	ADDS  R4, R22, R22
+	MUL   R13, R3, R4
+	ADCS  R4, R23, R23
+	MUL   R14, R3, R4
+	ADCS  R4, R24, R24
+	MUL   R15, R3, R4
+	ADCS  R4, R25, R25
+	MUL   R16, R3, R4
+	ADCS  R4, R19, R19
+	MUL   R17, R3, R4
+	ADCS  R4, R20, R20
+	ADC   ZR, R21, R21
+	UMULH R12, R3, R5
+	ADDS  R5, R23, R23
+	UMULH R13, R3, R5
+	ADCS  R5, R24, R24
+	UMULH R14, R3, R5
+	ADCS  R5, R25, R25
+	UMULH R15, R3, R5
+	ADCS  R5, R19, R19
+	UMULH R16, R3, R5
+	ADCS  R5, R20, R20
+	UMULH R17, R3, R5
+	ADC   R5, R21, R21
+	MOVD  32(R0), R2
+
+	// t = t + x * y[4]
+	MUL   R6, R2, R4
+	ADDS  R4, R23, R23
+	MUL   R7, R2, R4
+	ADCS  R4, R24, R24
+	MUL   R8, R2, R4
+	ADCS  R4, R25, R25
+	MUL   R9, R2, R4
+	ADCS  R4, R19, R19
+	MUL   R10, R2, R4
+	ADCS  R4, R20, R20
+	MUL   R11, R2, R4
+	ADCS  R4, R21, R21
+	ADC   ZR, ZR, R22
+	UMULH R6, R2, R5
+	ADDS  R5, R24, R24
+	UMULH R7, R2, R5
+	ADCS  R5, R25, R25
+	UMULH R8, R2, R5
+	ADCS  R5, R19, R19
+	UMULH R9, R2, R5
+	ADCS  R5, R20, R20
+	UMULH R10, R2, R5
+	ADCS  R5, R21, R21
+	UMULH R11, R2, R5
+	ADC   R5, R22, R22
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R23, R3
+	MUL   R12, R3, R4
+	ADDS  R4, R23, R23
+	MUL   R13, R3, R4
+	ADCS  R4, R24, R24
+	MUL   R14, R3, R4
+	ADCS  R4, R25, R25
+	MUL   R15, R3, R4
+	ADCS  R4, R19, R19
+	MUL   R16, R3, R4
+	ADCS  R4, R20, R20
+	MUL   R17, R3, R4
+	ADCS  R4, R21, R21
+	ADC   ZR, R22, R22
+	UMULH R12, R3, R5
+	ADDS  R5, R24, R24
+	UMULH R13, R3, R5
+	ADCS  R5, R25, R25
+	UMULH R14, R3, R5
+	ADCS  R5, R19, R19
+	UMULH R15, R3, R5
+	ADCS  R5, R20, R20
+	UMULH R16, R3, R5
+	ADCS  R5, R21, R21
+	UMULH R17, R3, R5
+	ADC   R5, R22, R22
+	MOVD  40(R0), R2
+
+	// t = t + x * y[5]
+	MUL   R6, R2, R4
+	ADDS  R4, R24, R24
+	MUL   R7, R2, R4
+	ADCS  R4, R25, R25
+	MUL   R8, R2, R4
+	ADCS  R4, R19, R19
+	MUL   R9, R2, R4
+	ADCS  R4, R20, R20
+	MUL   R10, R2, R4
+	ADCS  R4, R21, R21
+	MUL   R11, R2, R4
+	ADCS  R4, R22, R22
+	ADC   ZR, ZR, R23
+	UMULH R6, R2, R5
+	ADDS  R5, R25, R25
+	UMULH R7, R2, R5
+	ADCS  R5, R19, R19
+	UMULH R8, R2, R5
+	ADCS  R5, R20, R20
+	UMULH R9, R2, R5
+	ADCS  R5, R21, R21
+	UMULH R10, R2, R5
+	ADCS  R5, R22, R22
+	UMULH R11, R2, R5
+	ADC   R5, R23, R23
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R24, R3
+	MUL   R12, R3, R4
+	ADDS  R4, R24, R24
+	MUL   R13, R3, R4
+	ADCS  R4, R25, R25
+	MUL   R14, R3, R4
+	ADCS  R4, R19, R19
+	MUL   R15, R3, R4
+	ADCS  R4, R20, R20
+	MUL   R16, R3, R4
+	ADCS  R4, R21, R21
+	MUL   R17, R3, R4
+	ADCS  R4, R22, R22
+	ADC   ZR, R23, R23
+	UMULH R12, R3, R5
+	ADDS  R5, R25, R25
+	UMULH R13, R3, R5
+	ADCS  R5, R19, R19
+	UMULH R14, R3, R5
+	ADCS  R5, R20, R20
+	UMULH R15, R3, R5
+	ADCS  R5, R21, R21
+	UMULH R16, R3, R5
+	ADCS  R5, R22, R22
+	UMULH R17, R3, R5
+	ADC   R5, R23, R23
+
+	// reduce t if t ⩾ q
+	SUBS R12, R25, R6
+	SBCS R13, R19, R7
+	SBCS R14, R20, R8
+	SBCS R15, R21, R9
+	SBCS R16, R22, R10
+	SBCS R17, R23, R11
+	CSEL CS, R6, R25, R25
+	CSEL CS, R7, R19, R19
+	CSEL CS, R8, R20, R20
+	CSEL CS, R9, R21, R21
+	CSEL CS, R10, R22, R22
+	CSEL CS, R11, R23, R23
+	MOVD res+0(FP), R0
+	STP  (R25, R19), 0(R0)
+	STP  (R20, R21), 16(R0)
+	STP  (R22, R23), 32(R0)
+	RET
+
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVD $q<>(SB), R7
+	LDP  32(R7), (R11, R12)
+	LDP  16(R7), (R9, R10)
+	LDP  0(R7), (R7, R8)
+	MOVD res+0(FP), R0
+	LDP  32(R0), (R5, R6)
+	LDP  16(R0), (R3, R4)
+	LDP  0(R0), (R1, R2)
+	SUBS R7, R1, R13
+	SBCS R8, R2, R14
+	SBCS R9, R3, R15
+	SBCS R10, R4, R16
+	SBCS R11, R5, R17
+	SBCS R12, R6, R19
+	CSEL CS, R13, R1, R1
+	CSEL CS, R14, R2, R2
+	CSEL CS, R15, R3, R3
+	CSEL CS, R16, R4, R4
+	CSEL CS, R17, R5, R5
+	CSEL CS, R19, R6, R6
+	STP  (R1, R2), 0(R0)
+	STP  (R3, R4), 16(R0)
+	STP  (R5, R6), 32(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD $q<>(SB), R14
+	LDP  32(R14), (R19, R20)
+	LDP  16(R14), (R16, R17)
+	LDP  0(R14), (R14, R15)
+	MOVD a+0(FP), R0
+	LDP  32(R0), (R6, R7)
+	LDP  16(R0), (R4, R5)
+	LDP  0(R0), (R2, R3)
+	MOVD b+8(FP), R1
+	LDP  32(R1), (R12, R13)
+	LDP  16(R1), (R10, R11)
+	LDP  0(R1), (R8, R9)
+	ADDS R2, R8, R21
+	ADCS R3, R9, R22
+	ADCS R4, R10, R23
+	ADCS R5, R11, R24
+	ADCS R6, R12, R25
+	ADCS R7, R13, R26
+	SUBS R8, R2, R2
+	SBCS R9, R3, R3
+	SBCS R10, R4, R4
+	SBCS R11, R5, R5
+	SBCS R12, R6, R6
+	SBCS R13, R7, R7
+	CSEL CS, ZR, R14, R8
+	CSEL CS, ZR, R15, R9
+	CSEL CS, ZR, R16, R10
+	CSEL CS, ZR, R17, R11
+	CSEL CS, ZR, R19, R12
+	CSEL CS, ZR, R20, R13
+	ADDS R2, R8, R2
+	ADCS R3, R9, R3
+	ADCS R4, R10, R4
+	ADCS R5, R11, R5
+	ADCS R6, R12, R6
+	ADCS R7, R13, R7
+	STP  (R2, R3), 0(R1)
+	STP  (R4, R5), 16(R1)
+	STP  (R6, R7), 32(R1)
+	SUBS R14, R21, R8
+	SBCS R15, R22, R9
+	SBCS R16, R23, R10
+	SBCS R17, R24, R11
+	SBCS R19, R25, R12
+	SBCS R20, R26, R13
+	CSEL CS, R8, R21, R21
+	CSEL CS, R9, R22, R22
+	CSEL CS, R10, R23, R23
+	CSEL CS, R11, R24, R24
+	CSEL CS, R12, R25, R25
+	CSEL CS, R13, R26, R26
+	STP  (R21, R22), 0(R0)
+	STP  (R23, R24), 16(R0)
+	STP  (R25, R26), 32(R0)
+	RET
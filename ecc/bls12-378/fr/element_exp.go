@@ -326,6 +326,264 @@ func (z *Element) expBySqrtExp(x Element) *Element {
 	return z
 }
 
+// vectorExpBySqrtExpNbTemporaries is the number of temporary vectors used by vectorExpBySqrtExp
+const vectorExpBySqrtExpNbTemporaries = 11
+
+// vectorExpBySqrtExp sets z[i] = x[i]^41cf7391def65d630ef0ff69c7b761ffd5cefe7b4128000265228 for all i, with the addition chain of expBySqrtExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpBySqrtExpNbTemporaries * len(x).
+func vectorExpBySqrtExp(z, x, scratch Vector) {
+	n := len(x)
+	var (
+		t0  = scratch[0*n : 0*n+n]
+		t1  = scratch[1*n : 1*n+n]
+		t2  = scratch[2*n : 2*n+n]
+		t3  = scratch[3*n : 3*n+n]
+		t4  = scratch[4*n : 4*n+n]
+		t5  = scratch[5*n : 5*n+n]
+		t6  = scratch[6*n : 6*n+n]
+		t7  = scratch[7*n : 7*n+n]
+		t8  = scratch[8*n : 8*n+n]
+		t9  = scratch[9*n : 9*n+n]
+		t10 = scratch[10*n : 10*n+n]
+	)
+
+	// Step 1: t3 = x^0x2
+	t3.Mul(x, x)
+
+	// Step 2: t2 = x^0x4
+	t2.Mul(t3, t3)
+
+	// Step 3: z = x^0x5
+	z.Mul(x, t2)
+
+	// Step 4: t9 = x^0xa
+	t9.Mul(z, z)
+
+	// Step 5: t6 = x^0xf
+	t6.Mul(z, t9)
+
+	// Step 6: t8 = x^0x13
+	t8.Mul(t2, t6)
+
+	// Step 7: t4 = x^0x14
+	t4.Mul(x, t8)
+
+	// Step 8: t0 = x^0x1d
+	t0.Mul(t9, t8)
+
+	// Step 9: t1 = x^0x2c
+	t1.Mul(t6, t0)
+
+	// Step 10: t0 = x^0x49
+	t0.Mul(t0, t1)
+
+	// Step 11: t5 = x^0x4d
+	t5.Mul(t2, t0)
+
+	// Step 12: t7 = x^0x4f
+	t7.Mul(t3, t5)
+
+	// Step 13: t3 = x^0x53
+	t3.Mul(t2, t7)
+
+	// Step 14: t2 = x^0x5c
+	t2.Mul(t6, t5)
+
+	// Step 15: t7 = x^0xab
+	t7.Mul(t7, t2)
+
+	// Step 16: t8 = x^0xbe
+	t8.Mul(t8, t7)
+
+	// Step 17: t9 = x^0xc8
+	t9.Mul(t9, t8)
+
+	// Step 18: t10 = x^0x190
+	t10.Mul(t9, t9)
+
+	// Step 19: t9 = x^0x23b
+	t9.Mul(t7, t10)
+
+	// Step 20: t7 = x^0x284
+	t7.Mul(t0, t9)
+
+	// Step 21: t10 = x^0x414
+	t10.Mul(t10, t7)
+
+	// Step 22: t5 = x^0x461
+	t5.Mul(t5, t10)
+
+	// Step 23: t3 = x^0x4b4
+	t3.Mul(t3, t5)
+
+	// Step 24: t0 = x^0x4fd
+	t0.Mul(t0, t3)
+
+	// Step 25: t7 = x^0x781
+	t7.Mul(t7, t0)
+
+	// Step 26: t6 = x^0x790
+	t6.Mul(t6, t7)
+
+	// Step 27: t9 = x^0x9cb
+	t9.Mul(t9, t6)
+
+	// Step 28: t5 = x^0xe2c
+	t5.Mul(t5, t9)
+
+	// Step 29: t0 = x^0x1329
+	t0.Mul(t0, t5)
+
+	// Step 30: t8 = x^0x13e7
+	t8.Mul(t8, t0)
+
+	// Step 31: t1 = x^0x1413
+	t1.Mul(t1, t8)
+
+	// Step 32: t7 = x^0x1b94
+	t7.Mul(t7, t1)
+
+	// Step 33: t8 = x^0x2f7b
+	t8.Mul(t8, t7)
+
+	// Step 34: t5 = x^0x3da7
+	t5.Mul(t5, t8)
+
+	// Step 35: t4 = x^0x3dbb
+	t4.Mul(t4, t5)
+
+	// Step 36: t10 = x^0x41cf
+	t10.Mul(t10, t4)
+
+	// Step 37: t7 = x^0x5d63
+	t7.Mul(t7, t10)
+
+	// Step 38: t9 = x^0x672e
+	t9.Mul(t9, t7)
+
+	// Step 39: t1 = x^0x7b41
+	t1.Mul(t1, t9)
+
+	// Step 40: t3 = x^0x7ff5
+	t3.Mul(t3, t1)
+
+	// Step 41: t10 = x^0x839e
+	t10.Mul(t10, t10)
+
+	// Step 42: t9 = x^0xe723
+	t9.Mul(t9, t3)
+
+	// Step 43: t2 = x^0xe77f
+	t2.Mul(t2, t9)
+
+	// Step 59: t10 = x^0x839e0000
+	for s := 0; s < 16; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 60: t9 = x^0x839ee723
+	t9.Mul(t9, t10)
+
+	// Step 74: t9 = x^0x20e7b9c8c000
+	for s := 0; s < 14; s++ {
+		t9.Mul(t9, t9)
+	}
+
+	// Step 75: t8 = x^0x20e7b9c8ef7b
+	t8.Mul(t8, t9)
+
+	// Step 92: t8 = x^0x41cf7391def60000
+	for s := 0; s < 17; s++ {
+		t8.Mul(t8, t8)
+	}
+
+	// Step 93: t7 = x^0x41cf7391def65d63
+	t7.Mul(t7, t8)
+
+	// Step 113: t7 = x^0x41cf7391def65d6300000
+	for s := 0; s < 20; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 114: t6 = x^0x41cf7391def65d6300790
+	t6.Mul(t6, t7)
+
+	// Step 115: t6 = x^0x41cf7391def65d630ef0f
+	t6.Mul(t2, t6)
+
+	// Step 129: t6 = x^0x1073dce477bd9758c3bc3c000
+	for s := 0; s < 14; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 130: t5 = x^0x1073dce477bd9758c3bc3fda7
+	t5.Mul(t5, t6)
+
+	// Step 147: t5 = x^0x20e7b9c8ef7b2eb187787fb4e0000
+	for s := 0; s < 17; s++ {
+		t5.Mul(t5, t5)
+	}
+
+	// Step 148: t4 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb
+	t4.Mul(t4, t5)
+
+	// Step 167: t4 = x^0x1073dce477bd9758c3bc3fda71edd80000
+	for s := 0; s < 19; s++ {
+		t4.Mul(t4, t4)
+	}
+
+	// Step 168: t3 = x^0x1073dce477bd9758c3bc3fda71edd87ff5
+	t3.Mul(t3, t4)
+
+	// Step 185: t3 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffea0000
+	for s := 0; s < 17; s++ {
+		t3.Mul(t3, t3)
+	}
+
+	// Step 186: t2 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f
+	t2.Mul(t2, t3)
+
+	// Step 203: t2 = x^0x41cf7391def65d630ef0ff69c7b761ffd5cefe0000
+	for s := 0; s < 17; s++ {
+		t2.Mul(t2, t2)
+	}
+
+	// Step 204: t1 = x^0x41cf7391def65d630ef0ff69c7b761ffd5cefe7b41
+	t1.Mul(t1, t2)
+
+	// Step 209: t1 = x^0x839ee723bdecbac61de1fed38f6ec3ffab9dfcf6820
+	for s := 0; s < 5; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 210: t1 = x^0x839ee723bdecbac61de1fed38f6ec3ffab9dfcf6825
+	t1.Mul(z, t1)
+
+	// Step 240: t1 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da0940000000
+	for s := 0; s < 30; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 241: t0 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da0940001329
+	t0.Mul(t0, t1)
+
+	// Step 247: t0 = x^0x839ee723bdecbac61de1fed38f6ec3ffab9dfcf682500004ca40
+	for s := 0; s < 6; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 248: z = x^0x839ee723bdecbac61de1fed38f6ec3ffab9dfcf682500004ca45
+	z.Mul(z, t0)
+
+	// Step 251: z = x^0x41cf7391def65d630ef0ff69c7b761ffd5cefe7b4128000265228
+	for s := 0; s < 3; s++ {
+		z.Mul(z, z)
+	}
+
+}
+
 // expByLegendreExp is equivalent to z.Exp(x, 1073dce477bd9758c3bc3fda71edd87ff573bf9ed04a00009948a20000000000)
 //
 // uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
@@ -640,3 +898,663 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	return z
 }
+
+// vectorExpByLegendreExpNbTemporaries is the number of temporary vectors used by vectorExpByLegendreExp
+const vectorExpByLegendreExpNbTemporaries = 12
+
+// vectorExpByLegendreExp sets z[i] = x[i]^1073dce477bd9758c3bc3fda71edd87ff573bf9ed04a00009948a20000000000 for all i, with the addition chain of expByLegendreExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpByLegendreExpNbTemporaries * len(x).
+func vectorExpByLegendreExp(z, x, scratch Vector) {
+	n := len(x)
+	var (
+		t0  = scratch[0*n : 0*n+n]
+		t1  = scratch[1*n : 1*n+n]
+		t2  = scratch[2*n : 2*n+n]
+		t3  = scratch[3*n : 3*n+n]
+		t4  = scratch[4*n : 4*n+n]
+		t5  = scratch[5*n : 5*n+n]
+		t6  = scratch[6*n : 6*n+n]
+		t7  = scratch[7*n : 7*n+n]
+		t8  = scratch[8*n : 8*n+n]
+		t9  = scratch[9*n : 9*n+n]
+		t10 = scratch[10*n : 10*n+n]
+		t11 = scratch[11*n : 11*n+n]
+	)
+
+	// Step 1: t3 = x^0x2
+	t3.Mul(x, x)
+
+	// Step 2: z = x^0x4
+	z.Mul(t3, t3)
+
+	// Step 3: t1 = x^0x5
+	t1.Mul(x, z)
+
+	// Step 4: t10 = x^0xa
+	t10.Mul(t1, t1)
+
+	// Step 5: t7 = x^0xf
+	t7.Mul(t1, t10)
+
+	// Step 6: t9 = x^0x13
+	t9.Mul(z, t7)
+
+	// Step 7: t5 = x^0x14
+	t5.Mul(x, t9)
+
+	// Step 8: t0 = x^0x1d
+	t0.Mul(t10, t9)
+
+	// Step 9: t2 = x^0x2c
+	t2.Mul(t7, t0)
+
+	// Step 10: t0 = x^0x49
+	t0.Mul(t0, t2)
+
+	// Step 11: t6 = x^0x4d
+	t6.Mul(z, t0)
+
+	// Step 12: t8 = x^0x4f
+	t8.Mul(t3, t6)
+
+	// Step 13: z = x^0x51
+	z.Mul(t3, t8)
+
+	// Step 14: t4 = x^0x53
+	t4.Mul(t3, z)
+
+	// Step 15: t3 = x^0x5c
+	t3.Mul(t7, t6)
+
+	// Step 16: t8 = x^0xab
+	t8.Mul(t8, t3)
+
+	// Step 17: t9 = x^0xbe
+	t9.Mul(t9, t8)
+
+	// Step 18: t10 = x^0xc8
+	t10.Mul(t10, t9)
+
+	// Step 19: t11 = x^0x190
+	t11.Mul(t10, t10)
+
+	// Step 20: t10 = x^0x23b
+	t10.Mul(t8, t11)
+
+	// Step 21: t8 = x^0x284
+	t8.Mul(t0, t10)
+
+	// Step 22: t11 = x^0x414
+	t11.Mul(t11, t8)
+
+	// Step 23: t6 = x^0x461
+	t6.Mul(t6, t11)
+
+	// Step 24: t4 = x^0x4b4
+	t4.Mul(t4, t6)
+
+	// Step 25: t0 = x^0x4fd
+	t0.Mul(t0, t4)
+
+	// Step 26: t8 = x^0x781
+	t8.Mul(t8, t0)
+
+	// Step 27: t7 = x^0x790
+	t7.Mul(t7, t8)
+
+	// Step 28: t10 = x^0x9cb
+	t10.Mul(t10, t7)
+
+	// Step 29: t6 = x^0xe2c
+	t6.Mul(t6, t10)
+
+	// Step 30: t0 = x^0x1329
+	t0.Mul(t0, t6)
+
+	// Step 31: t9 = x^0x13e7
+	t9.Mul(t9, t0)
+
+	// Step 32: t2 = x^0x1413
+	t2.Mul(t2, t9)
+
+	// Step 33: t8 = x^0x1b94
+	t8.Mul(t8, t2)
+
+	// Step 34: t9 = x^0x2f7b
+	t9.Mul(t9, t8)
+
+	// Step 35: t6 = x^0x3da7
+	t6.Mul(t6, t9)
+
+	// Step 36: t5 = x^0x3dbb
+	t5.Mul(t5, t6)
+
+	// Step 37: t11 = x^0x41cf
+	t11.Mul(t11, t5)
+
+	// Step 38: t8 = x^0x5d63
+	t8.Mul(t8, t11)
+
+	// Step 39: t10 = x^0x672e
+	t10.Mul(t10, t8)
+
+	// Step 40: t2 = x^0x7b41
+	t2.Mul(t2, t10)
+
+	// Step 41: t4 = x^0x7ff5
+	t4.Mul(t4, t2)
+
+	// Step 42: t11 = x^0x839e
+	t11.Mul(t11, t11)
+
+	// Step 43: t10 = x^0xe723
+	t10.Mul(t10, t4)
+
+	// Step 44: t3 = x^0xe77f
+	t3.Mul(t3, t10)
+
+	// Step 60: t11 = x^0x839e0000
+	for s := 0; s < 16; s++ {
+		t11.Mul(t11, t11)
+	}
+
+	// Step 61: t10 = x^0x839ee723
+	t10.Mul(t10, t11)
+
+	// Step 75: t10 = x^0x20e7b9c8c000
+	for s := 0; s < 14; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 76: t9 = x^0x20e7b9c8ef7b
+	t9.Mul(t9, t10)
+
+	// Step 93: t9 = x^0x41cf7391def60000
+	for s := 0; s < 17; s++ {
+		t9.Mul(t9, t9)
+	}
+
+	// Step 94: t8 = x^0x41cf7391def65d63
+	t8.Mul(t8, t9)
+
+	// Step 114: t8 = x^0x41cf7391def65d6300000
+	for s := 0; s < 20; s++ {
+		t8.Mul(t8, t8)
+	}
+
+	// Step 115: t7 = x^0x41cf7391def65d6300790
+	t7.Mul(t7, t8)
+
+	// Step 116: t7 = x^0x41cf7391def65d630ef0f
+	t7.Mul(t3, t7)
+
+	// Step 130: t7 = x^0x1073dce477bd9758c3bc3c000
+	for s := 0; s < 14; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 131: t6 = x^0x1073dce477bd9758c3bc3fda7
+	t6.Mul(t6, t7)
+
+	// Step 148: t6 = x^0x20e7b9c8ef7b2eb187787fb4e0000
+	for s := 0; s < 17; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 149: t5 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb
+	t5.Mul(t5, t6)
+
+	// Step 168: t5 = x^0x1073dce477bd9758c3bc3fda71edd80000
+	for s := 0; s < 19; s++ {
+		t5.Mul(t5, t5)
+	}
+
+	// Step 169: t4 = x^0x1073dce477bd9758c3bc3fda71edd87ff5
+	t4.Mul(t4, t5)
+
+	// Step 186: t4 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffea0000
+	for s := 0; s < 17; s++ {
+		t4.Mul(t4, t4)
+	}
+
+	// Step 187: t3 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f
+	t3.Mul(t3, t4)
+
+	// Step 204: t3 = x^0x41cf7391def65d630ef0ff69c7b761ffd5cefe0000
+	for s := 0; s < 17; s++ {
+		t3.Mul(t3, t3)
+	}
+
+	// Step 205: t2 = x^0x41cf7391def65d630ef0ff69c7b761ffd5cefe7b41
+	t2.Mul(t2, t3)
+
+	// Step 210: t2 = x^0x839ee723bdecbac61de1fed38f6ec3ffab9dfcf6820
+	for s := 0; s < 5; s++ {
+		t2.Mul(t2, t2)
+	}
+
+	// Step 211: t1 = x^0x839ee723bdecbac61de1fed38f6ec3ffab9dfcf6825
+	t1.Mul(t1, t2)
+
+	// Step 241: t1 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da0940000000
+	for s := 0; s < 30; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 242: t0 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da0940001329
+	t0.Mul(t0, t1)
+
+	// Step 252: t0 = x^0x839ee723bdecbac61de1fed38f6ec3ffab9dfcf682500004ca400
+	for s := 0; s < 10; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 253: z = x^0x839ee723bdecbac61de1fed38f6ec3ffab9dfcf682500004ca451
+	z.Mul(z, t0)
+
+	// Step 294: z = x^0x1073dce477bd9758c3bc3fda71edd87ff573bf9ed04a00009948a20000000000
+	for s := 0; s < 41; s++ {
+		z.Mul(z, z)
+	}
+
+}
+
+// expByInverseExp is equivalent to z.Exp(x, 20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da0940001329143ffffffffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_101      = _10 + _11
+	//	_111      = _10 + _101
+	//	_1100     = _101 + _111
+	//	_1101     = 1 + _1100
+	//	_10000    = _11 + _1101
+	//	_10011    = _11 + _10000
+	//	_10111    = _111 + _10000
+	//	_100011   = _1100 + _10111
+	//	_100101   = _10 + _100011
+	//	_100111   = _10 + _100101
+	//	_101001   = _10 + _100111
+	//	_101011   = _10 + _101001
+	//	_101111   = _1100 + _100011
+	//	_110101   = _1100 + _101001
+	//	_111011   = _1100 + _101111
+	//	_111101   = _10 + _111011
+	//	_1111010  = 2*_111101
+	//	_1111111  = _101 + _1111010
+	//	_11111110 = 2*_1111111
+	//	_11111111 = 1 + _11111110
+	//	x9        = 2*_11111111 + 1
+	//	i26       = x9 << 2
+	//	x11       = _11 + i26
+	//	i42       = ((_111101 + i26) << 6 + _111011) << 6 + _100111
+	//	i65       = ((i42 << 8 + _100011) << 6 + _101111) << 7
+	//	i80       = ((_111101 + i65) << 6 + _100101) << 6 + _110101
+	//	i100      = ((i80 << 6 + _100011) << 10 + _111011) << 2
+	//	i120      = ((_11 + i100) << 12 + _11111111) << 5 + _1101
+	//	i141      = ((i120 << 5 + _111) << 9 + _111101) << 5
+	//	i162      = ((_10111 + i141) << 3 + _11) << 15 + x11
+	//	i185      = ((i162 << 7 + _101011) << 6 + _100111) << 8
+	//	i199      = ((_1111111 + i185) << 8 + _111101) << 3 + _101
+	//	i242      = ((i199 << 11 + _100101) << 22 + _10011) << 8
+	//	i266      = ((_101001 + i242) << 6 + _101) << 15 + x11
+	//	i299      = ((i266 << 11 + x11) << 11 + x11) << 9
+	//	return      x9 + i299
+	//
+	// Operations: 248 squares 52 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: t9 = x^0x3
+	t9.Mul(&x, z)
+
+	// Step 3: t1 = x^0x5
+	t1.Mul(z, t9)
+
+	// Step 4: t11 = x^0x7
+	t11.Mul(z, t1)
+
+	// Step 5: t0 = x^0xc
+	t0.Mul(t1, t11)
+
+	// Step 6: t12 = x^0xd
+	t12.Mul(&x, t0)
+
+	// Step 7: t2 = x^0x10
+	t2.Mul(t9, t12)
+
+	// Step 8: t3 = x^0x13
+	t3.Mul(t9, t2)
+
+	// Step 9: t10 = x^0x17
+	t10.Mul(t11, t2)
+
+	// Step 10: t15 = x^0x23
+	t15.Mul(t0, t10)
+
+	// Step 11: t4 = x^0x25
+	t4.Mul(z, t15)
+
+	// Step 12: t7 = x^0x27
+	t7.Mul(z, t4)
+
+	// Step 13: t2 = x^0x29
+	t2.Mul(z, t7)
+
+	// Step 14: t8 = x^0x2b
+	t8.Mul(z, t2)
+
+	// Step 15: t17 = x^0x2f
+	t17.Mul(t0, t15)
+
+	// Step 16: t16 = x^0x35
+	t16.Mul(t0, t2)
+
+	// Step 17: t14 = x^0x3b
+	t14.Mul(t0, t17)
+
+	// Step 18: t5 = x^0x3d
+	t5.Mul(z, t14)
+
+	// Step 19: z = x^0x7a
+	z.Square(t5)
+
+	// Step 20: t6 = x^0x7f
+	t6.Mul(t1, z)
+
+	// Step 21: z = x^0xfe
+	z.Square(t6)
+
+	// Step 22: t13 = x^0xff
+	t13.Mul(&x, z)
+
+	// Step 23: z = x^0x1fe
+	z.Square(t13)
+
+	// Step 24: z = x^0x1ff
+	z.Mul(&x, z)
+
+	// Step 26: t18 = x^0x7fc
+	t18.Square(z)
+	for s := 1; s < 2; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 27: t0 = x^0x7ff
+	t0.Mul(t9, t18)
+
+	// Step 28: t18 = x^0x839
+	t18.Mul(t5, t18)
+
+	// Step 34: t18 = x^0x20e40
+	for s := 0; s < 6; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 35: t18 = x^0x20e7b
+	t18.Mul(t14, t18)
+
+	// Step 41: t18 = x^0x839ec0
+	for s := 0; s < 6; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 42: t18 = x^0x839ee7
+	t18.Mul(t7, t18)
+
+	// Step 50: t18 = x^0x839ee700
+	for s := 0; s < 8; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 51: t18 = x^0x839ee723
+	t18.Mul(t15, t18)
+
+	// Step 57: t18 = x^0x20e7b9c8c0
+	for s := 0; s < 6; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 58: t17 = x^0x20e7b9c8ef
+	t17.Mul(t17, t18)
+
+	// Step 65: t17 = x^0x1073dce47780
+	for s := 0; s < 7; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 66: t17 = x^0x1073dce477bd
+	t17.Mul(t5, t17)
+
+	// Step 72: t17 = x^0x41cf7391def40
+	for s := 0; s < 6; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 73: t17 = x^0x41cf7391def65
+	t17.Mul(t4, t17)
+
+	// Step 79: t17 = x^0x1073dce477bd940
+	for s := 0; s < 6; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 80: t16 = x^0x1073dce477bd975
+	t16.Mul(t16, t17)
+
+	// Step 86: t16 = x^0x41cf7391def65d40
+	for s := 0; s < 6; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 87: t15 = x^0x41cf7391def65d63
+	t15.Mul(t15, t16)
+
+	// Step 97: t15 = x^0x1073dce477bd9758c00
+	for s := 0; s < 10; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 98: t14 = x^0x1073dce477bd9758c3b
+	t14.Mul(t14, t15)
+
+	// Step 100: t14 = x^0x41cf7391def65d630ec
+	for s := 0; s < 2; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 101: t14 = x^0x41cf7391def65d630ef
+	t14.Mul(t9, t14)
+
+	// Step 113: t14 = x^0x41cf7391def65d630ef000
+	for s := 0; s < 12; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 114: t13 = x^0x41cf7391def65d630ef0ff
+	t13.Mul(t13, t14)
+
+	// Step 119: t13 = x^0x839ee723bdecbac61de1fe0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 120: t12 = x^0x839ee723bdecbac61de1fed
+	t12.Mul(t12, t13)
+
+	// Step 125: t12 = x^0x1073dce477bd9758c3bc3fda0
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 126: t11 = x^0x1073dce477bd9758c3bc3fda7
+	t11.Mul(t11, t12)
+
+	// Step 135: t11 = x^0x20e7b9c8ef7b2eb187787fb4e00
+	for s := 0; s < 9; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 136: t11 = x^0x20e7b9c8ef7b2eb187787fb4e3d
+	t11.Mul(t5, t11)
+
+	// Step 141: t11 = x^0x41cf7391def65d630ef0ff69c7a0
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 142: t10 = x^0x41cf7391def65d630ef0ff69c7b7
+	t10.Mul(t10, t11)
+
+	// Step 145: t10 = x^0x20e7b9c8ef7b2eb187787fb4e3db8
+	for s := 0; s < 3; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 146: t9 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb
+	t9.Mul(t9, t10)
+
+	// Step 161: t9 = x^0x1073dce477bd9758c3bc3fda71edd8000
+	for s := 0; s < 15; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 162: t9 = x^0x1073dce477bd9758c3bc3fda71edd87ff
+	t9.Mul(t0, t9)
+
+	// Step 169: t9 = x^0x839ee723bdecbac61de1fed38f6ec3ff80
+	for s := 0; s < 7; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 170: t8 = x^0x839ee723bdecbac61de1fed38f6ec3ffab
+	t8.Mul(t8, t9)
+
+	// Step 176: t8 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeac0
+	for s := 0; s < 6; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 177: t7 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae7
+	t7.Mul(t7, t8)
+
+	// Step 185: t7 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae700
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 186: t6 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f
+	t6.Mul(t6, t7)
+
+	// Step 194: t6 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f00
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 195: t5 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3d
+	t5.Mul(t5, t6)
+
+	// Step 198: t5 = x^0x1073dce477bd9758c3bc3fda71edd87ff573bf9e8
+	for s := 0; s < 3; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 199: t5 = x^0x1073dce477bd9758c3bc3fda71edd87ff573bf9ed
+	t5.Mul(t1, t5)
+
+	// Step 210: t5 = x^0x839ee723bdecbac61de1fed38f6ec3ffab9dfcf6800
+	for s := 0; s < 11; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 211: t4 = x^0x839ee723bdecbac61de1fed38f6ec3ffab9dfcf6825
+	t4.Mul(t4, t5)
+
+	// Step 233: t4 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da09400000
+	for s := 0; s < 22; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 234: t3 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da09400013
+	t3.Mul(t3, t4)
+
+	// Step 242: t3 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da0940001300
+	for s := 0; s < 8; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 243: t2 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da0940001329
+	t2.Mul(t2, t3)
+
+	// Step 249: t2 = x^0x839ee723bdecbac61de1fed38f6ec3ffab9dfcf682500004ca40
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 250: t1 = x^0x839ee723bdecbac61de1fed38f6ec3ffab9dfcf682500004ca45
+	t1.Mul(t1, t2)
+
+	// Step 265: t1 = x^0x41cf7391def65d630ef0ff69c7b761ffd5cefe7b4128000265228000
+	for s := 0; s < 15; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 266: t1 = x^0x41cf7391def65d630ef0ff69c7b761ffd5cefe7b41280002652287ff
+	t1.Mul(t0, t1)
+
+	// Step 277: t1 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da0940001329143ff800
+	for s := 0; s < 11; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 278: t1 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da0940001329143fffff
+	t1.Mul(t0, t1)
+
+	// Step 289: t1 = x^0x1073dce477bd9758c3bc3fda71edd87ff573bf9ed04a00009948a1fffff800
+	for s := 0; s < 11; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 290: t0 = x^0x1073dce477bd9758c3bc3fda71edd87ff573bf9ed04a00009948a1ffffffff
+	t0.Mul(t0, t1)
+
+	// Step 299: t0 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da0940001329143fffffffe00
+	for s := 0; s < 9; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 300: z = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da0940001329143ffffffffff
+	z.Mul(z, t0)
+
+	return z
+}
@@ -0,0 +1,213 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestE3Ops(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE3()
+	genB := GenE3()
+	genC := GenE3()
+
+	properties.Property("[E3] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E3) bool {
+			var c E3
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] mul should be commutative and distributive over add", prop.ForAll(
+		func(a, b, c *E3) bool {
+			var ab, ba, l, r, t E3
+			ab.Mul(a, b)
+			ba.Mul(b, a)
+			l.Add(b, c).Mul(&l, a)
+			r.Mul(a, c)
+			t.Mul(a, b)
+			r.Add(&r, &t)
+			return ab.Equal(&ba) && l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[E3] mul should be associative", prop.ForAll(
+		func(a, b, c *E3) bool {
+			var l, r E3
+			l.Mul(a, b).Mul(&l, c)
+			r.Mul(b, c).Mul(a, &r)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[E3] square and mul should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Mul(a, a)
+			c.Square(a)
+			a.Square(a)
+			return b.Equal(&c) && a.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] inverting twice should leave an element invariant", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Inverse(a).Inverse(&b)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] x * x⁻¹ should be 1", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			if a.IsZero() {
+				return b.Inverse(a).IsZero()
+			}
+			b.Inverse(a).Mul(&b, a)
+			return b.IsOne()
+		},
+		genA,
+	))
+
+	properties.Property("[E3] batch inversion should output the same result as inversion", prop.ForAll(
+		func(a, b, c *E3) bool {
+			batch := BatchInvertE3([]E3{*a, *b, *c})
+			a.Inverse(a)
+			b.Inverse(b)
+			c.Inverse(c)
+			return batch[0].Equal(a) && batch[1].Equal(b) && batch[2].Equal(c)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[E3] MulByElement should be the product by an element of the base field", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			c.MulByElement(a, &b.A0)
+			d.A0 = b.A0
+			d.Mul(a, &d)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] Exp(x, q³) should be x", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			q := fr.Modulus()
+			var e big.Int
+			e.Exp(q, big.NewInt(3), nil)
+			b.Exp(*a, &e)
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Frobenius(x) should be Exp(x, q)", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Frobenius(a)
+			c.Exp(*a, fr.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Sqrt(x²)² should be x²", prop.ForAll(
+		func(a *E3) bool {
+			var square, b E3
+			square.Square(a)
+			if b.Sqrt(&square) == nil {
+				return false
+			}
+			b.Square(&b)
+			return b.Equal(&square) && (square.IsZero() || square.Legendre() == 1)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Sqrt should succeed if and only if the Legendre symbol is not -1", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Set(a)
+			if b.Sqrt(a) == nil {
+				// a non-square leaves z unchanged
+				return a.Legendre() == -1 && b.Equal(a)
+			}
+			b.Square(&b)
+			return a.Legendre() != -1 && b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Exp(x, -k) should be (x⁻¹)ᵏ", prop.ForAll(
+		func(a *E3, k int64) bool {
+			var b, c E3
+			b.Exp(*a, big.NewInt(-k))
+			c.Inverse(a).Exp(c, big.NewInt(k))
+			return b.Equal(&c)
+		},
+		genA,
+		gen.Int64Range(1, 1<<20),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// GenE3 generates an E3 element
+func GenE3() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var a E3
+		if _, err := a.SetRandom(); err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(&a, gopter.NoShrinker)
+	}
+}
@@ -17,6 +17,7 @@
 package fft
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io"
 	"math/big"
@@ -26,8 +27,6 @@ import (
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
 
-	curve "github.com/consensys/gnark-crypto/ecc/bls12-378"
-
 	"github.com/consensys/gnark-crypto/ecc"
 )
 
@@ -69,7 +68,6 @@ func NewDomain(m uint64, shift ...fr.Element) *Domain {
 	domain.Cardinality = uint64(x)
 
 	// generator of the largest 2-adic subgroup
-
 	domain.FrMultiplicativeGen.SetUint64(22)
 
 	if len(shift) != 0 {
@@ -97,7 +95,6 @@ func Generator(m uint64) (fr.Element, error) {
 	x := ecc.NextPowerOfTwo(m)
 
 	var rootOfUnity fr.Element
-
 	rootOfUnity.SetString("4045585818372166415418670827807793147093034396422209590578257013290761627990")
 	const maxOrderRoot uint64 = 42
 
@@ -209,50 +206,49 @@ func precomputeExpTableChunk(w fr.Element, power uint64, table []fr.Element) {
 // to the provided writer
 func (d *Domain) WriteTo(w io.Writer) (int64, error) {
 
-	enc := curve.NewEncoder(w)
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], d.Cardinality)
+	n, err := w.Write(buf[:])
+	written := int64(n)
+	if err != nil {
+		return written, err
+	}
 
-	toEncode := []interface{}{d.Cardinality, &d.CardinalityInv, &d.Generator, &d.GeneratorInv, &d.FrMultiplicativeGen, &d.FrMultiplicativeGenInv}
+	toEncode := []*fr.Element{&d.CardinalityInv, &d.Generator, &d.GeneratorInv, &d.FrMultiplicativeGen, &d.FrMultiplicativeGenInv}
 
 	for _, v := range toEncode {
-		if err := enc.Encode(v); err != nil {
-			return enc.BytesWritten(), err
+		b := v.Bytes()
+		n, err = w.Write(b[:])
+		written += int64(n)
+		if err != nil {
+			return written, err
 		}
 	}
 
-	return enc.BytesWritten(), nil
+	return written, nil
 }
 
 // ReadFrom attempts to decode a domain from Reader
 func (d *Domain) ReadFrom(r io.Reader) (int64, error) {
 
-	dec := curve.NewDecoder(r)
-
-	toDecode := []interface{}{&d.Cardinality, &d.CardinalityInv, &d.Generator, &d.GeneratorInv, &d.FrMultiplicativeGen, &d.FrMultiplicativeGenInv}
-
-	for _, v := range toDecode {
-		if err := dec.Decode(v); err != nil {
-			return dec.BytesRead(), err
-		}
+	read, err := d.decode(r)
+	if err != nil {
+		return read, err
 	}
 
 	// twiddle factors
 	d.preComputeTwiddles()
 
-	return dec.BytesRead(), nil
+	return read, nil
 }
 
 // AsyncReadFrom attempts to decode a domain from Reader. It returns a channel that will be closed
 // when the precomputation is done.
 func (d *Domain) AsyncReadFrom(r io.Reader) (int64, error, chan struct{}) {
 
-	dec := curve.NewDecoder(r)
-
-	toDecode := []interface{}{&d.Cardinality, &d.CardinalityInv, &d.Generator, &d.GeneratorInv, &d.FrMultiplicativeGen, &d.FrMultiplicativeGenInv}
-
-	for _, v := range toDecode {
-		if err := dec.Decode(v); err != nil {
-			return dec.BytesRead(), err, nil
-		}
+	read, err := d.decode(r)
+	if err != nil {
+		return read, err, nil
 	}
 
 	chDone := make(chan struct{})
@@ -264,5 +260,33 @@ func (d *Domain) AsyncReadFrom(r io.Reader) (int64, error, chan struct{}) {
 		close(chDone)
 	}()
 
-	return dec.BytesRead(), nil, chDone
+	return read, nil, chDone
+}
+
+// decode reads the cardinality (big endian) and the elements written by WriteTo
+func (d *Domain) decode(r io.Reader) (int64, error) {
+
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	read := int64(n)
+	if err != nil {
+		return read, err
+	}
+	d.Cardinality = binary.BigEndian.Uint64(buf[:])
+
+	toDecode := []*fr.Element{&d.CardinalityInv, &d.Generator, &d.GeneratorInv, &d.FrMultiplicativeGen, &d.FrMultiplicativeGenInv}
+
+	var b [fr.Bytes]byte
+	for _, v := range toDecode {
+		n, err = io.ReadFull(r, b[:])
+		read += int64(n)
+		if err != nil {
+			return read, err
+		}
+		if err = v.SetBytesCanonical(b[:]); err != nil {
+			return read, err
+		}
+	}
+
+	return read, nil
 }
@@ -54,6 +54,31 @@ func TestPolynomialEval(t *testing.T) {
 	}
 }
 
+func TestPolynomialEvalBlocks(t *testing.T) {
+
+	var point fr.Element
+	point.SetRandom()
+
+	// sizes around the block boundaries, with a partial or full top block
+	for _, n := range []int{1, 2, 2*evalBlockSize - 1, 2 * evalBlockSize, 2*evalBlockSize + 1, 3 * evalBlockSize, 100} {
+		f := make(Polynomial, n)
+		for i := range f {
+			f[i].SetRandom()
+		}
+
+		// Horner's rule
+		expectedEval := f[n-1]
+		for i := n - 2; i >= 0; i-- {
+			expectedEval.Mul(&expectedEval, &point).Add(&expectedEval, &f[i])
+		}
+
+		purportedEval := f.Eval(&point)
+		if !purportedEval.Equal(&expectedEval) {
+			t.Fatalf("polynomial evaluation failed for %d coefficients", n)
+		}
+	}
+}
+
 func TestPolynomialAddConstantInPlace(t *testing.T) {
 
 	// build polynomial
@@ -134,6 +134,26 @@ func TestG2AffineIsOnCurve(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG2AffineRandomPoint(t *testing.T) {
+	t.Parallel()
+	r1, r2 := rand.New(rand.NewSource(42)), rand.New(rand.NewSource(42)) //#nosec G404 -- deterministic test fixture
+	for i := 0; i < 10; i++ {
+		var p1, p2 G2Affine
+		if _, err := p1.RandomPoint(r1); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := p2.RandomPoint(r2); err != nil {
+			t.Fatal(err)
+		}
+		if !p1.IsOnCurve() || !p1.IsInSubGroup() {
+			t.Fatal("RandomPoint should return a point of the prime order subgroup")
+		}
+		if !p1.Equal(&p2) {
+			t.Fatal("RandomPoint should be deterministic for a given reader")
+		}
+	}
+}
+
 func TestG2AffineConversions(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -358,6 +378,43 @@ func TestG2AffineOps(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[BLS12-378] constant-time scalar multiplication should output the same result as ScalarMultiplication", prop.ForAll(
+		func(s fr.Element) bool {
+
+			r := fr.Modulus()
+			var scalar, negScalar, blindedScalar big.Int
+			s.BigInt(&scalar)
+			negScalar.Neg(&scalar)
+			blindedScalar.Mul(&scalar, r).Add(&blindedScalar, &scalar)
+
+			var expected, expectedNeg, op1, op2, op3 G2Jac
+			expected.ScalarMultiplication(&g2Gen, &scalar)
+			expectedNeg.Neg(&expected)
+			op1.ScalarMultiplicationCT(&g2Gen, &scalar)
+			op2.ScalarMultiplicationCT(&g2Gen, &negScalar)
+			op3.ScalarMultiplicationCT(&g2Gen, &blindedScalar)
+
+			var expectedAff, opAff G2Affine
+			expectedAff.FromJacobian(&expected)
+			opAff.ScalarMultiplicationCT(&g2GenAff, &scalar)
+
+			return op1.Equal(&expected) && op2.Equal(&expectedNeg) && op3.Equal(&expected) && opAff.Equal(&expectedAff)
+		},
+		genScalar,
+	))
+
+	properties.Property("[BLS12-378] constant-time scalar multiplication by 0 and r should output inf", prop.ForAll(
+		func(s fr.Element) bool {
+			var op1, op2 G2Jac
+			var op3 G2Affine
+			op1.ScalarMultiplicationCT(&g2Gen, big.NewInt(0))
+			op2.ScalarMultiplicationCT(&g2Gen, fr.Modulus())
+			op3.ScalarMultiplicationCT(&g2GenAff, big.NewInt(0))
+			return op1.Equal(&g2Infinity) && op2.Equal(&g2Infinity) && op3.IsInfinity()
+		},
+		genScalar,
+	))
+
 	properties.Property("[BLS12-378] psi should map points from E' to itself", prop.ForAll(
 		func() bool {
 			var a G2Jac
@@ -575,6 +632,14 @@ func BenchmarkG2JacScalarMultiplication(b *testing.B) {
 		}
 	})
 
+	var ct G2Jac
+	b.Run("constant-time", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			ct.ScalarMultiplicationCT(&g2Gen, &scalar)
+		}
+	})
+
 	var glv G2Jac
 	b.Run("GLV", func(b *testing.B) {
 		b.ResetTimer()
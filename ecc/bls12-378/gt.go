@@ -0,0 +1,234 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12378
+
+import (
+	"errors"
+	"math/big"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// MultiExpGT computes ∏ᵢ basesᵢ^scalarsᵢ with a bucket method, using signed c-bit windows.
+//
+// The bases must be in GT: inverses are computed with a conjugation and squarings with the
+// cyclotomic squaring.
+func MultiExpGT(bases []GT, scalars []fr.Element, config ecc.MultiExpConfig) (GT, error) {
+	var res GT
+	res.SetOne()
+
+	nbPoints := len(bases)
+	if nbPoints != len(scalars) {
+		return res, errors.New("len(bases) != len(scalars)")
+	}
+	if config.NbTasks > 1024 {
+		return res, errors.New("invalid config: config.NbTasks > 1024")
+	}
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	}
+	if nbPoints == 0 {
+		return res, nil
+	}
+
+	c := bestCGT(nbPoints)
+	nbChunks := gtNbChunks(c)
+
+	// digits[i*nbChunks+j] is the j-th signed digit of scalars[i]
+	digits := make([]int32, nbPoints*nbChunks)
+	parallel.Execute(nbPoints, func(start, end int) {
+		for i := start; i < end; i++ {
+			gtSignedDigits(digits[i*nbChunks:(i+1)*nbChunks], &scalars[i], c)
+		}
+	}, config.NbTasks)
+
+	chunks := make([]GT, nbChunks)
+	parallel.Execute(nbChunks, func(start, end int) {
+		buckets := make([]GT, 1<<(c-1))
+		isSet := make([]bool, len(buckets))
+		var tmp GT
+		for j := start; j < end; j++ {
+			for k := range isSet {
+				isSet[k] = false
+			}
+			for i := 0; i < nbPoints; i++ {
+				d := digits[i*nbChunks+j]
+				if d == 0 {
+					continue
+				}
+				b := &bases[i]
+				if d < 0 {
+					tmp.Conjugate(b)
+					b = &tmp
+					d = -d
+				}
+				if isSet[d-1] {
+					buckets[d-1].Mul(&buckets[d-1], b)
+				} else {
+					buckets[d-1].Set(b)
+					isSet[d-1] = true
+				}
+			}
+
+			// ∏ₖ bucketₖ^k, with a running product
+			var runningProduct GT
+			runningProduct.SetOne()
+			chunks[j].SetOne()
+			for k := len(buckets) - 1; k >= 0; k-- {
+				if isSet[k] {
+					runningProduct.Mul(&runningProduct, &buckets[k])
+				}
+				chunks[j].Mul(&chunks[j], &runningProduct)
+			}
+		}
+	}, config.NbTasks)
+
+	res.Set(&chunks[nbChunks-1])
+	for j := nbChunks - 2; j >= 0; j-- {
+		for k := uint64(0); k < c; k++ {
+			res.CyclotomicSquare(&res)
+		}
+		res.Mul(&res, &chunks[j])
+	}
+
+	return res, nil
+}
+
+// GTFixedBaseTable stores, for a fixed base g in GT, the powers g^(d·2^(c·j)) for each c-bit
+// window j and each signed digit 1 ≤ d ≤ 2^(c-1).
+//
+// An exponentiation of g then needs about fr.Bits/c multiplications and no squaring; this trades
+// (fr.Bits/c + 1)·2^(c-1) elements of memory for faster repeated exponentiations of the same base
+// (typically, a generator of GT in GT-based encryption).
+type GTFixedBaseTable struct {
+	c        uint64
+	nbChunks int
+	table    []GT // table[j·2^(c-1)+d-1] = g^(d·2^(c·j))
+}
+
+// NewGTFixedBaseTable precomputes the table of powers of g for window size c.
+//
+// If c == 0, a default window size is used. The call returns an error if c is not in [2, 16]
+// or if g is not in GT.
+func NewGTFixedBaseTable(g *GT, c uint64) (*GTFixedBaseTable, error) {
+	if c == 0 {
+		c = 5
+	}
+	if c < 2 || c > 16 {
+		return nil, errors.New("invalid window size")
+	}
+	if !g.IsInSubGroup() {
+		return nil, errors.New("invalid base: not in GT")
+	}
+
+	t := &GTFixedBaseTable{
+		c:        c,
+		nbChunks: gtNbChunks(c),
+	}
+	half := 1 << (c - 1)
+	t.table = make([]GT, t.nbChunks*half)
+
+	// each window only depends on the last power of the previous one
+	t.table[0].Set(g)
+	for j := 0; j < t.nbChunks; j++ {
+		w := t.table[j*half : (j+1)*half]
+		if j != 0 {
+			// g^(2^(c·j)) = (g^(2^(c-1)·2^(c·(j-1))))²
+			w[0].CyclotomicSquare(&t.table[j*half-1])
+		}
+		for d := 1; d < half; d++ {
+			w[d].Mul(&w[d-1], &w[0])
+		}
+	}
+
+	return t, nil
+}
+
+// Exp returns gᵏ, g being the base of the table.
+func (t *GTFixedBaseTable) Exp(k *big.Int) GT {
+	var s fr.Element
+	s.SetBigInt(k)
+
+	digits := make([]int32, t.nbChunks)
+	gtSignedDigits(digits, &s, t.c)
+
+	var res, tmp GT
+	res.SetOne()
+	half := 1 << (t.c - 1)
+	for j, d := range digits {
+		switch {
+		case d > 0:
+			res.Mul(&res, &t.table[j*half+int(d)-1])
+		case d < 0:
+			tmp.Conjugate(&t.table[j*half+int(-d)-1])
+			res.Mul(&res, &tmp)
+		}
+	}
+	return res
+}
+
+// gtNbChunks returns the number of c-bit signed digits of a scalar; the most significant
+// bit of the last window is always 0, such that it can absorb the carry of the recoding.
+func gtNbChunks(c uint64) int {
+	return fr.Bits/int(c) + 1
+}
+
+// bestCGT returns the window size minimizing the number of GT multiplications
+// of a multi-exponentiation of size nbPoints.
+func bestCGT(nbPoints int) uint64 {
+	var best uint64
+	bestCost := -1
+	for c := uint64(2); c <= 16; c++ {
+		// per window: a multiplication per point, two per bucket, and c cyclotomic squarings
+		cost := gtNbChunks(c) * (nbPoints + (1 << c) + int(c))
+		if bestCost == -1 || cost < bestCost {
+			best, bestCost = c, cost
+		}
+	}
+	return best
+}
+
+// gtSignedDigits writes in digits the signed c-bit digits of s, such that
+// s = Σⱼ digits[j]·2^(c·j) and -2^(c-1) ≤ digits[j] ≤ 2^(c-1).
+func gtSignedDigits(digits []int32, s *fr.Element, c uint64) {
+	k := s.Bits()
+	mask := uint64(1)<<c - 1
+	var carry uint64
+	for j := range digits {
+		var d uint64
+		start := uint64(j) * c
+		if start < fr.Bits {
+			w, shift := start/64, start%64
+			d = k[w] >> shift
+			if shift+c > 64 && w+1 < fr.Limbs {
+				d |= k[w+1] << (64 - shift)
+			}
+			d &= mask
+		}
+		d += carry
+		carry = 0
+		if d >= 1<<(c-1) && j != len(digits)-1 {
+			digits[j] = int32(d) - int32(1<<c)
+			carry = 1
+		} else {
+			digits[j] = int32(d)
+		}
+	}
+}
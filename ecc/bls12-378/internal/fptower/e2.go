@@ -17,7 +17,9 @@
 package fptower
 
 import (
+	"crypto/rand"
 	"github.com/consensys/gnark-crypto/ecc/bls12-378/fp"
+	"io"
 	"math/big"
 )
 
@@ -92,10 +94,15 @@ func (z *E2) SetOne() *E2 {
 
 // SetRandom sets a0 and a1 to random values
 func (z *E2) SetRandom() (*E2, error) {
-	if _, err := z.A0.SetRandom(); err != nil {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom is like SetRandom, reading the randomness from r
+func (z *E2) SetRandomFrom(r io.Reader) (*E2, error) {
+	if _, err := z.A0.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
-	if _, err := z.A1.SetRandom(); err != nil {
+	if _, err := z.A1.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
 	return z, nil
@@ -278,6 +285,72 @@ func BatchInvertE2(a []E2) []E2 {
 	return res
 }
 
+// BatchSqrtE2 returns the square roots of the elements of a, and for each of them
+// whether it is a square; if a[i] is not a square, res[i] is 0 and isSquare[i] is false.
+//
+// For x = x₀ + x₁u, with λ a square root of the norm n = x₀² - βx₁², the square root is
+// y₀ + y₁u with y₀² = (x₀ ± λ)/2 and y₁ = x₁/(2y₀). When x₁ ≠ 0 exactly one of (x₀ ± λ)/2
+// is a square. The square roots in fp are computed in batch (see fp.BatchSqrt) and the
+// divisions share a single inversion.
+func BatchSqrtE2(a []E2) (res []E2, isSquare []bool) {
+	res = make([]E2, len(a))
+	isSquare = make([]bool, len(a))
+
+	// x is a square iff its norm is
+	norms := make([]fp.Element, len(a))
+	for i := range a {
+		a[i].norm(&norms[i])
+	}
+	lambda, normIsSquare := fp.BatchSqrt(norms)
+
+	// y₀² = (x₀ + λ)/2, or (x₀ - λ)/2 if the former is not a square
+	y0Squared := make([]fp.Element, len(a))
+	for i := range a {
+		if normIsSquare[i] {
+			y0Squared[i].Add(&a[i].A0, &lambda[i]).Halve()
+		}
+	}
+	y0, ok := fp.BatchSqrt(y0Squared)
+	retry := make([]int, 0, len(a))
+	for i := range a {
+		if normIsSquare[i] && !ok[i] {
+			retry = append(retry, i)
+		}
+	}
+	if len(retry) != 0 {
+		y0SquaredRetry := make([]fp.Element, len(retry))
+		for j, i := range retry {
+			y0SquaredRetry[j].Sub(&a[i].A0, &lambda[i]).Halve()
+		}
+		y0Retry, _ := fp.BatchSqrt(y0SquaredRetry)
+		for j, i := range retry {
+			y0[i] = y0Retry[j]
+		}
+	}
+
+	// y₁ = x₁/(2y₀)
+	twoY0 := make([]fp.Element, len(a))
+	for i := range a {
+		twoY0[i].Double(&y0[i])
+	}
+	twoY0Inv := fp.BatchInvert(twoY0)
+
+	for i := range a {
+		if !normIsSquare[i] {
+			continue
+		}
+		isSquare[i] = true
+		if y0[i].IsZero() {
+			// x₁ = 0 and x₀ is not a square in fp, or x = 0
+			res[i].Sqrt(&a[i])
+			continue
+		}
+		res[i].A0 = y0[i]
+		res[i].A1.Mul(&a[i].A1, &twoY0Inv[i])
+	}
+	return
+}
+
 func (z *E2) Select(cond int, caseZ *E2, caseNz *E2) *E2 {
 	//Might be able to save a nanosecond or two by an aggregate implementation
 
@@ -54,6 +54,8 @@ func TestEncoder(t *testing.T) {
 	var inK fr.Vector
 	var inL [][]fr.Element
 	var inM [][]uint64
+	var inN GT
+	var inO []GT
 
 	// set values of inputs
 	inA = rand.Uint64() //#nosec G404 weak rng is fine here
@@ -72,12 +74,16 @@ func TestEncoder(t *testing.T) {
 	inK[41].SetUint64(42)
 	inL = [][]fr.Element{inJ, inK}
 	inM = [][]uint64{{1, 2}, {4}, {}}
+	inN, _ = Pair([]G1Affine{inD}, []G2Affine{inF})
+	inO = make([]GT, 2)
+	inO[0].SetOne()
+	inO[1] = inN
 
 	// encode them, compressed and raw
 	var buf, bufRaw bytes.Buffer
 	enc := NewEncoder(&buf)
 	encRaw := NewEncoder(&bufRaw, RawEncoding())
-	toEncode := []interface{}{inA, &inB, &inC, &inD, &inE, &inF, inG, inH, inI, inJ, inK, inL, inM}
+	toEncode := []interface{}{inA, &inB, &inC, &inD, &inE, &inF, inG, inH, inI, inJ, inK, inL, inM, &inN, inO}
 	for _, v := range toEncode {
 		if err := enc.Encode(v); err != nil {
 			t.Fatal(err)
@@ -104,8 +110,10 @@ func TestEncoder(t *testing.T) {
 		var outK fr.Vector
 		var outL [][]fr.Element
 		var outM [][]uint64
+		var outN GT
+		var outO []GT
 
-		toDecode := []interface{}{&outA, &outB, &outC, &outD, &outE, &outF, &outG, &outH, &outI, &outJ, &outK, &outL, &outM}
+		toDecode := []interface{}{&outA, &outB, &outC, &outD, &outE, &outF, &outG, &outH, &outI, &outJ, &outK, &outL, &outM, &outN, &outO}
 		for _, v := range toDecode {
 			if err := dec.Decode(v); err != nil {
 				t.Fatal(err)
@@ -151,6 +159,17 @@ func TestEncoder(t *testing.T) {
 		if !reflect.DeepEqual(inM, outM) {
 			t.Fatal("decode(encode(slice²(uint64))) failed")
 		}
+		if !inN.Equal(&outN) {
+			t.Fatal("decode(encode(GT)) failed")
+		}
+		if len(inO) != len(outO) {
+			t.Fatal("decode(encode(slice(GT))) failed")
+		}
+		for i := 0; i < len(inO); i++ {
+			if !inO[i].Equal(&outO[i]) {
+				t.Fatal("decode(encode(slice(GT))) failed")
+			}
+		}
 		if n != dec.BytesRead() {
 			t.Fatal("bytes read don't match bytes written")
 		}
@@ -248,6 +267,92 @@ func TestG1AffineInvalidBitMask(t *testing.T) {
 	}
 }
 
+func TestG1AffineDecodeSlice(t *testing.T) {
+	t.Parallel()
+
+	// compressed points, with a few points at infinity
+	points := make([]G1Affine, 37)
+	for i := range points {
+		if i%7 == 0 {
+			continue
+		}
+		var s big.Int
+		s.SetUint64(uint64(i))
+		points[i].ScalarMultiplication(&g1GenAff, &s)
+	}
+
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(points); err != nil {
+		t.Fatal(err)
+	}
+	var decoded []G1Affine
+	if err := NewDecoder(bytes.NewReader(buf.Bytes())).Decode(&decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(points, decoded) {
+		t.Fatal("decoded slice doesn't match encoded slice")
+	}
+
+	// a compressed X coordinate for which X³ + b is not a square must be rejected
+	var bad G1Affine
+	for {
+		var ySquared fp.Element
+		bad.X.SetRandom()
+		ySquared.Square(&bad.X).Mul(&ySquared, &bad.X)
+		ySquared.Add(&ySquared, &bCurveCoeff)
+		if ySquared.Legendre() == -1 {
+			break
+		}
+	}
+	bad.Y.SetOne()
+	points[len(points)/2] = bad
+
+	buf.Reset()
+	if err := NewEncoder(&buf).Encode(points); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewDecoder(bytes.NewReader(buf.Bytes())).Decode(&decoded); err == nil {
+		t.Fatal("decoding an invalid compressed point should fail")
+	}
+}
+
+func BenchmarkG1AffineDecodeSlice(b *testing.B) {
+	// the subgroup checks are skipped to measure the decompression
+	const n = 1024
+	points := make([]G1Affine, n)
+	var s big.Int
+	for i := range points {
+		s.SetUint64(uint64(i + 1))
+		points[i].ScalarMultiplication(&g1GenAff, &s)
+	}
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(points); err != nil {
+		b.Fatal(err)
+	}
+	encoded := buf.Bytes()
+
+	b.Run("setBytes", func(b *testing.B) {
+		// skip the length prefix of the slice
+		data := encoded[4:]
+		for j := 0; j < b.N; j++ {
+			for i := range points {
+				if _, err := points[i].setBytes(data[i*SizeOfG1AffineCompressed:], false); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("Decoder", func(b *testing.B) {
+		b.ReportAllocs()
+		var decoded []G1Affine
+		for j := 0; j < b.N; j++ {
+			if err := NewDecoder(bytes.NewReader(encoded), NoSubgroupChecks()).Decode(&decoded); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
 func TestG1AffineSerialization(t *testing.T) {
 	t.Parallel()
 	// test round trip serialization of infinity
@@ -361,6 +466,92 @@ func TestG2AffineInvalidBitMask(t *testing.T) {
 	}
 }
 
+func TestG2AffineDecodeSlice(t *testing.T) {
+	t.Parallel()
+
+	// compressed points, with a few points at infinity
+	points := make([]G2Affine, 37)
+	for i := range points {
+		if i%7 == 0 {
+			continue
+		}
+		var s big.Int
+		s.SetUint64(uint64(i))
+		points[i].ScalarMultiplication(&g2GenAff, &s)
+	}
+
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(points); err != nil {
+		t.Fatal(err)
+	}
+	var decoded []G2Affine
+	if err := NewDecoder(bytes.NewReader(buf.Bytes())).Decode(&decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(points, decoded) {
+		t.Fatal("decoded slice doesn't match encoded slice")
+	}
+
+	// a compressed X coordinate for which X³ + b is not a square must be rejected
+	var bad G2Affine
+	for {
+		var ySquared fptower.E2
+		bad.X.SetRandom()
+		ySquared.Square(&bad.X).Mul(&ySquared, &bad.X)
+		ySquared.Add(&ySquared, &bTwistCurveCoeff)
+		if ySquared.Legendre() == -1 {
+			break
+		}
+	}
+	bad.Y.SetOne()
+	points[len(points)/2] = bad
+
+	buf.Reset()
+	if err := NewEncoder(&buf).Encode(points); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewDecoder(bytes.NewReader(buf.Bytes())).Decode(&decoded); err == nil {
+		t.Fatal("decoding an invalid compressed point should fail")
+	}
+}
+
+func BenchmarkG2AffineDecodeSlice(b *testing.B) {
+	// the subgroup checks are skipped to measure the decompression
+	const n = 1024
+	points := make([]G2Affine, n)
+	var s big.Int
+	for i := range points {
+		s.SetUint64(uint64(i + 1))
+		points[i].ScalarMultiplication(&g2GenAff, &s)
+	}
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(points); err != nil {
+		b.Fatal(err)
+	}
+	encoded := buf.Bytes()
+
+	b.Run("setBytes", func(b *testing.B) {
+		// skip the length prefix of the slice
+		data := encoded[4:]
+		for j := 0; j < b.N; j++ {
+			for i := range points {
+				if _, err := points[i].setBytes(data[i*SizeOfG2AffineCompressed:], false); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("Decoder", func(b *testing.B) {
+		b.ReportAllocs()
+		var decoded []G2Affine
+		for j := 0; j < b.N; j++ {
+			if err := NewDecoder(bytes.NewReader(encoded), NoSubgroupChecks()).Decode(&decoded); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
 func TestG2AffineSerialization(t *testing.T) {
 	t.Parallel()
 	// test round trip serialization of infinity
@@ -0,0 +1,466 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12378
+
+import (
+	"errors"
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+	"math"
+	"runtime"
+)
+
+// MultiExpBatchG1 computes the multi-exponentiations of points by each of the scalar vectors
+// and returns the results, in the same order.
+//
+// The scalar vectors may be shorter than points, in which case only the first len(scalars[k]) points
+// are used for the k-th multi-exponentiation (as in a KZG commitment against a larger SRS).
+//
+// Compared to len(scalars) calls to MultiExp, the points are loaded once per window for all the
+// scalar vectors, the bucket memory is allocated once per go routine, and the batch affine additions
+// are shared between all the multi-exponentiations, amortizing the field inversions. The go routines
+// are spread over the windows of all the multi-exponentiations at once, instead of the windows of
+// a single one.
+//
+// This call return an error if a scalar vector is longer than points or if provided config is invalid.
+func MultiExpBatchG1(points []G1Affine, scalars [][]fr.Element, config ecc.MultiExpConfig) ([]G1Affine, error) {
+	nbPoints := 0
+	for k := range scalars {
+		if len(scalars[k]) > len(points) {
+			return nil, errors.New("len(scalars[k]) > len(points)")
+		}
+		if len(scalars[k]) > nbPoints {
+			nbPoints = len(scalars[k])
+		}
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	nbMSM := len(scalars)
+	if nbMSM == 0 {
+		return []G1Affine{}, nil
+	}
+
+	// same cost estimate as MultiExp; the buckets are allocated on the heap, so we are
+	// limited by the batch affine types only.
+	implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	var c uint64
+	min := math.MaxFloat64
+	for _, cc := range implementedCs {
+		if lastC(cc) > 16 {
+			continue
+		}
+		cost := float64((fr.Bits+1)*(nbPoints+(1<<cc))) / float64(cc)
+		if cost < min {
+			min = cost
+			c = cc
+		}
+	}
+	nbChunks := int(computeNbChunks(c))
+	nbBuckets := 1 << (c - 1)
+	if lc := lastC(c); lc > c {
+		nbBuckets = 1 << (lc - 1)
+	}
+
+	// partition the scalars of each multi-exponentiation
+	digits := make([][]uint16, nbMSM)
+	for k := range scalars {
+		digits[k], _ = partitionScalars(scalars[k], c, config.NbTasks)
+	}
+
+	// windowSums[k*nbChunks+j] is the weighted bucket sum of the j-th window of the k-th multi-exponentiation
+	windowSums := make([]g1JacExtended, nbMSM*nbChunks)
+
+	parallel.Execute(nbChunks, func(start, end int) {
+		// bucket memory is allocated once per go routine, and reused for each window.
+		buckets := make([]G1Affine, nbMSM*nbBuckets)
+		bucketsJE := make([]g1JacExtended, nbMSM*nbBuckets)
+		for j := start; j < end; j++ {
+			processWindowBatchG1(j, c, points, scalars, digits, buckets, bucketsJE, windowSums)
+		}
+	}, config.NbTasks)
+
+	// combine the windows of each multi-exponentiation
+	results := make([]G1Jac, nbMSM)
+	parallel.Execute(nbMSM, func(start, end int) {
+		for k := start; k < end; k++ {
+			var _p g1JacExtended
+			_p.Set(&windowSums[k*nbChunks+nbChunks-1])
+			for j := nbChunks - 2; j >= 0; j-- {
+				for l := uint64(0); l < c; l++ {
+					_p.double(&_p)
+				}
+				_p.add(&windowSums[k*nbChunks+j])
+			}
+			results[k].unsafeFromJacExtended(&_p)
+		}
+	}, config.NbTasks)
+	return BatchJacobianToAffineG1(results), nil
+}
+
+// processWindowBatchG1 accumulates the points in the buckets of the j-th window of all
+// the multi-exponentiations and stores the weighted bucket sums in windowSums.
+//
+// For each point, the additions into the buckets of the different multi-exponentiations are
+// independent; they are queued in a batch of affine additions sharing a single inversion.
+// Operations that can't go into the current batch (bucket already in the batch, doubling, ...)
+// are done in extended Jacobian coordinates in bucketsJE.
+func processWindowBatchG1(j int, c uint64, points []G1Affine, scalars [][]fr.Element, digits [][]uint16, buckets []G1Affine, bucketsJE []g1JacExtended, windowSums []g1JacExtended) {
+	nbMSM := len(scalars)
+	nbBuckets := len(buckets) / nbMSM
+	nbChunks := int(computeNbChunks(c))
+
+	for i := range buckets {
+		buckets[i].SetInfinity()
+		bucketsJE[i].setInfinity()
+	}
+
+	var (
+		R         ppG1AffineC16 // bucket references
+		P         pG1AffineC16  // points to be added to R (R += P)
+		bucketIDs [len(R)]int
+		batchSize int
+	)
+	inBatch := make([]bool, len(buckets))
+
+	executeAndReset := func() {
+		batchAddG1Affine[pG1AffineC16, ppG1AffineC16, cG1AffineC16](&R, &P, batchSize)
+		for i := 0; i < batchSize; i++ {
+			inBatch[bucketIDs[i]] = false
+		}
+		batchSize = 0
+	}
+
+	for i := range points {
+		if points[i].IsInfinity() {
+			continue
+		}
+		for k := 0; k < nbMSM; k++ {
+			n := len(scalars[k])
+			if i >= n {
+				continue
+			}
+			digit := digits[k][j*n+i]
+			if digit == 0 {
+				continue
+			}
+
+			// if msbWindow bit is set, we need to subtract
+			isAdd := digit&1 == 0
+			bucketID := k * nbBuckets
+			if isAdd {
+				bucketID += int(digit>>1) - 1
+			} else {
+				bucketID += int(digit >> 1)
+			}
+
+			if inBatch[bucketID] {
+				// conflict: the bucket is already used in the current batch
+				if isAdd {
+					bucketsJE[bucketID].addMixed(&points[i])
+				} else {
+					bucketsJE[bucketID].subMixed(&points[i])
+				}
+				continue
+			}
+
+			B := &buckets[bucketID]
+			if B.IsInfinity() {
+				// the bucket is empty, we just set it.
+				if isAdd {
+					B.Set(&points[i])
+				} else {
+					B.Neg(&points[i])
+				}
+				continue
+			}
+			if B.X.Equal(&points[i].X) {
+				// doubling or cancellation, not handled by the batch affine addition
+				if isAdd {
+					bucketsJE[bucketID].addMixed(&points[i])
+				} else {
+					bucketsJE[bucketID].subMixed(&points[i])
+				}
+				continue
+			}
+
+			inBatch[bucketID] = true
+			bucketIDs[batchSize] = bucketID
+			R[batchSize] = B
+			if isAdd {
+				P[batchSize].Set(&points[i])
+			} else {
+				P[batchSize].Neg(&points[i])
+			}
+			batchSize++
+			if batchSize == len(R) {
+				executeAndReset()
+			}
+		}
+	}
+	if batchSize != 0 {
+		executeAndReset()
+	}
+
+	// reduce buckets into total
+	// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+	for k := 0; k < nbMSM; k++ {
+		var runningSum, total g1JacExtended
+		runningSum.setInfinity()
+		total.setInfinity()
+		for b := nbBuckets - 1; b >= 0; b-- {
+			id := k*nbBuckets + b
+			if !buckets[id].IsInfinity() {
+				runningSum.addMixed(&buckets[id])
+			}
+			if !bucketsJE[id].ZZ.IsZero() {
+				runningSum.add(&bucketsJE[id])
+			}
+			total.add(&runningSum)
+		}
+		windowSums[k*nbChunks+j] = total
+	}
+}
+
+// MultiExpBatchG2 computes the multi-exponentiations of points by each of the scalar vectors
+// and returns the results, in the same order.
+//
+// The scalar vectors may be shorter than points, in which case only the first len(scalars[k]) points
+// are used for the k-th multi-exponentiation (as in a KZG commitment against a larger SRS).
+//
+// Compared to len(scalars) calls to MultiExp, the points are loaded once per window for all the
+// scalar vectors, the bucket memory is allocated once per go routine, and the batch affine additions
+// are shared between all the multi-exponentiations, amortizing the field inversions. The go routines
+// are spread over the windows of all the multi-exponentiations at once, instead of the windows of
+// a single one.
+//
+// This call return an error if a scalar vector is longer than points or if provided config is invalid.
+func MultiExpBatchG2(points []G2Affine, scalars [][]fr.Element, config ecc.MultiExpConfig) ([]G2Affine, error) {
+	nbPoints := 0
+	for k := range scalars {
+		if len(scalars[k]) > len(points) {
+			return nil, errors.New("len(scalars[k]) > len(points)")
+		}
+		if len(scalars[k]) > nbPoints {
+			nbPoints = len(scalars[k])
+		}
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	nbMSM := len(scalars)
+	if nbMSM == 0 {
+		return []G2Affine{}, nil
+	}
+
+	// same cost estimate as MultiExp; the buckets are allocated on the heap, so we are
+	// limited by the batch affine types only.
+	implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	var c uint64
+	min := math.MaxFloat64
+	for _, cc := range implementedCs {
+		if lastC(cc) > 16 {
+			continue
+		}
+		cost := float64((fr.Bits+1)*(nbPoints+(1<<cc))) / float64(cc)
+		if cost < min {
+			min = cost
+			c = cc
+		}
+	}
+	nbChunks := int(computeNbChunks(c))
+	nbBuckets := 1 << (c - 1)
+	if lc := lastC(c); lc > c {
+		nbBuckets = 1 << (lc - 1)
+	}
+
+	// partition the scalars of each multi-exponentiation
+	digits := make([][]uint16, nbMSM)
+	for k := range scalars {
+		digits[k], _ = partitionScalars(scalars[k], c, config.NbTasks)
+	}
+
+	// windowSums[k*nbChunks+j] is the weighted bucket sum of the j-th window of the k-th multi-exponentiation
+	windowSums := make([]g2JacExtended, nbMSM*nbChunks)
+
+	parallel.Execute(nbChunks, func(start, end int) {
+		// bucket memory is allocated once per go routine, and reused for each window.
+		buckets := make([]G2Affine, nbMSM*nbBuckets)
+		bucketsJE := make([]g2JacExtended, nbMSM*nbBuckets)
+		for j := start; j < end; j++ {
+			processWindowBatchG2(j, c, points, scalars, digits, buckets, bucketsJE, windowSums)
+		}
+	}, config.NbTasks)
+
+	// combine the windows of each multi-exponentiation
+	results := make([]G2Jac, nbMSM)
+	parallel.Execute(nbMSM, func(start, end int) {
+		for k := start; k < end; k++ {
+			var _p g2JacExtended
+			_p.Set(&windowSums[k*nbChunks+nbChunks-1])
+			for j := nbChunks - 2; j >= 0; j-- {
+				for l := uint64(0); l < c; l++ {
+					_p.double(&_p)
+				}
+				_p.add(&windowSums[k*nbChunks+j])
+			}
+			results[k].unsafeFromJacExtended(&_p)
+		}
+	}, config.NbTasks)
+	toReturn := make([]G2Affine, nbMSM)
+	parallel.Execute(nbMSM, func(start, end int) {
+		for k := start; k < end; k++ {
+			toReturn[k].FromJacobian(&results[k])
+		}
+	})
+	return toReturn, nil
+}
+
+// processWindowBatchG2 accumulates the points in the buckets of the j-th window of all
+// the multi-exponentiations and stores the weighted bucket sums in windowSums.
+//
+// For each point, the additions into the buckets of the different multi-exponentiations are
+// independent; they are queued in a batch of affine additions sharing a single inversion.
+// Operations that can't go into the current batch (bucket already in the batch, doubling, ...)
+// are done in extended Jacobian coordinates in bucketsJE.
+func processWindowBatchG2(j int, c uint64, points []G2Affine, scalars [][]fr.Element, digits [][]uint16, buckets []G2Affine, bucketsJE []g2JacExtended, windowSums []g2JacExtended) {
+	nbMSM := len(scalars)
+	nbBuckets := len(buckets) / nbMSM
+	nbChunks := int(computeNbChunks(c))
+
+	for i := range buckets {
+		buckets[i].SetInfinity()
+		bucketsJE[i].setInfinity()
+	}
+
+	var (
+		R         ppG2AffineC16 // bucket references
+		P         pG2AffineC16  // points to be added to R (R += P)
+		bucketIDs [len(R)]int
+		batchSize int
+	)
+	inBatch := make([]bool, len(buckets))
+
+	executeAndReset := func() {
+		batchAddG2Affine[pG2AffineC16, ppG2AffineC16, cG2AffineC16](&R, &P, batchSize)
+		for i := 0; i < batchSize; i++ {
+			inBatch[bucketIDs[i]] = false
+		}
+		batchSize = 0
+	}
+
+	for i := range points {
+		if points[i].IsInfinity() {
+			continue
+		}
+		for k := 0; k < nbMSM; k++ {
+			n := len(scalars[k])
+			if i >= n {
+				continue
+			}
+			digit := digits[k][j*n+i]
+			if digit == 0 {
+				continue
+			}
+
+			// if msbWindow bit is set, we need to subtract
+			isAdd := digit&1 == 0
+			bucketID := k * nbBuckets
+			if isAdd {
+				bucketID += int(digit>>1) - 1
+			} else {
+				bucketID += int(digit >> 1)
+			}
+
+			if inBatch[bucketID] {
+				// conflict: the bucket is already used in the current batch
+				if isAdd {
+					bucketsJE[bucketID].addMixed(&points[i])
+				} else {
+					bucketsJE[bucketID].subMixed(&points[i])
+				}
+				continue
+			}
+
+			B := &buckets[bucketID]
+			if B.IsInfinity() {
+				// the bucket is empty, we just set it.
+				if isAdd {
+					B.Set(&points[i])
+				} else {
+					B.Neg(&points[i])
+				}
+				continue
+			}
+			if B.X.Equal(&points[i].X) {
+				// doubling or cancellation, not handled by the batch affine addition
+				if isAdd {
+					bucketsJE[bucketID].addMixed(&points[i])
+				} else {
+					bucketsJE[bucketID].subMixed(&points[i])
+				}
+				continue
+			}
+
+			inBatch[bucketID] = true
+			bucketIDs[batchSize] = bucketID
+			R[batchSize] = B
+			if isAdd {
+				P[batchSize].Set(&points[i])
+			} else {
+				P[batchSize].Neg(&points[i])
+			}
+			batchSize++
+			if batchSize == len(R) {
+				executeAndReset()
+			}
+		}
+	}
+	if batchSize != 0 {
+		executeAndReset()
+	}
+
+	// reduce buckets into total
+	// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+	for k := 0; k < nbMSM; k++ {
+		var runningSum, total g2JacExtended
+		runningSum.setInfinity()
+		total.setInfinity()
+		for b := nbBuckets - 1; b >= 0; b-- {
+			id := k*nbBuckets + b
+			if !buckets[id].IsInfinity() {
+				runningSum.addMixed(&buckets[id])
+			}
+			if !bucketsJE[id].ZZ.IsZero() {
+				runningSum.add(&bucketsJE[id])
+			}
+			total.add(&runningSum)
+		}
+		windowSums[k*nbChunks+j] = total
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecvrf
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestECVRF(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[BLS12-378] test the proving and verification", prop.ForAll(
+		func(alpha []byte) bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			publicKey := privKey.PublicKey
+
+			pi, beta, err := Prove(privKey, alpha)
+			if err != nil || len(pi) != SizeProof || len(beta) != SizeOutput {
+				return false
+			}
+			betaVerify, ok := Verify(&publicKey, alpha, pi)
+			if !ok || !bytes.Equal(beta, betaVerify) {
+				return false
+			}
+			betaProof, err := ProofToHash(pi)
+			return err == nil && bytes.Equal(beta, betaProof)
+		},
+		gen.SliceOf(gen.UInt8()),
+	))
+
+	properties.Property("[BLS12-378] proofs should be deterministic", prop.ForAll(
+		func(alpha []byte) bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+
+			pi1, beta1, _ := Prove(privKey, alpha)
+			pi2, beta2, _ := Prove(privKey, alpha)
+
+			return bytes.Equal(pi1, pi2) && bytes.Equal(beta1, beta2)
+		},
+		gen.SliceOf(gen.UInt8()),
+	))
+
+	properties.Property("[BLS12-378] verification should fail on a different input or key", prop.ForAll(
+		func(alpha []byte) bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			otherKey, _ := GenerateKey(rand.Reader)
+
+			pi, beta, _ := Prove(privKey, alpha)
+			_, beta2, _ := Prove(privKey, append(alpha, 0))
+			if bytes.Equal(beta, beta2) {
+				return false
+			}
+
+			if _, ok := Verify(&privKey.PublicKey, append(alpha, 0), pi); ok {
+				return false
+			}
+			_, ok := Verify(&otherKey.PublicKey, alpha, pi)
+			return !ok
+		},
+		gen.SliceOf(gen.UInt8()),
+	))
+
+	properties.Property("[BLS12-378] verification should fail on a tampered proof", prop.ForAll(
+		func(i int, bit uint) bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			alpha := []byte("testing ECVRF")
+
+			pi, _, _ := Prove(privKey, alpha)
+			pi[i] ^= 1 << bit
+			_, ok := Verify(&privKey.PublicKey, alpha, pi)
+			return !ok
+		},
+		gen.IntRange(0, SizeProof-1),
+		gen.UIntRange(0, 7),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestMalformedProof(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := GenerateKey(rand.Reader)
+	alpha := []byte("testing ECVRF")
+	pi, _, _ := Prove(privKey, alpha)
+
+	if _, ok := Verify(&privKey.PublicKey, alpha, pi[:SizeProof-1]); ok {
+		t.Fatal("truncated proof should not verify")
+	}
+	if _, err := ProofToHash(append(pi, 0)); err == nil {
+		t.Fatal("proof of wrong size should be rejected")
+	}
+
+	// s ≥ q
+	tampered := make([]byte, SizeProof)
+	copy(tampered, pi)
+	for i := sizePoint + sizeChallenge; i < SizeProof; i++ {
+		tampered[i] = 0xff
+	}
+	if _, err := ProofToHash(tampered); err == nil {
+		t.Fatal("proof with s ≥ q should be rejected")
+	}
+
+	var pk PublicKey
+	if _, ok := Verify(&pk, alpha, pi); ok {
+		t.Fatal("proof should not verify under the identity public key")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkProveECVRF(b *testing.B) {
+
+	privKey, _ := GenerateKey(rand.Reader)
+
+	alpha := []byte("benchmarking ECVRF prove()")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Prove(privKey, alpha)
+	}
+}
+
+func BenchmarkVerifyECVRF(b *testing.B) {
+
+	privKey, _ := GenerateKey(rand.Reader)
+	alpha := []byte("benchmarking ECVRF prove()")
+	pi, _, _ := Prove(privKey, alpha)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(&privKey.PublicKey, alpha, pi)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/field/hash"
+)
+
+// Elligator 2 constants of the Montgomery curve K·t² = s³ + J·s² + s birationally equivalent
+// to the twisted Edwards curve, with J = 2(a+d)/(a-d) and K = 4/(a-d)
+var (
+	elligator2Once sync.Once
+	elligator2     struct {
+		c1 fr.Element // J/K
+		c2 fr.Element // 1/K²
+		k  fr.Element // K
+		z  fr.Element // non-square Z
+	}
+)
+
+func initElligator2() {
+	ecurve := GetEdwardsCurve()
+
+	var aMinusD fr.Element
+	aMinusD.Sub(&ecurve.A, &ecurve.D)
+
+	// K = 4/(a-d)
+	elligator2.k.SetUint64(4).Div(&elligator2.k, &aMinusD)
+
+	// J/K = (a+d)/2
+	elligator2.c1.Add(&ecurve.A, &ecurve.D).Halve()
+
+	// 1/K²
+	elligator2.c2.Inverse(&elligator2.k).Square(&elligator2.c2)
+
+	elligator2.z.SetInt64(5)
+}
+
+// MapToCurve maps a field element to a point of the curve, with the Elligator 2 method
+// (https://www.rfc-editor.org/rfc/rfc9380#section-6.7.1) on the birationally equivalent
+// Montgomery curve, followed by the rational map of https://www.rfc-editor.org/rfc/rfc9380#section-6.8.2.
+//
+// The returned point is not in the prime order subgroup in general.
+func MapToCurve(u *fr.Element) PointAffine {
+	elligator2Once.Do(initElligator2)
+
+	var one, tv, x1, x2, gx, y fr.Element
+	one.SetOne()
+
+	// x1 = -(J/K) · inv0(1 + Z·u²)
+	tv.Square(u).
+		Mul(&tv, &elligator2.z).
+		Add(&tv, &one).
+		Inverse(&tv)
+	x1.Mul(&tv, &elligator2.c1).Neg(&x1)
+	if x1.IsZero() {
+		x1.Neg(&elligator2.c1)
+	}
+
+	// x2 = -x1 - J/K
+	x2.Add(&x1, &elligator2.c1).Neg(&x2)
+
+	// gx1 = x1³ + (J/K)·x1² + x1/K²
+	elligator2G(&gx, &x1)
+
+	x := &x1
+	sgn0 := uint64(1)
+	if gx.Legendre() == -1 {
+		// gx2 = gx1·Z·u² is a square
+		elligator2G(&gx, &x2)
+		x = &x2
+		sgn0 = 0
+	}
+	y.Sqrt(&gx)
+	if y.Bits()[0]&1 != sgn0 {
+		y.Neg(&y)
+	}
+
+	// (s, t) = (x·K, y·K)
+	var s, t fr.Element
+	s.Mul(x, &elligator2.k)
+	t.Mul(&y, &elligator2.k)
+
+	// (v, w) = (s/t, (s-1)/(s+1)), exceptional cases are mapped to the identity
+	var p PointAffine
+	tv.Add(&s, &one)
+	if t.IsZero() || tv.IsZero() {
+		p.setInfinity()
+		return p
+	}
+	p.X.Div(&s, &t)
+	p.Y.Sub(&s, &one).Div(&p.Y, &tv)
+
+	return p
+}
+
+// elligator2G sets z = x³ + (J/K)·x² + x/K²
+func elligator2G(z, x *fr.Element) {
+	var tv fr.Element
+	tv.Add(x, &elligator2.c1).
+		Mul(&tv, x).
+		Add(&tv, &elligator2.c2)
+	z.Mul(&tv, x)
+}
+
+// EncodeToCurve hashes a message to a point of the prime order subgroup of the curve,
+// following the encode_to_curve method of https://www.rfc-editor.org/rfc/rfc9380#section-3
+// with expand_message_xmd (SHA-256) and the Elligator 2 map.
+//
+// The output distribution is not uniform: use HashToCurve unless the protocol only requires
+// an encoding. dst is the domain separation tag, it must be unique to the application.
+// The ciphersuite defaults to expand_message_xmd with SHA-256, and can be changed with hash.Option.
+func EncodeToCurve(msg, dst []byte, opts ...hash.Option) (PointAffine, error) {
+	var res PointAffine
+	u, err := fr.Hash(msg, dst, 1, opts...)
+	if err != nil {
+		return res, err
+	}
+
+	q := MapToCurve(&u[0])
+	var p PointProj
+	p.FromAffine(&q)
+	clearCofactor(&p)
+
+	res.FromProj(&p)
+	return res, nil
+}
+
+// HashToCurve hashes a message to a point of the prime order subgroup of the curve,
+// following the hash_to_curve method of https://www.rfc-editor.org/rfc/rfc9380#section-3
+// with expand_message_xmd (SHA-256) and the Elligator 2 map.
+//
+// The output is indistinguishable from a uniformly random point of the subgroup.
+// dst is the domain separation tag, it must be unique to the application.
+// The ciphersuite defaults to expand_message_xmd with SHA-256, and can be changed with hash.Option.
+func HashToCurve(msg, dst []byte, opts ...hash.Option) (PointAffine, error) {
+	var res PointAffine
+	u, err := fr.Hash(msg, dst, 2, opts...)
+	if err != nil {
+		return res, err
+	}
+
+	q0 := MapToCurve(&u[0])
+	q1 := MapToCurve(&u[1])
+	var p PointProj
+	p.FromAffine(&q0).MixedAdd(&p, &q1)
+	clearCofactor(&p)
+
+	res.FromProj(&p)
+	return res, nil
+}
+
+// clearCofactor multiplies p by the cofactor 8
+func clearCofactor(p *PointProj) {
+	p.Double(p)
+	p.Double(p)
+	p.Double(p)
+}
@@ -0,0 +1,335 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecvrf
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/field/hash"
+)
+
+const (
+	sizeFr         = fr.Bytes
+	sizePoint      = fr.Bytes // compressed point
+	sizeChallenge  = 16       // cLen, for 128 bits of security
+	sizePublicKey  = sizePoint
+	sizePrivateKey = sizeFr + sizePublicKey
+
+	// SizeProof is the size in bytes of a proof π = Γ ∥ c ∥ s
+	SizeProof = sizePoint + sizeChallenge + sizeFr
+	// SizeOutput is the size in bytes of a VRF output β
+	SizeOutput = sha512.Size
+)
+
+// suiteString identifies the ciphersuite of this package in the domain separation
+// of the hashes. It is chosen by this package and not registered with IANA.
+const suiteString = 0xfd
+
+// domain separators of RFC 9381, section 5
+const (
+	challengeGenerationDomainSeparatorFront = 0x02
+	challengeGenerationDomainSeparatorBack  = 0x00
+	proofToHashDomainSeparatorFront         = 0x03
+	proofToHashDomainSeparatorBack          = 0x00
+)
+
+// h2cSuiteID is the hash-to-curve suite used to map VRF inputs to the curve (RFC 9380)
+const h2cSuiteID = "BANDERSNATCH_XMD:SHA-512_ELL2_NU_"
+
+// dst is the domain separation tag of encode_to_curve: "ECVRF_" ∥ h2c_suite_ID_string ∥ suite_string
+var dst = append([]byte("ECVRF_"+h2cSuiteID), suiteString)
+
+var (
+	errInvalidPoint = errors.New("invalid point encoding")
+	errInvalidProof = errors.New("invalid proof")
+)
+
+var curveParams = bandersnatch.GetEdwardsCurve()
+var cofactor = curveParams.Cofactor.BigInt(new(big.Int))
+var one = new(big.Int).SetInt64(1)
+
+// PublicKey represents an ECVRF public key Y = x⋅B
+type PublicKey struct {
+	A bandersnatch.PointAffine
+}
+
+// PrivateKey represents an ECVRF private key
+type PrivateKey struct {
+	PublicKey PublicKey    // copy of the associated public key
+	scalar    [sizeFr]byte // secret scalar x, in big Endian
+}
+
+// randFieldElement returns a random element of the order of the given
+// curve using the procedure given in FIPS 186-4, Appendix B.5.1.
+func randFieldElement(rand io.Reader) (k *big.Int, err error) {
+	b := make([]byte, fr.Bits/8+8)
+	_, err = io.ReadFull(rand, b)
+	if err != nil {
+		return
+	}
+
+	k = new(big.Int).SetBytes(b)
+	n := new(big.Int).Sub(&curveParams.Order, one)
+	k.Mod(k, n)
+	k.Add(k, one)
+	return
+}
+
+// GenerateKey generates a public and private key pair.
+func GenerateKey(rand io.Reader) (*PrivateKey, error) {
+
+	k, err := randFieldElement(rand)
+	if err != nil {
+		return nil, err
+
+	}
+
+	privateKey := new(PrivateKey)
+	k.FillBytes(privateKey.scalar[:sizeFr])
+	privateKey.PublicKey.A.ScalarMultiplicationCT(&curveParams.Base, k)
+	return privateKey, nil
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() *PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Prove computes the VRF proof π and output β of the input alpha under the private key sk.
+//
+// The proof is deterministic: proving the same input with the same key yields the same π.
+func Prove(sk *PrivateKey, alpha []byte) (pi, beta []byte, err error) {
+	x := new(big.Int).SetBytes(sk.scalar[:sizeFr])
+
+	yString := sk.PublicKey.A.Bytes()
+	H, err := encodeToCurve(yString[:], alpha)
+	if err != nil {
+		return nil, nil, err
+	}
+	hString := H.Bytes()
+
+	// Γ = x⋅H
+	var gamma bandersnatch.PointAffine
+	gamma.ScalarMultiplicationCT(&H, x)
+
+	// k = nonce_generation(sk, h_string)
+	k, err := randFieldElement(nonce(sk, hString[:]))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// U = k⋅B, V = k⋅H
+	var U, V bandersnatch.PointAffine
+	U.ScalarMultiplicationCT(&curveParams.Base, k)
+	V.ScalarMultiplicationCT(&H, k)
+
+	c := challenge(&sk.PublicKey.A, &H, &gamma, &U, &V)
+
+	// s = k + c⋅x mod q
+	s := new(big.Int).Mul(c, x)
+	s.Add(s, k).Mod(s, &curveParams.Order)
+
+	pi = make([]byte, SizeProof)
+	gammaString := gamma.Bytes()
+	copy(pi[:sizePoint], gammaString[:])
+	c.FillBytes(pi[sizePoint : sizePoint+sizeChallenge])
+	s.FillBytes(pi[sizePoint+sizeChallenge:])
+
+	return pi, gammaToHash(&gamma), nil
+}
+
+// Verify checks the VRF proof pi of the input alpha under the public key pk.
+// It returns the VRF output β and true if the proof is valid, nil and false otherwise.
+func Verify(pk *PublicKey, alpha, pi []byte) (beta []byte, ok bool) {
+	// validate_key: Y must be on the curve and not of low order
+	var cY bandersnatch.PointAffine
+	cY.ScalarMultiplication(&pk.A, cofactor)
+	if !pk.A.IsOnCurve() || cY.IsZero() {
+		return nil, false
+	}
+
+	gamma, c, s, err := decodeProof(pi)
+	if err != nil {
+		return nil, false
+	}
+
+	yString := pk.A.Bytes()
+	H, err := encodeToCurve(yString[:], alpha)
+	if err != nil {
+		return nil, false
+	}
+
+	// U = s⋅B - c⋅Y
+	var U, cPk bandersnatch.PointAffine
+	U.ScalarMultiplication(&curveParams.Base, s)
+	cPk.ScalarMultiplication(&pk.A, c)
+	cPk.Neg(&cPk)
+	U.Add(&U, &cPk)
+
+	// V = s⋅H - c⋅Γ
+	var V, cGamma bandersnatch.PointAffine
+	V.ScalarMultiplication(&H, s)
+	cGamma.ScalarMultiplication(&gamma, c)
+	cGamma.Neg(&cGamma)
+	V.Add(&V, &cGamma)
+
+	cPrime := challenge(&pk.A, &H, &gamma, &U, &V)
+	var cBytes, cPrimeBytes [sizeChallenge]byte
+	c.FillBytes(cBytes[:])
+	cPrime.FillBytes(cPrimeBytes[:])
+	if subtle.ConstantTimeCompare(cBytes[:], cPrimeBytes[:]) != 1 {
+		return nil, false
+	}
+
+	return gammaToHash(&gamma), true
+}
+
+// ProofToHash returns the VRF output β of the proof pi.
+//
+// It does not verify pi: the output should only be used once the proof has been
+// checked with Verify.
+func ProofToHash(pi []byte) ([]byte, error) {
+	gamma, _, _, err := decodeProof(pi)
+	if err != nil {
+		return nil, err
+	}
+	return gammaToHash(&gamma), nil
+}
+
+// encodeToCurve maps alpha to the curve with encode_to_curve, salted with the encoding of the public key
+func encodeToCurve(salt, alpha []byte) (bandersnatch.PointAffine, error) {
+	msg := make([]byte, 0, len(salt)+len(alpha))
+	msg = append(msg, salt...)
+	msg = append(msg, alpha...)
+	return bandersnatch.EncodeToCurve(msg, dst, hash.WithExpandMsgXmd(sha512.New))
+}
+
+// challenge returns c = Hash(suite_string ∥ 0x02 ∥ P1 ∥ ... ∥ P5 ∥ 0x00) truncated to cLen bytes
+func challenge(points ...*bandersnatch.PointAffine) *big.Int {
+	h := sha512.New()
+	h.Write([]byte{suiteString, challengeGenerationDomainSeparatorFront})
+	for _, p := range points {
+		b := p.Bytes()
+		h.Write(b[:])
+	}
+	h.Write([]byte{challengeGenerationDomainSeparatorBack})
+	return new(big.Int).SetBytes(h.Sum(nil)[:sizeChallenge])
+}
+
+// gammaToHash returns β = Hash(suite_string ∥ 0x03 ∥ point_to_string(cofactor⋅Γ) ∥ 0x00)
+func gammaToHash(gamma *bandersnatch.PointAffine) []byte {
+	var cGamma bandersnatch.PointAffine
+	cGamma.ScalarMultiplication(gamma, cofactor)
+
+	h := sha512.New()
+	h.Write([]byte{suiteString, proofToHashDomainSeparatorFront})
+	b := cGamma.Bytes()
+	h.Write(b[:])
+	h.Write([]byte{proofToHashDomainSeparatorBack})
+	return h.Sum(nil)
+}
+
+// decodeProof parses pi as Γ ∥ c ∥ s and checks that Γ is a valid point and s < q
+func decodeProof(pi []byte) (gamma bandersnatch.PointAffine, c, s *big.Int, err error) {
+	if len(pi) != SizeProof {
+		err = errInvalidProof
+		return
+	}
+	if err = decodePoint(&gamma, pi[:sizePoint]); err != nil {
+		return
+	}
+	c = new(big.Int).SetBytes(pi[sizePoint : sizePoint+sizeChallenge])
+	s = new(big.Int).SetBytes(pi[sizePoint+sizeChallenge:])
+	if s.Cmp(&curveParams.Order) >= 0 {
+		err = errInvalidProof
+		return
+	}
+	return
+}
+
+// decodePoint sets p from its compressed encoding buf. It rejects points which are
+// not on the curve and non-canonical encodings.
+func decodePoint(p *bandersnatch.PointAffine, buf []byte) error {
+	if len(buf) != sizePoint {
+		return errInvalidPoint
+	}
+	if _, err := p.SetBytes(buf); err != nil {
+		return err
+	}
+	if !p.IsOnCurve() {
+		return errInvalidPoint
+	}
+	canonical := p.Bytes()
+	if subtle.ConstantTimeCompare(canonical[:], buf) != 1 {
+		return errInvalidPoint
+	}
+	return nil
+}
+
+type zr struct{}
+
+// Read replaces the contents of dst with zeros. It is safe for concurrent use.
+func (zr) Read(dst []byte) (n int, err error) {
+	for i := range dst {
+		dst[i] = 0
+	}
+	return len(dst), nil
+}
+
+var zeroReader = zr{}
+
+const (
+	aesIV = "gnark-crypto IV." // must be 16 chars (equal block size)
+)
+
+// nonce returns a deterministic CSPRNG from which the nonce k is drawn.
+func nonce(privateKey *PrivateKey, hString []byte) *cipher.StreamReader {
+	// As in ecdsa, the nonce is derived from an AES-CTR CSPRNG keyed by
+	//
+	//    SHA2-512(privateKey.scalar ∥ h_string)[:32]
+	//
+	// but without additional entropy, so that proofs are reproducible. The
+	// secret scalar alone makes the key unpredictable, and h_string binds it
+	// to the VRF input.
+
+	// Initialize an SHA-512 hash context; digest...
+	md := sha512.New()
+	md.Write(privateKey.scalar[:sizeFr]) // the private key,
+	md.Write(hString)                    // and the encoded input;
+	key := md.Sum(nil)[:32]              // and compute ChopMD-256(SHA-512),
+	// which is an indifferentiable MAC.
+
+	// Create an AES-CTR instance to use as a CSPRNG.
+	block, _ := aes.NewCipher(key)
+
+	// Create a CSPRNG that xors a stream of zeros with
+	// the output of the AES-CTR instance.
+	return &cipher.StreamReader{
+		R: zeroReader,
+		S: cipher.NewCTR(block, []byte(aesIV)),
+	}
+}
@@ -0,0 +1,269 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0xffffffff00000001
+DATA q<>+8(SB)/8, $0x53bda402fffe5bfe
+DATA q<>+16(SB)/8, $0x3339d80809a1d805
+DATA q<>+24(SB)/8, $0x73eda753299d7d48
+GLOBL q<>(SB), (RODATA+NOPTR), $32
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0xfffffffeffffffff
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+// mul(res, x, y *Element) sets res = x * y * R⁻¹ (mod q)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD $q<>(SB), R10
+	LDP  16(R10), (R12, R13)
+	LDP  0(R10), (R10, R11)
+	MOVD qInv0<>(SB), R1
+	MOVD x+8(FP), R0
+	LDP  16(R0), (R8, R9)
+	LDP  0(R0), (R6, R7)
+	MOVD y+16(FP), R0
+	MOVD 0(R0), R2
+
+	// t = t + x * y[0]
+	MUL   R6, R2, R14
+	MUL   R7, R2, R15
+	MUL   R8, R2, R16
+	MUL   R9, R2, R17
+	UMULH R6, R2, R5
+	ADDS  R5, R15, R15
+	UMULH R7, R2, R5
+	ADCS  R5, R16, R16
+	UMULH R8, R2, R5
+	ADCS  R5, R17, R17
+	UMULH R9, R2, R5
+	ADC   R5, ZR, R19
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R14, R3
+	MUL   R10, R3, R4
+	ADDS  R4, R14, R14
+	MUL   R11, R3, R4
+	ADCS  R4, R15, R15
+	MUL   R12, R3, R4
+	ADCS  R4, R16, R16
+	MUL   R13, R3, R4
+	ADCS  R4, R17, R17
+	ADC   ZR, R19, R19
+	UMULH R10, R3, R5
+	ADDS  R5, R15, R15
+	UMULH R11, R3, R5
+	ADCS  R5, R16, R16
+	UMULH R12, R3, R5
+	ADCS  R5, R17, R17
+	UMULH R13, R3, R5
+	ADC   R5, R19, R19
+	MOVD  8(R0), R2
+
+	// t = t + x * y[1]
+	MUL   R6, R2, R4
+	ADDS  R4, R15, R15
+	MUL   R7, R2, R4
+	ADCS  R4, R16, R16
+	MUL   R8, R2, R4
+	ADCS  R4, R17, R17
+	MUL   R9, R2, R4
+	ADCS  R4, R19, R19
+	ADC   ZR, ZR, R14
+	UMULH R6, R2, R5
+	ADDS  R5, R16, R16
+	UMULH R7, R2, R5
+	ADCS  R5, R17, R17
+	UMULH R8, R2, R5
+	ADCS  R5, R19, R19
+	UMULH R9, R2, R5
+	ADC   R5, R14, R14
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R15, R3
+	MUL   R10, R3, R4
+	ADDS  R4, R15, R15
+	MUL   R11, R3, R4
+	ADCS  R4, R16, R16
+	MUL   R12, R3, R4
+	ADCS  R4, R17, R17
+	MUL   R13, R3, R4
+	ADCS  R4, R19, R19
+	ADC   ZR, R14, R14
+	UMULH R10, R3, R5
+	ADDS  R5, R16, R16
+	UMULH R11, R3, R5
+	ADCS  R5, R17, R17
+	UMULH R12, R3, R5
+	ADCS  R5, R19, R19
+	UMULH R13, R3, R5
+	ADC   R5, R14, R14
+	MOVD  16(R0), R2
+
+	// t = t + x * y[2]
+	MUL   R6, R2, R4
+	ADDS  R4, R16, R16
+	MUL   R7, R2, R4
+	ADCS  R4, R17, R17
+	MUL   R8, R2, R4
+	ADCS  R4, R19, R19
+	MUL   R9, R2, R4
+	ADCS  R4, R14, R14
+	ADC   ZR, ZR, R15
+	UMULH R6, R2, R5
+	ADDS  R5, R17, R17
+	UMULH R7, R2, R5
+	ADCS  R5, R19, R19
+	UMULH R8, R2, R5
+	ADCS  R5, R14, R14
+	UMULH R9, R2, R5
+	ADC   R5, R15, R15
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R16, R3
+	MUL   R10, R3, R4
+	ADDS  R4, R16, R16
+	MUL   R11, R3, R4
+	ADCS  R4, R17, R17
+	MUL   R12, R3, R4
+	ADCS  R4, R19, R19
+	MUL   R13, R3, R4
+	ADCS  R4, R14, R14
+	ADC   ZR, R15, R15
+	UMULH R10, R3, R5
+	ADDS  R5, R17, R17
+	UMULH R11, R3, R5
+	ADCS  R5, R19, R19
+	UMULH R12, R3, R5
+	ADCS  R5, R14, R14
+	UMULH R13, R3, R5
+	ADC   R5, R15, R15
+	MOVD  24(R0), R2
+
+	// t = t + x * y[3]
+	MUL   R6, R2, R4
+	ADDS  R4, R17, R17
+	MUL   R7, R2, R4
+	ADCS  R4, R19, R19
+	MUL   R8, R2, R4
+	ADCS  R4, R14, R14
+	MUL   R9, R2, R4
+	ADCS  R4, R15, R15
+	ADC   ZR, ZR, R16
+	UMULH R6, R2, R5
+	ADDS  R5, R19, R19
+	UMULH R7, R2, R5
+	ADCS  R5, R14, R14
+	UMULH R8, R2, R5
+	ADCS  R5, R15, R15
+	UMULH R9, R2, R5
+	ADC   R5, R16, R16
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R17, R3
+	MUL   R10, R3, R4
+	ADDS  R4, R17, R17
+	MUL   R11, R3, R4
+	ADCS  R4, R19, R19
+	MUL   R12, R3, R4
+	ADCS  R4, R14, R14
+	MUL   R13, R3, R4
+	ADCS  R4, R15, R15
+	ADC   ZR, R16, R16
+	UMULH R10, R3, R5
+	ADDS  R5, R19, R19
+	UMULH R11, R3, R5
+	ADCS  R5, R14, R14
+	UMULH R12, R3, R5
+	ADCS  R5, R15, R15
+	UMULH R13, R3, R5
+	ADC   R5, R16, R16
+
+	// reduce t if t ⩾ q
+	SUBS R10, R19, R6
+	SBCS R11, R14, R7
+	SBCS R12, R15, R8
+	SBCS R13, R16, R9
+	CSEL CS, R6, R19, R19
+	CSEL CS, R7, R14, R14
+	CSEL CS, R8, R15, R15
+	CSEL CS, R9, R16, R16
+	MOVD res+0(FP), R0
+	STP  (R19, R14), 0(R0)
+	STP  (R15, R16), 16(R0)
+	RET
+
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVD $q<>(SB), R5
+	LDP  16(R5), (R7, R8)
+	LDP  0(R5), (R5, R6)
+	MOVD res+0(FP), R0
+	LDP  16(R0), (R3, R4)
+	LDP  0(R0), (R1, R2)
+	SUBS R5, R1, R9
+	SBCS R6, R2, R10
+	SBCS R7, R3, R11
+	SBCS R8, R4, R12
+	CSEL CS, R9, R1, R1
+	CSEL CS, R10, R2, R2
+	CSEL CS, R11, R3, R3
+	CSEL CS, R12, R4, R4
+	STP  (R1, R2), 0(R0)
+	STP  (R3, R4), 16(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD $q<>(SB), R10
+	LDP  16(R10), (R12, R13)
+	LDP  0(R10), (R10, R11)
+	MOVD a+0(FP), R0
+	LDP  16(R0), (R4, R5)
+	LDP  0(R0), (R2, R3)
+	MOVD b+8(FP), R1
+	LDP  16(R1), (R8, R9)
+	LDP  0(R1), (R6, R7)
+	ADDS R2, R6, R14
+	ADCS R3, R7, R15
+	ADCS R4, R8, R16
+	ADCS R5, R9, R17
+	SUBS R6, R2, R2
+	SBCS R7, R3, R3
+	SBCS R8, R4, R4
+	SBCS R9, R5, R5
+	CSEL CS, ZR, R10, R6
+	CSEL CS, ZR, R11, R7
+	CSEL CS, ZR, R12, R8
+	CSEL CS, ZR, R13, R9
+	ADDS R2, R6, R2
+	ADCS R3, R7, R3
+	ADCS R4, R8, R4
+	ADCS R5, R9, R5
+	STP  (R2, R3), 0(R1)
+	STP  (R4, R5), 16(R1)
+	SUBS R10, R14, R6
+	SBCS R11, R15, R7
+	SBCS R12, R16, R8
+	SBCS R13, R17, R9
+	CSEL CS, R6, R14, R14
+	CSEL CS, R7, R15, R15
+	CSEL CS, R8, R16, R16
+	CSEL CS, R9, R17, R17
+	STP  (R14, R15), 0(R0)
+	STP  (R16, R17), 16(R0)
+	RET
@@ -0,0 +1,430 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"crypto/rand"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// E3 is a degree 3 extension of fr.Element, E3 = 𝔽[u]/(u³ - (2))
+type E3 struct {
+	A0, A1, A2 fr.Element
+}
+
+// nonResidueE3 is α such that E3 = 𝔽[u]/(u³ - α)
+var nonResidueE3 = func() fr.Element {
+	var alpha fr.Element
+	alpha.SetInt64(2)
+	return alpha
+}()
+
+// mulByNonResidueE3 sets z = α·x
+func mulByNonResidueE3(z, x *fr.Element) {
+	z.Double(x)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E3) Equal(x *E3) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1) && z.A2.Equal(&x.A2)
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E3) SetZero() *E3 {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E3) SetOne() *E3 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E3) Set(x *E3) *E3 {
+	*z = *x
+	return z
+}
+
+// SetRandom sets the coordinates of z to random values and returns z
+func (z *E3) SetRandom() (*E3, error) {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom is like SetRandom, reading the randomness from r
+func (z *E3) SetRandomFrom(r io.Reader) (*E3, error) {
+	if _, err := z.A0.SetRandomFrom(r); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandomFrom(r); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetRandomFrom(r); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *E3) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *E3) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// Add sets z = x + y and returns z
+func (z *E3) Add(x, y *E3) *E3 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	z.A2.Add(&x.A2, &y.A2)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E3) Sub(x, y *E3) *E3 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	z.A2.Sub(&x.A2, &y.A2)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E3) Double(x *E3) *E3 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	z.A2.Double(&x.A2)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E3) Neg(x *E3) *E3 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	z.A2.Neg(&x.A2)
+	return z
+}
+
+// MulByElement sets z = x·y for y in the base field and returns z
+func (z *E3) MulByElement(x *E3, y *fr.Element) *E3 {
+	var yCopy fr.Element
+	yCopy.Set(y)
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	z.A2.Mul(&x.A2, &yCopy)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E3) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*u" + "+" + z.A2.String() + "*u²"
+}
+
+// Mul sets z = x·y and returns z
+func (z *E3) Mul(x, y *E3) *E3 {
+	// Karatsuba
+	var v0, v1, v2, a, b, c0, c1, c2 fr.Element
+	v0.Mul(&x.A0, &y.A0)
+	v1.Mul(&x.A1, &y.A1)
+	v2.Mul(&x.A2, &y.A2)
+
+	// c0 = v0 + α((a1 + a2)(b1 + b2) - v1 - v2)
+	a.Add(&x.A1, &x.A2)
+	b.Add(&y.A1, &y.A2)
+	c0.Mul(&a, &b).Sub(&c0, &v1).Sub(&c0, &v2)
+	mulByNonResidueE3(&c0, &c0)
+	c0.Add(&c0, &v0)
+
+	// c1 = (a0 + a1)(b0 + b1) - v0 - v1 + α·v2
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	c1.Mul(&a, &b).Sub(&c1, &v0).Sub(&c1, &v1)
+	mulByNonResidueE3(&a, &v2)
+	c1.Add(&c1, &a)
+
+	// c2 = (a0 + a2)(b0 + b2) - v0 - v2 + v1
+	a.Add(&x.A0, &x.A2)
+	b.Add(&y.A0, &y.A2)
+	c2.Mul(&a, &b).Sub(&c2, &v0).Sub(&c2, &v2).Add(&c2, &v1)
+
+	z.A0 = c0
+	z.A1 = c1
+	z.A2 = c2
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *E3) Square(x *E3) *E3 {
+	// Chung-Hasan SQR2
+	var s0, s1, s2, s3, s4, t fr.Element
+	s0.Square(&x.A0)
+	s1.Mul(&x.A0, &x.A1).Double(&s1)
+	s2.Sub(&x.A0, &x.A1).Add(&s2, &x.A2).Square(&s2)
+	s3.Mul(&x.A1, &x.A2).Double(&s3)
+	s4.Square(&x.A2)
+
+	// c0 = s0 + α·s3
+	mulByNonResidueE3(&t, &s3)
+	z.A0.Add(&s0, &t)
+	// c2 = s1 + s2 + s3 - s0 - s4
+	z.A2.Add(&s1, &s2).Add(&z.A2, &s3).Sub(&z.A2, &s0).Sub(&z.A2, &s4)
+	// c1 = s1 + α·s4
+	mulByNonResidueE3(&t, &s4)
+	z.A1.Add(&s1, &t)
+	return z
+}
+
+// Inverse sets z to the inverse of x and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E3) Inverse(x *E3) *E3 {
+	// x⁻¹ = (c0 + c1·u + c2·u²) / (a0·c0 + α(a2·c1 + a1·c2)), where
+	// c0 = a0² - α·a1·a2, c1 = α·a2² - a0·a1, c2 = a1² - a0·a2
+	var c0, c1, c2, t, n fr.Element
+	c0.Mul(&x.A1, &x.A2)
+	mulByNonResidueE3(&c0, &c0)
+	t.Square(&x.A0)
+	c0.Sub(&t, &c0)
+
+	c1.Square(&x.A2)
+	mulByNonResidueE3(&c1, &c1)
+	t.Mul(&x.A0, &x.A1)
+	c1.Sub(&c1, &t)
+
+	c2.Square(&x.A1)
+	t.Mul(&x.A0, &x.A2)
+	c2.Sub(&c2, &t)
+
+	n.Mul(&x.A2, &c1)
+	t.Mul(&x.A1, &c2)
+	n.Add(&n, &t)
+	mulByNonResidueE3(&n, &n)
+	t.Mul(&x.A0, &c0)
+	n.Add(&n, &t).Inverse(&n)
+
+	z.A0.Mul(&c0, &n)
+	z.A1.Mul(&c1, &n)
+	z.A2.Mul(&c2, &n)
+	return z
+}
+
+// conjugatesProduct sets y to x^p·x^(p²)⋯x^(p²), such that x·y is the norm of x
+func (x *E3) conjugatesProduct(y *E3) {
+	var f E3
+	f.Frobenius(x)
+	y.Set(&f)
+	for i := 2; i < 3; i++ {
+		f.Frobenius(&f)
+		y.Mul(y, &f)
+	}
+}
+
+// mulConstantCoeffE3 sets c to the constant coefficient of x·y
+func mulConstantCoeffE3(c *fr.Element, x, y *E3) {
+	var h, t fr.Element
+	h.Mul(&x.A1, &y.A2)
+	t.Mul(&x.A2, &y.A1)
+	h.Add(&h, &t)
+	mulByNonResidueE3(&h, &h)
+	t.Mul(&x.A0, &y.A0)
+	c.Add(&t, &h)
+}
+
+// norm sets n to the norm of z, the product of its conjugates z·z^p⋯z^(p²)
+func (z *E3) norm(n *fr.Element) {
+	var y E3
+	z.conjugatesProduct(&y)
+	mulConstantCoeffE3(n, z, &y)
+}
+
+// frobeniusCoeffE3 holds the γᵢ such that (aᵢuⁱ)ᵖ = γᵢ·aᵢ·u^σ(i)
+var frobeniusCoeffE3 = func() (gamma [3]fr.Element) {
+	setString(&gamma[1], "228988810152649578064853576960394133503")
+	setString(&gamma[2], "52435875175126190479447740508185965837461563690374988244538805122978187051009")
+	return
+}()
+
+// Frobenius sets z = xᵖ, with p the characteristic, and returns z
+func (z *E3) Frobenius(x *E3) *E3 {
+	var c [3]fr.Element
+	c[0] = x.A0
+	c[1].Mul(&x.A1, &frobeniusCoeffE3[1])
+	c[2].Mul(&x.A2, &frobeniusCoeffE3[2])
+	z.A0 = c[0]
+	z.A1 = c[1]
+	z.A2 = c[2]
+	return z
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+//
+// z is a square in E3 if and only if its norm is a square in the base field
+func (z *E3) Legendre() int {
+	var n fr.Element
+	z.norm(&n)
+	return n.Legendre()
+}
+
+// sqrtExponentE3 is (s-1)/2, where p³ - 1 = 2ᵉ·s with s odd
+var sqrtExponentE3, _ = new(big.Int).SetString("be2fa653f6f2f009b3d42b813f0870fe8d8119e29ae810e61848c84f56bea3f0a0b859ef17356f3b3a6c26e3e33af62bf71e8dd0e4aad2bed3581faaf32071e4e6fac0a0331b5b4c57915fa1cd44dfefda16206fffd89fffffffffe", 16)
+
+// sqrtGE3 is cˢ for a non-square c in E3
+var sqrtGE3 = func() (g E3) {
+	setString(&g.A0, "25642348266032178891414562611216695725540212240283898283801845277188111692422")
+	setString(&g.A1, "0")
+	setString(&g.A2, "0")
+	return
+}()
+
+// Sqrt z = √x
+// if the square root doesn't exist (x is not a square in E3)
+// Sqrt leaves z unchanged and returns nil
+func (z *E3) Sqrt(x *E3) *E3 {
+	// Tonelli-Shanks, see Sqrt in the base field
+	var w, y, b, t E3
+
+	// w = x^((s-1)/2)
+	w.Exp(*x, sqrtExponentE3)
+
+	// y = x^((s+1)/2) = w * x
+	y.Mul(x, &w)
+
+	// b = xˢ = w * w * x = y * w
+	b.Mul(&w, &y)
+
+	g := sqrtGE3
+	r := uint64(32)
+
+	// t = x^((p³-1)/2) = r-1 squaring of xˢ
+	t = b
+	for i := uint64(0); i < r-1; i++ {
+		t.Square(&t)
+	}
+	if t.IsZero() {
+		return z.SetZero()
+	}
+	if !t.IsOne() {
+		// t != 1, we don't have a square root
+		return nil
+	}
+	for {
+		var m uint64
+		t = b
+
+		// for t != 1
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1))
+		ge := int(r - m - 1)
+		t = g
+		for ge > 0 {
+			t.Square(&t)
+			ge--
+		}
+
+		g.Square(&t)
+		y.Mul(&y, &t)
+		b.Mul(&b, &g)
+		r = m
+	}
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *E3) Exp(x E3, k *big.Int) *E3 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = bigIntPool.Get().(*big.Int)
+		defer bigIntPool.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// BatchInvertE3 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE3(a []E3) []E3 {
+	res := make([]E3, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E3
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
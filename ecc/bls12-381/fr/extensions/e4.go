@@ -0,0 +1,462 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"crypto/rand"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// E4 is a degree 4 extension of fr.Element, E4 = 𝔽[u]/(u⁴ - (5))
+type E4 struct {
+	A0, A1, A2, A3 fr.Element
+}
+
+// nonResidueE4 is α such that E4 = 𝔽[u]/(u⁴ - α)
+var nonResidueE4 = func() fr.Element {
+	var alpha fr.Element
+	alpha.SetInt64(5)
+	return alpha
+}()
+
+// mulByNonResidueE4 sets z = α·x
+func mulByNonResidueE4(z, x *fr.Element) {
+	z.Mul(x, &nonResidueE4)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E4) Equal(x *E4) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1) && z.A2.Equal(&x.A2) && z.A3.Equal(&x.A3)
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E4) SetZero() *E4 {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	z.A3.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E4) SetOne() *E4 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	z.A3.SetZero()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E4) Set(x *E4) *E4 {
+	*z = *x
+	return z
+}
+
+// SetRandom sets the coordinates of z to random values and returns z
+func (z *E4) SetRandom() (*E4, error) {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom is like SetRandom, reading the randomness from r
+func (z *E4) SetRandomFrom(r io.Reader) (*E4, error) {
+	if _, err := z.A0.SetRandomFrom(r); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandomFrom(r); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetRandomFrom(r); err != nil {
+		return nil, err
+	}
+	if _, err := z.A3.SetRandomFrom(r); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *E4) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero() && z.A2.IsZero() && z.A3.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *E4) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero() && z.A2.IsZero() && z.A3.IsZero()
+}
+
+// Add sets z = x + y and returns z
+func (z *E4) Add(x, y *E4) *E4 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	z.A2.Add(&x.A2, &y.A2)
+	z.A3.Add(&x.A3, &y.A3)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E4) Sub(x, y *E4) *E4 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	z.A2.Sub(&x.A2, &y.A2)
+	z.A3.Sub(&x.A3, &y.A3)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E4) Double(x *E4) *E4 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	z.A2.Double(&x.A2)
+	z.A3.Double(&x.A3)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E4) Neg(x *E4) *E4 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	z.A2.Neg(&x.A2)
+	z.A3.Neg(&x.A3)
+	return z
+}
+
+// MulByElement sets z = x·y for y in the base field and returns z
+func (z *E4) MulByElement(x *E4, y *fr.Element) *E4 {
+	var yCopy fr.Element
+	yCopy.Set(y)
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	z.A2.Mul(&x.A2, &yCopy)
+	z.A3.Mul(&x.A3, &yCopy)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E4) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*u" + "+" + z.A2.String() + "*u²" + "+" + z.A3.String() + "*u³"
+}
+
+// Mul sets z = x·y and returns z
+func (z *E4) Mul(x, y *E4) *E4 {
+	// schoolbook, with u⁴ = α: cₖ = Σ_{i+j=k} aᵢbⱼ + α·Σ_{i+j=k+4} aᵢbⱼ
+	var c [4]fr.Element
+	var h, t fr.Element
+
+	// c0
+	c[0].Mul(&x.A0, &y.A0)
+	h.Mul(&x.A1, &y.A3)
+	t.Mul(&x.A2, &y.A2)
+	h.Add(&h, &t)
+	t.Mul(&x.A3, &y.A1)
+	h.Add(&h, &t)
+	mulByNonResidueE4(&h, &h)
+	c[0].Add(&c[0], &h)
+
+	// c1
+	c[1].Mul(&x.A0, &y.A1)
+	t.Mul(&x.A1, &y.A0)
+	c[1].Add(&c[1], &t)
+	h.Mul(&x.A2, &y.A3)
+	t.Mul(&x.A3, &y.A2)
+	h.Add(&h, &t)
+	mulByNonResidueE4(&h, &h)
+	c[1].Add(&c[1], &h)
+
+	// c2
+	c[2].Mul(&x.A0, &y.A2)
+	t.Mul(&x.A1, &y.A1)
+	c[2].Add(&c[2], &t)
+	t.Mul(&x.A2, &y.A0)
+	c[2].Add(&c[2], &t)
+	h.Mul(&x.A3, &y.A3)
+	mulByNonResidueE4(&h, &h)
+	c[2].Add(&c[2], &h)
+
+	// c3
+	c[3].Mul(&x.A0, &y.A3)
+	t.Mul(&x.A1, &y.A2)
+	c[3].Add(&c[3], &t)
+	t.Mul(&x.A2, &y.A1)
+	c[3].Add(&c[3], &t)
+	t.Mul(&x.A3, &y.A0)
+	c[3].Add(&c[3], &t)
+	z.A0 = c[0]
+	z.A1 = c[1]
+	z.A2 = c[2]
+	z.A3 = c[3]
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *E4) Square(x *E4) *E4 {
+	// schoolbook, computing the cross products aᵢaⱼ (i < j) once and doubling them
+	var c [4]fr.Element
+	var h, t fr.Element
+
+	// c0
+	c[0].Square(&x.A0)
+	h.Mul(&x.A1, &x.A3).Double(&h)
+	t.Square(&x.A2)
+	h.Add(&h, &t)
+	mulByNonResidueE4(&h, &h)
+	c[0].Add(&c[0], &h)
+
+	// c1
+	c[1].Mul(&x.A0, &x.A1).Double(&c[1])
+	h.Mul(&x.A2, &x.A3).Double(&h)
+	mulByNonResidueE4(&h, &h)
+	c[1].Add(&c[1], &h)
+
+	// c2
+	c[2].Mul(&x.A0, &x.A2).Double(&c[2])
+	t.Square(&x.A1)
+	c[2].Add(&c[2], &t)
+	h.Square(&x.A3)
+	mulByNonResidueE4(&h, &h)
+	c[2].Add(&c[2], &h)
+
+	// c3
+	c[3].Mul(&x.A0, &x.A3).Double(&c[3])
+	t.Mul(&x.A1, &x.A2).Double(&t)
+	c[3].Add(&c[3], &t)
+	z.A0 = c[0]
+	z.A1 = c[1]
+	z.A2 = c[2]
+	z.A3 = c[3]
+	return z
+}
+
+// Inverse sets z to the inverse of x and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E4) Inverse(x *E4) *E4 {
+	// x⁻¹ = y / N(x), with y = x^p·x^(p²)⋯x^(p³) the product of the other
+	// conjugates of x, and N(x) = x·y in the base field
+	var y E4
+	var n fr.Element
+	x.conjugatesProduct(&y)
+	mulConstantCoeffE4(&n, x, &y)
+	n.Inverse(&n)
+	return z.MulByElement(&y, &n)
+}
+
+// conjugatesProduct sets y to x^p·x^(p²)⋯x^(p³), such that x·y is the norm of x
+func (x *E4) conjugatesProduct(y *E4) {
+	var f E4
+	f.Frobenius(x)
+	y.Set(&f)
+	for i := 2; i < 4; i++ {
+		f.Frobenius(&f)
+		y.Mul(y, &f)
+	}
+}
+
+// mulConstantCoeffE4 sets c to the constant coefficient of x·y
+func mulConstantCoeffE4(c *fr.Element, x, y *E4) {
+	var h, t fr.Element
+	h.Mul(&x.A1, &y.A3)
+	t.Mul(&x.A2, &y.A2)
+	h.Add(&h, &t)
+	t.Mul(&x.A3, &y.A1)
+	h.Add(&h, &t)
+	mulByNonResidueE4(&h, &h)
+	t.Mul(&x.A0, &y.A0)
+	c.Add(&t, &h)
+}
+
+// norm sets n to the norm of z, the product of its conjugates z·z^p⋯z^(p³)
+func (z *E4) norm(n *fr.Element) {
+	var y E4
+	z.conjugatesProduct(&y)
+	mulConstantCoeffE4(n, z, &y)
+}
+
+// frobeniusCoeffE4 holds the γᵢ such that (aᵢuⁱ)ᵖ = γᵢ·aᵢ·u^σ(i)
+var frobeniusCoeffE4 = func() (gamma [4]fr.Element) {
+	setString(&gamma[1], "3465144826073652318776269530687742778270252468765361963008")
+	setString(&gamma[2], "52435875175126190479447740508185965837690552500527637822603658699938581184512")
+	setString(&gamma[3], "52435875175126190475982595682112313518914282969839895044333406231173219221505")
+	return
+}()
+
+// Frobenius sets z = xᵖ, with p the characteristic, and returns z
+func (z *E4) Frobenius(x *E4) *E4 {
+	var c [4]fr.Element
+	c[0] = x.A0
+	c[1].Mul(&x.A1, &frobeniusCoeffE4[1])
+	c[2].Mul(&x.A2, &frobeniusCoeffE4[2])
+	c[3].Mul(&x.A3, &frobeniusCoeffE4[3])
+	z.A0 = c[0]
+	z.A1 = c[1]
+	z.A2 = c[2]
+	z.A3 = c[3]
+	return z
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+//
+// z is a square in E4 if and only if its norm is a square in the base field
+func (z *E4) Legendre() int {
+	var n fr.Element
+	z.norm(&n)
+	return n.Legendre()
+}
+
+// sqrtExponentE4 is (s-1)/2, where p⁴ - 1 = 2ᵉ·s with s odd
+var sqrtExponentE4, _ = new(big.Int).SetString("1587fd88e462457f5ef5631274d31a9ad001d420ae3e64333f30da01bb8ee7d922f1e7bc750638d7bc1c7d3e5973db1442abe40eb9fef9cc826a9b901ab569714c4c2f7ca289c160a57787aa92ed55cb19735bc285816996e623d8bb253157a677480a833c91e8dcfeeabfd0c0075fd89e148027fff2e003fffffff", 16)
+
+// sqrtGE4 is cˢ for a non-square c in E4
+var sqrtGE4 = func() (g E4) {
+	setString(&g.A0, "0")
+	setString(&g.A1, "0")
+	setString(&g.A2, "0")
+	setString(&g.A3, "6636383068789161685391946400472294545777441814840874461867657975375426982235")
+	return
+}()
+
+// Sqrt z = √x
+// if the square root doesn't exist (x is not a square in E4)
+// Sqrt leaves z unchanged and returns nil
+func (z *E4) Sqrt(x *E4) *E4 {
+	// Tonelli-Shanks, see Sqrt in the base field
+	var w, y, b, t E4
+
+	// w = x^((s-1)/2)
+	w.Exp(*x, sqrtExponentE4)
+
+	// y = x^((s+1)/2) = w * x
+	y.Mul(x, &w)
+
+	// b = xˢ = w * w * x = y * w
+	b.Mul(&w, &y)
+
+	g := sqrtGE4
+	r := uint64(34)
+
+	// t = x^((p⁴-1)/2) = r-1 squaring of xˢ
+	t = b
+	for i := uint64(0); i < r-1; i++ {
+		t.Square(&t)
+	}
+	if t.IsZero() {
+		return z.SetZero()
+	}
+	if !t.IsOne() {
+		// t != 1, we don't have a square root
+		return nil
+	}
+	for {
+		var m uint64
+		t = b
+
+		// for t != 1
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1))
+		ge := int(r - m - 1)
+		t = g
+		for ge > 0 {
+			t.Square(&t)
+			ge--
+		}
+
+		g.Square(&t)
+		y.Mul(&y, &t)
+		b.Mul(&b, &g)
+		r = m
+	}
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *E4) Exp(x E4, k *big.Int) *E4 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = bigIntPool.Get().(*big.Int)
+		defer bigIntPool.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// BatchInvertE4 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE4(a []E4) []E4 {
+	res := make([]E4, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E4
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ipp provides the target and multi-exponentiation inner pairing product arguments
+// (TIPP and MIPP) used to aggregate proofs (SnarkPack, https://eprint.iacr.org/2021/529).
+//
+// Given vectors A ∈ G₁ᵐ, B ∈ G₂ᵐ and C ∈ G₁ᵐ committed with the structured two-tier commitment
+// of the ProvingKey, and a scalar r, a Proof shows in O(log m) elements and verification time that
+//
+//	Z_AB = ∏ᵢ e(Aᵢ, Bᵢ)^(rⁱ)	(TIPP)
+//	Z_C  = Σᵢ rⁱ·Cᵢ		(MIPP)
+//
+// The arguments are folded together as in SnarkPack: a generalized inner product argument halves
+// the vectors and the commitment keys at each round, and the final keys are checked with KZG
+// openings against the powers of the SRS.
+//
+// The SRS is made of the powers of two independent secrets a and b, in G₁ and G₂; it must come
+// from two distinct ceremonies.
+package ipp
@@ -0,0 +1,816 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipp
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidNbElements    = errors.New("number of elements must be a power of two and at most the size of the SRS")
+	ErrLengthMismatch       = errors.New("vectors of different lengths")
+	ErrMinSRSSize           = errors.New("minimum srs size is 2")
+	ErrInvalidProof         = errors.New("invalid proof: inconsistent sizes or elements not in GT")
+	ErrVerifyTIPP           = errors.New("can't verify target inner pairing product proof")
+	ErrVerifyMIPP           = errors.New("can't verify multi-exponentiation inner pairing product proof")
+	ErrVerifyCommitmentKeys = errors.New("can't verify the opening of the final commitment keys")
+)
+
+// ProvingKey holds the powers of the SRS secrets a and b; the commitment keys of m elements are
+//
+//	v₁ = ([aⁱ]G₂)ᵢ₍ₘ, v₂ = ([bⁱ]G₂)ᵢ₍ₘ, w₁ = ([aⁿ⁺ⁱ]G₁)ᵢ₍ₘ, w₂ = ([bⁿ⁺ⁱ]G₁)ᵢ₍ₘ
+//
+// n being the size of the SRS.
+type ProvingKey struct {
+	G1A, G1B []curve.G1Affine // [G₁, [a]G₁, ..., [a²ⁿ⁻¹]G₁] and the same with b
+	G2A, G2B []curve.G2Affine // [G₂, [a]G₂, ..., [aⁿ⁻¹]G₂] and the same with b
+}
+
+// VerifyingKey used to verify proofs
+type VerifyingKey struct {
+	G1   [3]curve.G1Affine // [G₁, [a]G₁, [b]G₁]
+	G2   [3]curve.G2Affine // [G₂, [a]G₂, [b]G₂]
+	Size uint64            // n, the maximum number of elements of a proof
+}
+
+// SRS must be computed through MPC and comprises the ProvingKey and the VerifyingKey
+type SRS struct {
+	Pk ProvingKey
+	Vk VerifyingKey
+}
+
+// NewSRS returns a new SRS of the given size, using a and b as randomness source
+//
+// In production, a SRS generated through MPC should be used.
+//
+// implements io.ReaderFrom and io.WriterTo
+func NewSRS(size uint64, a, b *big.Int) (*SRS, error) {
+	if size < 2 {
+		return nil, ErrMinSRSSize
+	}
+	var srs SRS
+	_, _, gen1Aff, gen2Aff := curve.Generators()
+
+	var alpha, beta fr.Element
+	alpha.SetBigInt(a)
+	beta.SetBigInt(b)
+	alphas, betas := powers(&alpha, int(2*size)), powers(&beta, int(2*size))
+
+	srs.Pk.G1A = append([]curve.G1Affine{gen1Aff}, curve.BatchScalarMultiplicationG1(&gen1Aff, alphas[1:])...)
+	srs.Pk.G1B = append([]curve.G1Affine{gen1Aff}, curve.BatchScalarMultiplicationG1(&gen1Aff, betas[1:])...)
+	srs.Pk.G2A = append([]curve.G2Affine{gen2Aff}, curve.BatchScalarMultiplicationG2(&gen2Aff, alphas[1:size])...)
+	srs.Pk.G2B = append([]curve.G2Affine{gen2Aff}, curve.BatchScalarMultiplicationG2(&gen2Aff, betas[1:size])...)
+
+	srs.Vk.G1 = [3]curve.G1Affine{gen1Aff, srs.Pk.G1A[1], srs.Pk.G1B[1]}
+	srs.Vk.G2[0] = gen2Aff
+	srs.Vk.G2[1].ScalarMultiplication(&gen2Aff, a)
+	srs.Vk.G2[2].ScalarMultiplication(&gen2Aff, b)
+	srs.Vk.Size = size
+
+	return &srs, nil
+}
+
+// Commitment is a structured commitment (T, U) ∈ GT², binding under the two commitment keys
+// derived from the secrets a and b.
+type Commitment struct {
+	T, U curve.GT
+}
+
+// CommitPair commits to A ∈ G₁ᵐ and B ∈ G₂ᵐ:
+//
+//	T = ∏ᵢ e(Aᵢ, v₁ᵢ)·e(w₁ᵢ, Bᵢ), U = ∏ᵢ e(Aᵢ, v₂ᵢ)·e(w₂ᵢ, Bᵢ)
+func (pk *ProvingKey) CommitPair(A []curve.G1Affine, B []curve.G2Affine) (Commitment, error) {
+	var com Commitment
+	m := len(A)
+	if len(B) != m {
+		return com, ErrLengthMismatch
+	}
+	if err := pk.checkSize(m); err != nil {
+		return com, err
+	}
+	n := len(pk.G2A)
+	err := runAll(
+		func() (err error) {
+			com.T, err = pairingProduct(concatG1(A, pk.G1A[n:n+m]), concatG2(pk.G2A[:m], B))
+			return
+		},
+		func() (err error) {
+			com.U, err = pairingProduct(concatG1(A, pk.G1B[n:n+m]), concatG2(pk.G2B[:m], B))
+			return
+		},
+	)
+	return com, err
+}
+
+// CommitSingle commits to C ∈ G₁ᵐ:
+//
+//	T = ∏ᵢ e(Cᵢ, v₁ᵢ), U = ∏ᵢ e(Cᵢ, v₂ᵢ)
+func (pk *ProvingKey) CommitSingle(C []curve.G1Affine) (Commitment, error) {
+	var com Commitment
+	m := len(C)
+	if err := pk.checkSize(m); err != nil {
+		return com, err
+	}
+	err := runAll(
+		func() (err error) {
+			com.T, err = pairingProduct(C, pk.G2A[:m])
+			return
+		},
+		func() (err error) {
+			com.U, err = pairingProduct(C, pk.G2B[:m])
+			return
+		},
+	)
+	return com, err
+}
+
+func (pk *ProvingKey) checkSize(m int) error {
+	n := len(pk.G2A)
+	if m == 0 || m&(m-1) != 0 || m > n || len(pk.G2B) != n || len(pk.G1A) != 2*n || len(pk.G1B) != 2*n {
+		return ErrInvalidNbElements
+	}
+	return nil
+}
+
+// Proof of the TIPP and MIPP relations.
+//
+// implements io.ReaderFrom and io.WriterTo
+type Proof struct {
+	// ZAB = ∏ᵢ e(Aᵢ, Bᵢ)^(rⁱ), the claimed target inner pairing product
+	ZAB curve.GT
+
+	// ZC = Σᵢ rⁱ·Cᵢ, the claimed multi-exponentiation
+	ZC curve.G1Affine
+
+	// cross terms of the rounds of the inner product argument,
+	// [L₀, R₀, L₁, R₁, ...] for each folded value
+	ComAB, ComC []Commitment
+	CrossAB     []curve.GT
+	CrossC      []curve.G1Affine
+
+	// vectors and commitment keys, once folded to a single element
+	FinalA, FinalC   curve.G1Affine
+	FinalB           curve.G2Affine
+	FinalV1, FinalV2 curve.G2Affine
+	FinalW1, FinalW2 curve.G1Affine
+
+	// KZG openings of the final commitment keys
+	OpeningV1, OpeningV2 curve.G2Affine
+	OpeningW1, OpeningW2 curve.G1Affine
+}
+
+// Prove proves that proof.ZAB = ∏ᵢ e(Aᵢ, Bᵢ)^(rⁱ) and proof.ZC = Σᵢ rⁱ·Cᵢ, for the
+// commitments comAB = pk.CommitPair(A, B) and comC = pk.CommitSingle(C).
+//
+// The commitments must be computed (and bound to the transcript deriving r, if r is a
+// challenge) before calling Prove; len(A) must be a power of two.
+func Prove(pk *ProvingKey, comAB, comC Commitment, A []curve.G1Affine, B []curve.G2Affine, C []curve.G1Affine, r fr.Element, hf hash.Hash) (Proof, error) {
+	var proof Proof
+	m := len(A)
+	if len(B) != m || len(C) != m {
+		return proof, ErrLengthMismatch
+	}
+	if err := pk.checkSize(m); err != nil {
+		return proof, err
+	}
+	if r.IsZero() {
+		return proof, errors.New("r must be non-zero")
+	}
+	n := len(pk.G2A)
+	nbRounds := bits.TrailingZeros(uint(m))
+
+	// we prove the relations for B' = (rⁱ·Bᵢ) and the vector of powers of r; B' is committed
+	// with comAB under the keys w' = (r⁻ⁱ·wᵢ) since e(wᵢ, Bᵢ) = e(r⁻ⁱ·wᵢ, rⁱ·Bᵢ).
+	var rInv fr.Element
+	rInv.Inverse(&r)
+	rs := powers(&r, m)
+	rsInv := powers(&rInv, m)
+
+	B = scaleG2(B, rs)
+	w1 := scaleG1(pk.G1A[n:n+m], rsInv)
+	w2 := scaleG1(pk.G1B[n:n+m], rsInv)
+	v1, v2 := pk.G2A[:m], pk.G2B[:m]
+
+	var err error
+	if proof.ZAB, err = pairingProduct(A, B); err != nil {
+		return proof, err
+	}
+	if _, err = proof.ZC.MultiExp(C, rs, ecc.MultiExpConfig{}); err != nil {
+		return proof, err
+	}
+
+	fs := fiatshamir.NewTranscript(hf, challengesID(nbRounds)...)
+	if err = bindPublicData(&fs, challengeID(0, nbRounds), &comAB, &comC, &proof, &r); err != nil {
+		return proof, err
+	}
+
+	xs := make([]fr.Element, nbRounds)
+	xsInv := make([]fr.Element, nbRounds)
+	for j := 0; j < nbRounds; j++ {
+		h := len(A) / 2
+		AL, AR := A[:h], A[h:]
+		BL, BR := B[:h], B[h:]
+		CL, CR := C[:h], C[h:]
+		rL, rR := rs[:h], rs[h:]
+		v1L, v1R, v2L, v2R := v1[:h], v1[h:], v2[:h], v2[h:]
+		w1L, w1R, w2L, w2R := w1[:h], w1[h:], w2[:h], w2[h:]
+
+		// L terms are the contributions of the right half of A, C (and w) with the left half of
+		// B (and v), R terms the other way around
+		var comABL, comABR, comCL, comCR Commitment
+		var crossABL, crossABR curve.GT
+		var crossCL, crossCR curve.G1Affine
+		err = runAll(
+			func() (err error) {
+				comABL.T, err = pairingProduct(concatG1(AR, w1R), concatG2(v1L, BL))
+				return
+			},
+			func() (err error) {
+				comABL.U, err = pairingProduct(concatG1(AR, w2R), concatG2(v2L, BL))
+				return
+			},
+			func() (err error) {
+				comABR.T, err = pairingProduct(concatG1(AL, w1L), concatG2(v1R, BR))
+				return
+			},
+			func() (err error) {
+				comABR.U, err = pairingProduct(concatG1(AL, w2L), concatG2(v2R, BR))
+				return
+			},
+			func() (err error) {
+				crossABL, err = pairingProduct(AR, BL)
+				return
+			},
+			func() (err error) {
+				crossABR, err = pairingProduct(AL, BR)
+				return
+			},
+			func() (err error) {
+				comCL.T, err = pairingProduct(CR, v1L)
+				return
+			},
+			func() (err error) {
+				comCL.U, err = pairingProduct(CR, v2L)
+				return
+			},
+			func() (err error) {
+				comCR.T, err = pairingProduct(CL, v1R)
+				return
+			},
+			func() (err error) {
+				comCR.U, err = pairingProduct(CL, v2R)
+				return
+			},
+			func() (err error) {
+				_, err = crossCL.MultiExp(CR, rL, ecc.MultiExpConfig{})
+				return
+			},
+			func() (err error) {
+				_, err = crossCR.MultiExp(CL, rR, ecc.MultiExpConfig{})
+				return
+			},
+		)
+		if err != nil {
+			return proof, err
+		}
+		proof.ComAB = append(proof.ComAB, comABL, comABR)
+		proof.ComC = append(proof.ComC, comCL, comCR)
+		proof.CrossAB = append(proof.CrossAB, crossABL, crossABR)
+		proof.CrossC = append(proof.CrossC, crossCL, crossCR)
+
+		if xs[j], err = deriveRoundChallenge(&fs, j, nbRounds, &proof); err != nil {
+			return proof, err
+		}
+		xsInv[j].Inverse(&xs[j])
+
+		// fold the vectors and the keys such that the cross terms cancel out
+		A = foldG1(AL, AR, &xs[j])
+		C = foldG1(CL, CR, &xs[j])
+		w1 = foldG1(w1L, w1R, &xs[j])
+		w2 = foldG1(w2L, w2R, &xs[j])
+		B = foldG2(BL, BR, &xsInv[j])
+		v1 = foldG2(v1L, v1R, &xsInv[j])
+		v2 = foldG2(v2L, v2R, &xsInv[j])
+		rs = foldFr(rL, rR, &xsInv[j])
+	}
+
+	proof.FinalA, proof.FinalB, proof.FinalC = A[0], B[0], C[0]
+	proof.FinalV1, proof.FinalV2 = v1[0], v2[0]
+	proof.FinalW1, proof.FinalW2 = w1[0], w2[0]
+
+	// the final keys are commitments to polynomials defined by the challenges, we open them
+	// at a random point:
+	// v = [f_v(a)]G₂ with f_v(X) = ∏ⱼ (1 + xⱼ⁻¹·X^(2ᵏ⁻¹⁻ʲ)),
+	// w = [aⁿ·f_w(a)]G₁ with f_w(X) = ∏ⱼ (1 + xⱼ·(X/r)^(2ᵏ⁻¹⁻ʲ)).
+	z, err := deriveFinalChallenge(&fs, nbRounds, &proof)
+	if err != nil {
+		return proof, err
+	}
+	fv := foldedCoefficients(xsInv, nil)
+	fw := make([]fr.Element, n+m)
+	copy(fw[n:], foldedCoefficients(xs, rsInv))
+
+	err = runAll(
+		func() error {
+			return openG2(&proof.OpeningV1, pk.G2A, fv, &z)
+		},
+		func() error {
+			return openG2(&proof.OpeningV2, pk.G2B, fv, &z)
+		},
+		func() error {
+			return openG1(&proof.OpeningW1, pk.G1A, fw, &z)
+		},
+		func() error {
+			return openG1(&proof.OpeningW2, pk.G1B, fw, &z)
+		},
+	)
+
+	return proof, err
+}
+
+// Verify verifies that proof.ZAB = ∏ᵢ e(Aᵢ, Bᵢ)^(rⁱ) and proof.ZC = Σᵢ rⁱ·Cᵢ for the vectors committed
+// in comAB and comC.
+func Verify(vk *VerifyingKey, comAB, comC Commitment, r fr.Element, proof *Proof, hf hash.Hash) error {
+	nbRounds := len(proof.CrossAB) / 2
+	if len(proof.CrossAB) != 2*nbRounds || len(proof.CrossC) != 2*nbRounds ||
+		len(proof.ComAB) != 2*nbRounds || len(proof.ComC) != 2*nbRounds ||
+		nbRounds >= 64 || uint64(1)<<nbRounds > vk.Size {
+		return ErrInvalidProof
+	}
+	if r.IsZero() {
+		return errors.New("r must be non-zero")
+	}
+
+	// the folding below relies on GT arithmetic (inversion by conjugation)
+	gts := make([]*curve.GT, 0, 10*nbRounds+1)
+	gts = append(gts, &proof.ZAB)
+	for i := range proof.CrossAB {
+		gts = append(gts, &proof.CrossAB[i], &proof.ComAB[i].T, &proof.ComAB[i].U, &proof.ComC[i].T, &proof.ComC[i].U)
+	}
+	for _, z := range gts {
+		if !z.IsInSubGroup() {
+			return ErrInvalidProof
+		}
+	}
+
+	// recompute the challenges
+	fs := fiatshamir.NewTranscript(hf, challengesID(nbRounds)...)
+	if err := bindPublicData(&fs, challengeID(0, nbRounds), &comAB, &comC, proof, &r); err != nil {
+		return err
+	}
+	xs := make([]fr.Element, nbRounds)
+	for j := range xs {
+		var err error
+		if xs[j], err = deriveRoundChallenge(&fs, j, nbRounds, proof); err != nil {
+			return err
+		}
+	}
+	z, err := deriveFinalChallenge(&fs, nbRounds, proof)
+	if err != nil {
+		return err
+	}
+	xsInv := fr.BatchInvert(xs)
+
+	// fold the claimed values: X' = X·Lⱼ^xⱼ·Rⱼ^(xⱼ⁻¹)
+	scalars := make([]fr.Element, 2*nbRounds+1)
+	scalars[0].SetOne()
+	for j := range xs {
+		scalars[2*j+1], scalars[2*j+2] = xs[j], xsInv[j]
+	}
+	foldGT := func(res *curve.GT, x *curve.GT, cross func(i int) *curve.GT) func() error {
+		return func() (err error) {
+			bases := make([]curve.GT, len(scalars))
+			bases[0] = *x
+			for i := 1; i < len(bases); i++ {
+				bases[i] = *cross(i - 1)
+			}
+			*res, err = curve.MultiExpGT(bases, scalars, ecc.MultiExpConfig{})
+			return
+		}
+	}
+	var finalComAB, finalComC Commitment
+	var finalZAB curve.GT
+	var finalZC curve.G1Affine
+	err = runAll(
+		foldGT(&finalComAB.T, &comAB.T, func(i int) *curve.GT { return &proof.ComAB[i].T }),
+		foldGT(&finalComAB.U, &comAB.U, func(i int) *curve.GT { return &proof.ComAB[i].U }),
+		foldGT(&finalComC.T, &comC.T, func(i int) *curve.GT { return &proof.ComC[i].T }),
+		foldGT(&finalComC.U, &comC.U, func(i int) *curve.GT { return &proof.ComC[i].U }),
+		foldGT(&finalZAB, &proof.ZAB, func(i int) *curve.GT { return &proof.CrossAB[i] }),
+		func() (err error) {
+			_, err = finalZC.MultiExp(append([]curve.G1Affine{proof.ZC}, proof.CrossC...), scalars, ecc.MultiExpConfig{})
+			return
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// check the final values against the folded claims
+	var tAB, uAB, zAB, tC, uC curve.GT
+	err = runAll(
+		func() (err error) {
+			tAB, err = pairingProduct([]curve.G1Affine{proof.FinalA, proof.FinalW1}, []curve.G2Affine{proof.FinalV1, proof.FinalB})
+			return
+		},
+		func() (err error) {
+			uAB, err = pairingProduct([]curve.G1Affine{proof.FinalA, proof.FinalW2}, []curve.G2Affine{proof.FinalV2, proof.FinalB})
+			return
+		},
+		func() (err error) {
+			zAB, err = pairingProduct([]curve.G1Affine{proof.FinalA}, []curve.G2Affine{proof.FinalB})
+			return
+		},
+		func() (err error) {
+			tC, err = pairingProduct([]curve.G1Affine{proof.FinalC}, []curve.G2Affine{proof.FinalV1})
+			return
+		},
+		func() (err error) {
+			uC, err = pairingProduct([]curve.G1Affine{proof.FinalC}, []curve.G2Affine{proof.FinalV2})
+			return
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if !tAB.Equal(&finalComAB.T) || !uAB.Equal(&finalComAB.U) || !zAB.Equal(&finalZAB) {
+		return ErrVerifyTIPP
+	}
+
+	// the folded vector of powers of r is f_v(r)
+	var bRFinal big.Int
+	rFinal := evalFolded(xsInv, &r)
+	rFinal.BigInt(&bRFinal)
+	var zC curve.G1Affine
+	zC.ScalarMultiplication(&proof.FinalC, &bRFinal)
+	if !tC.Equal(&finalComC.T) || !uC.Equal(&finalComC.U) || !zC.Equal(&finalZC) {
+		return ErrVerifyMIPP
+	}
+
+	// check the openings of the final keys, with the evaluations
+	// f_v(z) and zⁿ·f_w(z) = zⁿ·∏ⱼ (1 + xⱼ·(z/r)^(2ᵏ⁻¹⁻ʲ))
+	var zr, zn, fwz fr.Element
+	zr.Inverse(&r)
+	zr.Mul(&zr, &z)
+	fwz = evalFolded(xs, &zr)
+	zn.Exp(z, new(big.Int).SetUint64(vk.Size))
+	fwz.Mul(&fwz, &zn)
+	fvz := evalFolded(xsInv, &z)
+
+	var ok [4]bool
+	err = runAll(
+		func() (err error) {
+			ok[0], err = vk.checkOpeningG2(&proof.FinalV1, &proof.OpeningV1, &vk.G1[1], &z, &fvz)
+			return
+		},
+		func() (err error) {
+			ok[1], err = vk.checkOpeningG2(&proof.FinalV2, &proof.OpeningV2, &vk.G1[2], &z, &fvz)
+			return
+		},
+		func() (err error) {
+			ok[2], err = vk.checkOpeningG1(&proof.FinalW1, &proof.OpeningW1, &vk.G2[1], &z, &fwz)
+			return
+		},
+		func() (err error) {
+			ok[3], err = vk.checkOpeningG1(&proof.FinalW2, &proof.OpeningW2, &vk.G2[2], &z, &fwz)
+			return
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if !(ok[0] && ok[1] && ok[2] && ok[3]) {
+		return ErrVerifyCommitmentKeys
+	}
+
+	return nil
+}
+
+// checkOpeningG2 returns true if e([s]G₁ - [z]G₁, π) == e(G₁, key - [y]G₂), [s]G₁ being sG1
+func (vk *VerifyingKey) checkOpeningG2(key, pi *curve.G2Affine, sG1 *curve.G1Affine, z, y *fr.Element) (bool, error) {
+	var bz, by big.Int
+	z.BigInt(&bz)
+	y.BigInt(&by)
+
+	var p curve.G1Affine
+	p.ScalarMultiplication(&vk.G1[0], &bz)
+	p.Sub(sG1, &p)
+
+	var q curve.G2Affine
+	q.ScalarMultiplication(&vk.G2[0], &by)
+	q.Sub(key, &q)
+
+	var negG1 curve.G1Affine
+	negG1.Neg(&vk.G1[0])
+
+	return curve.PairingCheck([]curve.G1Affine{p, negG1}, []curve.G2Affine{*pi, q})
+}
+
+// checkOpeningG1 returns true if e(π, [s]G₂ - [z]G₂) == e(key - [y]G₁, G₂), [s]G₂ being sG2
+func (vk *VerifyingKey) checkOpeningG1(key, pi *curve.G1Affine, sG2 *curve.G2Affine, z, y *fr.Element) (bool, error) {
+	var bz, by big.Int
+	z.BigInt(&bz)
+	y.BigInt(&by)
+
+	var q curve.G2Affine
+	q.ScalarMultiplication(&vk.G2[0], &bz)
+	q.Sub(sG2, &q)
+
+	var p curve.G1Affine
+	p.ScalarMultiplication(&vk.G1[0], &by)
+	p.Sub(&p, key)
+
+	return curve.PairingCheck([]curve.G1Affine{*pi, p}, []curve.G2Affine{q, vk.G2[0]})
+}
+
+// openG1 sets pi to the KZG opening [(f(s) - f(z))/(s - z)]G₁ of f at z, srs being the powers of s
+func openG1(pi *curve.G1Affine, srs []curve.G1Affine, f []fr.Element, z *fr.Element) error {
+	q := quotient(f, z)
+	if len(q) == 0 {
+		pi.SetInfinity()
+		return nil
+	}
+	_, err := pi.MultiExp(srs[:len(q)], q, ecc.MultiExpConfig{})
+	return err
+}
+
+// openG2 sets pi to the KZG opening [(f(s) - f(z))/(s - z)]G₂ of f at z, srs being the powers of s
+func openG2(pi *curve.G2Affine, srs []curve.G2Affine, f []fr.Element, z *fr.Element) error {
+	q := quotient(f, z)
+	if len(q) == 0 {
+		pi.SetInfinity()
+		return nil
+	}
+	_, err := pi.MultiExp(srs[:len(q)], q, ecc.MultiExpConfig{})
+	return err
+}
+
+// quotient returns the coefficients of (f - f(z))/(X - z)
+func quotient(f []fr.Element, z *fr.Element) []fr.Element {
+	if len(f) <= 1 {
+		return nil
+	}
+	// synthetic division, the remainder f(z) is dropped
+	q := make([]fr.Element, len(f)-1)
+	q[len(q)-1] = f[len(f)-1]
+	var t fr.Element
+	for i := len(q) - 2; i >= 0; i-- {
+		t.Mul(&q[i+1], z)
+		q[i].Add(&f[i+1], &t)
+	}
+	return q
+}
+
+// foldedCoefficients returns the coefficients of ∏ⱼ (1 + xⱼ·X^(2ᵏ⁻¹⁻ʲ)), with k = len(xs);
+// if scale is not nil, the i-th coefficient is multiplied by scale[i].
+func foldedCoefficients(xs []fr.Element, scale []fr.Element) []fr.Element {
+	res := make([]fr.Element, 1<<len(xs))
+	res[0].SetOne()
+	for j := len(xs) - 1; j >= 0; j-- {
+		h := 1 << (len(xs) - 1 - j)
+		for i := 0; i < h; i++ {
+			res[h+i].Mul(&res[i], &xs[j])
+		}
+	}
+	for i := range scale {
+		res[i].Mul(&res[i], &scale[i])
+	}
+	return res
+}
+
+// evalFolded returns ∏ⱼ (1 + xⱼ·z^(2ᵏ⁻¹⁻ʲ)), with k = len(xs)
+func evalFolded(xs []fr.Element, z *fr.Element) fr.Element {
+	var res, zPow, t fr.Element
+	res.SetOne()
+	zPow.Set(z)
+	for j := len(xs) - 1; j >= 0; j-- {
+		t.Mul(&xs[j], &zPow).Add(&t, &one)
+		res.Mul(&res, &t)
+		zPow.Square(&zPow)
+	}
+	return res
+}
+
+var one = func() fr.Element {
+	var one fr.Element
+	one.SetOne()
+	return one
+}()
+
+// pairingProduct returns ∏ᵢ e(Pᵢ, Qᵢ)
+func pairingProduct(P []curve.G1Affine, Q []curve.G2Affine) (curve.GT, error) {
+	ml, err := curve.MillerLoop(P, Q)
+	if err != nil {
+		return curve.GT{}, err
+	}
+	return curve.FinalExponentiation(&ml), nil
+}
+
+// runAll runs the tasks in parallel and returns the first error, if any
+func runAll(tasks ...func() error) error {
+	errs := make([]error, len(tasks))
+	parallel.Execute(len(tasks), func(start, end int) {
+		for i := start; i < end; i++ {
+			errs[i] = tasks[i]()
+		}
+	})
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// powers returns [1, x, x², ..., xⁿ⁻¹]
+func powers(x *fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], x)
+	}
+	return res
+}
+
+func concatG1(a, b []curve.G1Affine) []curve.G1Affine {
+	res := make([]curve.G1Affine, len(a)+len(b))
+	copy(res, a)
+	copy(res[len(a):], b)
+	return res
+}
+
+func concatG2(a, b []curve.G2Affine) []curve.G2Affine {
+	res := make([]curve.G2Affine, len(a)+len(b))
+	copy(res, a)
+	copy(res[len(a):], b)
+	return res
+}
+
+// scaleG1 returns (sᵢ·Pᵢ)ᵢ
+func scaleG1(P []curve.G1Affine, s []fr.Element) []curve.G1Affine {
+	res := make([]curve.G1Jac, len(P))
+	parallel.Execute(len(P), func(start, end int) {
+		var b big.Int
+		for i := start; i < end; i++ {
+			res[i].ScalarMultiplicationAffine(&P[i], s[i].BigInt(&b))
+		}
+	})
+	return curve.BatchJacobianToAffineG1(res)
+}
+
+// scaleG2 returns (sᵢ·Qᵢ)ᵢ
+func scaleG2(Q []curve.G2Affine, s []fr.Element) []curve.G2Affine {
+	res := make([]curve.G2Affine, len(Q))
+	parallel.Execute(len(Q), func(start, end int) {
+		var b big.Int
+		for i := start; i < end; i++ {
+			res[i].ScalarMultiplication(&Q[i], s[i].BigInt(&b))
+		}
+	})
+	return res
+}
+
+// foldG1 returns (Lᵢ + x·Rᵢ)ᵢ
+func foldG1(L, R []curve.G1Affine, x *fr.Element) []curve.G1Affine {
+	var b big.Int
+	x.BigInt(&b)
+	res := make([]curve.G1Jac, len(L))
+	parallel.Execute(len(L), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].ScalarMultiplicationAffine(&R[i], &b)
+			res[i].AddMixed(&L[i])
+		}
+	})
+	return curve.BatchJacobianToAffineG1(res)
+}
+
+// foldG2 returns (Lᵢ + x·Rᵢ)ᵢ
+func foldG2(L, R []curve.G2Affine, x *fr.Element) []curve.G2Affine {
+	var b big.Int
+	x.BigInt(&b)
+	res := make([]curve.G2Affine, len(L))
+	parallel.Execute(len(L), func(start, end int) {
+		var p curve.G2Jac
+		for i := start; i < end; i++ {
+			p.FromAffine(&R[i])
+			p.ScalarMultiplication(&p, &b)
+			p.AddMixed(&L[i])
+			res[i].FromJacobian(&p)
+		}
+	})
+	return res
+}
+
+// foldFr returns (Lᵢ + x·Rᵢ)ᵢ
+func foldFr(L, R []fr.Element, x *fr.Element) []fr.Element {
+	res := make([]fr.Element, len(L))
+	for i := range res {
+		res[i].Mul(&R[i], x).Add(&res[i], &L[i])
+	}
+	return res
+}
+
+// challengeID returns the name of the j-th challenge of the transcript; the challenges are
+// the nbRounds folding challenges xⱼ, followed by the evaluation point z of the final keys.
+func challengeID(j, nbRounds int) string {
+	if j == nbRounds {
+		return "z"
+	}
+	return "x" + strconv.Itoa(j)
+}
+
+func challengesID(nbRounds int) []string {
+	res := make([]string, nbRounds+1)
+	for j := range res {
+		res[j] = challengeID(j, nbRounds)
+	}
+	return res
+}
+
+// bindPublicData binds the commitments, the claimed values and r to the first challenge
+func bindPublicData(fs *fiatshamir.Transcript, id string, comAB, comC *Commitment, proof *Proof, r *fr.Element) error {
+	for _, z := range []*curve.GT{&comAB.T, &comAB.U, &comC.T, &comC.U, &proof.ZAB} {
+		b := z.Bytes()
+		if err := fs.Bind(id, b[:]); err != nil {
+			return err
+		}
+	}
+	if err := fs.Bind(id, proof.ZC.Marshal()); err != nil {
+		return err
+	}
+	return fs.Bind(id, r.Marshal())
+}
+
+// deriveRoundChallenge binds the cross terms of the round j and returns the challenge xⱼ
+func deriveRoundChallenge(fs *fiatshamir.Transcript, j, nbRounds int, proof *Proof) (fr.Element, error) {
+	id := challengeID(j, nbRounds)
+	for _, i := range []int{2 * j, 2*j + 1} {
+		for _, z := range []*curve.GT{&proof.ComAB[i].T, &proof.ComAB[i].U, &proof.ComC[i].T, &proof.ComC[i].U, &proof.CrossAB[i]} {
+			b := z.Bytes()
+			if err := fs.Bind(id, b[:]); err != nil {
+				return fr.Element{}, err
+			}
+		}
+		if err := fs.Bind(id, proof.CrossC[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	return computeChallenge(fs, id)
+}
+
+// deriveFinalChallenge binds the final values and returns the evaluation point z of the final keys
+func deriveFinalChallenge(fs *fiatshamir.Transcript, nbRounds int, proof *Proof) (fr.Element, error) {
+	id := challengeID(nbRounds, nbRounds)
+	toBind := [][]byte{
+		proof.FinalA.Marshal(),
+		proof.FinalB.Marshal(),
+		proof.FinalC.Marshal(),
+		proof.FinalV1.Marshal(),
+		proof.FinalV2.Marshal(),
+		proof.FinalW1.Marshal(),
+		proof.FinalW2.Marshal(),
+	}
+	for _, b := range toBind {
+		if err := fs.Bind(id, b); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	return computeChallenge(fs, id)
+}
+
+func computeChallenge(fs *fiatshamir.Transcript, id string) (fr.Element, error) {
+	b, err := fs.ComputeChallenge(id)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var x fr.Element
+	x.SetBytes(b)
+	if x.IsZero() {
+		return x, errors.New("challenge is zero")
+	}
+	return x, nil
+}
@@ -19,12 +19,14 @@ package kzg
 import (
 	"errors"
 	"hash"
+	"io"
 	"math/big"
 	"sync"
 
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/polynomial"
 	"github.com/consensys/gnark-crypto/fiat-shamir"
 
 	"github.com/consensys/gnark-crypto/internal/parallel"
@@ -51,6 +53,52 @@ type ProvingKey struct {
 type VerifyingKey struct {
 	G2 [2]bls12381.G2Affine // [G₂, [α]G₂ ]
 	G1 bls12381.G1Affine
+
+	lines *precomputedLines // pairing lines of G2, see PrecomputeLines
+}
+
+// precomputedLines holds the pairing lines of the G2 points g2
+type precomputedLines struct {
+	g2    [2]bls12381.G2Affine
+	lines [2]bls12381.PrecomputedLines
+}
+
+// PrecomputeLines computes the pairing lines of vk.G2, which speed up the verification of
+// opening proofs. They are not serialized.
+//
+// It is called by NewSRS and ReadFrom; a VerifyingKey built otherwise works without them.
+// The lines are only used while vk.G2 is the value they were computed for: if vk.G2 is
+// modified, the verification falls back to the pairing check, until PrecomputeLines is called again.
+func (vk *VerifyingKey) PrecomputeLines() {
+	vk.lines = &precomputedLines{
+		g2: vk.G2,
+		lines: [2]bls12381.PrecomputedLines{
+			bls12381.PrecomputeLines(vk.G2[0]),
+			bls12381.PrecomputeLines(vk.G2[1]),
+		},
+	}
+}
+
+// pairingCheck returns true if e(P₀, G₂)·e(P₁, [α]G₂) == 1
+func (vk *VerifyingKey) pairingCheck(P0, P1 bls12381.G1Affine) (bool, error) {
+	if vk.lines == nil || !vk.lines.g2[0].Equal(&vk.G2[0]) || !vk.lines.g2[1].Equal(&vk.G2[1]) {
+		// no lines, or lines of another G2
+		return bls12381.PairingCheck(
+			[]bls12381.G1Affine{P0, P1},
+			[]bls12381.G2Affine{vk.G2[0], vk.G2[1]},
+		)
+	}
+	ml, err := bls12381.MillerLoopFixedQ(
+		[]bls12381.G1Affine{P0, P1},
+		vk.lines.lines[:],
+	)
+	if err != nil {
+		return false, err
+	}
+	res := bls12381.FinalExponentiation(&ml)
+	var one bls12381.GT
+	one.SetOne()
+	return res.Equal(&one), nil
 }
 
 // SRS must be computed through MPC and comprises the ProvingKey and the VerifyingKey
@@ -59,17 +107,11 @@ type SRS struct {
 	Vk VerifyingKey
 }
 
-// TODO @Tabaie get rid of this and use the polynomial package
 // eval returns p(point) where p is interpreted as a polynomial
 // ∑_{i<len(p)}p[i]Xⁱ
 func eval(p []fr.Element, point fr.Element) fr.Element {
-	var res fr.Element
-	n := len(p)
-	res.Set(&p[n-1])
-	for i := n - 2; i >= 0; i-- {
-		res.Mul(&res, &point).Add(&res, &p[i])
-	}
-	return res
+	pol := polynomial.Polynomial(p)
+	return pol.Eval(&point)
 }
 
 // NewSRS returns a new SRS using alpha as randomness source
@@ -93,6 +135,7 @@ func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
 	srs.Vk.G1 = gen1Aff
 	srs.Vk.G2[0] = gen2Aff
 	srs.Vk.G2[1].ScalarMultiplication(&gen2Aff, bAlpha)
+	srs.Vk.PrecomputeLines()
 
 	alphas := make([]fr.Element, size-1)
 	alphas[0] = alpha
@@ -105,6 +148,23 @@ func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
 	return &srs, nil
 }
 
+// NewSRSFrom returns a new SRS for a secret alpha sampled from r (as NewSRS does with the
+// given alpha). With a deterministic (seeded) reader, the SRS is reproducible, which is only
+// suitable for tests and benchmarks: anyone knowing r knows alpha.
+//
+// In production, a SRS generated through MPC should be used.
+func NewSRSFrom(size uint64, r io.Reader) (*SRS, error) {
+	var alpha fr.Element
+	for alpha.IsZero() {
+		if _, err := alpha.SetRandomFrom(r); err != nil {
+			return nil, err
+		}
+	}
+	var bAlpha big.Int
+	alpha.BigInt(&bAlpha)
+	return NewSRS(size, &bAlpha)
+}
+
 // OpeningProof KZG proof for opening at a single point.
 //
 // implements io.ReaderFrom and io.WriterTo
@@ -204,10 +264,7 @@ func Verify(commitment *Digest, proof *OpeningProof, point fr.Element, vk Verify
 	totalG1Aff.FromJacobian(&totalG1)
 
 	// e([f(α)-f(a)+aH(α)]G₁], G₂).e([-H(α)]G₁, [α]G₂) == 1
-	check, err := bls12381.PairingCheck(
-		[]bls12381.G1Affine{totalG1Aff, negH},
-		[]bls12381.G2Affine{vk.G2[0], vk.G2[1]},
-	)
+	check, err := vk.pairingCheck(totalG1Aff, negH)
 	if err != nil {
 		return err
 	}
@@ -460,10 +517,7 @@ func BatchVerifyMultiPoints(digests []Digest, proofs []OpeningProof, points []fr
 
 	// pairing check
 	// e([∑ᵢλᵢ(fᵢ(α) - fᵢ(pᵢ) + pᵢHᵢ(α))]G₁, G₂).e([-∑ᵢλᵢ[Hᵢ(α)]G₁), [α]G₂)
-	check, err := bls12381.PairingCheck(
-		[]bls12381.G1Affine{foldedDigests, foldedQuotients},
-		[]bls12381.G2Affine{vk.G2[0], vk.G2[1]},
-	)
+	check, err := vk.pairingCheck(foldedDigests, foldedQuotients)
 	if err != nil {
 		return err
 	}
@@ -65,7 +65,7 @@ func NewMiMC() hash.Hash {
 // Reset resets the Hash to its initial state.
 func (d *digest) Reset() {
 	d.data = d.data[:0]
-	d.h = fr.Element{0, 0, 0, 0}
+	d.h.SetZero()
 }
 
 // Sum appends the current hash to b and returns the resulting slice.
@@ -101,8 +101,11 @@ func (d *digest) BlockSize() int {
 // To hash arbitrary data ([]byte not representing canonical field elements) use fr.Hash first
 func (d *digest) Write(p []byte) (int, error) {
 
-	var start int
-	for start = 0; start < len(p); start += BlockSize {
+	if len(p)%BlockSize != 0 {
+		return 0, errors.New("invalid input length: must represent a list of field elements, expects a []byte of len m*BlockSize")
+	}
+
+	for start := 0; start < len(p); start += BlockSize {
 		if elem, err := fr.BigEndian.Element((*[BlockSize]byte)(p[start : start+BlockSize])); err == nil {
 			d.data = append(d.data, elem)
 		} else {
@@ -110,9 +113,6 @@ func (d *digest) Write(p []byte) (int, error) {
 		}
 	}
 
-	if start != len(p) {
-		return 0, errors.New("invalid input length: must represent a list of field elements, expects a []byte of len m*BlockSize")
-	}
 	return len(p), nil
 }
 
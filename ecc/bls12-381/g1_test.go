@@ -20,11 +20,13 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"os"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/utils"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 )
@@ -120,6 +122,26 @@ func TestG1AffineIsOnCurve(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG1AffineRandomPoint(t *testing.T) {
+	t.Parallel()
+	r1, r2 := rand.New(rand.NewSource(42)), rand.New(rand.NewSource(42)) //#nosec G404 -- deterministic test fixture
+	for i := 0; i < 10; i++ {
+		var p1, p2 G1Affine
+		if _, err := p1.RandomPoint(r1); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := p2.RandomPoint(r2); err != nil {
+			t.Fatal(err)
+		}
+		if !p1.IsOnCurve() || !p1.IsInSubGroup() {
+			t.Fatal("RandomPoint should return a point of the prime order subgroup")
+		}
+		if !p1.Equal(&p2) {
+			t.Fatal("RandomPoint should be deterministic for a given reader")
+		}
+	}
+}
+
 func TestG1AffineConversions(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -357,6 +379,55 @@ func TestG1AffineOps(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[BLS12-381] constant-time scalar multiplication should output the same result as ScalarMultiplication", prop.ForAll(
+		func(s fr.Element) bool {
+
+			r := fr.Modulus()
+			var scalar, negScalar, blindedScalar big.Int
+			s.BigInt(&scalar)
+			negScalar.Neg(&scalar)
+			blindedScalar.Mul(&scalar, r).Add(&blindedScalar, &scalar)
+
+			var expected, expectedNeg, op1, op2, op3 G1Jac
+			expected.ScalarMultiplication(&g1Gen, &scalar)
+			expectedNeg.Neg(&expected)
+			op1.ScalarMultiplicationCT(&g1Gen, &scalar)
+			op2.ScalarMultiplicationCT(&g1Gen, &negScalar)
+			op3.ScalarMultiplicationCT(&g1Gen, &blindedScalar)
+
+			var expectedAff, opAff G1Affine
+			expectedAff.FromJacobian(&expected)
+			opAff.ScalarMultiplicationCT(&g1GenAff, &scalar)
+
+			return op1.Equal(&expected) && op2.Equal(&expectedNeg) && op3.Equal(&expected) && opAff.Equal(&expectedAff)
+		},
+		genScalar,
+	))
+
+	properties.Property("[BLS12-381] constant-time scalar multiplication by 0 and r should output inf", prop.ForAll(
+		func(s fr.Element) bool {
+			var op1, op2 G1Jac
+			var op3 G1Affine
+			op1.ScalarMultiplicationCT(&g1Gen, big.NewInt(0))
+			op2.ScalarMultiplicationCT(&g1Gen, fr.Modulus())
+			op3.ScalarMultiplicationCT(&g1GenAff, big.NewInt(0))
+			return op1.Equal(&g1Infinity) && op2.Equal(&g1Infinity) && op3.IsInfinity()
+		},
+		genScalar,
+	))
+
+	properties.Property("[BLS12-381] ScalarMultiplicationBaseCT and ScalarMultiplicationBase should output the same result", prop.ForAll(
+		func(s fr.Element) bool {
+			var scalar big.Int
+			s.BigInt(&scalar)
+			var op1, op2 G1Affine
+			op1.ScalarMultiplicationBaseCT(&scalar)
+			op2.ScalarMultiplicationBase(&scalar)
+			return op1.Equal(&op2)
+		},
+		genScalar,
+	))
+
 	properties.Property("[BLS12-381] scalar multiplication (GLV) should depend only on the scalar mod r", prop.ForAll(
 		func(s fr.Element) bool {
 
@@ -453,6 +524,38 @@ func TestG1AffineCofactorCleaning(t *testing.T) {
 
 }
 
+// TestG1AffineScalarMultiplicationCTTiming runs a dudect-style timing test of ScalarMultiplicationBaseCT,
+// comparing short (64-bit) random scalars with full size random scalars, as a leak of the scalar bit length
+// is enough to recover ECDSA keys from a few signatures. Both classes use random scalars since a fixed input
+// trains the branch predictor on the conditional reductions of the field arithmetic.
+// The test is skipped unless the DUDECT environment variable is set, as timing measurements are noisy
+// on shared machines.
+func TestG1AffineScalarMultiplicationCTTiming(t *testing.T) {
+	if os.Getenv("DUDECT") == "" {
+		t.Skip("set DUDECT to run the timing test")
+	}
+	const nbMeasurements = 1 << 12
+	scalars := make([]big.Int, nbMeasurements)
+	prepare := func(i, class int) {
+		var s fr.Element
+		s.SetRandom()
+		if class == 0 {
+			scalars[i].SetUint64(s[0])
+			return
+		}
+		s.BigInt(&scalars[i])
+	}
+	var res G1Affine
+	run := func(i int) {
+		res.ScalarMultiplicationBaseCT(&scalars[i])
+	}
+	tStat := utils.DudectTStatistic(nbMeasurements, prepare, run)
+	t.Logf("t-statistic: %.2f", tStat)
+	if tStat > utils.DudectThreshold || tStat < -utils.DudectThreshold {
+		t.Fatalf("timing leakage detected: |t| = %.2f > %d", tStat, utils.DudectThreshold)
+	}
+}
+
 func TestG1AffineBatchScalarMultiplication(t *testing.T) {
 
 	parameters := gopter.DefaultTestParameters()
@@ -586,6 +689,14 @@ func BenchmarkG1JacScalarMultiplication(b *testing.B) {
 		}
 	})
 
+	var ct G1Jac
+	b.Run("constant-time", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			ct.ScalarMultiplicationCT(&g1Gen, &scalar)
+		}
+	})
+
 	var glv G1Jac
 	b.Run("GLV", func(b *testing.B) {
 		b.ResetTimer()
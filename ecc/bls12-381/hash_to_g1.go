@@ -18,6 +18,7 @@ package bls12381
 
 import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/field/hash"
 
 	"math/big"
 )
@@ -289,11 +290,12 @@ func MapToG1(u fp.Element) G1Affine {
 // EncodeToG1 hashes a message to a point on the G1 curve using the SSWU map.
 // It is faster than HashToG1, but the result is not uniformly distributed. Unsuitable as a random oracle.
 // dst stands for "domain separation tag", a string unique to the construction using the hash function
+// The ciphersuite defaults to expand_message_xmd with SHA-256, and can be changed with hash.Option.
 // https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#roadmap
-func EncodeToG1(msg, dst []byte) (G1Affine, error) {
+func EncodeToG1(msg, dst []byte, opts ...hash.Option) (G1Affine, error) {
 
 	var res G1Affine
-	u, err := fp.Hash(msg, dst, 1)
+	u, err := fp.Hash(msg, dst, 1, opts...)
 	if err != nil {
 		return res, err
 	}
@@ -309,9 +311,10 @@ func EncodeToG1(msg, dst []byte) (G1Affine, error) {
 // HashToG1 hashes a message to a point on the G1 curve using the SSWU map.
 // Slower than EncodeToG1, but usable as a random oracle.
 // dst stands for "domain separation tag", a string unique to the construction using the hash function
+// The ciphersuite defaults to expand_message_xmd with SHA-256, and can be changed with hash.Option.
 // https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#roadmap
-func HashToG1(msg, dst []byte) (G1Affine, error) {
-	u, err := fp.Hash(msg, dst, 2*1)
+func HashToG1(msg, dst []byte, opts ...hash.Option) (G1Affine, error) {
+	u, err := fp.Hash(msg, dst, 2*1, opts...)
 	if err != nil {
 		return G1Affine{}, err
 	}
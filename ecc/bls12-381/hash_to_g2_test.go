@@ -17,10 +17,14 @@
 package bls12381
 
 import (
+	"crypto/sha256"
+	"crypto/sha512"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/internal/fptower"
+	"github.com/consensys/gnark-crypto/field/hash"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
+	"golang.org/x/crypto/sha3"
 	"math/rand"
 	"strings"
 	"testing"
@@ -62,6 +66,46 @@ func TestG2SqrtRatio(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestHashToG2Options(t *testing.T) {
+	msg, dst := []byte("abc"), []byte("QUUX-V01-CS02-with-options")
+	ref, err := HashToG2(msg, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the default ciphersuite is expand_message_xmd with SHA-256
+	p, err := HashToG2(msg, dst, hash.WithExpandMsgXmd(sha256.New))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Equal(&ref) {
+		t.Fatal("explicit SHA-256 should match the default ciphersuite")
+	}
+
+	opts := []hash.Option{
+		hash.WithExpandMsgXmd(sha512.New),
+		hash.WithExpandMsgXmd(sha3.NewLegacyKeccak256),
+		hash.WithExpandMsgXof(sha3.NewShake128, 128),
+		hash.WithExpandMsgXof(sha3.NewShake256, 256),
+	}
+	for i, opt := range opts {
+		p, err := HashToG2(msg, dst, opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p.IsInSubGroup() || p.Equal(&ref) {
+			t.Fatal("unexpected HashToG2 output for option", i)
+		}
+		p, err = EncodeToG2(msg, dst, opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p.IsInSubGroup() {
+			t.Fatal("unexpected EncodeToG2 output for option", i)
+		}
+	}
+}
+
 func TestHashToFpG2(t *testing.T) {
 	for _, c := range encodeToG2Vector.cases {
 		elems, err := fp.Hash([]byte(c.msg), encodeToG2Vector.dst, 2)
@@ -0,0 +1,271 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0xb9feffffffffaaab
+DATA q<>+8(SB)/8, $0x1eabfffeb153ffff
+DATA q<>+16(SB)/8, $0x6730d2a0f6b0f624
+DATA q<>+24(SB)/8, $0x64774b84f38512bf
+DATA q<>+32(SB)/8, $0x4b1ba7b6434bacd7
+DATA q<>+40(SB)/8, $0x1a0111ea397fe69a
+GLOBL q<>(SB), (RODATA+NOPTR), $48
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x89f3fffcfffcfffd
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+TEXT ·addE2(SB), NOSPLIT, $0-24
+	MOVD $q<>(SB), R15
+	LDP  32(R15), (R20, R21)
+	LDP  16(R15), (R17, R19)
+	LDP  0(R15), (R15, R16)
+	MOVD res+0(FP), R0
+	MOVD x+8(FP), R1
+	MOVD y+16(FP), R2
+	LDP  32(R1), (R7, R8)
+	LDP  16(R1), (R5, R6)
+	LDP  0(R1), (R3, R4)
+	LDP  32(R2), (R13, R14)
+	LDP  16(R2), (R11, R12)
+	LDP  0(R2), (R9, R10)
+	ADDS R3, R9, R3
+	ADCS R4, R10, R4
+	ADCS R5, R11, R5
+	ADCS R6, R12, R6
+	ADCS R7, R13, R7
+	ADCS R8, R14, R8
+	SUBS R15, R3, R9
+	SBCS R16, R4, R10
+	SBCS R17, R5, R11
+	SBCS R19, R6, R12
+	SBCS R20, R7, R13
+	SBCS R21, R8, R14
+	CSEL CS, R9, R3, R3
+	CSEL CS, R10, R4, R4
+	CSEL CS, R11, R5, R5
+	CSEL CS, R12, R6, R6
+	CSEL CS, R13, R7, R7
+	CSEL CS, R14, R8, R8
+	STP  (R3, R4), 0(R0)
+	STP  (R5, R6), 16(R0)
+	STP  (R7, R8), 32(R0)
+	LDP  80(R1), (R7, R8)
+	LDP  64(R1), (R5, R6)
+	LDP  48(R1), (R3, R4)
+	LDP  80(R2), (R13, R14)
+	LDP  64(R2), (R11, R12)
+	LDP  48(R2), (R9, R10)
+	ADDS R3, R9, R3
+	ADCS R4, R10, R4
+	ADCS R5, R11, R5
+	ADCS R6, R12, R6
+	ADCS R7, R13, R7
+	ADCS R8, R14, R8
+	SUBS R15, R3, R9
+	SBCS R16, R4, R10
+	SBCS R17, R5, R11
+	SBCS R19, R6, R12
+	SBCS R20, R7, R13
+	SBCS R21, R8, R14
+	CSEL CS, R9, R3, R3
+	CSEL CS, R10, R4, R4
+	CSEL CS, R11, R5, R5
+	CSEL CS, R12, R6, R6
+	CSEL CS, R13, R7, R7
+	CSEL CS, R14, R8, R8
+	STP  (R3, R4), 48(R0)
+	STP  (R5, R6), 64(R0)
+	STP  (R7, R8), 80(R0)
+	RET
+
+TEXT ·doubleE2(SB), NOSPLIT, $0-16
+	MOVD $q<>(SB), R14
+	LDP  32(R14), (R19, R20)
+	LDP  16(R14), (R16, R17)
+	LDP  0(R14), (R14, R15)
+	MOVD res+0(FP), R0
+	MOVD x+8(FP), R1
+	LDP  32(R1), (R6, R7)
+	LDP  16(R1), (R4, R5)
+	LDP  0(R1), (R2, R3)
+	ADDS R2, R2, R2
+	ADCS R3, R3, R3
+	ADCS R4, R4, R4
+	ADCS R5, R5, R5
+	ADCS R6, R6, R6
+	ADCS R7, R7, R7
+	SUBS R14, R2, R8
+	SBCS R15, R3, R9
+	SBCS R16, R4, R10
+	SBCS R17, R5, R11
+	SBCS R19, R6, R12
+	SBCS R20, R7, R13
+	CSEL CS, R8, R2, R2
+	CSEL CS, R9, R3, R3
+	CSEL CS, R10, R4, R4
+	CSEL CS, R11, R5, R5
+	CSEL CS, R12, R6, R6
+	CSEL CS, R13, R7, R7
+	STP  (R2, R3), 0(R0)
+	STP  (R4, R5), 16(R0)
+	STP  (R6, R7), 32(R0)
+	LDP  80(R1), (R6, R7)
+	LDP  64(R1), (R4, R5)
+	LDP  48(R1), (R2, R3)
+	ADDS R2, R2, R2
+	ADCS R3, R3, R3
+	ADCS R4, R4, R4
+	ADCS R5, R5, R5
+	ADCS R6, R6, R6
+	ADCS R7, R7, R7
+	SUBS R14, R2, R8
+	SBCS R15, R3, R9
+	SBCS R16, R4, R10
+	SBCS R17, R5, R11
+	SBCS R19, R6, R12
+	SBCS R20, R7, R13
+	CSEL CS, R8, R2, R2
+	CSEL CS, R9, R3, R3
+	CSEL CS, R10, R4, R4
+	CSEL CS, R11, R5, R5
+	CSEL CS, R12, R6, R6
+	CSEL CS, R13, R7, R7
+	STP  (R2, R3), 48(R0)
+	STP  (R4, R5), 64(R0)
+	STP  (R6, R7), 80(R0)
+	RET
+
+TEXT ·subE2(SB), NOSPLIT, $0-24
+	MOVD $q<>(SB), R15
+	LDP  32(R15), (R20, R21)
+	LDP  16(R15), (R17, R19)
+	LDP  0(R15), (R15, R16)
+	MOVD res+0(FP), R0
+	MOVD x+8(FP), R1
+	MOVD y+16(FP), R2
+	LDP  32(R1), (R7, R8)
+	LDP  16(R1), (R5, R6)
+	LDP  0(R1), (R3, R4)
+	LDP  32(R2), (R13, R14)
+	LDP  16(R2), (R11, R12)
+	LDP  0(R2), (R9, R10)
+	SUBS R9, R3, R3
+	SBCS R10, R4, R4
+	SBCS R11, R5, R5
+	SBCS R12, R6, R6
+	SBCS R13, R7, R7
+	SBCS R14, R8, R8
+	CSEL CS, ZR, R15, R9
+	CSEL CS, ZR, R16, R10
+	CSEL CS, ZR, R17, R11
+	CSEL CS, ZR, R19, R12
+	CSEL CS, ZR, R20, R13
+	CSEL CS, ZR, R21, R14
+	ADDS R3, R9, R3
+	ADCS R4, R10, R4
+	ADCS R5, R11, R5
+	ADCS R6, R12, R6
+	ADCS R7, R13, R7
+	ADCS R8, R14, R8
+	STP  (R3, R4), 0(R0)
+	STP  (R5, R6), 16(R0)
+	STP  (R7, R8), 32(R0)
+	LDP  80(R1), (R7, R8)
+	LDP  64(R1), (R5, R6)
+	LDP  48(R1), (R3, R4)
+	LDP  80(R2), (R13, R14)
+	LDP  64(R2), (R11, R12)
+	LDP  48(R2), (R9, R10)
+	SUBS R9, R3, R3
+	SBCS R10, R4, R4
+	SBCS R11, R5, R5
+	SBCS R12, R6, R6
+	SBCS R13, R7, R7
+	SBCS R14, R8, R8
+	CSEL CS, ZR, R15, R9
+	CSEL CS, ZR, R16, R10
+	CSEL CS, ZR, R17, R11
+	CSEL CS, ZR, R19, R12
+	CSEL CS, ZR, R20, R13
+	CSEL CS, ZR, R21, R14
+	ADDS R3, R9, R3
+	ADCS R4, R10, R4
+	ADCS R5, R11, R5
+	ADCS R6, R12, R6
+	ADCS R7, R13, R7
+	ADCS R8, R14, R8
+	STP  (R3, R4), 48(R0)
+	STP  (R5, R6), 64(R0)
+	STP  (R7, R8), 80(R0)
+	RET
+
+TEXT ·negE2(SB), NOSPLIT, $0-16
+	MOVD $q<>(SB), R9
+	LDP  32(R9), (R13, R14)
+	LDP  16(R9), (R11, R12)
+	LDP  0(R9), (R9, R10)
+	MOVD res+0(FP), R0
+	MOVD x+8(FP), R1
+	LDP  32(R1), (R7, R8)
+	LDP  16(R1), (R5, R6)
+	LDP  0(R1), (R3, R4)
+	ORR  R3, R4, R2
+	ORR  R5, R2, R2
+	ORR  R6, R2, R2
+	ORR  R7, R2, R2
+	ORR  R8, R2, R2
+	SUBS R3, R9, R3
+	SBCS R4, R10, R4
+	SBCS R5, R11, R5
+	SBCS R6, R12, R6
+	SBCS R7, R13, R7
+	SBCS R8, R14, R8
+	CMP  $0, R2
+	CSEL EQ, ZR, R3, R3
+	CSEL EQ, ZR, R4, R4
+	CSEL EQ, ZR, R5, R5
+	CSEL EQ, ZR, R6, R6
+	CSEL EQ, ZR, R7, R7
+	CSEL EQ, ZR, R8, R8
+	STP  (R3, R4), 0(R0)
+	STP  (R5, R6), 16(R0)
+	STP  (R7, R8), 32(R0)
+	LDP  80(R1), (R7, R8)
+	LDP  64(R1), (R5, R6)
+	LDP  48(R1), (R3, R4)
+	ORR  R3, R4, R2
+	ORR  R5, R2, R2
+	ORR  R6, R2, R2
+	ORR  R7, R2, R2
+	ORR  R8, R2, R2
+	SUBS R3, R9, R3
+	SBCS R4, R10, R4
+	SBCS R5, R11, R5
+	SBCS R6, R12, R6
+	SBCS R7, R13, R7
+	SBCS R8, R14, R8
+	CMP  $0, R2
+	CSEL EQ, ZR, R3, R3
+	CSEL EQ, ZR, R4, R4
+	CSEL EQ, ZR, R5, R5
+	CSEL EQ, ZR, R6, R6
+	CSEL EQ, ZR, R7, R7
+	CSEL EQ, ZR, R8, R8
+	STP  (R3, R4), 48(R0)
+	STP  (R5, R6), 64(R0)
+	STP  (R7, R8), 80(R0)
+	RET
@@ -0,0 +1,644 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12381
+
+import (
+	"errors"
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+	"io"
+	"math"
+	"math/bits"
+	"runtime"
+)
+
+// g1MultiExpTableCs lists the window sizes a G1MultiExpTable can be built with
+// (the c we use must be in this slice)
+var g1MultiExpTableCs = []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+// G1MultiExpTable stores, for a fixed set of bases P_i, the multiples 2^{c·j}·P_i
+// for each c-bit window j of a scalar.
+//
+// A multi-exponentiation against these bases then needs a single set of buckets shared by all
+// the windows, and no doublings; this trades nbChunks(c) times the memory of the bases for faster
+// repeated multi-exponentiations (typically, commitments against a fixed SRS).
+type G1MultiExpTable struct {
+	c        uint64
+	nbChunks int
+	table    []G1Affine // table[i*nbChunks+j] = 2^{c·j}·P_i
+}
+
+// NewG1MultiExpTable precomputes the table of multiples of points for window size c.
+//
+// If c == 0, a window size is picked according to len(points). A table built with window size c
+// can run multi-exponentiations with any implemented multiple of c as window size; so a smaller c
+// serves a wider range of len(scalars), at the cost of memory. The call returns an error if c
+// is not a window size implemented by the multi-exponentiation.
+func NewG1MultiExpTable(points []G1Affine, c uint64) (*G1MultiExpTable, error) {
+	if c == 0 {
+		c = bestCPrecomputed(len(points), g1MultiExpTableCs)
+	}
+	if !containsC(g1MultiExpTableCs, c) {
+		return nil, errors.New("invalid window size")
+	}
+
+	t := &G1MultiExpTable{
+		c:        c,
+		nbChunks: int(computeNbChunks(c)),
+	}
+	nbChunks := t.nbChunks
+	tableJac := make([]G1Jac, len(points)*nbChunks)
+
+	parallel.Execute(len(points), func(start, end int) {
+		var p G1Jac
+		for i := start; i < end; i++ {
+			p.FromAffine(&points[i])
+			for j := 0; j < nbChunks; j++ {
+				if j != 0 {
+					for k := uint64(0); k < c; k++ {
+						p.DoubleAssign()
+					}
+				}
+				tableJac[i*nbChunks+j] = p
+			}
+		}
+	})
+	t.table = BatchJacobianToAffineG1(tableJac)
+
+	return t, nil
+}
+
+// C returns the window size of the table.
+func (t *G1MultiExpTable) C() uint64 {
+	return t.c
+}
+
+// Len returns the number of bases in the table.
+func (t *G1MultiExpTable) Len() int {
+	if t.nbChunks == 0 {
+		return 0
+	}
+	return len(t.table) / t.nbChunks
+}
+
+// MultiExpPrecomputed computes the multi-exponentiation of the bases of table by scalars.
+//
+// len(scalars) may be smaller than table.Len(), in which case only the first len(scalars)
+// bases are used.
+// This call return an error if len(scalars) > table.Len() or if provided config is invalid.
+func (p *G1Affine) MultiExpPrecomputed(table *G1MultiExpTable, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Affine, error) {
+	var _p G1Jac
+	if _, err := _p.MultiExpPrecomputed(table, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpPrecomputed computes the multi-exponentiation of the bases of table by scalars.
+//
+// len(scalars) may be smaller than table.Len(), in which case only the first len(scalars)
+// bases are used. If the table is not expected to speed up the computation (for example, for
+// few scalars compared to the window size of the table), it falls back to MultiExp.
+// This call return an error if len(scalars) > table.Len() or if provided config is invalid.
+func (p *G1Jac) MultiExpPrecomputed(table *G1MultiExpTable, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Jac, error) {
+	nbPoints := len(scalars)
+	if nbPoints > table.Len() {
+		return nil, errors.New("len(scalars) > table.Len()")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	plan, ok := planMultiExpPrecomputed(nbPoints, table.c, config.NbTasks, g1MultiExpTableCs)
+	if !ok {
+		// the bases are the first column of the table
+		bases := make([]G1Affine, nbPoints)
+		for i := range bases {
+			bases[i] = table.table[i*table.nbChunks]
+		}
+		return p.MultiExp(bases, scalars, config)
+	}
+	return p.multiExpPrecomputed(table, scalars, config.NbTasks, plan), nil
+}
+
+func (p *G1Jac) multiExpPrecomputed(table *G1MultiExpTable, scalars []fr.Element, nbTasks int, plan precomputedPlan) *G1Jac {
+	nbPoints := len(scalars)
+	nbColumns := table.nbChunks
+	c := plan.c
+	nbChunks := int(computeNbChunks(c))
+
+	// step 1
+	// we compute, for each scalar, its signed c-bit digits (see partitionScalars), and lay them out
+	// in the same order as the table: the c-bit window j of the i-th scalar multiplies
+	// 2^{c·j}·P_i = table[i*nbColumns + j*(c/table.c)].
+	// the digits are dispatched among the bucket ranges, and rewritten relatively to their range.
+	digits, chunkStats := partitionScalars(scalars, c, nbTasks)
+	rangeDigits := dispatchDigits(digits, nbPoints, nbChunks, nbColumns, int(c/table.c), bucketsC(c), plan.nbRanges)
+
+	// step 2
+	// each task accumulates a slice of the bases in its range of 2^{cR-1} buckets.
+	cR := bucketsC(c) - uint64(bits.TrailingZeros(uint(plan.nbRanges)))
+	nbTasks = plan.nbRanges * plan.nbSplits
+	var stat chunkStat
+	for _, s := range chunkStats {
+		stat.nbBucketFilled += s.nbBucketFilled
+	}
+	stat.nbBucketFilled /= nbTasks
+	if stat.nbBucketFilled > 1<<(cR-1) {
+		stat.nbBucketFilled = 1 << (cR - 1)
+	}
+	processChunk := getChunkProcessorG1(cR, stat)
+
+	points := table.table[:nbPoints*nbColumns]
+	chTotals := make([]chan g1JacExtended, nbTasks)
+	chSums := make([]chan g1JacExtended, nbTasks)
+	for r := 0; r < plan.nbRanges; r++ {
+		for s := 0; s < plan.nbSplits; s++ {
+			t := r*plan.nbSplits + s
+			start := (nbPoints * s / plan.nbSplits) * nbColumns
+			end := (nbPoints * (s + 1) / plan.nbSplits) * nbColumns
+			chTotals[t] = make(chan g1JacExtended, 1)
+			if r != 0 {
+				chSums[t] = make(chan g1JacExtended, 1)
+			}
+			go processChunk(uint64(t), chTotals[t], cR, points[start:end], rangeDigits[r][start:end], nil, chSums[t])
+		}
+	}
+
+	// step 3
+	// the range r holds the buckets b = r·w ... (r+1)·w - 1 (w = 2^{cR-1}), processed as the buckets
+	// b - r·w; so with S_r and U_r the weighted and plain sums of the buckets of the range r,
+	// the result is Σ_r S_r + w · Σ_r r·U_r.
+	var total, runningSum, offsets g1JacExtended
+	total.setInfinity()
+	runningSum.setInfinity()
+	offsets.setInfinity()
+	for r := plan.nbRanges - 1; r >= 0; r-- {
+		for s := 0; s < plan.nbSplits; s++ {
+			t := r*plan.nbSplits + s
+			res := <-chTotals[t]
+			total.add(&res)
+			if r != 0 {
+				sum := <-chSums[t]
+				runningSum.add(&sum)
+			}
+		}
+		if r != 0 {
+			offsets.add(&runningSum)
+		}
+	}
+	if plan.nbRanges > 1 {
+		for i := uint64(1); i < cR; i++ {
+			offsets.double(&offsets)
+		}
+		total.add(&offsets)
+	}
+
+	return p.unsafeFromJacExtended(&total)
+}
+
+// WriteTo writes binary encoding of the table in w.
+func (t *G1MultiExpTable) WriteTo(w io.Writer) (int64, error) {
+	return t.writeTo(w)
+}
+
+// WriteRawTo writes binary encoding of the table in w without point compression.
+func (t *G1MultiExpTable) WriteRawTo(w io.Writer) (int64, error) {
+	return t.writeTo(w, RawEncoding())
+}
+
+func (t *G1MultiExpTable) writeTo(w io.Writer, options ...func(*Encoder)) (int64, error) {
+	enc := NewEncoder(w, options...)
+	if err := enc.Encode([]uint64{t.c}); err != nil {
+		return enc.BytesWritten(), err
+	}
+	if err := enc.Encode(t.table); err != nil {
+		return enc.BytesWritten(), err
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes a table from r.
+func (t *G1MultiExpTable) ReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r)
+}
+
+// UnsafeReadFrom decodes a table from r without checking that the points
+// are in the correct subgroup.
+func (t *G1MultiExpTable) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, NoSubgroupChecks())
+}
+
+func (t *G1MultiExpTable) readFrom(r io.Reader, options ...func(*Decoder)) (int64, error) {
+	dec := NewDecoder(r, options...)
+	var header []uint64
+	if err := dec.Decode(&header); err != nil {
+		return dec.BytesRead(), err
+	}
+	if len(header) != 1 || !containsC(g1MultiExpTableCs, header[0]) {
+		return dec.BytesRead(), errors.New("invalid window size")
+	}
+	t.c = header[0]
+	t.nbChunks = int(computeNbChunks(t.c))
+	if err := dec.Decode(&t.table); err != nil {
+		return dec.BytesRead(), err
+	}
+	if len(t.table)%t.nbChunks != 0 {
+		return dec.BytesRead(), errors.New("invalid table length")
+	}
+	return dec.BytesRead(), nil
+}
+
+// g2MultiExpTableCs lists the window sizes a G2MultiExpTable can be built with
+// (the c we use must be in this slice)
+var g2MultiExpTableCs = []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+// G2MultiExpTable stores, for a fixed set of bases P_i, the multiples 2^{c·j}·P_i
+// for each c-bit window j of a scalar.
+//
+// A multi-exponentiation against these bases then needs a single set of buckets shared by all
+// the windows, and no doublings; this trades nbChunks(c) times the memory of the bases for faster
+// repeated multi-exponentiations (typically, commitments against a fixed SRS).
+type G2MultiExpTable struct {
+	c        uint64
+	nbChunks int
+	table    []G2Affine // table[i*nbChunks+j] = 2^{c·j}·P_i
+}
+
+// NewG2MultiExpTable precomputes the table of multiples of points for window size c.
+//
+// If c == 0, a window size is picked according to len(points). A table built with window size c
+// can run multi-exponentiations with any implemented multiple of c as window size; so a smaller c
+// serves a wider range of len(scalars), at the cost of memory. The call returns an error if c
+// is not a window size implemented by the multi-exponentiation.
+func NewG2MultiExpTable(points []G2Affine, c uint64) (*G2MultiExpTable, error) {
+	if c == 0 {
+		c = bestCPrecomputed(len(points), g2MultiExpTableCs)
+	}
+	if !containsC(g2MultiExpTableCs, c) {
+		return nil, errors.New("invalid window size")
+	}
+
+	t := &G2MultiExpTable{
+		c:        c,
+		nbChunks: int(computeNbChunks(c)),
+	}
+	nbChunks := t.nbChunks
+	t.table = make([]G2Affine, len(points)*nbChunks)
+
+	parallel.Execute(len(points), func(start, end int) {
+		var p G2Jac
+		for i := start; i < end; i++ {
+			p.FromAffine(&points[i])
+			for j := 0; j < nbChunks; j++ {
+				if j != 0 {
+					for k := uint64(0); k < c; k++ {
+						p.DoubleAssign()
+					}
+				}
+				t.table[i*nbChunks+j].FromJacobian(&p)
+			}
+		}
+	})
+
+	return t, nil
+}
+
+// C returns the window size of the table.
+func (t *G2MultiExpTable) C() uint64 {
+	return t.c
+}
+
+// Len returns the number of bases in the table.
+func (t *G2MultiExpTable) Len() int {
+	if t.nbChunks == 0 {
+		return 0
+	}
+	return len(t.table) / t.nbChunks
+}
+
+// MultiExpPrecomputed computes the multi-exponentiation of the bases of table by scalars.
+//
+// len(scalars) may be smaller than table.Len(), in which case only the first len(scalars)
+// bases are used.
+// This call return an error if len(scalars) > table.Len() or if provided config is invalid.
+func (p *G2Affine) MultiExpPrecomputed(table *G2MultiExpTable, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Affine, error) {
+	var _p G2Jac
+	if _, err := _p.MultiExpPrecomputed(table, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpPrecomputed computes the multi-exponentiation of the bases of table by scalars.
+//
+// len(scalars) may be smaller than table.Len(), in which case only the first len(scalars)
+// bases are used. If the table is not expected to speed up the computation (for example, for
+// few scalars compared to the window size of the table), it falls back to MultiExp.
+// This call return an error if len(scalars) > table.Len() or if provided config is invalid.
+func (p *G2Jac) MultiExpPrecomputed(table *G2MultiExpTable, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Jac, error) {
+	nbPoints := len(scalars)
+	if nbPoints > table.Len() {
+		return nil, errors.New("len(scalars) > table.Len()")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	plan, ok := planMultiExpPrecomputed(nbPoints, table.c, config.NbTasks, g2MultiExpTableCs)
+	if !ok {
+		// the bases are the first column of the table
+		bases := make([]G2Affine, nbPoints)
+		for i := range bases {
+			bases[i] = table.table[i*table.nbChunks]
+		}
+		return p.MultiExp(bases, scalars, config)
+	}
+	return p.multiExpPrecomputed(table, scalars, config.NbTasks, plan), nil
+}
+
+func (p *G2Jac) multiExpPrecomputed(table *G2MultiExpTable, scalars []fr.Element, nbTasks int, plan precomputedPlan) *G2Jac {
+	nbPoints := len(scalars)
+	nbColumns := table.nbChunks
+	c := plan.c
+	nbChunks := int(computeNbChunks(c))
+
+	// step 1
+	// we compute, for each scalar, its signed c-bit digits (see partitionScalars), and lay them out
+	// in the same order as the table: the c-bit window j of the i-th scalar multiplies
+	// 2^{c·j}·P_i = table[i*nbColumns + j*(c/table.c)].
+	// the digits are dispatched among the bucket ranges, and rewritten relatively to their range.
+	digits, chunkStats := partitionScalars(scalars, c, nbTasks)
+	rangeDigits := dispatchDigits(digits, nbPoints, nbChunks, nbColumns, int(c/table.c), bucketsC(c), plan.nbRanges)
+
+	// step 2
+	// each task accumulates a slice of the bases in its range of 2^{cR-1} buckets.
+	cR := bucketsC(c) - uint64(bits.TrailingZeros(uint(plan.nbRanges)))
+	nbTasks = plan.nbRanges * plan.nbSplits
+	var stat chunkStat
+	for _, s := range chunkStats {
+		stat.nbBucketFilled += s.nbBucketFilled
+	}
+	stat.nbBucketFilled /= nbTasks
+	if stat.nbBucketFilled > 1<<(cR-1) {
+		stat.nbBucketFilled = 1 << (cR - 1)
+	}
+	processChunk := getChunkProcessorG2(cR, stat)
+
+	points := table.table[:nbPoints*nbColumns]
+	chTotals := make([]chan g2JacExtended, nbTasks)
+	chSums := make([]chan g2JacExtended, nbTasks)
+	for r := 0; r < plan.nbRanges; r++ {
+		for s := 0; s < plan.nbSplits; s++ {
+			t := r*plan.nbSplits + s
+			start := (nbPoints * s / plan.nbSplits) * nbColumns
+			end := (nbPoints * (s + 1) / plan.nbSplits) * nbColumns
+			chTotals[t] = make(chan g2JacExtended, 1)
+			if r != 0 {
+				chSums[t] = make(chan g2JacExtended, 1)
+			}
+			go processChunk(uint64(t), chTotals[t], cR, points[start:end], rangeDigits[r][start:end], nil, chSums[t])
+		}
+	}
+
+	// step 3
+	// the range r holds the buckets b = r·w ... (r+1)·w - 1 (w = 2^{cR-1}), processed as the buckets
+	// b - r·w; so with S_r and U_r the weighted and plain sums of the buckets of the range r,
+	// the result is Σ_r S_r + w · Σ_r r·U_r.
+	var total, runningSum, offsets g2JacExtended
+	total.setInfinity()
+	runningSum.setInfinity()
+	offsets.setInfinity()
+	for r := plan.nbRanges - 1; r >= 0; r-- {
+		for s := 0; s < plan.nbSplits; s++ {
+			t := r*plan.nbSplits + s
+			res := <-chTotals[t]
+			total.add(&res)
+			if r != 0 {
+				sum := <-chSums[t]
+				runningSum.add(&sum)
+			}
+		}
+		if r != 0 {
+			offsets.add(&runningSum)
+		}
+	}
+	if plan.nbRanges > 1 {
+		for i := uint64(1); i < cR; i++ {
+			offsets.double(&offsets)
+		}
+		total.add(&offsets)
+	}
+
+	return p.unsafeFromJacExtended(&total)
+}
+
+// WriteTo writes binary encoding of the table in w.
+func (t *G2MultiExpTable) WriteTo(w io.Writer) (int64, error) {
+	return t.writeTo(w)
+}
+
+// WriteRawTo writes binary encoding of the table in w without point compression.
+func (t *G2MultiExpTable) WriteRawTo(w io.Writer) (int64, error) {
+	return t.writeTo(w, RawEncoding())
+}
+
+func (t *G2MultiExpTable) writeTo(w io.Writer, options ...func(*Encoder)) (int64, error) {
+	enc := NewEncoder(w, options...)
+	if err := enc.Encode([]uint64{t.c}); err != nil {
+		return enc.BytesWritten(), err
+	}
+	if err := enc.Encode(t.table); err != nil {
+		return enc.BytesWritten(), err
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes a table from r.
+func (t *G2MultiExpTable) ReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r)
+}
+
+// UnsafeReadFrom decodes a table from r without checking that the points
+// are in the correct subgroup.
+func (t *G2MultiExpTable) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, NoSubgroupChecks())
+}
+
+func (t *G2MultiExpTable) readFrom(r io.Reader, options ...func(*Decoder)) (int64, error) {
+	dec := NewDecoder(r, options...)
+	var header []uint64
+	if err := dec.Decode(&header); err != nil {
+		return dec.BytesRead(), err
+	}
+	if len(header) != 1 || !containsC(g2MultiExpTableCs, header[0]) {
+		return dec.BytesRead(), errors.New("invalid window size")
+	}
+	t.c = header[0]
+	t.nbChunks = int(computeNbChunks(t.c))
+	if err := dec.Decode(&t.table); err != nil {
+		return dec.BytesRead(), err
+	}
+	if len(t.table)%t.nbChunks != 0 {
+		return dec.BytesRead(), errors.New("invalid table length")
+	}
+	return dec.BytesRead(), nil
+}
+
+// precomputedPlan describes how a MultiExpPrecomputed is run: the digits of the scalars over
+// c-bit windows are accumulated in a single set of buckets (the table holds the multiples
+// 2^{c·j}·P_i, so there is no need for one set of buckets per window); the buckets are split in
+// nbRanges ranges and the bases in nbSplits slices, and each of the nbRanges*nbSplits tasks
+// accumulates the digits of one slice of bases falling in one range of buckets.
+type precomputedPlan struct {
+	c        uint64
+	nbRanges int
+	nbSplits int
+}
+
+// maxRanges bounds the number of bucket ranges; each range needs its own array of digits.
+const maxRanges = 8
+
+// bucketsC returns the number of bits of the largest bucket index for c-bit windows
+// (the last window may accommodate a carry, see lastC).
+func bucketsC(c uint64) uint64 {
+	if lc := lastC(c); lc > c {
+		return lc
+	}
+	return c
+}
+
+// precomputedCost returns an approximation of the wall time (in group operations) of a
+// MultiExpPrecomputed over nbPoints bases run with plan: each task adds its share of the
+// nbPoints*nbChunks(c) (point, window) pairs in its buckets, then reduces them.
+func precomputedCost(nbPoints int, plan precomputedPlan) float64 {
+	nbPairs := float64(nbPoints * int(computeNbChunks(plan.c)))
+	return nbPairs/float64(plan.nbRanges*plan.nbSplits) + float64(uint64(1)<<bucketsC(plan.c))/float64(plan.nbRanges)
+}
+
+// multiExpCost returns an approximation of the wall time (in group operations) of a MultiExp
+// over nbPoints bases with nbTasks go routines; it uses the same model as MultiExp to pick c,
+// and assumes the work is evenly spread on the tasks.
+func multiExpCost(nbPoints int, nbTasks int, implementedCs []uint64) float64 {
+	min := math.MaxFloat64
+	for _, c := range implementedCs {
+		cost := float64((fr.Bits+1)*(nbPoints+(1<<c))) / float64(c)
+		if cost < min {
+			min = cost
+		}
+	}
+	return min / float64(nbTasks)
+}
+
+// planMultiExpPrecomputed returns the plan minimizing precomputedCost for nbPoints bases of a table
+// with window size tableC, using up to nbTasks go routines. A plan may use any window size that is a
+// multiple of tableC (the table then holds every (c/tableC)-th needed column), as long as it and the
+// bucket range sizes are implemented window sizes.
+//
+// The returned boolean is false if MultiExp is expected to be at least as fast.
+func planMultiExpPrecomputed(nbPoints int, tableC uint64, nbTasks int, implementedCs []uint64) (precomputedPlan, bool) {
+	var best precomputedPlan
+	bestCost := math.MaxFloat64
+	for _, c := range implementedCs {
+		if c%tableC != 0 || !containsC(implementedCs, bucketsC(c)) {
+			continue
+		}
+		for nbRanges := 1; nbRanges <= nbTasks && nbRanges <= maxRanges; nbRanges *= 2 {
+			// each range holds 2^{cR-1} buckets
+			cR := bucketsC(c) - uint64(bits.TrailingZeros(uint(nbRanges)))
+			if nbRanges > 1 && !containsC(implementedCs, cR) {
+				continue
+			}
+			plan := precomputedPlan{c: c, nbRanges: nbRanges, nbSplits: nbTasks / nbRanges}
+			if cost := precomputedCost(nbPoints, plan); cost < bestCost {
+				bestCost = cost
+				best = plan
+			}
+		}
+	}
+	return best, bestCost < multiExpCost(nbPoints, nbTasks, implementedCs)
+}
+
+// bestCPrecomputed returns the table window size minimizing the cost of a MultiExpPrecomputed
+// over nbPoints bases, among the implemented window sizes.
+func bestCPrecomputed(nbPoints int, implementedCs []uint64) uint64 {
+	var C uint64
+	min := math.MaxFloat64
+	for _, c := range implementedCs {
+		if plan, _ := planMultiExpPrecomputed(nbPoints, c, runtime.NumCPU(), implementedCs); plan.c == c {
+			if cost := precomputedCost(nbPoints, plan); cost < min {
+				min = cost
+				C = c
+			}
+		}
+	}
+	return C
+}
+
+func containsC(cs []uint64, c uint64) bool {
+	for _, v := range cs {
+		if v == c {
+			return true
+		}
+	}
+	return false
+}
+
+// dispatchDigits lays out the window-major digits output by partitionScalars (digits[j*nbScalars+i])
+// in the point-major order of a table (res[.][i*nbColumns+j*stride]), and dispatches them in nbRanges
+// arrays: a digit whose bucket falls in the r-th range of 2^{cB-1}/nbRanges buckets is rewritten
+// relative to the start of the range in res[r].
+func dispatchDigits(digits []uint16, nbScalars, nbChunks, nbColumns, stride int, cB uint64, nbRanges int) [][]uint16 {
+	res := make([][]uint16, nbRanges)
+	for r := range res {
+		res[r] = make([]uint16, nbScalars*nbColumns)
+	}
+	shift := cB - 1 - uint64(bits.TrailingZeros(uint(nbRanges)))
+	mask := uint16(1<<shift) - 1
+	parallel.Execute(nbScalars, func(start, end int) {
+		for i := start; i < end; i++ {
+			for j := 0; j < nbChunks; j++ {
+				digit := digits[j*nbScalars+i]
+				if digit == 0 {
+					continue
+				}
+				if nbRanges == 1 {
+					res[0][i*nbColumns+j*stride] = digit
+					continue
+				}
+				// the digit is ±(b+1) for the bucket b (see partitionScalars)
+				sign := digit & 1
+				b := (digit >> 1) - 1 + sign
+				r := b >> shift
+				b &= mask
+				res[r][i*nbColumns+j*stride] = ((b + 1 - sign) << 1) | sign
+			}
+		}
+	})
+	return res
+}
@@ -0,0 +1,156 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12381
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+func TestMultiExpStreamG1(t *testing.T) {
+	const nbSamples = 1000
+	// multi exp points
+	var samplePoints [nbSamples]G1Affine
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	samplePoints[42].SetInfinity()
+
+	var sampleScalars [nbSamples]fr.Element
+	fillBenchScalars(sampleScalars[:])
+
+	var compressed, raw bytes.Buffer
+	if err := NewEncoder(&compressed).Encode(samplePoints[:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewEncoder(&raw, RawEncoding()).Encode(samplePoints[:]); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, nbScalars := range []int{nbSamples, nbSamples / 3} {
+		var expected G1Affine
+		expected.MultiExp(samplePoints[:nbScalars], sampleScalars[:nbScalars], ecc.MultiExpConfig{})
+
+		for _, chunkSize := range []int{0, 1, 97, nbSamples} {
+			for _, encoded := range [][]byte{compressed.Bytes(), raw.Bytes()} {
+				t.Run(fmt.Sprintf("%d scalars, chunk size %d, %d bytes", nbScalars, chunkSize, len(encoded)), func(t *testing.T) {
+					var got G1Affine
+					if _, err := got.MultiExpStream(bytes.NewReader(encoded), sampleScalars[:nbScalars], chunkSize, ecc.MultiExpConfig{}); err != nil {
+						t.Fatal(err)
+					}
+					if !got.Equal(&expected) {
+						t.Fatal("streaming msm doesn't match MultiExp")
+					}
+					if _, err := got.MultiExpStream(bytes.NewReader(encoded), sampleScalars[:nbScalars], chunkSize, ecc.MultiExpConfig{}, NoSubgroupChecks()); err != nil {
+						t.Fatal(err)
+					}
+					if !got.Equal(&expected) {
+						t.Fatal("streaming msm without subgroup checks doesn't match MultiExp")
+					}
+				})
+			}
+		}
+	}
+
+	// truncated stream
+	var got G1Affine
+	truncated := compressed.Bytes()[:compressed.Len()/2]
+	if _, err := got.MultiExpStream(bytes.NewReader(truncated), sampleScalars[:], 97, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("expected an error on a truncated stream")
+	}
+
+	// not enough points
+	var short bytes.Buffer
+	if err := NewEncoder(&short).Encode(samplePoints[:10]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := got.MultiExpStream(&short, sampleScalars[:], 0, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("expected an error when the stream contains less points than scalars")
+	}
+}
+
+func TestMultiExpStreamG2(t *testing.T) {
+	const nbSamples = 1000
+	// multi exp points
+	var samplePoints [nbSamples]G2Affine
+	var g G2Jac
+	g.Set(&g2Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g2Gen)
+	}
+	samplePoints[42].SetInfinity()
+
+	var sampleScalars [nbSamples]fr.Element
+	fillBenchScalars(sampleScalars[:])
+
+	var compressed, raw bytes.Buffer
+	if err := NewEncoder(&compressed).Encode(samplePoints[:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewEncoder(&raw, RawEncoding()).Encode(samplePoints[:]); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, nbScalars := range []int{nbSamples, nbSamples / 3} {
+		var expected G2Affine
+		expected.MultiExp(samplePoints[:nbScalars], sampleScalars[:nbScalars], ecc.MultiExpConfig{})
+
+		for _, chunkSize := range []int{0, 1, 97, nbSamples} {
+			for _, encoded := range [][]byte{compressed.Bytes(), raw.Bytes()} {
+				t.Run(fmt.Sprintf("%d scalars, chunk size %d, %d bytes", nbScalars, chunkSize, len(encoded)), func(t *testing.T) {
+					var got G2Affine
+					if _, err := got.MultiExpStream(bytes.NewReader(encoded), sampleScalars[:nbScalars], chunkSize, ecc.MultiExpConfig{}); err != nil {
+						t.Fatal(err)
+					}
+					if !got.Equal(&expected) {
+						t.Fatal("streaming msm doesn't match MultiExp")
+					}
+					if _, err := got.MultiExpStream(bytes.NewReader(encoded), sampleScalars[:nbScalars], chunkSize, ecc.MultiExpConfig{}, NoSubgroupChecks()); err != nil {
+						t.Fatal(err)
+					}
+					if !got.Equal(&expected) {
+						t.Fatal("streaming msm without subgroup checks doesn't match MultiExp")
+					}
+				})
+			}
+		}
+	}
+
+	// truncated stream
+	var got G2Affine
+	truncated := compressed.Bytes()[:compressed.Len()/2]
+	if _, err := got.MultiExpStream(bytes.NewReader(truncated), sampleScalars[:], 97, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("expected an error on a truncated stream")
+	}
+
+	// not enough points
+	var short bytes.Buffer
+	if err := NewEncoder(&short).Encode(samplePoints[:10]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := got.MultiExpStream(&short, sampleScalars[:], 0, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("expected an error when the stream contains less points than scalars")
+	}
+}
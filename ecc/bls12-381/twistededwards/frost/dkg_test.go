@@ -0,0 +1,232 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards"
+)
+
+// runDKG runs the key generation between nbParticipants goroutines connected by a LocalNetwork
+func runDKG(threshold, nbParticipants int) ([]*KeyShare, error) {
+	network := NewLocalNetwork(nbParticipants)
+	keyShares := make([]*KeyShare, nbParticipants)
+	errs := make([]error, nbParticipants)
+
+	var wg sync.WaitGroup
+	for id := 1; id <= nbParticipants; id++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			keyShares[id-1], errs[id-1] = dkgParticipant(network, id, threshold)
+		}(id)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keyShares, nil
+}
+
+func dkgParticipant(network *LocalNetwork, id, threshold int) (*KeyShare, error) {
+	n := network.NbParticipants()
+	kg, err := NewKeyGen(id, threshold, n)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := kg.Round1(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	if err := network.Broadcast(id, *msg); err != nil {
+		return nil, err
+	}
+
+	// messages of both rounds may be interleaved
+	var round1 []Round1Message
+	var round2 []Round2Message
+	receive := func(until func() bool) error {
+		for !until() {
+			m, err := network.Receive(id)
+			if err != nil {
+				return err
+			}
+			switch p := m.Payload.(type) {
+			case Round1Message:
+				round1 = append(round1, p)
+			case Round2Message:
+				round2 = append(round2, p)
+			}
+		}
+		return nil
+	}
+
+	if err := receive(func() bool { return len(round1) == n-1 }); err != nil {
+		return nil, err
+	}
+	shares, err := kg.Round2(round1)
+	if err != nil {
+		return nil, err
+	}
+	for i := range shares {
+		if err := network.Send(id, shares[i].To, shares[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := receive(func() bool { return len(round2) == n-1 }); err != nil {
+		return nil, err
+	}
+	return kg.Finalize(round2)
+}
+
+func TestDKG(t *testing.T) {
+	t.Parallel()
+
+	for _, params := range [][2]int{{1, 1}, {2, 3}, {3, 5}} {
+		threshold, n := params[0], params[1]
+		keyShares, err := runDKG(threshold, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, ks := range keyShares {
+			if ks.ID != i+1 || ks.Public.Threshold != threshold || len(ks.Public.Shares) != n {
+				t.Fatal("unexpected key share")
+			}
+			if !ks.Public.Key.Equal(&keyShares[0].Public.Key) {
+				t.Fatal("participants disagree on the group key")
+			}
+			for j := range ks.Public.Shares {
+				if !ks.Public.Shares[j].Equal(&keyShares[0].Public.Shares[j]) {
+					t.Fatal("participants disagree on the verification shares")
+				}
+			}
+			var yi twistededwards.PointAffine
+			yi.ScalarMultiplication(&curveParams.Base, &ks.secret)
+			if !yi.Equal(&ks.Public.Shares[i]) {
+				t.Fatal("verification share does not match the secret share")
+			}
+		}
+
+		// the last threshold secret shares interpolate the group secret key
+		commitments := make([]Commitment, threshold)
+		for i := range commitments {
+			commitments[i].ID = n - threshold + i + 1
+		}
+		var secret big.Int
+		for i := range commitments {
+			lambda := lagrangeCoefficient(commitments[i].ID, commitments)
+			lambda.Mul(lambda, &keyShares[commitments[i].ID-1].secret)
+			secret.Add(&secret, lambda)
+		}
+		secret.Mod(&secret, order)
+		var y twistededwards.PointAffine
+		y.ScalarMultiplication(&curveParams.Base, &secret)
+		if !y.Equal(&keyShares[0].Public.Key) {
+			t.Fatal("secret shares do not interpolate the group secret key")
+		}
+	}
+}
+
+// dkgRound1 runs the first round of the key generation sequentially
+func dkgRound1(t *testing.T, threshold, n int) ([]*KeyGen, []Round1Message) {
+	kgs := make([]*KeyGen, n)
+	msgs := make([]Round1Message, n)
+	for i := range kgs {
+		var err error
+		if kgs[i], err = NewKeyGen(i+1, threshold, n); err != nil {
+			t.Fatal(err)
+		}
+		msg, err := kgs[i].Round1(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msgs[i] = *msg
+	}
+	return kgs, msgs
+}
+
+func TestDKGInvalidProof(t *testing.T) {
+	t.Parallel()
+
+	kgs, msgs := dkgRound1(t, 2, 3)
+	msgs[0].ProofZ.Add(&msgs[0].ProofZ, big.NewInt(1)).Mod(&msgs[0].ProofZ, order)
+
+	_, err := kgs[1].Round2([]Round1Message{msgs[0], msgs[2]})
+	if !errors.Is(err, ErrInvalidProof) {
+		t.Fatal("expected an invalid proof of knowledge", err)
+	}
+}
+
+func TestDKGInvalidShare(t *testing.T) {
+	t.Parallel()
+
+	kgs, msgs := dkgRound1(t, 2, 3)
+	received := make([][]Round2Message, 3)
+	for i := range kgs {
+		others := make([]Round1Message, 0, 2)
+		for j := range msgs {
+			if j != i {
+				others = append(others, msgs[j])
+			}
+		}
+		shares, err := kgs[i].Round2(others)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range shares {
+			received[s.To-1] = append(received[s.To-1], s)
+		}
+	}
+
+	// participant 3 sends a wrong share to participant 1
+	for i := range received[0] {
+		if received[0][i].From == 3 {
+			received[0][i].Share.Add(&received[0][i].Share, big.NewInt(1)).Mod(&received[0][i].Share, order)
+		}
+	}
+	if _, err := kgs[0].Finalize(received[0]); !errors.Is(err, ErrInvalidShare) {
+		t.Fatal("expected an invalid share", err)
+	}
+	if _, err := kgs[1].Finalize(received[1]); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDKGParameters(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewKeyGen(1, 0, 3); err == nil {
+		t.Fatal("threshold 0 should be rejected")
+	}
+	if _, err := NewKeyGen(1, 4, 3); err == nil {
+		t.Fatal("threshold larger than the number of participants should be rejected")
+	}
+	if _, err := NewKeyGen(4, 2, 3); err == nil {
+		t.Fatal("identifier larger than the number of participants should be rejected")
+	}
+}
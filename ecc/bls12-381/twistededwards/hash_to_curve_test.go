@@ -0,0 +1,215 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"crypto/sha512"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/field/hash"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"golang.org/x/crypto/sha3"
+)
+
+type point struct {
+	x string
+	y string
+}
+
+type encodeTestCase struct {
+	msg string
+	P   point  // P the final output
+	u   string // u hashed onto the field
+	Q   point  // Q map to curve output
+}
+
+type hashTestCase struct {
+	msg string
+	P   point  // P the final output
+	u0  string // u0 hashed onto the field
+	u1  string // u1 extra hashed onto the field
+	Q0  point  // Q0 map to curve output
+	Q1  point  // Q1 extra map to curve output
+}
+
+type encodeTestVector struct {
+	dst   []byte
+	cases []encodeTestCase
+}
+
+type hashTestVector struct {
+	dst   []byte
+	cases []hashTestCase
+}
+
+var encodeToCurveVector encodeTestVector
+var hashToCurveVector hashTestVector
+
+func TestMapToCurve(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[BLS12-381] MapToCurve should output a point on the curve", prop.ForAll(
+		func(s big.Int) bool {
+			var u fr.Element
+			u.SetBigInt(&s)
+			p := MapToCurve(&u)
+			return p.IsOnCurve()
+		},
+		GenBigInt(),
+	))
+
+	properties.Property("[BLS12-381] HashToCurve and EncodeToCurve should output a point in the prime order subgroup", prop.ForAll(
+		func(s big.Int) bool {
+			params := GetEdwardsCurve()
+			msg := s.Bytes()
+			p0, err := HashToCurve(msg, []byte("dst"))
+			if err != nil {
+				return false
+			}
+			p1, err := EncodeToCurve(msg, []byte("dst"))
+			if err != nil {
+				return false
+			}
+			var q0, q1 PointAffine
+			q0.ScalarMultiplication(&p0, &params.Order)
+			q1.ScalarMultiplication(&p1, &params.Order)
+			return p0.IsOnCurve() && p1.IsOnCurve() && q0.IsZero() && q1.IsZero()
+		},
+		GenBigInt(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	var u fr.Element
+	p := MapToCurve(&u)
+	if !p.IsOnCurve() {
+		t.Fatal("MapToCurve(0) is not on the curve")
+	}
+}
+
+func TestEncodeToCurveVectors(t *testing.T) {
+	for _, c := range encodeToCurveVector.cases {
+		u, err := fr.Hash([]byte(c.msg), encodeToCurveVector.dst, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMatchCoord(t, "u", c.msg, c.u, &u[0])
+
+		q := MapToCurve(&u[0])
+		testMatchPoint(t, "Q", c.msg, c.Q, &q)
+
+		p, err := EncodeToCurve([]byte(c.msg), encodeToCurveVector.dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMatchPoint(t, "P", c.msg, c.P, &p)
+	}
+}
+
+func TestHashToCurveVectors(t *testing.T) {
+	for _, c := range hashToCurveVector.cases {
+		u, err := fr.Hash([]byte(c.msg), hashToCurveVector.dst, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMatchCoord(t, "u0", c.msg, c.u0, &u[0])
+		testMatchCoord(t, "u1", c.msg, c.u1, &u[1])
+
+		q0 := MapToCurve(&u[0])
+		q1 := MapToCurve(&u[1])
+		testMatchPoint(t, "Q0", c.msg, c.Q0, &q0)
+		testMatchPoint(t, "Q1", c.msg, c.Q1, &q1)
+
+		p, err := HashToCurve([]byte(c.msg), hashToCurveVector.dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMatchPoint(t, "P", c.msg, c.P, &p)
+	}
+}
+
+func TestHashToCurveOptions(t *testing.T) {
+	params := GetEdwardsCurve()
+	msg, dst := []byte("abc"), []byte("QUUX-V01-CS02-with-options")
+	ref, err := HashToCurve(msg, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := []hash.Option{
+		hash.WithExpandMsgXmd(sha512.New),
+		hash.WithExpandMsgXof(sha3.NewShake128, 128),
+	}
+	for i, opt := range opts {
+		p, err := HashToCurve(msg, dst, opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var q PointAffine
+		q.ScalarMultiplication(&p, &params.Order)
+		if !p.IsOnCurve() || !q.IsZero() || p.Equal(&ref) {
+			t.Fatal("unexpected HashToCurve output for option", i)
+		}
+	}
+}
+
+func testMatchCoord(t *testing.T, coordName string, msg string, expectedStr string, seen *fr.Element) {
+	var expected fr.Element
+	if _, err := expected.SetString(expectedStr); err != nil {
+		t.Fatal(err)
+	}
+	if !expected.Equal(seen) {
+		t.Errorf("mismatch on \"%s\", %s:\n\texpected %s\n\tsaw      %s", msg, coordName, expected.String(), seen.String())
+	}
+}
+
+func testMatchPoint(t *testing.T, pointName string, msg string, expected point, seen *PointAffine) {
+	testMatchCoord(t, pointName+".x", msg, expected.x, &seen.X)
+	testMatchCoord(t, pointName+".y", msg, expected.y, &seen.Y)
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkMapToCurve(b *testing.B) {
+	var u fr.Element
+	u.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MapToCurve(&u)
+	}
+}
+
+func BenchmarkHashToCurve(b *testing.B) {
+	msg := []byte("message")
+	dst := []byte("dst")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		HashToCurve(msg, dst)
+	}
+}
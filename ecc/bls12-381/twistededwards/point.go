@@ -17,11 +17,14 @@
 package twistededwards
 
 import (
+	"crypto/rand"
 	"crypto/subtle"
+	"encoding/binary"
 	"io"
 	"math/big"
 	"math/bits"
 
+	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 )
 
@@ -268,6 +271,18 @@ func (p *PointAffine) ScalarMultiplication(p1 *PointAffine, scalar *big.Int) *Po
 	return p
 }
 
+// RandomPoint sets p to a uniformly random point of the prime order subgroup, reading the randomness from r,
+// and returns p. It computes p = [s]Base for a random scalar s, so that with a deterministic (seeded) reader
+// the points are reproducible.
+func (p *PointAffine) RandomPoint(r io.Reader) (*PointAffine, error) {
+	curve := GetEdwardsCurve()
+	s, err := rand.Int(r, &curve.Order)
+	if err != nil {
+		return nil, err
+	}
+	return p.ScalarMultiplication(&curve.Base, s), nil
+}
+
 // setInfinity sets p to O (0:1)
 func (p *PointAffine) setInfinity() *PointAffine {
 	p.X.SetZero()
@@ -440,6 +455,116 @@ func (p *PointProj) ScalarMultiplication(p1 *PointProj, scalar *big.Int) *PointP
 	return p
 }
 
+// ctWindowSize is the window size of the regular signed recoding used by the constant-time
+// scalar multiplications; the lookup tables hold the 2^{ctWindowSize-1} first odd multiples of the base.
+const ctWindowSize = 4
+
+// ScalarMultiplicationCT scalar multiplication of a point p1 in affine coordinates with a scalar
+// in big.Int, in constant time with respect to the scalar.
+//
+// See PointProj.ScalarMultiplicationCT; p1 must be in the prime order subgroup.
+func (p *PointAffine) ScalarMultiplicationCT(p1 *PointAffine, scalar *big.Int) *PointAffine {
+	var p1Proj, resProj PointProj
+	p1Proj.FromAffine(p1)
+	resProj.ScalarMultiplicationCT(&p1Proj, scalar)
+
+	// Z⁻¹ is computed with a fixed exponentiation instead of a (variable-time) inversion
+	var I fr.Element
+	e := fr.Modulus()
+	e.Sub(e, big.NewInt(2))
+	I.Exp(resProj.Z, e)
+	p.X.Mul(&resProj.X, &I)
+	p.Y.Mul(&resProj.Y, &I)
+
+	return p
+}
+
+// ScalarMultiplicationCT scalar multiplication of a point p1 in projective coordinates with a scalar
+// in big.Int, in constant time with respect to the scalar.
+//
+// The scalar, reduced modulo the order ℓ of the prime subgroup, is recoded in regular signed digits
+// (cf ecc.RegularSignedDecomposition), and the multiples of p1 are selected from a lookup table with
+// a constant-time scan, and added with the (complete) projective formulas; the sequence of group operations
+// and memory accesses doesn't depend on the scalar. The projective coordinates of p1 are randomized
+// beforehand. It is meant for secret scalars (private keys, nonces, ...) and is slower than ScalarMultiplication.
+//
+// p1 must be in the prime order subgroup.
+func (p *PointProj) ScalarMultiplicationCT(p1 *PointProj, scalar *big.Int) *PointProj {
+	ecurve := GetEdwardsCurve()
+
+	// table[i] = (2i+1)⋅p1, with randomized projective coordinates
+	var table [1 << (ctWindowSize - 1)]PointProj
+	var lambda fr.Element
+	if _, err := lambda.SetRandom(); err != nil || lambda.IsZero() {
+		lambda.SetOne()
+	}
+	table[0].X.Mul(&p1.X, &lambda)
+	table[0].Y.Mul(&p1.Y, &lambda)
+	table[0].Z.Mul(&p1.Z, &lambda)
+	var p2 PointProj
+	p2.Double(&table[0])
+	for i := 1; i < len(table); i++ {
+		table[i].Add(&table[i-1], &p2)
+	}
+
+	// k ≡ scalar (mod ℓ) and k is odd; since p1 is in the ℓ-torsion, k⋅p1 = scalar⋅p1
+	k := oddScalarWords(scalar, &ecurve.Order)
+	digits := make([]int8, (ecurve.Order.BitLen()+ctWindowSize)/ctWindowSize+1)
+	ecc.RegularSignedDecomposition(k, ctWindowSize, digits)
+
+	var res, tmp PointProj
+	res.lookupCT(&table, digits[len(digits)-1])
+	for i := len(digits) - 2; i >= 0; i-- {
+		for j := 0; j < ctWindowSize; j++ {
+			res.Double(&res)
+		}
+		tmp.lookupCT(&table, digits[i])
+		res.Add(&res, &tmp)
+	}
+
+	p.Set(&res)
+	return p
+}
+
+// lookupCT sets p = d ⋅ p1, d being an odd digit in [-(2^ctWindowSize-1), 2^ctWindowSize-1]
+// and table[i] = (2i+1)⋅p1. All the entries of the table are read, and the result is negated
+// or not, without branching on d.
+func (p *PointProj) lookupCT(table *[1 << (ctWindowSize - 1)]PointProj, d int8) *PointProj {
+	sign := int32(d) >> 31                       // -1 if d < 0, 0 otherwise
+	idx := (((int32(d) ^ sign) - sign) - 1) >> 1 // (|d|-1)/2
+	p.Set(&table[0])
+	for i := 1; i < len(table); i++ {
+		c := subtle.ConstantTimeEq(int32(i), idx)
+		p.X.Select(c, &p.X, &table[i].X)
+		p.Y.Select(c, &p.Y, &table[i].Y)
+		p.Z.Select(c, &p.Z, &table[i].Z)
+	}
+	var negX fr.Element
+	negX.Neg(&p.X)
+	p.X.Select(int(sign&1), &p.X, &negX)
+	return p
+}
+
+// oddScalarWords returns k ≡ s (mod order) with k odd, in little-endian 64-bit words, order being odd.
+// k is either s mod order or (s mod order) + order, the choice is made without branching on s.
+func oddScalarWords(s, order *big.Int) []uint64 {
+	nbWords := (order.BitLen() + 63) / 64
+	var e big.Int
+	e.Mod(s, order)
+	b := e.FillBytes(make([]byte, nbWords*8))
+	o := order.FillBytes(make([]byte, nbWords*8))
+
+	k := make([]uint64, nbWords+1)
+	mask := (uint64(b[len(b)-1]) & 1) - 1 // all ones if s mod order is even, 0 otherwise
+	var carry uint64
+	for i := 0; i < nbWords; i++ {
+		offset := (nbWords - 1 - i) * 8
+		k[i], carry = bits.Add64(binary.BigEndian.Uint64(b[offset:]), binary.BigEndian.Uint64(o[offset:])&mask, carry)
+	}
+	k[nbWords] = carry
+	return k
+}
+
 // ------- Extended coordinates
 
 // Set sets p to p1 and return it
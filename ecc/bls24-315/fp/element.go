@@ -319,6 +319,15 @@ func (z *Element) LexicographicallyLargest() bool {
 // This might error only if reading from crypto/rand.Reader errors,
 // in which case, value of z is undefined.
 func (z *Element) SetRandom() (*Element, error) {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom sets z to a uniform random value in [0, q), reading the randomness from r.
+// With a deterministic (seeded) reader, the sequence of values is reproducible.
+//
+// This might error only if reading from r errors,
+// in which case, value of z is undefined.
+func (z *Element) SetRandomFrom(r io.Reader) (*Element, error) {
 	// this code is generated for all modulus
 	// and derived from go/src/crypto/rand/util.go
 
@@ -341,7 +350,7 @@ func (z *Element) SetRandom() (*Element, error) {
 
 	for {
 		// note that bytes[k:l] is always 0
-		if _, err := io.ReadFull(rand.Reader, bytes[:k]); err != nil {
+		if _, err := io.ReadFull(r, bytes[:k]); err != nil {
 			return nil, err
 		}
 
@@ -494,6 +503,56 @@ func (z *Element) Select(c int, x0 *Element, x1 *Element) *Element {
 	return z
 }
 
+// AddCT z = x + y (mod q), without branching on x or y (as Add does);
+// it is meant for secret operands.
+func (z *Element) AddCT(x, y *Element) *Element {
+	var carry uint64
+	z[0], carry = bits.Add64(x[0], y[0], 0)
+	z[1], carry = bits.Add64(x[1], y[1], carry)
+	z[2], carry = bits.Add64(x[2], y[2], carry)
+	z[3], carry = bits.Add64(x[3], y[3], carry)
+	z[4], carry = bits.Add64(x[4], y[4], carry)
+
+	// if x + y ⩾ q → z -= q, without branching on z
+	var s [5]uint64
+	var b uint64
+	s[0], b = bits.Sub64(z[0], q0, 0)
+	s[1], b = bits.Sub64(z[1], q1, b)
+	s[2], b = bits.Sub64(z[2], q2, b)
+	s[3], b = bits.Sub64(z[3], q3, b)
+	s[4], b = bits.Sub64(z[4], q4, b)
+	// mask = 0xFF..FF if the addition overflowed or the subtraction didn't borrow, 0 otherwise
+	mask := -(carry | (b ^ 1))
+	z[0] ^= mask & (z[0] ^ s[0])
+	z[1] ^= mask & (z[1] ^ s[1])
+	z[2] ^= mask & (z[2] ^ s[2])
+	z[3] ^= mask & (z[3] ^ s[3])
+	z[4] ^= mask & (z[4] ^ s[4])
+	return z
+}
+
+// SubCT z = x - y (mod q), without branching on x or y (as Sub does);
+// it is meant for secret operands.
+func (z *Element) SubCT(x, y *Element) *Element {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	z[1], b = bits.Sub64(x[1], y[1], b)
+	z[2], b = bits.Sub64(x[2], y[2], b)
+	z[3], b = bits.Sub64(x[3], y[3], b)
+	z[4], b = bits.Sub64(x[4], y[4], b)
+
+	// if x < y → z += q, without branching on z
+	// mask = 0xFF..FF if the subtraction borrowed, 0 otherwise
+	mask := -b
+	var c uint64
+	z[0], c = bits.Add64(z[0], q0&mask, 0)
+	z[1], c = bits.Add64(z[1], q1&mask, c)
+	z[2], c = bits.Add64(z[2], q2&mask, c)
+	z[3], c = bits.Add64(z[3], q3&mask, c)
+	z[4], _ = bits.Add64(z[4], q4&mask, c)
+	return z
+}
+
 // _mulGeneric is unoptimized textbook CIOS
 // it is a fallback solution on x86 when ADX instruction set is not available
 // and is used for testing purposes.
@@ -650,33 +709,22 @@ func _mulGeneric(z, x, y *Element) {
 	t[4], C = bits.Add64(t[5], C, 0)
 	t[5], _ = bits.Add64(0, D, C)
 
-	if t[5] != 0 {
-		// we need to reduce, we have a result on 6 words
-		var b uint64
-		z[0], b = bits.Sub64(t[0], q0, 0)
-		z[1], b = bits.Sub64(t[1], q1, b)
-		z[2], b = bits.Sub64(t[2], q2, b)
-		z[3], b = bits.Sub64(t[3], q3, b)
-		z[4], _ = bits.Sub64(t[4], q4, b)
-		return
-	}
-
-	// copy t into z
-	z[0] = t[0]
-	z[1] = t[1]
-	z[2] = t[2]
-	z[3] = t[3]
-	z[4] = t[4]
+	// t < 2q: we subtract q if t[5] != 0 or t ⩾ q, without branching on secret data
+	var s [5]uint64
+	var b uint64
+	s[0], b = bits.Sub64(t[0], q0, 0)
+	s[1], b = bits.Sub64(t[1], q1, b)
+	s[2], b = bits.Sub64(t[2], q2, b)
+	s[3], b = bits.Sub64(t[3], q3, b)
+	s[4], b = bits.Sub64(t[4], q4, b)
+	// mask = 0xFF..FF if we need to subtract, 0 otherwise
+	mask := -(t[5] | (b ^ 1))
+	z[0] = t[0] ^ (mask & (t[0] ^ s[0]))
+	z[1] = t[1] ^ (mask & (t[1] ^ s[1]))
+	z[2] = t[2] ^ (mask & (t[2] ^ s[2]))
+	z[3] = t[3] ^ (mask & (t[3] ^ s[3]))
+	z[4] = t[4] ^ (mask & (t[4] ^ s[4]))
 
-	// if z ⩾ q → z -= q
-	if !z.smallerThanModulus() {
-		var b uint64
-		z[0], b = bits.Sub64(z[0], q0, 0)
-		z[1], b = bits.Sub64(z[1], q1, b)
-		z[2], b = bits.Sub64(z[2], q2, b)
-		z[3], b = bits.Sub64(z[3], q3, b)
-		z[4], _ = bits.Sub64(z[4], q4, b)
-	}
 }
 
 func _fromMontGeneric(z *Element) {
@@ -816,15 +864,18 @@ func (z *Element) BitLen() int {
 }
 
 // Hash msg to count prime field elements.
-// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-5.2
-func Hash(msg, dst []byte, count int) ([]Element, error) {
-	// 128 bits of security
-	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter = 128
-	const Bytes = 1 + (Bits-1)/8
-	const L = 16 + Bytes
+// https://www.rfc-editor.org/rfc/rfc9380#section-5.2
+//
+// By default, expand_message_xmd with SHA-256 is used with a security level of 128 bits;
+// the ciphersuite can be changed with hash.Option (e.g. hash.WithExpandMsgXof).
+func Hash(msg, dst []byte, count int, opts ...hash.Option) ([]Element, error) {
+	cfg := hash.NewConfig(opts...)
+
+	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter
+	L := (Bits + cfg.SecurityLevel + 7) / 8
 
 	lenInBytes := count * L
-	pseudoRandomBytes, err := hash.ExpandMsgXmd(msg, dst, lenInBytes)
+	pseudoRandomBytes, err := cfg.Expand(msg, dst, lenInBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -1256,15 +1307,77 @@ func (z *Element) Sqrt(x *Element) *Element {
 	// see modSqrtTonelliShanks in math/big/int.go
 	// using https://www.maa.org/sites/default/files/pdf/upload_library/22/Polya/07468342.di020786.02p0470a.pdf
 
-	var y, b, t, w Element
 	// w = x^((s-1)/2))
+	var w Element
 	w.expBySqrtExp(*x)
+	return z.sqrtFromExp(x, &w)
+}
+
+// SqrtCT z = √x (mod q) in constant time: the sequence of operations doesn't depend on x,
+// only the returned value reveals whether x is a square. It is suitable for secret data.
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil
+func (z *Element) SqrtCT(x *Element) *Element {
+	// constant-time Tonelli-Shanks with a fixed number of iterations and conditional moves
+	// see RFC 9380, appendix I.4
+
+	// w = x^((s-1)/2))
+	var w Element
+	w.expBySqrtExp(*x)
+
+	var y, t, b, yt, tt, one Element
+	one.SetOne()
 
 	// y = x^((s+1)/2)) = w * x
 	y.Mul(x, &w)
 
+	// t = xˢ = w * y
+	t.Mul(&w, &y)
+
+	// c = nonResidue ^ s
+	var c = Element{
+		11195128742969911322,
+		1359304652430195240,
+		15267589139354181340,
+		10518360976114966361,
+		300769513466036652,
+	}
+
+	for i := 20; i >= 2; i-- {
+		b = t
+		for j := 1; j <= i-2; j++ {
+			b.Square(&b)
+		}
+		// notOne = 0 if b == 1, 1 otherwise
+		notOne := b.NotEqual(&one)
+		notOne = (notOne | -notOne) >> 63
+
+		yt.Mul(&y, &c)
+		y.Select(int(notOne), &y, &yt)
+		c.Square(&c)
+		tt.Mul(&t, &c)
+		t.Select(int(notOne), &t, &tt)
+	}
+
+	// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
+	var square Element
+	square.Square(&y)
+	if square.Equal(x) {
+		return z.Set(&y)
+	}
+	return nil
+}
+
+// sqrtFromExp sets z = √x (mod q) and returns z, or returns nil if x is not a square,
+// given w = x^((s-1)/2), where q - 1 = 2ᵉ·s with s odd (see Sqrt)
+func (z *Element) sqrtFromExp(x, w *Element) *Element {
+	var y, b, t Element
+
+	// y = x^((s+1)/2)) = w * x
+	y.Mul(x, w)
+
 	// b = xˢ = w * w * x = y * x
-	b.Mul(&w, &y)
+	b.Mul(w, &y)
 
 	// g = nonResidue ^ s
 	var g = Element{
@@ -1317,6 +1430,69 @@ func (z *Element) Sqrt(x *Element) *Element {
 	}
 }
 
+// batchExpSize is the number of elements whose exponentiations are computed together
+// by BatchSqrt and BatchLegendre; it bounds the size of the temporary vectors.
+const batchExpSize = 256
+
+// BatchLegendre returns the Legendre symbols of the elements of a (see Legendre).
+//
+// The exponentiations share the same addition chain and are computed on vectors
+// of elements, in parallel.
+func BatchLegendre(a []Element) []int {
+	res := make([]int, len(a))
+	batchExp(a, vectorExpByLegendreExp, vectorExpByLegendreExpNbTemporaries, func(i int, l *Element) {
+		switch {
+		case l.IsZero():
+			res[i] = 0
+		case l.IsOne():
+			res[i] = 1
+		default:
+			res[i] = -1
+		}
+	})
+	return res
+}
+
+// BatchSqrt returns the square roots of the elements of a (see Sqrt), and for each of them
+// whether it is a square; if a[i] is not a square, res[i] is 0 and isSquare[i] is false.
+//
+// The exponentiations share the same addition chain and are computed on vectors
+// of elements, in parallel.
+func BatchSqrt(a []Element) (res []Element, isSquare []bool) {
+	res = make([]Element, len(a))
+	isSquare = make([]bool, len(a))
+	batchExp(a, vectorExpBySqrtExp, vectorExpBySqrtExpNbTemporaries, func(i int, w *Element) {
+		isSquare[i] = res[i].sqrtFromExp(&a[i], w) != nil
+	})
+	return
+}
+
+// batchExp calls f(i, aᵢᵏ) for all i, where the exponentiations are computed in parallel
+// by chunks of batchExpSize elements.
+// exp sets z[j] = x[j]ᵏ for all j, with z and x not overlapping, using nbTemporaries
+// temporary vectors from scratch.
+func batchExp(a []Element, exp func(z, x, scratch Vector), nbTemporaries int, f func(i int, ak *Element)) {
+	execute(len(a), func(start, end int) {
+		// the result and the temporaries of all the chunks of the worker share one buffer
+		size := end - start
+		if size > batchExpSize {
+			size = batchExpSize
+		}
+		buf := make(Vector, (nbTemporaries+1)*size)
+		for i := start; i < end; i += batchExpSize {
+			n := end - i
+			if n > batchExpSize {
+				n = batchExpSize
+			}
+			ak := buf[:n]
+			exp(ak, Vector(a[i:i+n]), buf[size:size+nbTemporaries*n])
+			for j := range ak {
+				f(i+j, &ak[j])
+			}
+		}
+	})
+}
+
 const (
 	k               = 32 // word size / 2
 	signBitSelector = uint64(1) << 63
@@ -1675,6 +1851,14 @@ func updateFactorsDecompose(c int64) (int64, int64) {
 	return f, g
 }
 
+// InverseCT z = x⁻¹ (mod q) in constant time, computed as x^(q-2) (Fermat's little theorem);
+// unlike Inverse, the sequence of operations doesn't depend on x and it is suitable for secret data.
+//
+// if x == 0, sets and returns z = x
+func (z *Element) InverseCT(x *Element) *Element {
+	return z.expByInverseExp(*x)
+}
+
 // negL negates in place [x | xHi] and return the new most significant word xHi
 func negL(x *Element, xHi uint64) uint64 {
 	var b uint64
@@ -1728,3 +1912,144 @@ func (z *Element) linearCombNonModular(x *Element, xC int64, y *Element, yC int6
 
 	return yHi
 }
+
+// UnreducedAccumulator accumulates sums of products of field elements on 11 words,
+// without reducing them modulo q: MulAcc costs a bare multiplication, and the modular
+// reduction is done once, by Reduce.
+//
+// The zero value is an empty accumulator; it can hold up to 2⁶³ products.
+type UnreducedAccumulator struct {
+	t [11]uint64
+}
+
+// MulAcc sets acc = acc + x * y, without modular reduction
+func (acc *UnreducedAccumulator) MulAcc(x, y *Element) {
+	var t [10]uint64
+
+	// t = x * y (schoolbook)
+	var C uint64
+	C, t[0] = bits.Mul64(x[0], y[0])
+	C, t[1] = madd1(x[1], y[0], C)
+	C, t[2] = madd1(x[2], y[0], C)
+	C, t[3] = madd1(x[3], y[0], C)
+	C, t[4] = madd1(x[4], y[0], C)
+	t[5] = C
+	C, t[1] = madd1(x[0], y[1], t[1])
+	C, t[2] = madd2(x[1], y[1], t[2], C)
+	C, t[3] = madd2(x[2], y[1], t[3], C)
+	C, t[4] = madd2(x[3], y[1], t[4], C)
+	C, t[5] = madd2(x[4], y[1], t[5], C)
+	t[6] = C
+	C, t[2] = madd1(x[0], y[2], t[2])
+	C, t[3] = madd2(x[1], y[2], t[3], C)
+	C, t[4] = madd2(x[2], y[2], t[4], C)
+	C, t[5] = madd2(x[3], y[2], t[5], C)
+	C, t[6] = madd2(x[4], y[2], t[6], C)
+	t[7] = C
+	C, t[3] = madd1(x[0], y[3], t[3])
+	C, t[4] = madd2(x[1], y[3], t[4], C)
+	C, t[5] = madd2(x[2], y[3], t[5], C)
+	C, t[6] = madd2(x[3], y[3], t[6], C)
+	C, t[7] = madd2(x[4], y[3], t[7], C)
+	t[8] = C
+	C, t[4] = madd1(x[0], y[4], t[4])
+	C, t[5] = madd2(x[1], y[4], t[5], C)
+	C, t[6] = madd2(x[2], y[4], t[6], C)
+	C, t[7] = madd2(x[3], y[4], t[7], C)
+	C, t[8] = madd2(x[4], y[4], t[8], C)
+	t[9] = C
+
+	// acc = acc + t
+	var carry uint64
+	acc.t[0], carry = bits.Add64(acc.t[0], t[0], carry)
+	acc.t[1], carry = bits.Add64(acc.t[1], t[1], carry)
+	acc.t[2], carry = bits.Add64(acc.t[2], t[2], carry)
+	acc.t[3], carry = bits.Add64(acc.t[3], t[3], carry)
+	acc.t[4], carry = bits.Add64(acc.t[4], t[4], carry)
+	acc.t[5], carry = bits.Add64(acc.t[5], t[5], carry)
+	acc.t[6], carry = bits.Add64(acc.t[6], t[6], carry)
+	acc.t[7], carry = bits.Add64(acc.t[7], t[7], carry)
+	acc.t[8], carry = bits.Add64(acc.t[8], t[8], carry)
+	acc.t[9], carry = bits.Add64(acc.t[9], t[9], carry)
+	acc.t[10] += carry
+}
+
+// Reduce sets z = acc (mod q) and returns z; acc is left unchanged
+func (acc *UnreducedAccumulator) Reduce(z *Element) *Element {
+	// with xᵢ = aᵢR and yᵢ = bᵢR in Montgomery form, acc holds T = Σ xᵢyᵢ = R²·Σ aᵢbᵢ
+	// and we need z = T·R⁻¹ (mod q). T may exceed q·R, so we reduce it twice,
+	// to T·R⁻¹ < q·R and then to T·R⁻² < 2q, and multiply the result by R² (mod q).
+	t := acc.t
+	montgomeryReduceWide(&t)
+
+	var u [11]uint64
+	copy(u[:], t[5:])
+	montgomeryReduceWide(&u)
+
+	copy(z[:], u[5:10])
+	if u[10] != 0 || !z.smallerThanModulus() {
+		var b uint64
+		for i := 0; i < 5; i++ {
+			z[i], b = bits.Sub64(z[i], qElement[i], b)
+		}
+	}
+	return z.Mul(z, &rSquare)
+}
+
+// Reset empties the accumulator
+func (acc *UnreducedAccumulator) Reset() {
+	acc.t = [11]uint64{}
+}
+
+// montgomeryReduceWide sets t[5:] = t * R⁻¹ (mod q) on 6 words, with a
+// word-by-word Montgomery reduction; the result is less than t / R + q.
+func montgomeryReduceWide(t *[11]uint64) {
+	// the carry out of t[i+5] is added at the next row
+	var m, C, c uint64
+
+	// t[0] becomes 0
+	m = t[0] * qInvNeg
+	C = madd0(m, qElement[0], t[0])
+	C, t[1] = madd2(m, qElement[1], t[1], C)
+	C, t[2] = madd2(m, qElement[2], t[2], C)
+	C, t[3] = madd2(m, qElement[3], t[3], C)
+	C, t[4] = madd2(m, qElement[4], t[4], C)
+	t[5], c = bits.Add64(t[5], C, 0)
+
+	// t[1] becomes 0
+	m = t[1] * qInvNeg
+	C = madd0(m, qElement[0], t[1])
+	C, t[2] = madd2(m, qElement[1], t[2], C)
+	C, t[3] = madd2(m, qElement[2], t[3], C)
+	C, t[4] = madd2(m, qElement[3], t[4], C)
+	C, t[5] = madd2(m, qElement[4], t[5], C)
+	t[6], c = bits.Add64(t[6], C, c)
+
+	// t[2] becomes 0
+	m = t[2] * qInvNeg
+	C = madd0(m, qElement[0], t[2])
+	C, t[3] = madd2(m, qElement[1], t[3], C)
+	C, t[4] = madd2(m, qElement[2], t[4], C)
+	C, t[5] = madd2(m, qElement[3], t[5], C)
+	C, t[6] = madd2(m, qElement[4], t[6], C)
+	t[7], c = bits.Add64(t[7], C, c)
+
+	// t[3] becomes 0
+	m = t[3] * qInvNeg
+	C = madd0(m, qElement[0], t[3])
+	C, t[4] = madd2(m, qElement[1], t[4], C)
+	C, t[5] = madd2(m, qElement[2], t[5], C)
+	C, t[6] = madd2(m, qElement[3], t[6], C)
+	C, t[7] = madd2(m, qElement[4], t[7], C)
+	t[8], c = bits.Add64(t[8], C, c)
+
+	// t[4] becomes 0
+	m = t[4] * qInvNeg
+	C = madd0(m, qElement[0], t[4])
+	C, t[5] = madd2(m, qElement[1], t[5], C)
+	C, t[6] = madd2(m, qElement[2], t[6], C)
+	C, t[7] = madd2(m, qElement[3], t[7], C)
+	C, t[8] = madd2(m, qElement[4], t[8], C)
+	t[9], c = bits.Add64(t[9], C, c)
+	t[10] += c
+}
@@ -0,0 +1,370 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0x6fe802ff40300001
+DATA q<>+8(SB)/8, $0x421ee5da52bde502
+DATA q<>+16(SB)/8, $0xdec1d01aa27a1ae0
+DATA q<>+24(SB)/8, $0xd3f7498be97c5eaf
+DATA q<>+32(SB)/8, $0x04c23a02b586d650
+GLOBL q<>(SB), (RODATA+NOPTR), $40
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x702ff9ff402fffff
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+// mul(res, x, y *Element) sets res = x * y * R⁻¹ (mod q)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD $q<>(SB), R11
+	MOVD 32(R11), R15
+	LDP  16(R11), (R13, R14)
+	LDP  0(R11), (R11, R12)
+	MOVD qInv0<>(SB), R1
+	MOVD x+8(FP), R0
+	MOVD 32(R0), R10
+	LDP  16(R0), (R8, R9)
+	LDP  0(R0), (R6, R7)
+	MOVD y+16(FP), R0
+	MOVD 0(R0), R2
+
+	// t = t + x * y[0]
+	MUL   R6, R2, R16
+	MUL   R7, R2, R17
+	MUL   R8, R2, R19
+	MUL   R9, R2, R20
+	MUL   R10, R2, R21
+	UMULH R6, R2, R5
+	ADDS  R5, R17, R17
+	UMULH R7, R2, R5
+	ADCS  R5, R19, R19
+	UMULH R8, R2, R5
+	ADCS  R5, R20, R20
+	UMULH R9, R2, R5
+	ADCS  R5, R21, R21
+	UMULH R10, R2, R5
+	ADC   R5, ZR, R22
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R16, R3
+	MUL   R11, R3, R4
+	ADDS  R4, R16, R16
+	MUL   R12, R3, R4
+	ADCS  R4, R17, R17
+	MUL   R13, R3, R4
+	ADCS  R4, R19, R19
+	MUL   R14, R3, R4
+	ADCS  R4, R20, R20
+	MUL   R15, R3, R4
+	ADCS  R4, R21, R21
+	ADC   ZR, R22, R22
+	UMULH R11, R3, R5
+	ADDS  R5, R17, R17
+	UMULH R12, R3, R5
+	ADCS  R5, R19, R19
+	UMULH R13, R3, R5
+	ADCS  R5, R20, R20
+	UMULH R14, R3, R5
+	ADCS  R5, R21, R21
+	UMULH R15, R3, R5
+	ADC   R5, R22, R22
+	MOVD  8(R0), R2
+
+	// t = t + x * y[1]
+	MUL   R6, R2, R4
+	ADDS  R4, R17, R17
+	MUL   R7, R2, R4
+	ADCS  R4, R19, R19
+	MUL   R8, R2, R4
+	ADCS  R4, R20, R20
+	MUL   R9, R2, R4
+	ADCS  R4, R21, R21
+	MUL   R10, R2, R4
+	ADCS  R4, R22, R22
+	ADC   ZR, ZR, R16
+	UMULH R6, R2, R5
+	ADDS  R5, R19, R19
+	UMULH R7, R2, R5
+	ADCS  R5, R20, R20
+	UMULH R8, R2, R5
+	ADCS  R5, R21, R21
+	UMULH R9, R2, R5
+	ADCS  R5, R22, R22
+	UMULH R10, R2, R5
+	ADC   R5, R16, R16
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R17, R3
+	MUL   R11, R3, R4
+	ADDS  R4, R17, R17
+	MUL   R12, R3, R4
+	ADCS  R4, R19, R19
+	MUL   R13, R3, R4
+	ADCS  R4, R20, R20
+	MUL   R14, R3, R4
+	ADCS  R4, R21, R21
+	MUL   R15, R3, R4
+	ADCS  R4, R22, R22
+	ADC   ZR, R16, R16
+	UMULH R11, R3, R5
+	ADDS  R5, R19, R19
+	UMULH R12, R3, R5
+	ADCS  R5, R20, R20
+	UMULH R13, R3, R5
+	ADCS  R5, R21, R21
+	UMULH R14, R3, R5
+	ADCS  R5, R22, R22
+	UMULH R15, R3, R5
+	ADC   R5, R16, R16
+	MOVD  16(R0), R2
+
+	// t = t + x * y[2]
+	MUL   R6, R2, R4
+	ADDS  R4, R19, R19
+	MUL   R7, R2, R4
+	ADCS  R4, R20, R20
+	MUL   R8, R2, R4
+	ADCS  R4, R21, R21
+	MUL   R9, R2, R4
+	ADCS  R4, R22, R22
+	MUL   R10, R2, R4
+	ADCS  R4, R16, R16
+	ADC   ZR, ZR, R17
+	UMULH R6, R2, R5
+	ADDS  R5, R20, R20
+	UMULH R7, R2, R5
+	ADCS  R5, R21, R21
+	UMULH R8, R2, R5
+	ADCS  R5, R22, R22
+	UMULH R9, R2, R5
+	ADCS  R5, R16, R16
+	UMULH R10, R2, R5
+	ADC   R5, R17, R17
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R19, R3
+	MUL   R11, R3, R4
+	ADDS  R4, R19, R19
+	MUL   R12, R3, R4
+	ADCS  R4, R20, R20
+	MUL   R13, R3, R4
+	ADCS  R4, R21, R21
+	MUL   R14, R3, R4
+	ADCS  R4, R22, R22
+	MUL   R15, R3, R4
+	ADCS  R4, R16, R16
+	ADC   ZR, R17, R17
+	UMULH R11, R3, R5
+	ADDS  R5, R20, R20
+	UMULH R12, R3, R5
+	ADCS  R5, R21, R21
+	UMULH R13, R3, R5
+	ADCS  R5, R22, R22
+	UMULH R14, R3, R5
+	ADCS  R5, R16, R16
+	UMULH R15, R3, R5
+	ADC   R5, R17, R17
+	MOVD  24(R0), R2
+
+	// t = t + x * y[3]
+	MUL   R6, R2, R4
+	ADDS  R4, R20, R20
+	MUL   R7, R2, R4
+	ADCS  R4, R21, R21
+	MUL   R8, R2, R4
+	ADCS  R4, R22, R22
+	MUL   R9, R2, R4
+	ADCS  R4, R16, R16
+	MUL   R10, R2, R4
+	ADCS  R4, R17, R17
+	ADC   ZR, ZR, R19
+	UMULH R6, R2, R5
+	ADDS  R5, R21, R21
+	UMULH R7, R2, R5
+	ADCS  R5, R22, R22
+	UMULH R8, R2, R5
+	ADCS  R5, R16, R16
+	UMULH R9, R2, R5
+	ADCS  R5, R17, R17
+	UMULH R10, R2, R5
+	ADC   R5, R19, R19
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R20, R3
+	MUL   R11, R3, R4
+	ADDS  R4, R20, R20
+	MUL   R12, R3, R4
+	ADCS  R4, R21, R21
+	MUL   R13, R3, R4
+	ADCS  R4, R22, R22
+	MUL   R14, R3, R4
+	ADCS  R4, R16, R16
+	MUL   R15, R3, R4
+	ADCS  R4, R17, R17
+	ADC   ZR, R19, R19
+	UMULH R11, R3, R5
+	ADDS  R5, R21, R21
+	UMULH R12, R3, R5
+	ADCS  R5, R22, R22
+	UMULH R13, R3, R5
+	ADCS  R5, R16, R16
+	UMULH R14, R3, R5
+	ADCS  R5, R17, R17
+	UMULH R15, R3, R5
+	ADC   R5, R19, R19
+	MOVD  32(R0), R2
+
+	// t = t + x * y[4]
+	MUL   R6, R2, R4
+	ADDS  R4, R21, R21
+	MUL   R7, R2, R4
+	ADCS  R4, R22, R22
+	MUL   R8, R2, R4
+	ADCS  R4, R16, R16
+	MUL   R9, R2, R4
+	ADCS  R4, R17, R17
+	MUL   R10, R2, R4
+	ADCS  R4, R19, R19
+	ADC   ZR, ZR, R20
+	UMULH R6, R2, R5
+	ADDS  R5, R22, R22
+	UMULH R7, R2, R5
+	ADCS  R5, R16, R16
+	UMULH R8, R2, R5
+	ADCS  R5, R17, R17
+	UMULH R9, R2, R5
+	ADCS  R5, R19, R19
+	UMULH R10, R2, R5
+	ADC   R5, R20, R20
+
+	// m = t[0] * q'[0] mod W; t = (t + m * q) / W
+	MUL   R1, R21, R3
+	MUL   R11, R3, R4
+	ADDS  R4, R21, R21
+	MUL   R12, R3, R4
+	ADCS  R4, R22, R22
+	MUL   R13, R3, R4
+	ADCS  R4, R16, R16
+	MUL   R14, R3, R4
+	ADCS  R4, R17, R17
+	MUL   R15, R3, R4
+	ADCS  R4, R19, R19
+	ADC   ZR, R20, R20
+	UMULH R11, R3, R5
+	ADDS  R5, R22, R22
+	UMULH R12, R3, R5
+	ADCS  R5, R16, R16
+	UMULH R13, R3, R5
+	ADCS  R5, R17, R17
+	UMULH R14, R3, R5
+	ADCS  R5, R19, R19
+	UMULH R15, R3, R5
+	ADC   R5, R20, R20
+
+	// reduce t if t ⩾ q
+	SUBS R11, R22, R6
+	SBCS R12, R16, R7
+	SBCS R13, R17, R8
+	SBCS R14, R19, R9
+	SBCS R15, R20, R10
+	CSEL CS, R6, R22, R22
+	CSEL CS, R7, R16, R16
+	CSEL CS, R8, R17, R17
+	CSEL CS, R9, R19, R19
+	CSEL CS, R10, R20, R20
+	MOVD res+0(FP), R0
+	STP  (R22, R16), 0(R0)
+	STP  (R17, R19), 16(R0)
+	MOVD R20, 32(R0)
+	RET
+
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVD $q<>(SB), R6
+	MOVD 32(R6), R10
+	LDP  16(R6), (R8, R9)
+	LDP  0(R6), (R6, R7)
+	MOVD res+0(FP), R0
+	MOVD 32(R0), R5
+	LDP  16(R0), (R3, R4)
+	LDP  0(R0), (R1, R2)
+	SUBS R6, R1, R11
+	SBCS R7, R2, R12
+	SBCS R8, R3, R13
+	SBCS R9, R4, R14
+	SBCS R10, R5, R15
+	CSEL CS, R11, R1, R1
+	CSEL CS, R12, R2, R2
+	CSEL CS, R13, R3, R3
+	CSEL CS, R14, R4, R4
+	CSEL CS, R15, R5, R5
+	STP  (R1, R2), 0(R0)
+	STP  (R3, R4), 16(R0)
+	MOVD R5, 32(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD $q<>(SB), R12
+	MOVD 32(R12), R16
+	LDP  16(R12), (R14, R15)
+	LDP  0(R12), (R12, R13)
+	MOVD a+0(FP), R0
+	MOVD 32(R0), R6
+	LDP  16(R0), (R4, R5)
+	LDP  0(R0), (R2, R3)
+	MOVD b+8(FP), R1
+	MOVD 32(R1), R11
+	LDP  16(R1), (R9, R10)
+	LDP  0(R1), (R7, R8)
+	ADDS R2, R7, R17
+	ADCS R3, R8, R19
+	ADCS R4, R9, R20
+	ADCS R5, R10, R21
+	ADCS R6, R11, R22
+	SUBS R7, R2, R2
+	SBCS R8, R3, R3
+	SBCS R9, R4, R4
+	SBCS R10, R5, R5
+	SBCS R11, R6, R6
+	CSEL CS, ZR, R12, R7
+	CSEL CS, ZR, R13, R8
+	CSEL CS, ZR, R14, R9
+	CSEL CS, ZR, R15, R10
+	CSEL CS, ZR, R16, R11
+	ADDS R2, R7, R2
+	ADCS R3, R8, R3
+	ADCS R4, R9, R4
+	ADCS R5, R10, R5
+	ADCS R6, R11, R6
+	STP  (R2, R3), 0(R1)
+	STP  (R4, R5), 16(R1)
+	MOVD R6, 32(R1)
+	SUBS R12, R17, R7
+	SBCS R13, R19, R8
+	SBCS R14, R20, R9
+	SBCS R15, R21, R10
+	SBCS R16, R22, R11
+	CSEL CS, R7, R17, R17
+	CSEL CS, R8, R19, R19
+	CSEL CS, R9, R20, R20
+	CSEL CS, R10, R21, R21
+	CSEL CS, R11, R22, R22
+	STP  (R17, R19), 0(R0)
+	STP  (R20, R21), 16(R0)
+	MOVD R22, 32(R0)
+	RET
@@ -18,10 +18,9 @@ package fft
 
 import (
 	"github.com/consensys/gnark-crypto/ecc"
-	"github.com/consensys/gnark-crypto/internal/parallel"
-	"math/bits"
-
 	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/utils"
+	"math/bits"
 )
 
 // Decimation is used in the FFT call to select decimation in time or in frequency
@@ -46,7 +45,7 @@ func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option)
 	if opt.coset {
 		if decimation == DIT {
 			// scale by coset table (in bit reversed order)
-			parallel.Execute(len(a), func(start, end int) {
+			utils.Parallelize(len(a), func(start, end int) {
 				n := uint64(len(a))
 				nn := uint64(64 - bits.TrailingZeros64(n))
 				for i := start; i < end; i++ {
@@ -55,10 +54,9 @@ func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option)
 				}
 			}, opt.nbTasks)
 		} else {
-			parallel.Execute(len(a), func(start, end int) {
-				for i := start; i < end; i++ {
-					a[i].Mul(&a[i], &domain.CosetTable[i])
-				}
+			utils.Parallelize(len(a), func(start, end int) {
+				v := fr.Vector(a[start:end])
+				v.Mul(v, domain.CosetTable[start:end])
 			}, opt.nbTasks)
 		}
 	}
@@ -105,26 +103,24 @@ func (domain *Domain) FFTInverse(a []fr.Element, decimation Decimation, opts ...
 
 	// scale by CardinalityInv
 	if !opt.coset {
-		parallel.Execute(len(a), func(start, end int) {
-			for i := start; i < end; i++ {
-				a[i].Mul(&a[i], &domain.CardinalityInv)
-			}
+		utils.Parallelize(len(a), func(start, end int) {
+			v := fr.Vector(a[start:end])
+			v.ScalarMul(v, &domain.CardinalityInv)
 		}, opt.nbTasks)
 		return
 	}
 
 	if decimation == DIT {
-		parallel.Execute(len(a), func(start, end int) {
-			for i := start; i < end; i++ {
-				a[i].Mul(&a[i], &domain.CosetTableInv[i]).
-					Mul(&a[i], &domain.CardinalityInv)
-			}
+		utils.Parallelize(len(a), func(start, end int) {
+			v := fr.Vector(a[start:end])
+			v.Mul(v, domain.CosetTableInv[start:end])
+			v.ScalarMul(v, &domain.CardinalityInv)
 		}, opt.nbTasks)
 		return
 	}
 
 	// decimation == DIF, need to access coset table in bit reversed order.
-	parallel.Execute(len(a), func(start, end int) {
+	utils.Parallelize(len(a), func(start, end int) {
 		n := uint64(len(a))
 		nn := uint64(64 - bits.TrailingZeros64(n))
 		for i := start; i < end; i++ {
@@ -155,19 +151,11 @@ func difFFT(a []fr.Element, twiddles [][]fr.Element, stage, maxSplits int, chDon
 	if (m > butterflyThreshold) && (stage < maxSplits) {
 		// 1 << stage == estimated used CPUs
 		numCPU := nbTasks / (1 << (stage))
-		parallel.Execute(m, func(start, end int) {
-			for i := start; i < end; i++ {
-				fr.Butterfly(&a[i], &a[i+m])
-				a[i+m].Mul(&a[i+m], &twiddles[stage][i])
-			}
+		utils.Parallelize(m, func(start, end int) {
+			innerDIFWithTwiddles(a, twiddles[stage], start, end, m)
 		}, numCPU)
 	} else {
-		// i == 0
-		fr.Butterfly(&a[0], &a[m])
-		for i := 1; i < m; i++ {
-			fr.Butterfly(&a[i], &a[i+m])
-			a[i+m].Mul(&a[i+m], &twiddles[stage][i])
-		}
+		innerDIFWithTwiddles(a, twiddles[stage], 0, m, m)
 	}
 
 	if m == 1 {
@@ -219,19 +207,32 @@ func ditFFT(a []fr.Element, twiddles [][]fr.Element, stage, maxSplits int, chDon
 	if (m > butterflyThreshold) && (stage < maxSplits) {
 		// 1 << stage == estimated used CPUs
 		numCPU := nbTasks / (1 << (stage))
-		parallel.Execute(m, func(start, end int) {
-			for k := start; k < end; k++ {
-				a[k+m].Mul(&a[k+m], &twiddles[stage][k])
-				fr.Butterfly(&a[k], &a[k+m])
-			}
+		utils.Parallelize(m, func(start, end int) {
+			innerDITWithTwiddles(a, twiddles[stage], start, end, m)
 		}, numCPU)
 
 	} else {
-		fr.Butterfly(&a[0], &a[m])
-		for k := 1; k < m; k++ {
-			a[k+m].Mul(&a[k+m], &twiddles[stage][k])
-			fr.Butterfly(&a[k], &a[k+m])
-		}
+		innerDITWithTwiddles(a, twiddles[stage], 0, m, m)
+	}
+}
+
+// innerDIFWithTwiddles applies the butterflies a[i], a[i+m] for start ≤ i < end,
+// then multiplies a[i+m] by the twiddles
+func innerDIFWithTwiddles(a []fr.Element, twiddles []fr.Element, start, end, m int) {
+	for i := start; i < end; i++ {
+		fr.Butterfly(&a[i], &a[i+m])
+	}
+	v := fr.Vector(a[start+m : end+m])
+	v.Mul(v, twiddles[start:end])
+}
+
+// innerDITWithTwiddles multiplies a[i+m] by the twiddles, then applies the butterflies
+// a[i], a[i+m] for start ≤ i < end
+func innerDITWithTwiddles(a []fr.Element, twiddles []fr.Element, start, end, m int) {
+	v := fr.Vector(a[start+m : end+m])
+	v.Mul(v, twiddles[start:end])
+	for i := start; i < end; i++ {
+		fr.Butterfly(&a[i], &a[i+m])
 	}
 }
 
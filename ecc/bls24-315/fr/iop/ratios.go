@@ -125,9 +125,8 @@ func BuildRatioShuffledVectors(numerator, denominator []*Polynomial, beta fr.Ele
 	}
 
 	t = fr.BatchInvert(t)
-	for i := 1; i < n; i++ {
-		coeffs[i].Mul(&coeffs[i], &t[i])
-	}
+	r := fr.Vector(coeffs[1:n])
+	r.Mul(r, t[1:n])
 
 	res := NewPolynomial(&coeffs, expectedForm)
 
@@ -243,9 +242,8 @@ func BuildRatioCopyConstraint(
 		start++
 		end++
 		tInv := fr.BatchInvert(t[start:end])
-		for i := start; i < end; i++ {
-			coeffs[i].Mul(&coeffs[i], &tInv[i-start])
-		}
+		c := fr.Vector(coeffs[start:end])
+		c.Mul(c, tInv)
 	}, nbTasks)
 
 	res := NewPolynomial(&coeffs, expectedForm)
@@ -17,11 +17,13 @@
 package pedersen
 
 import (
+	"crypto/rand"
 	"fmt"
 	curve "github.com/consensys/gnark-crypto/ecc/bls24-315"
 	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
 	"github.com/consensys/gnark-crypto/utils"
 	"github.com/stretchr/testify/assert"
+	mrand "math/rand"
 	"testing"
 )
 
@@ -45,12 +47,10 @@ func randomFrSlice(t *testing.T, size int) []interface{} {
 	return res
 }
 
-func randomOnG1() (curve.G1Affine, error) { // TODO: Add to G1.go?
-	if gBytes, err := randomFrSizedBytes(); err != nil {
-		return curve.G1Affine{}, err
-	} else {
-		return curve.HashToG1(gBytes, []byte("random on g1"))
-	}
+func randomOnG1() (curve.G1Affine, error) {
+	var p curve.G1Affine
+	_, err := p.RandomPoint(rand.Reader)
+	return p, err
 }
 
 func randomG1Slice(t *testing.T, size int) []curve.G1Affine {
@@ -164,6 +164,26 @@ func TestCommitFiveElements(t *testing.T) {
 	testCommit(t, randomFrSlice(t, 5)...)
 }
 
+func TestSetupFrom(t *testing.T) {
+	basis := randomG1Slice(t, 5)
+
+	// the same seed yields the same keys
+	pk1, vk1, err := SetupFrom(mrand.New(mrand.NewSource(42)), basis) //#nosec G404 -- deterministic test fixture
+	assert.NoError(t, err)
+	pk2, vk2, err := SetupFrom(mrand.New(mrand.NewSource(42)), basis) //#nosec G404 -- deterministic test fixture
+	assert.NoError(t, err)
+	assert.True(t, vk1.g.Equal(&vk2.g) && vk1.gRootSigmaNeg.Equal(&vk2.gRootSigmaNeg))
+	assert.Equal(t, pk1[0].basisExpSigma, pk2[0].basisExpSigma)
+
+	// and the keys are consistent
+	values := interfaceSliceToFrSlice(t, randomFrSlice(t, 5)...)
+	commitment, err := pk1[0].Commit(values)
+	assert.NoError(t, err)
+	pok, err := pk1[0].ProveKnowledge(values)
+	assert.NoError(t, err)
+	assert.NoError(t, vk1.Verify(commitment, pok))
+}
+
 func TestMarshal(t *testing.T) {
 	var pk ProvingKey
 	pk.basisExpSigma = randomG1Slice(t, 5)
@@ -173,9 +193,9 @@ func TestMarshal(t *testing.T) {
 		vk  VerifyingKey
 		err error
 	)
-	vk.g, err = randomOnG2()
+	_, err = vk.g.RandomPoint(rand.Reader)
 	assert.NoError(t, err)
-	vk.gRootSigmaNeg, err = randomOnG2()
+	_, err = vk.gRootSigmaNeg.RandomPoint(rand.Reader)
 	assert.NoError(t, err)
 
 	t.Run("ProvingKey -> Bytes -> ProvingKey must remain identical.", utils.SerializationRoundTrip(&pk))
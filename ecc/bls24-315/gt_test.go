@@ -0,0 +1,282 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestMultiExpGT(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 2
+
+	properties := gopter.NewProperties(parameters)
+
+	const nbBases = 40
+	bases := make([]GT, nbBases)
+	for i := range bases {
+		bases[i] = randomGT(t)
+	}
+	// the identity must be handled as any other base
+	bases[3].SetOne()
+
+	properties.Property("[BLS24-315] MultiExpGT should be consistent with ExpGLV", prop.ForAll(
+		func(mixer fr.Element) bool {
+			scalars := make([]fr.Element, nbBases)
+			scalars[0].Set(&mixer)
+			for i := 1; i < nbBases; i++ {
+				scalars[i].Mul(&scalars[i-1], &mixer)
+			}
+			// extreme scalars
+			scalars[1].SetZero()
+			scalars[2].SetOne().Neg(&scalars[2])
+
+			var expected, tmp GT
+			expected.SetOne()
+			var s big.Int
+			for i := range bases {
+				scalars[i].BigInt(&s)
+				tmp.ExpGLV(bases[i], &s)
+				expected.Mul(&expected, &tmp)
+			}
+
+			res, err := MultiExpGT(bases, scalars, ecc.MultiExpConfig{})
+			if err != nil {
+				return false
+			}
+			res1, err := MultiExpGT(bases[:1], scalars[:1], ecc.MultiExpConfig{NbTasks: 1})
+			if err != nil {
+				return false
+			}
+			tmp.ExpGLV(bases[0], scalars[0].BigInt(&s))
+			return res.Equal(&expected) && res1.Equal(&tmp)
+		},
+		GenFr(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	if _, err := MultiExpGT(bases, make([]fr.Element, nbBases-1), ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("MultiExpGT should fail with len(bases) != len(scalars)")
+	}
+}
+
+func TestGTFixedBaseTable(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	g := randomGT(t)
+	var tables []*GTFixedBaseTable
+	for _, c := range []uint64{0, 2, 7} {
+		table, err := NewGTFixedBaseTable(&g, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tables = append(tables, table)
+	}
+
+	properties.Property("[BLS24-315] GTFixedBaseTable.Exp should be consistent with ExpGLV", prop.ForAll(
+		func(k big.Int) bool {
+			// k is not reduced: exercise the reduction and the negative scalars
+			var r big.Int
+			r.Lsh(&k, 3).Sub(&r, fr.Modulus())
+
+			// ExpGLV expects a reduced scalar
+			var expected GT
+			var rr big.Int
+			expected.ExpGLV(g, rr.Mod(&r, fr.Modulus()))
+			for _, table := range tables {
+				res := table.Exp(&r)
+				if !res.Equal(&expected) {
+					return false
+				}
+			}
+			return true
+		},
+		GenBigInt(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	if _, err := NewGTFixedBaseTable(&g, 1); err == nil {
+		t.Fatal("NewGTFixedBaseTable should fail with c == 1")
+	}
+	var notInGT GT
+	notInGT.SetRandom()
+	if _, err := NewGTFixedBaseTable(&notInGT, 0); err == nil {
+		t.Fatal("NewGTFixedBaseTable should fail with a base not in GT")
+	}
+}
+
+func TestGTSerialization(t *testing.T) {
+	t.Parallel()
+
+	var one GT
+	one.SetOne()
+	elements := []GT{one, randomGT(t), randomGT(t)}
+
+	for _, z := range elements {
+		b, err := GTBytesCompressed(&z)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var res GT
+		if err := GTSetBytesCompressed(&res, b[:]); err != nil {
+			t.Fatal(err)
+		}
+		if !res.Equal(&z) {
+			t.Fatal("decompress(compress(z)) != z")
+		}
+
+		// the encoder must pick the decoding up from the first byte
+		var buf bytes.Buffer
+		enc, encRaw := NewEncoder(&buf), NewEncoder(&buf, RawEncoding())
+		if err := enc.Encode(&z); err != nil {
+			t.Fatal(err)
+		}
+		if err := encRaw.Encode(&z); err != nil {
+			t.Fatal(err)
+		}
+		if enc.BytesWritten() != SizeOfGTCompressed || encRaw.BytesWritten() != SizeOfGT {
+			t.Fatal("unexpected encoding size")
+		}
+		dec := NewDecoder(&buf)
+		var res1, res2 GT
+		if err := dec.Decode(&res1); err != nil {
+			t.Fatal(err)
+		}
+		if err := dec.Decode(&res2); err != nil {
+			t.Fatal(err)
+		}
+		if !res1.Equal(&z) || !res2.Equal(&z) {
+			t.Fatal("decode(encode(GT)) failed")
+		}
+	}
+
+	// an element of the torus which is not in GT
+	var notInGT, c GT
+	c.D0.SetRandom()
+	notInGT = c.D0.DecompressTorus()
+	b, err := GTBytesCompressed(&notInGT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var res GT
+	if err := GTSetBytesCompressed(&res, b[:]); err == nil {
+		t.Fatal("decompression should fail on an element not in GT")
+	}
+	if err := gtSetBytesCompressed(&res, b[:], false); err != nil || !res.Equal(&notInGT) {
+		t.Fatal("decompression without subgroup check failed")
+	}
+
+	// invalid flags
+	b[0] &^= mGTMask
+	if err := GTSetBytesCompressed(&res, b[:]); err != ErrInvalidEncoding {
+		t.Fatal("expected ErrInvalidEncoding")
+	}
+	b[0] |= mGTCompressedOne
+	if err := GTSetBytesCompressed(&res, b[:]); err != ErrInvalidEncoding {
+		t.Fatal("expected ErrInvalidEncoding")
+	}
+	if err := GTSetBytesCompressed(&res, b[1:]); err == nil {
+		t.Fatal("decompression should fail on a short buffer")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkMultiExpGT(b *testing.B) {
+	const nbBases = 1 << 7
+	bases := make([]GT, nbBases)
+	scalars := make([]fr.Element, nbBases)
+	for i := range bases {
+		bases[i] = randomGT(b)
+		scalars[i].SetRandom()
+	}
+
+	b.Run("MultiExpGT", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			MultiExpGT(bases, scalars, ecc.MultiExpConfig{})
+		}
+	})
+
+	b.Run("ExpGLV", func(b *testing.B) {
+		var res, tmp GT
+		var s big.Int
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			res.SetOne()
+			for i := range bases {
+				tmp.ExpGLV(bases[i], scalars[i].BigInt(&s))
+				res.Mul(&res, &tmp)
+			}
+		}
+	})
+}
+
+func BenchmarkGTFixedBaseTable(b *testing.B) {
+	g := randomGT(b)
+	table, err := NewGTFixedBaseTable(&g, 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var s fr.Element
+	s.SetRandom()
+	var k big.Int
+	s.BigInt(&k)
+
+	b.Run("Exp", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			table.Exp(&k)
+		}
+	})
+
+	b.Run("ExpGLV", func(b *testing.B) {
+		var res GT
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			res.ExpGLV(g, &k)
+		}
+	})
+}
+
+// randomGT returns a random element of GT.
+func randomGT(tb testing.TB) GT {
+	var z GT
+	if _, err := z.SetRandom(); err != nil {
+		tb.Fatal(err)
+	}
+	return FinalExponentiation(&z)
+}
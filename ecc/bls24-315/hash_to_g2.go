@@ -1,4 +1,4 @@
-// Copyright 2020 ConsenSys AG
+// Copyright 2020 Consensys Software Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -12,136 +12,239 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
 package bls24315
 
 import (
 	"github.com/consensys/gnark-crypto/ecc/bls24-315/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls24-315/internal/fptower"
+	"github.com/consensys/gnark-crypto/field/hash"
 )
 
-// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-4.1
-// Shallue and van de Woestijne method, works for any elliptic curve in Weierstrass curve
-func MapToCurve2(u fptower.E4) G2Affine {
+// MapToCurve2 implements the Shallue and van de Woestijne method, applicable to any elliptic curve in Weierstrass form
+// No cofactor clearing or isogeny
+// https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#straightline-svdw
+func MapToCurve2(u *fptower.E4) G2Affine {
+	var tv1, tv2, tv3, tv4 fptower.E4
+	var x1, x2, x3, gx1, gx2, gx, x, y fptower.E4
+	var one fptower.E4
+	var gx1NotSquare, gx1SquareOrGx2Not int
 
-	var res G2Affine
+	//constants
+	//c1 = g(Z)
+	//c2 = -Z / 2
+	//c3 = sqrt(-g(Z) * (3 * Z² + 4 * A))     # sgn0(c3) MUST equal 0
+	//c4 = -4 * g(Z) / (3 * Z² + 4 * A)
 
-	// constants
-	// sage script to find z: https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#appendix-E.1
-	var z, c1, c2, c3, c4 fptower.E4
-	z.B0.A0.SetOne()
-	z.B0.A1.SetString("0")
-	z.B1.A0.SetOne()
-	z.B1.A1.SetString("0")
-	c1.B0.A0.SetOne()
-	c1.B0.A1.SetOne()
-	c1.B1.A0.SetString("2")
-	c1.B1.A1.SetString("6108483493771298205388567675447533806912846525679192205394505462405828322019437284165171866703")
-	c2.B0.A0.SetString("19852571354756719167512844945204484872466751208457374667532142752818942046563171173536808566784")
-	c2.B0.A1.SetString("0")
-	c2.B1.A0.SetString("19852571354756719167512844945204484872466751208457374667532142752818942046563171173536808566784")
-	c2.B1.A1.SetString("0")
-
-	c3.B0.A0.SetString("14181901575451930365156064137229663961789100070994427419777314377609453770227083005360995137239")
-	c3.B0.A1.SetString("38867788984497805540592493226397363174027239449768861944710564870925669104016488974244557160817")
-	c3.B1.A0.SetString("7207770078990411004130237352587865513334954456592365258287987262730492706089979112564450405406")
-	c3.B1.A1.SetString("11314632945591044023254019576500732396578160594635551958097682961894415495755352199773541527735")
-
-	var tv1, tv2, tv3, tv4, one, x1, gx1, x2, gx2, x3, x, gx, y fptower.E4
-	one.SetOne()
-	tv1.Square(&u).Mul(&tv1, &c1)
-	tv2.Add(&one, &tv1)
-	tv1.Sub(&one, &tv1)
-	tv3.Mul(&tv2, &tv1).Inverse(&tv3)
-	tv4.Mul(&u, &tv1)
-	tv4.Mul(&tv4, &tv3)
-	tv4.Mul(&tv4, &c3)
-	x1.Sub(&c2, &tv4)
-	gx1.Square(&x1)
-	// 12. gx1 = gx1 + A
-	gx1.Mul(&gx1, &x1)
-	gx1.Add(&gx1, &bTwistCurveCoeff)
-	e1 := gx1.Legendre()
-	x2.Add(&c2, &tv4)
-	gx2.Square(&x2)
-	// 18. gx2 = gx2 + A
-	gx2.Mul(&gx2, &x2)
-	gx2.Add(&gx2, &bTwistCurveCoeff)
-	e2 := gx2.Legendre() - e1 // 2 if is_square(gx2) AND NOT e1
-	x3.Square(&tv2)
-	x3.Mul(&x3, &tv3)
-	x3.Square(&x3)
-	x3.Mul(&x3, &c4)
-	x3.Add(&x3, &z)
-	if e1 == 1 {
-		x.Set(&x1)
-	} else {
-		x.Set(&x3)
+	Z := fptower.E4{
+		B0: fptower.E2{
+			A0: fp.Element{15644621960581742324, 14386572475225096562, 14779164838037544250, 1810167257004564450, 336438643706536802},
+			A1: fp.Element{0},
+		},
+		B1: fptower.E2{
+			A0: fp.Element{0},
+			A1: fp.Element{0},
+		},
+	}
+	c1 := fptower.E4{
+		B0: fptower.E2{
+			A0: fp.Element{13119346002479801788, 5508682562602331132, 2693720390917035447, 10725394115481631913, 181358774020922624},
+			A1: fp.Element{0},
+		},
+		B1: fptower.E2{
+			A0: fp.Element{0},
+			A1: fp.Element{14835018474091022805, 4059211274438447823, 17174191230683291349, 5795645494093750226, 179263826259076473},
+		},
+	}
+	c2 := fptower.E4{
+		B0: fptower.E2{
+			A0: fp.Element{241387447832805511, 16017956017755374665, 8661756940720024642, 14368673898014568126, 174680983090168991},
+			A1: fp.Element{0},
+		},
+		B1: fptower.E2{
+			A0: fp.Element{0},
+			A1: fp.Element{0},
+		},
 	}
-	if e2 == 2 {
-		x.Set(&x2)
+	c3 := fptower.E4{
+		B0: fptower.E2{
+			A0: fp.Element{8608040618173677336, 12992626867810906763, 10537332463469871907, 13701323202750985316, 132262621700990924},
+			A1: fp.Element{16093789481466725564, 213006022164820730, 5901318066023518771, 15525524396345576190, 112273188909682544},
+		},
+		B1: fptower.E2{
+			A0: fp.Element{13279405329144288278, 8754492082728303908, 17743014495387614316, 9356200086446198398, 47354393053587643},
+			A1: fp.Element{9685158795349718112, 6166312447119655722, 5792683652770169056, 2501625840190724931, 294617562142770352},
+		},
 	}
-	gx.Square(&x)
-	// gx = gx + A
-	gx.Mul(&gx, &x)
-	gx.Add(&gx, &bTwistCurveCoeff)
-	y.Sqrt(&gx)
-	e3 := sign0(u.B0.A0) && sign0(y.B0.A0)
-	if !e3 {
-		y.Neg(&y)
+	c4 := fptower.E4{
+		B0: fptower.E2{
+			A0: fp.Element{8338846030432130748, 11766226373523768511, 7845147386838187228, 5653624310210013457, 8615548315867454},
+			A1: fp.Element{0},
+		},
+		B1: fptower.E2{
+			A0: fp.Element{0},
+			A1: fp.Element{5721607016346412076, 16622656440739077206, 1722692858955686562, 7405955712039004594, 246471490290615841},
+		},
 	}
-	res.X.Set(&x)
-	res.Y.Set(&y)
 
-	return res
+	one.SetOne()
+
+	tv1.Square(u)       //    1.  tv1 = u²
+	tv1.Mul(&tv1, &c1)  //    2.  tv1 = tv1 * c1
+	tv2.Add(&one, &tv1) //    3.  tv2 = 1 + tv1
+	tv1.Sub(&one, &tv1) //    4.  tv1 = 1 - tv1
+	tv3.Mul(&tv1, &tv2) //    5.  tv3 = tv1 * tv2
+
+	tv3.Inverse(&tv3)   //    6.  tv3 = inv0(tv3)
+	tv4.Mul(u, &tv1)    //    7.  tv4 = u * tv1
+	tv4.Mul(&tv4, &tv3) //    8.  tv4 = tv4 * tv3
+	tv4.Mul(&tv4, &c3)  //    9.  tv4 = tv4 * c3
+	x1.Sub(&c2, &tv4)   //    10.  x1 = c2 - tv4
+
+	gx1.Square(&x1) //    11. gx1 = x1²
+	//12. gx1 = gx1 + A     All curves in gnark-crypto have A=0 (j-invariant=0). It is crucial to include this step if the curve has nonzero A coefficient.
+	gx1.Mul(&gx1, &x1)                 //    13. gx1 = gx1 * x1
+	gx1.Add(&gx1, &bTwistCurveCoeff)   //    14. gx1 = gx1 + B
+	gx1NotSquare = gx1.Legendre() >> 1 //    15.  e1 = is_square(gx1)
+	// gx1NotSquare = 0 if gx1 is a square, -1 otherwise
+
+	x2.Add(&c2, &tv4) //    16.  x2 = c2 + tv4
+	gx2.Square(&x2)   //    17. gx2 = x2²
+	//    18. gx2 = gx2 + A     See line 12
+	gx2.Mul(&gx2, &x2)               //    19. gx2 = gx2 * x2
+	gx2.Add(&gx2, &bTwistCurveCoeff) //    20. gx2 = gx2 + B
+
+	{
+		gx2NotSquare := gx2.Legendre() >> 1              // gx2Square = 0 if gx2 is a square, -1 otherwise
+		gx1SquareOrGx2Not = gx2NotSquare | ^gx1NotSquare //    21.  e2 = is_square(gx2) AND NOT e1   # Avoid short-circuit logic ops
+	}
+
+	x3.Square(&tv2)   //    22.  x3 = tv2²
+	x3.Mul(&x3, &tv3) //    23.  x3 = x3 * tv3
+	x3.Square(&x3)    //    24.  x3 = x3²
+	x3.Mul(&x3, &c4)  //    25.  x3 = x3 * c4
+
+	x3.Add(&x3, &Z)                  //    26.  x3 = x3 + Z
+	x.Select(gx1NotSquare, &x1, &x3) //    27.   x = CMOV(x3, x1, e1)   # x = x1 if gx1 is square, else x = x3
+	// Select x1 iff gx1 is square iff gx1NotSquare = 0
+	x.Select(gx1SquareOrGx2Not, &x2, &x) //    28.   x = CMOV(x, x2, e2)    # x = x2 if gx2 is square and gx1 is not
+	// Select x2 iff gx2 is square and gx1 is not, iff gx1SquareOrGx2Not = 0
+	gx.Square(&x) //    29.  gx = x²
+	//    30.  gx = gx + A
+
+	gx.Mul(&gx, &x)                //    31.  gx = gx * x
+	gx.Add(&gx, &bTwistCurveCoeff) //    32.  gx = gx + B
+
+	y.Sqrt(&gx)                             //    33.   y = sqrt(gx)
+	signsNotEqual := g2Sgn0(u) ^ g2Sgn0(&y) //    34.  e3 = sgn0(u) == sgn0(y)
+
+	tv1.Neg(&y)
+	y.Select(int(signsNotEqual), &y, &tv1) //    35.   y = CMOV(-y, y, e3)       # Select correct sign of y
+	return G2Affine{x, y}
 }
 
-// MapToG2 maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
-// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-2.2.1
-func MapToG2(t fptower.E4) G2Affine {
-	res := MapToCurve2(t)
+// g2Sgn0 is an algebraic substitute for the notion of sign in ordered fields
+// Namely, every non-zero quadratic residue in a finite field of characteristic =/= 2 has exactly two square roots, one of each sign
+// https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#name-the-sgn0-function
+// The sign of an element is not obviously related to that of its Montgomery form
+func g2Sgn0(z *fptower.E4) uint64 {
+
+	nonMont := z.Bits()
+
+	sign := uint64(0) // 1. sign = 0
+	zero := uint64(1) // 2. zero = 1
+	var signI uint64
+	var zeroI uint64
+
+	// 3. i = 1
+	signI = nonMont.B0.A0[0] % 2 // 4.   sign_i = x_i mod 2
+	zeroI = g1NotZero(&nonMont.B0.A0)
+	zeroI = 1 ^ (zeroI|-zeroI)>>63 // 5.   zero_i = x_i == 0
+	sign = sign | (zero & signI)   // 6.   sign = sign OR (zero AND sign_i) # Avoid short-circuit logic ops
+	zero = zero & zeroI            // 7.   zero = zero AND zero_i
+	// 3. i = 2
+	signI = nonMont.B0.A1[0] % 2 // 4.   sign_i = x_i mod 2
+	zeroI = g1NotZero(&nonMont.B0.A1)
+	zeroI = 1 ^ (zeroI|-zeroI)>>63 // 5.   zero_i = x_i == 0
+	sign = sign | (zero & signI)   // 6.   sign = sign OR (zero AND sign_i) # Avoid short-circuit logic ops
+	zero = zero & zeroI            // 7.   zero = zero AND zero_i
+	// 3. i = 3
+	signI = nonMont.B1.A0[0] % 2 // 4.   sign_i = x_i mod 2
+	zeroI = g1NotZero(&nonMont.B1.A0)
+	zeroI = 1 ^ (zeroI|-zeroI)>>63 // 5.   zero_i = x_i == 0
+	sign = sign | (zero & signI)   // 6.   sign = sign OR (zero AND sign_i) # Avoid short-circuit logic ops
+	zero = zero & zeroI            // 7.   zero = zero AND zero_i
+	// 3. i = 4
+	signI = nonMont.B1.A1[0] % 2 // 4.   sign_i = x_i mod 2
+	// 5.   zero_i = x_i == 0
+	sign = sign | (zero & signI) // 6.   sign = sign OR (zero AND sign_i) # Avoid short-circuit logic ops
+	// 7.   zero = zero AND zero_i
+	return sign
+
+}
+
+// MapToG2 invokes the SVDW map, and guarantees that the result is in g2
+func MapToG2(u fptower.E4) G2Affine {
+	res := MapToCurve2(&u)
 	res.ClearCofactor(&res)
 	return res
 }
 
-// EncodeToG2 maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
-// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-2.2.2
-func EncodeToG2(msg, dst []byte) (G2Affine, error) {
+// EncodeToG2 hashes a message to a point on the G2 curve using the SVDW map.
+// It is faster than HashToG2, but the result is not uniformly distributed. Unsuitable as a random oracle.
+// dst stands for "domain separation tag", a string unique to the construction using the hash function
+// The ciphersuite defaults to expand_message_xmd with SHA-256, and can be changed with hash.Option.
+// https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#roadmap
+func EncodeToG2(msg, dst []byte, opts ...hash.Option) (G2Affine, error) {
+
 	var res G2Affine
-	_t, err := fp.Hash(msg, dst, 2)
+	u, err := fp.Hash(msg, dst, 4, opts...)
 	if err != nil {
 		return res, err
 	}
-	var t fptower.E4
-	t.B0.A0.Set(&_t[0])
-	t.B1.A0.Set(&_t[1])
-	res = MapToG2(t)
+
+	res = MapToCurve2(&fptower.E4{
+		B0: fptower.E2{A0: u[0], A1: u[1]},
+		B1: fptower.E2{A0: u[2], A1: u[3]},
+	})
+
+	res.ClearCofactor(&res)
 	return res, nil
 }
 
-// HashToG2 maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
-// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-3
-func HashToG2(msg, dst []byte) (G2Affine, error) {
-	var res G2Affine
-	u, err := fp.Hash(msg, dst, 4)
+// HashToG2 hashes a message to a point on the G2 curve using the SVDW map.
+// Slower than EncodeToG2, but usable as a random oracle.
+// dst stands for "domain separation tag", a string unique to the construction using the hash function
+// The ciphersuite defaults to expand_message_xmd with SHA-256, and can be changed with hash.Option.
+// https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#roadmap
+func HashToG2(msg, dst []byte, opts ...hash.Option) (G2Affine, error) {
+	u, err := fp.Hash(msg, dst, 2*4, opts...)
 	if err != nil {
-		return res, err
+		return G2Affine{}, err
 	}
-	var u0, u1 fptower.E4
-	u0.B0.A0.Set(&u[0])
-	u0.B1.A0.Set(&u[1])
-	u1.B0.A0.Set(&u[2])
-	u1.B1.A0.Set(&u[3])
-	Q0 := MapToG2(u0)
-	Q1 := MapToG2(u1)
-	var _Q0, _Q1, _res G2Jac
+
+	Q0 := MapToCurve2(&fptower.E4{
+		B0: fptower.E2{A0: u[0], A1: u[1]},
+		B1: fptower.E2{A0: u[2], A1: u[3]},
+	})
+	Q1 := MapToCurve2(&fptower.E4{
+		B0: fptower.E2{A0: u[4], A1: u[5]},
+		B1: fptower.E2{A0: u[6], A1: u[7]},
+	})
+
+	var _Q0, _Q1 G2Jac
 	_Q0.FromAffine(&Q0)
-	_Q1.FromAffine(&Q1)
-	_res.Set(&_Q1).AddAssign(&_Q0)
-	res.FromJacobian(&_res)
-	return res, nil
+	_Q1.FromAffine(&Q1).AddAssign(&_Q0)
+
+	_Q1.ClearCofactor(&_Q1)
+
+	Q1.FromJacobian(&_Q1)
+	return Q1, nil
 }
 
-// returns false if u>-u when seen as a bigInt
-func sign0(u fp.Element) bool {
-	return !u.LexicographicallyLargest()
+func g2NotZero(x *fptower.E4) uint64 {
+	//Assuming G1 is over Fp and that if hashing is available for G2, it also is for G1
+	return g1NotZero(&x.B0.A0) | g1NotZero(&x.B0.A1) | g1NotZero(&x.B1.A0) | g1NotZero(&x.B1.A1)
+
 }
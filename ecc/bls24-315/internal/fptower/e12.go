@@ -15,6 +15,8 @@
 package fptower
 
 import (
+	"crypto/rand"
+	"io"
 	"math/big"
 )
 
@@ -58,13 +60,18 @@ func (z *E12) SetOne() *E12 {
 
 // SetRandom set z to a random elmt
 func (z *E12) SetRandom() (*E12, error) {
-	if _, err := z.C0.SetRandom(); err != nil {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom is like SetRandom, reading the randomness from r
+func (z *E12) SetRandomFrom(r io.Reader) (*E12, error) {
+	if _, err := z.C0.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
-	if _, err := z.C1.SetRandom(); err != nil {
+	if _, err := z.C1.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
-	if _, err := z.C2.SetRandom(); err != nil {
+	if _, err := z.C2.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
 	return z, nil
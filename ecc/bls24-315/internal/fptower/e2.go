@@ -15,6 +15,8 @@
 package fptower
 
 import (
+	"crypto/rand"
+	"io"
 	"math/big"
 
 	"github.com/consensys/gnark-crypto/ecc/bls24-315/fp"
@@ -30,6 +32,14 @@ func (z *E2) Equal(x *E2) bool {
 	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
 }
 
+// Bits returns the coordinates of z in regular (non-Montgomery) form, stored in a E2
+func (z *E2) Bits() E2 {
+	r := E2{}
+	r.A0 = z.A0.Bits()
+	r.A1 = z.A1.Bits()
+	return r
+}
+
 // Cmp compares (lexicographic order) z and x and returns:
 //
 //	-1 if z <  x
@@ -82,10 +92,15 @@ func (z *E2) SetOne() *E2 {
 
 // SetRandom sets a0 and a1 to random values
 func (z *E2) SetRandom() (*E2, error) {
-	if _, err := z.A0.SetRandom(); err != nil {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom is like SetRandom, reading the randomness from r
+func (z *E2) SetRandomFrom(r io.Reader) (*E2, error) {
+	if _, err := z.A0.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
-	if _, err := z.A1.SetRandom(); err != nil {
+	if _, err := z.A1.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
 	return z, nil
@@ -226,6 +241,14 @@ func (z *E2) Sqrt(x *E2) *E2 {
 	return z
 }
 
+// Select is a constant-time conditional move.
+// If cond=0, z = caseZ. Else z = caseNz
+func (z *E2) Select(cond int, caseZ *E2, caseNz *E2) *E2 {
+	z.A0.Select(cond, &caseZ.A0, &caseNz.A0)
+	z.A1.Select(cond, &caseZ.A1, &caseNz.A1)
+	return z
+}
+
 func (z *E2) Div(x *E2, y *E2) *E2 {
 	var r E2
 	r.Inverse(y).Mul(x, &r)
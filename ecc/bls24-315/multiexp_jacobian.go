@@ -16,12 +16,16 @@
 
 package bls24315
 
+// processChunkG1Jacobian process a chunk of the scalars during the msm, using
+// g1JacExtended buckets. If chSum is not nil, the plain sum of the buckets is sent to it
+// after the weighted sum is sent to chRes.
 func processChunkG1Jacobian[B ibg1JacExtended](chunk uint64,
 	chRes chan<- g1JacExtended,
 	c uint64,
 	points []G1Affine,
 	digits []uint16,
-	sem chan struct{}) {
+	sem chan struct{},
+	chSum chan<- g1JacExtended) {
 
 	if sem != nil {
 		// if we are limited, wait for a token in the semaphore
@@ -69,6 +73,10 @@ func processChunkG1Jacobian[B ibg1JacExtended](chunk uint64,
 	}
 
 	chRes <- total
+	if chSum != nil {
+		// runningSum = bucket[0] + bucket[1] + ... + bucket[n-1]
+		chSum <- runningSum
+	}
 }
 
 // we declare the buckets as fixed-size array types
@@ -105,12 +113,16 @@ type ibg1JacExtended interface {
 		bucketg1JacExtendedC16
 }
 
+// processChunkG2Jacobian process a chunk of the scalars during the msm, using
+// g2JacExtended buckets. If chSum is not nil, the plain sum of the buckets is sent to it
+// after the weighted sum is sent to chRes.
 func processChunkG2Jacobian[B ibg2JacExtended](chunk uint64,
 	chRes chan<- g2JacExtended,
 	c uint64,
 	points []G2Affine,
 	digits []uint16,
-	sem chan struct{}) {
+	sem chan struct{},
+	chSum chan<- g2JacExtended) {
 
 	if sem != nil {
 		// if we are limited, wait for a token in the semaphore
@@ -158,6 +170,10 @@ func processChunkG2Jacobian[B ibg2JacExtended](chunk uint64,
 	}
 
 	chRes <- total
+	if chSum != nil {
+		// runningSum = bucket[0] + bucket[1] + ... + bucket[n-1]
+		chSum <- runningSum
+	}
 }
 
 // we declare the buckets as fixed-size array types
@@ -0,0 +1,482 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"errors"
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+	"io"
+	"math"
+	"runtime"
+	"sync/atomic"
+)
+
+// defaultStreamChunkSize is the number of points decoded at once by MultiExpStream
+// when no chunk size is provided.
+const defaultStreamChunkSize = 1 << 16
+
+// MultiExpStream computes the multi-exponentiation of the points read from r by scalars.
+//
+// r must contain a slice of points as written by Encoder.Encode([]G1Affine) (with or without
+// point compression); the points are decoded by chunks of chunkSize points (a default value is used
+// if chunkSize <= 0) and accumulated into the buckets of the bucket method as they are read, such that
+// the whole slice of points never needs to fit in memory. If the stream contains more points than
+// scalars, only the first len(scalars) points are read.
+//
+// Decoder options (NoSubgroupChecks) are honored when decoding the points.
+// This call return an error if the stream contains less than len(scalars) points, if a point
+// can't be decoded, or if provided config is invalid.
+func (p *G1Affine) MultiExpStream(r io.Reader, scalars []fr.Element, chunkSize int, config ecc.MultiExpConfig, options ...func(*Decoder)) (*G1Affine, error) {
+	var _p G1Jac
+	if _, err := _p.MultiExpStream(r, scalars, chunkSize, config, options...); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpStream computes the multi-exponentiation of the points read from r by scalars.
+//
+// r must contain a slice of points as written by Encoder.Encode([]G1Affine) (with or without
+// point compression); the points are decoded by chunks of chunkSize points (a default value is used
+// if chunkSize <= 0) and accumulated into the buckets of the bucket method as they are read, such that
+// the whole slice of points never needs to fit in memory. If the stream contains more points than
+// scalars, only the first len(scalars) points are read.
+//
+// Decoder options (NoSubgroupChecks) are honored when decoding the points.
+// This call return an error if the stream contains less than len(scalars) points, if a point
+// can't be decoded, or if provided config is invalid.
+func (p *G1Jac) MultiExpStream(r io.Reader, scalars []fr.Element, chunkSize int, config ecc.MultiExpConfig, options ...func(*Decoder)) (*G1Jac, error) {
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+	if chunkSize <= 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+
+	dec := NewDecoder(r, options...)
+	sliceLen, err := dec.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	nbPoints := len(scalars)
+	if int(sliceLen) < nbPoints {
+		return nil, errors.New("stream contains less points than scalars")
+	}
+
+	// the buckets live on the heap and are shared by all the chunks, so we are not limited
+	// by the generated bucket types; we use the same cost estimate as MultiExp.
+	implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	var c uint64
+	min := math.MaxFloat64
+	for _, cc := range implementedCs {
+		cost := float64((fr.Bits+1)*(nbPoints+(1<<cc))) / float64(cc)
+		if cost < min {
+			min = cost
+			c = cc
+		}
+	}
+	nbChunks := int(computeNbChunks(c))
+
+	// one set of buckets per c-bit window; the last window may need a larger bucket set
+	// to accommodate the carry.
+	buckets := make([][]g1JacExtended, nbChunks)
+	for j := range buckets {
+		nbBuckets := 1 << (c - 1)
+		if j == nbChunks-1 {
+			nbBuckets = 1 << (lastC(c) - 1)
+		}
+		buckets[j] = make([]g1JacExtended, nbBuckets)
+		for k := range buckets[j] {
+			buckets[j][k].setInfinity()
+		}
+	}
+
+	// decode the points in a separate go routine, such that decoding the next chunk
+	// overlaps with the bucket accumulation of the current one.
+	// we use 2 point buffers, which bounds the memory used.
+	type pointsChunk struct {
+		points []G1Affine
+		err    error
+	}
+	chPoints := make(chan pointsChunk, 1)
+	chFree := make(chan []G1Affine, 2)
+	chFree <- make([]G1Affine, chunkSize)
+	chFree <- make([]G1Affine, chunkSize)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		defer close(chPoints)
+		for start := 0; start < nbPoints; start += chunkSize {
+			var points []G1Affine
+			select {
+			case points = <-chFree:
+			case <-done:
+				return
+			}
+			n := chunkSize
+			if start+n > nbPoints {
+				n = nbPoints - start
+			}
+			points = points[:n]
+			err := dec.decodeG1Chunk(points)
+			select {
+			case chPoints <- pointsChunk{points: points, err: err}:
+			case <-done:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	offset := 0
+	for chunk := range chPoints {
+		if chunk.err != nil {
+			return nil, chunk.err
+		}
+		n := len(chunk.points)
+		digits, _ := partitionScalars(scalars[offset:offset+n], c, config.NbTasks)
+
+		// each window accumulates in its own buckets, so we can process them in parallel.
+		parallel.Execute(nbChunks, func(start, end int) {
+			for j := start; j < end; j++ {
+				accumulateBucketsG1(buckets[j], chunk.points, digits[j*n:(j+1)*n])
+			}
+		}, config.NbTasks)
+
+		offset += n
+		chFree <- chunk.points
+	}
+
+	// reduce the buckets of each window, and combine the windows
+	chChunks := make([]chan g1JacExtended, nbChunks)
+	for j := range chChunks {
+		chChunks[j] = make(chan g1JacExtended, 1)
+	}
+	parallel.Execute(nbChunks, func(start, end int) {
+		for j := start; j < end; j++ {
+			chChunks[j] <- reduceBucketsG1(buckets[j])
+		}
+	}, config.NbTasks)
+
+	return msmReduceChunkG1Affine(p, int(c), chChunks), nil
+}
+
+// decodeG1Chunk reads len(points) points from the decoder. The bytes are read
+// sequentially, then the points are decoded (and eventually decompressed) in parallel.
+func (dec *Decoder) decodeG1Chunk(points []G1Affine) error {
+	buf := make([]byte, len(points)*SizeOfG1AffineUncompressed)
+	offsets := make([]int, len(points)+1)
+	for i := range points {
+		o := offsets[i]
+		// we start by reading compressed point size, if metadata tells us it is uncompressed, we read more.
+		read, err := io.ReadFull(dec.r, buf[o:o+SizeOfG1AffineCompressed])
+		dec.n += int64(read)
+		if err != nil {
+			return err
+		}
+		nbBytes := SizeOfG1AffineCompressed
+		if !isCompressed(buf[o]) {
+			nbBytes = SizeOfG1AffineUncompressed
+			read, err = io.ReadFull(dec.r, buf[o+SizeOfG1AffineCompressed:o+nbBytes])
+			dec.n += int64(read)
+			if err != nil {
+				return err
+			}
+		}
+		offsets[i+1] = o + nbBytes
+	}
+
+	var nbErrs uint64
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			if _, err := points[i].setBytes(buf[offsets[i]:offsets[i+1]], dec.subGroupCheck); err != nil {
+				atomic.AddUint64(&nbErrs, 1)
+			}
+		}
+	})
+	if nbErrs != 0 {
+		return errors.New("point decoding failed")
+	}
+	return nil
+}
+
+// accumulateBucketsG1 adds the points in the buckets designated by the digits of a c-bit window.
+func accumulateBucketsG1(buckets []g1JacExtended, points []G1Affine, digits []uint16) {
+	for i, digit := range digits {
+		if digit == 0 {
+			continue
+		}
+
+		// if msbWindow bit is set, we need to subtract
+		if digit&1 == 0 {
+			// add
+			buckets[(digit>>1)-1].addMixed(&points[i])
+		} else {
+			// sub
+			buckets[(digit >> 1)].subMixed(&points[i])
+		}
+	}
+}
+
+// reduceBucketsG1 computes the weighted sum of the buckets
+// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+func reduceBucketsG1(buckets []g1JacExtended) g1JacExtended {
+	var runningSum, total g1JacExtended
+	runningSum.setInfinity()
+	total.setInfinity()
+	for k := len(buckets) - 1; k >= 0; k-- {
+		if !buckets[k].ZZ.IsZero() {
+			runningSum.add(&buckets[k])
+		}
+		total.add(&runningSum)
+	}
+	return total
+}
+
+// MultiExpStream computes the multi-exponentiation of the points read from r by scalars.
+//
+// r must contain a slice of points as written by Encoder.Encode([]G2Affine) (with or without
+// point compression); the points are decoded by chunks of chunkSize points (a default value is used
+// if chunkSize <= 0) and accumulated into the buckets of the bucket method as they are read, such that
+// the whole slice of points never needs to fit in memory. If the stream contains more points than
+// scalars, only the first len(scalars) points are read.
+//
+// Decoder options (NoSubgroupChecks) are honored when decoding the points.
+// This call return an error if the stream contains less than len(scalars) points, if a point
+// can't be decoded, or if provided config is invalid.
+func (p *G2Affine) MultiExpStream(r io.Reader, scalars []fr.Element, chunkSize int, config ecc.MultiExpConfig, options ...func(*Decoder)) (*G2Affine, error) {
+	var _p G2Jac
+	if _, err := _p.MultiExpStream(r, scalars, chunkSize, config, options...); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpStream computes the multi-exponentiation of the points read from r by scalars.
+//
+// r must contain a slice of points as written by Encoder.Encode([]G2Affine) (with or without
+// point compression); the points are decoded by chunks of chunkSize points (a default value is used
+// if chunkSize <= 0) and accumulated into the buckets of the bucket method as they are read, such that
+// the whole slice of points never needs to fit in memory. If the stream contains more points than
+// scalars, only the first len(scalars) points are read.
+//
+// Decoder options (NoSubgroupChecks) are honored when decoding the points.
+// This call return an error if the stream contains less than len(scalars) points, if a point
+// can't be decoded, or if provided config is invalid.
+func (p *G2Jac) MultiExpStream(r io.Reader, scalars []fr.Element, chunkSize int, config ecc.MultiExpConfig, options ...func(*Decoder)) (*G2Jac, error) {
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+	if chunkSize <= 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+
+	dec := NewDecoder(r, options...)
+	sliceLen, err := dec.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	nbPoints := len(scalars)
+	if int(sliceLen) < nbPoints {
+		return nil, errors.New("stream contains less points than scalars")
+	}
+
+	// the buckets live on the heap and are shared by all the chunks, so we are not limited
+	// by the generated bucket types; we use the same cost estimate as MultiExp.
+	implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	var c uint64
+	min := math.MaxFloat64
+	for _, cc := range implementedCs {
+		cost := float64((fr.Bits+1)*(nbPoints+(1<<cc))) / float64(cc)
+		if cost < min {
+			min = cost
+			c = cc
+		}
+	}
+	nbChunks := int(computeNbChunks(c))
+
+	// one set of buckets per c-bit window; the last window may need a larger bucket set
+	// to accommodate the carry.
+	buckets := make([][]g2JacExtended, nbChunks)
+	for j := range buckets {
+		nbBuckets := 1 << (c - 1)
+		if j == nbChunks-1 {
+			nbBuckets = 1 << (lastC(c) - 1)
+		}
+		buckets[j] = make([]g2JacExtended, nbBuckets)
+		for k := range buckets[j] {
+			buckets[j][k].setInfinity()
+		}
+	}
+
+	// decode the points in a separate go routine, such that decoding the next chunk
+	// overlaps with the bucket accumulation of the current one.
+	// we use 2 point buffers, which bounds the memory used.
+	type pointsChunk struct {
+		points []G2Affine
+		err    error
+	}
+	chPoints := make(chan pointsChunk, 1)
+	chFree := make(chan []G2Affine, 2)
+	chFree <- make([]G2Affine, chunkSize)
+	chFree <- make([]G2Affine, chunkSize)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		defer close(chPoints)
+		for start := 0; start < nbPoints; start += chunkSize {
+			var points []G2Affine
+			select {
+			case points = <-chFree:
+			case <-done:
+				return
+			}
+			n := chunkSize
+			if start+n > nbPoints {
+				n = nbPoints - start
+			}
+			points = points[:n]
+			err := dec.decodeG2Chunk(points)
+			select {
+			case chPoints <- pointsChunk{points: points, err: err}:
+			case <-done:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	offset := 0
+	for chunk := range chPoints {
+		if chunk.err != nil {
+			return nil, chunk.err
+		}
+		n := len(chunk.points)
+		digits, _ := partitionScalars(scalars[offset:offset+n], c, config.NbTasks)
+
+		// each window accumulates in its own buckets, so we can process them in parallel.
+		parallel.Execute(nbChunks, func(start, end int) {
+			for j := start; j < end; j++ {
+				accumulateBucketsG2(buckets[j], chunk.points, digits[j*n:(j+1)*n])
+			}
+		}, config.NbTasks)
+
+		offset += n
+		chFree <- chunk.points
+	}
+
+	// reduce the buckets of each window, and combine the windows
+	chChunks := make([]chan g2JacExtended, nbChunks)
+	for j := range chChunks {
+		chChunks[j] = make(chan g2JacExtended, 1)
+	}
+	parallel.Execute(nbChunks, func(start, end int) {
+		for j := start; j < end; j++ {
+			chChunks[j] <- reduceBucketsG2(buckets[j])
+		}
+	}, config.NbTasks)
+
+	return msmReduceChunkG2Affine(p, int(c), chChunks), nil
+}
+
+// decodeG2Chunk reads len(points) points from the decoder. The bytes are read
+// sequentially, then the points are decoded (and eventually decompressed) in parallel.
+func (dec *Decoder) decodeG2Chunk(points []G2Affine) error {
+	buf := make([]byte, len(points)*SizeOfG2AffineUncompressed)
+	offsets := make([]int, len(points)+1)
+	for i := range points {
+		o := offsets[i]
+		// we start by reading compressed point size, if metadata tells us it is uncompressed, we read more.
+		read, err := io.ReadFull(dec.r, buf[o:o+SizeOfG2AffineCompressed])
+		dec.n += int64(read)
+		if err != nil {
+			return err
+		}
+		nbBytes := SizeOfG2AffineCompressed
+		if !isCompressed(buf[o]) {
+			nbBytes = SizeOfG2AffineUncompressed
+			read, err = io.ReadFull(dec.r, buf[o+SizeOfG2AffineCompressed:o+nbBytes])
+			dec.n += int64(read)
+			if err != nil {
+				return err
+			}
+		}
+		offsets[i+1] = o + nbBytes
+	}
+
+	var nbErrs uint64
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			if _, err := points[i].setBytes(buf[offsets[i]:offsets[i+1]], dec.subGroupCheck); err != nil {
+				atomic.AddUint64(&nbErrs, 1)
+			}
+		}
+	})
+	if nbErrs != 0 {
+		return errors.New("point decoding failed")
+	}
+	return nil
+}
+
+// accumulateBucketsG2 adds the points in the buckets designated by the digits of a c-bit window.
+func accumulateBucketsG2(buckets []g2JacExtended, points []G2Affine, digits []uint16) {
+	for i, digit := range digits {
+		if digit == 0 {
+			continue
+		}
+
+		// if msbWindow bit is set, we need to subtract
+		if digit&1 == 0 {
+			// add
+			buckets[(digit>>1)-1].addMixed(&points[i])
+		} else {
+			// sub
+			buckets[(digit >> 1)].subMixed(&points[i])
+		}
+	}
+}
+
+// reduceBucketsG2 computes the weighted sum of the buckets
+// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+func reduceBucketsG2(buckets []g2JacExtended) g2JacExtended {
+	var runningSum, total g2JacExtended
+	runningSum.setInfinity()
+	total.setInfinity()
+	for k := len(buckets) - 1; k >= 0; k-- {
+		if !buckets[k].ZZ.IsZero() {
+			runningSum.add(&buckets[k])
+		}
+		total.add(&runningSum)
+	}
+	return total
+}
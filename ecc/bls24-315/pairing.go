@@ -267,6 +267,133 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	return result, nil
 }
 
+// PrecomputedLines holds the lines of the Miller loop of a fixed G2 point, see PrecomputeLines.
+//
+// The lines of the Miller loop only depend on the G2 point; when it is reused in many pairings
+// (typically, in a verifying key), they can be computed once and evaluated at each G1 point
+// with MillerLoopFixedQ, saving all the G2 arithmetic. The zero value is not usable.
+type PrecomputedLines struct {
+	isInfinity bool
+	// lines[j] holds the lines multiplied into the Miller loop accumulator after its j-th squaring
+	// (lines[0] before the first one)
+	lines [][]lineEvaluation
+}
+
+// PrecomputeLines computes the lines of the Miller loop of Q, to be used in MillerLoopFixedQ.
+//
+// This function doesn't check that Q is in the correct subgroup. See IsInSubGroup.
+func PrecomputeLines(Q G2Affine) PrecomputedLines {
+	if Q.IsInfinity() {
+		return PrecomputedLines{isInfinity: true}
+	}
+
+	// same steps as MillerLoop, for a single point
+	var qProj g2Proj
+	qProj.FromAffine(&Q)
+	var qNeg G2Affine
+	qNeg.Neg(&Q)
+	var l1, l2 lineEvaluation
+	lines := make([][]lineEvaluation, len(loopCounter)-1)
+
+	// i = 31
+	qProj.doubleStep(&l1)
+	lines[0] = []lineEvaluation{l1}
+
+	for i := len(loopCounter) - 3; i >= 1; i-- {
+		j := len(loopCounter) - 2 - i
+		qProj.doubleStep(&l1)
+		switch loopCounter[i] {
+		case 1:
+			qProj.addMixedStep(&l2, &Q)
+			lines[j] = []lineEvaluation{l1, l2}
+		case -1:
+			qProj.addMixedStep(&l2, &qNeg)
+			lines[j] = []lineEvaluation{l1, l2}
+		default:
+			lines[j] = []lineEvaluation{l1}
+		}
+	}
+
+	// i = 0
+	qProj.doubleStep(&l1)
+	qProj.lineCompute(&l2, &qNeg)
+	lines[len(lines)-1] = []lineEvaluation{l1, l2}
+
+	return PrecomputedLines{lines: lines}
+}
+
+// MillerLoopFixedQ computes the multi-Miller loop ∏ᵢ MillerLoop(Pᵢ, Qᵢ), where the lines of the Qᵢ
+// are precomputed with PrecomputeLines. The result is the same as MillerLoop's.
+//
+// It returns an error if the inputs sizes don't match or if some lines are not precomputed.
+func MillerLoopFixedQ(P []G1Affine, lines []PrecomputedLines) (GT, error) {
+	n := len(P)
+	if n == 0 || n != len(lines) {
+		return GT{}, errors.New("invalid inputs sizes")
+	}
+
+	// filter infinity points
+	p := make([]G1Affine, 0, n)
+	l := make([][][]lineEvaluation, 0, n)
+
+	for k := 0; k < n; k++ {
+		if !lines[k].isInfinity && len(lines[k].lines) != len(loopCounter)-1 {
+			return GT{}, errors.New("lines not precomputed")
+		}
+		if P[k].IsInfinity() || lines[k].isInfinity {
+			continue
+		}
+		p = append(p, P[k])
+		l = append(l, lines[k].lines)
+	}
+	n = len(p)
+
+	var result GT
+	result.SetOne()
+	var l1, l2 lineEvaluation
+	var prodLines [5]fptower.E4
+
+	for j := 0; j < len(loopCounter)-1; j++ {
+		if j != 0 {
+			// mutualize the square among n Miller loops
+			// (∏ᵢfᵢ)²
+			result.Square(&result)
+		}
+
+		for k := 0; k < n; k++ {
+			segment := l[k][j]
+			m := 0
+			for ; m+1 < len(segment); m += 2 {
+				// line evaluations at P[k]
+				l1.evaluate(&segment[m], &p[k])
+				l2.evaluate(&segment[m+1], &p[k])
+				// ℓ × ℓ
+				prodLines = fptower.Mul034By034(&l1.r0, &l1.r1, &l1.r2, &l2.r0, &l2.r1, &l2.r2)
+				// (ℓ × ℓ) × res
+				result.MulBy01234(&prodLines)
+			}
+			if m < len(segment) {
+				// line evaluation at P[k]
+				l1.evaluate(&segment[m], &p[k])
+				// ℓ × res
+				result.MulBy034(&l1.r0, &l1.r1, &l1.r2)
+			}
+		}
+	}
+
+	// negative x₀
+	result.Conjugate(&result)
+
+	return result, nil
+}
+
+// evaluate sets l to the evaluation (r0·y, r1·x, r2) of line at p = (x, y)
+func (l *lineEvaluation) evaluate(line *lineEvaluation, p *G1Affine) {
+	l.r0.MulByElement(&line.r0, &p.Y)
+	l.r1.MulByElement(&line.r1, &p.X)
+	l.r2 = line.r2
+}
+
 // doubleStep doubles a point in Homogenous projective coordinates, and evaluates the line in Miller loop
 // https://eprint.iacr.org/2013/722.pdf (Section 4.3)
 func (p *g2Proj) doubleStep(evaluations *lineEvaluation) {
@@ -0,0 +1,35 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ecvrf provides a verifiable random function (ECVRF) on the twisted Edwards curve
+// defined on the scalar field of bls24-315.
+//
+// The construction follows the ECVRF of RFC 9381: a proof π = (Γ, c, s) binds the
+// public key Y = x⋅B to the VRF input α through Γ = x⋅H, where H is obtained by
+// hashing α to the prime order subgroup; the output β is a hash of cofactor⋅Γ.
+//
+// The ciphersuite is specific to this package and has no registered suite_string:
+//   - hash-to-curve: BLS24_315_EDWARDS_XMD:SHA-512_ELL2_NU_ (Elligator 2, see twistededwards.EncodeToCurve),
+//   - point encoding: compressed as in RFC 8032, see twistededwards.PointAffine.Bytes,
+//   - hash function: SHA-512, challenge length 16 bytes.
+//
+// The nonce is derived deterministically from the secret scalar and the encoding of H,
+// so that proving the same input twice yields the same proof.
+//
+// Documentation:
+// - RFC 9381: https://www.rfc-editor.org/rfc/rfc9381.html
+// - RFC 9380: https://www.rfc-editor.org/rfc/rfc9380.html
+package ecvrf
@@ -0,0 +1,102 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"errors"
+	"sync"
+)
+
+var errUnknownParty = errors.New("unknown party")
+
+// Message is a message delivered by a LocalNetwork.
+type Message struct {
+	From, To int
+	Payload  interface{}
+}
+
+// LocalNetwork is an in-process stand-in for the authenticated channels connecting the
+// parties of the key generation and of the signing protocol, meant for tests and simulations.
+//
+// Participants are identified by 1…nbParticipants, and 0 identifies the signing coordinator.
+// Sending never blocks; each party receives its messages in the order they were sent.
+type LocalNetwork struct {
+	inboxes []inbox
+}
+
+type inbox struct {
+	lock     sync.Mutex
+	cond     *sync.Cond
+	messages []Message
+}
+
+// NewLocalNetwork returns a network connecting a coordinator and nbParticipants participants.
+func NewLocalNetwork(nbParticipants int) *LocalNetwork {
+	n := &LocalNetwork{inboxes: make([]inbox, nbParticipants+1)}
+	for i := range n.inboxes {
+		n.inboxes[i].cond = sync.NewCond(&n.inboxes[i].lock)
+	}
+	return n
+}
+
+// NbParticipants returns the number of participants connected to the network, not counting the coordinator.
+func (n *LocalNetwork) NbParticipants() int {
+	return len(n.inboxes) - 1
+}
+
+// Send delivers payload from party from to party to.
+func (n *LocalNetwork) Send(from, to int, payload interface{}) error {
+	if from < 0 || from >= len(n.inboxes) || to < 0 || to >= len(n.inboxes) {
+		return errUnknownParty
+	}
+	box := &n.inboxes[to]
+	box.lock.Lock()
+	box.messages = append(box.messages, Message{From: from, To: to, Payload: payload})
+	box.lock.Unlock()
+	box.cond.Signal()
+	return nil
+}
+
+// Broadcast delivers payload from party from to all the participants but itself.
+// The coordinator does not receive broadcasts.
+func (n *LocalNetwork) Broadcast(from int, payload interface{}) error {
+	for to := 1; to < len(n.inboxes); to++ {
+		if to == from {
+			continue
+		}
+		if err := n.Send(from, to, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Receive returns the next message sent to party id, waiting for one if the inbox is empty.
+func (n *LocalNetwork) Receive(id int) (Message, error) {
+	if id < 0 || id >= len(n.inboxes) {
+		return Message{}, errUnknownParty
+	}
+	box := &n.inboxes[id]
+	box.lock.Lock()
+	defer box.lock.Unlock()
+	for len(box.messages) == 0 {
+		box.cond.Wait()
+	}
+	m := box.messages[0]
+	box.messages = box.messages[1:]
+	return m, nil
+}
@@ -19,9 +19,11 @@ package twistededwards
 import (
 	"math/big"
 	"math/rand"
+	"os"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/utils"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 )
@@ -709,10 +711,104 @@ func TestOps(t *testing.T) {
 		genS1,
 	))
 
+	properties.Property("constant-time scalar multiplication should output the same result as ScalarMultiplication", prop.ForAll(
+		func(s big.Int) bool {
+
+			params := GetEdwardsCurve()
+
+			var negS big.Int
+			negS.Neg(&s)
+
+			var expected, expectedNeg, p1, p2 PointAffine
+			expected.ScalarMultiplication(&params.Base, &s)
+			expectedNeg.Neg(&expected)
+			p1.ScalarMultiplicationCT(&params.Base, &s)
+			p2.ScalarMultiplicationCT(&params.Base, &negS)
+
+			var baseProj, p3 PointProj
+			var p3Aff PointAffine
+			baseProj.FromAffine(&params.Base)
+			p3.ScalarMultiplicationCT(&baseProj, &s)
+			p3Aff.FromProj(&p3)
+
+			return p1.Equal(&expected) && p2.Equal(&expectedNeg) && p3Aff.Equal(&expected)
+		},
+		genS1,
+	))
+
+	properties.Property("constant-time scalar multiplication by 0 and the subgroup order should output O", prop.ForAll(
+		func(s big.Int) bool {
+
+			params := GetEdwardsCurve()
+
+			var p1, p2 PointAffine
+			p1.ScalarMultiplicationCT(&params.Base, big.NewInt(0))
+			p2.ScalarMultiplicationCT(&params.Base, &params.Order)
+
+			return p1.IsZero() && p2.IsZero()
+		},
+		genS1,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
 
+// TestScalarMulCTTiming runs a dudect-style timing test of ScalarMultiplicationCT, comparing short
+// (64-bit) random scalars with full size random scalars. It is skipped unless the DUDECT environment
+// variable is set, as timing measurements are noisy on shared machines.
+func TestRandomPoint(t *testing.T) {
+	t.Parallel()
+	curve := GetEdwardsCurve()
+	r1, r2 := rand.New(rand.NewSource(42)), rand.New(rand.NewSource(42)) //#nosec G404 -- deterministic test fixture
+	for i := 0; i < 10; i++ {
+		var p1, p2, o PointAffine
+		if _, err := p1.RandomPoint(r1); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := p2.RandomPoint(r2); err != nil {
+			t.Fatal(err)
+		}
+		if !p1.IsOnCurve() || !o.ScalarMultiplication(&p1, &curve.Order).IsZero() {
+			t.Fatal("RandomPoint should return a point of the prime order subgroup")
+		}
+		if !p1.Equal(&p2) {
+			t.Fatal("RandomPoint should be deterministic for a given reader")
+		}
+	}
+}
+
+func TestScalarMulCTTiming(t *testing.T) {
+	if os.Getenv("DUDECT") == "" {
+		t.Skip("set DUDECT to run the timing test")
+	}
+	params := GetEdwardsCurve()
+	const nbMeasurements = 1 << 12
+	scalars := make([]big.Int, nbMeasurements)
+	prepare := func(i, class int) {
+		var b [fr.Bytes]byte
+		_, err := rand.Read(b[:]) //#nosec G404 weak rng is fine here
+		if err != nil {
+			panic(err)
+		}
+		if class == 0 {
+			scalars[i].SetBytes(b[:8])
+			return
+		}
+		scalars[i].SetBytes(b[:])
+		scalars[i].Mod(&scalars[i], &params.Order)
+	}
+	var res PointAffine
+	run := func(i int) {
+		res.ScalarMultiplicationCT(&params.Base, &scalars[i])
+	}
+	tStat := utils.DudectTStatistic(nbMeasurements, prepare, run)
+	t.Logf("t-statistic: %.2f", tStat)
+	if tStat > utils.DudectThreshold || tStat < -utils.DudectThreshold {
+		t.Fatalf("timing leakage detected: |t| = %.2f > %d", tStat, utils.DudectThreshold)
+	}
+}
+
 func TestMarshal(t *testing.T) {
 	t.Parallel()
 	initOnce.Do(initCurveParams)
@@ -780,6 +876,22 @@ func BenchmarkScalarMulProjective(b *testing.B) {
 	}
 }
 
+func BenchmarkScalarMulCT(b *testing.B) {
+	params := GetEdwardsCurve()
+	var a PointProj
+	var s big.Int
+	a.FromAffine(&params.Base)
+	s.SetString("52435875175126190479447705081859658376581184513", 10)
+	s.Add(&s, &params.Order)
+
+	var ct PointProj
+
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		ct.ScalarMultiplicationCT(&a, &s)
+	}
+}
+
 func BenchmarkNeg(b *testing.B) {
 	params := GetEdwardsCurve()
 	var s big.Int
@@ -556,154 +556,113 @@ func (z *Element) expBySqrtExp(x Element) *Element {
 	return z
 }
 
-// expByLegendreExp is equivalent to z.Exp(x, 82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed59555)
-//
-// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
-func (z *Element) expByLegendreExp(x Element) *Element {
-	// addition chain:
-	//
-	//	_10      = 2*1
-	//	_11      = 1 + _10
-	//	_101     = _10 + _11
-	//	_111     = _10 + _101
-	//	_1001    = _10 + _111
-	//	_1011    = _10 + _1001
-	//	_1101    = _10 + _1011
-	//	_1111    = _10 + _1101
-	//	_10000   = 1 + _1111
-	//	_10001   = 1 + _10000
-	//	_10011   = _10 + _10001
-	//	_10101   = _10 + _10011
-	//	_10111   = _10 + _10101
-	//	_11001   = _10 + _10111
-	//	_11101   = _1101 + _10000
-	//	_111010  = 2*_11101
-	//	_111111  = _101 + _111010
-	//	_1000000 = 1 + _111111
-	//	i37      = ((_1000000 << 3 + _1011) << 8 + _11001) << 6
-	//	i53      = ((_10001 + i37) << 8 + _10011) << 5 + _1111
-	//	i74      = ((i53 << 3 + _11) << 10 + _10001) << 6
-	//	i86      = ((_1001 + i74) << 6 + _11001) << 3 + _111
-	//	i109     = ((i86 << 5 + _101) << 9 + _111111) << 7
-	//	i123     = ((_1011 + i109) << 2 + 1) << 9 + _1011
-	//	i141     = ((i123 << 7 + _111111) << 2 + _11) << 7
-	//	i160     = ((_11101 + i141) << 9 + _111) << 7 + _10001
-	//	i177     = ((i160 << 5 + _1101) << 6 + _1101) << 4
-	//	i194     = ((_11 + i177) << 8 + _1111) << 6 + _1101
-	//	i217     = ((i194 << 9 + _10011) << 6 + _111) << 6
-	//	i232     = ((_1101 + i217) << 8 + _10001) << 4 + _1011
-	//	i251     = ((i232 << 5 + _1011) << 5 + _1111) << 7
-	//	i262     = ((_11001 + i251) << 5 + _11001) << 3 + _111
-	//	i283     = ((i262 << 3 + 1) << 8 + _1101) << 8
-	//	i296     = ((_1001 + i283) << 3 + 1) << 7 + _111111
-	//	i316     = ((i296 << 7 + _111111) << 6 + _10001) << 5
-	//	i332     = ((_10101 + i316) << 6 + _10001) << 7 + _10111
-	//	i351     = ((i332 << 7 + _10101) << 4 + _1001) << 6
-	//	i365     = ((_11101 + i351) << 5 + _10101) << 6 + _11001
-	//	return     (i365 << 6 + _10101) << 6 + _10101
-	//
-	// Operations: 311 squares 68 multiplies
-
-	// Allocate Temporaries.
+// vectorExpBySqrtExpNbTemporaries is the number of temporary vectors used by vectorExpBySqrtExp
+const vectorExpBySqrtExpNbTemporaries = 14
+
+// vectorExpBySqrtExp sets z[i] = x[i]^41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b95976acaab for all i, with the addition chain of expBySqrtExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpBySqrtExpNbTemporaries * len(x).
+func vectorExpBySqrtExp(z, x, scratch Vector) {
+	n := len(x)
 	var (
-		t0  = new(Element)
-		t1  = new(Element)
-		t2  = new(Element)
-		t3  = new(Element)
-		t4  = new(Element)
-		t5  = new(Element)
-		t6  = new(Element)
-		t7  = new(Element)
-		t8  = new(Element)
-		t9  = new(Element)
-		t10 = new(Element)
-		t11 = new(Element)
-		t12 = new(Element)
-		t13 = new(Element)
+		t0  = scratch[0*n : 0*n+n]
+		t1  = scratch[1*n : 1*n+n]
+		t2  = scratch[2*n : 2*n+n]
+		t3  = scratch[3*n : 3*n+n]
+		t4  = scratch[4*n : 4*n+n]
+		t5  = scratch[5*n : 5*n+n]
+		t6  = scratch[6*n : 6*n+n]
+		t7  = scratch[7*n : 7*n+n]
+		t8  = scratch[8*n : 8*n+n]
+		t9  = scratch[9*n : 9*n+n]
+		t10 = scratch[10*n : 10*n+n]
+		t11 = scratch[11*n : 11*n+n]
+		t12 = scratch[12*n : 12*n+n]
+		t13 = scratch[13*n : 13*n+n]
 	)
 
-	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13 Element
-	// Step 1: t0 = x^0x2
-	t0.Square(&x)
+	// Step 1: t1 = x^0x2
+	t1.Mul(x, x)
 
 	// Step 2: t11 = x^0x3
-	t11.Mul(&x, t0)
+	t11.Mul(x, t1)
 
 	// Step 3: t12 = x^0x5
-	t12.Mul(t0, t11)
+	t12.Mul(t1, t11)
 
-	// Step 4: t7 = x^0x7
-	t7.Mul(t0, t12)
+	// Step 4: t8 = x^0x7
+	t8.Mul(t1, t12)
 
-	// Step 5: t2 = x^0x9
-	t2.Mul(t0, t7)
+	// Step 5: t3 = x^0x9
+	t3.Mul(t1, t8)
 
-	// Step 6: t9 = x^0xb
-	t9.Mul(t0, t2)
+	// Step 6: z = x^0xb
+	z.Mul(t1, t3)
 
-	// Step 7: t6 = x^0xd
-	t6.Mul(t0, t9)
+	// Step 7: t7 = x^0xd
+	t7.Mul(t1, z)
 
-	// Step 8: t8 = x^0xf
-	t8.Mul(t0, t6)
+	// Step 8: t9 = x^0xf
+	t9.Mul(t1, t7)
 
-	// Step 9: t1 = x^0x10
-	t1.Mul(&x, t8)
+	// Step 9: t2 = x^0x10
+	t2.Mul(x, t9)
 
-	// Step 10: t4 = x^0x11
-	t4.Mul(&x, t1)
+	// Step 10: t5 = x^0x11
+	t5.Mul(x, t2)
 
 	// Step 11: t10 = x^0x13
-	t10.Mul(t0, t4)
+	t10.Mul(t1, t5)
 
-	// Step 12: z = x^0x15
-	z.Mul(t0, t10)
+	// Step 12: t0 = x^0x15
+	t0.Mul(t1, t10)
 
-	// Step 13: t3 = x^0x17
-	t3.Mul(t0, z)
+	// Step 13: t4 = x^0x17
+	t4.Mul(t1, t0)
 
-	// Step 14: t0 = x^0x19
-	t0.Mul(t0, t3)
+	// Step 14: t1 = x^0x19
+	t1.Mul(t1, t4)
 
-	// Step 15: t1 = x^0x1d
-	t1.Mul(t6, t1)
+	// Step 15: t2 = x^0x1d
+	t2.Mul(t7, t2)
 
-	// Step 16: t5 = x^0x3a
-	t5.Square(t1)
+	// Step 16: t6 = x^0x3a
+	t6.Mul(t2, t2)
 
-	// Step 17: t5 = x^0x3f
-	t5.Mul(t12, t5)
+	// Step 17: t6 = x^0x3f
+	t6.Mul(t12, t6)
 
 	// Step 18: t13 = x^0x40
-	t13.Mul(&x, t5)
+	t13.Mul(x, t6)
 
 	// Step 21: t13 = x^0x200
 	for s := 0; s < 3; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 22: t13 = x^0x20b
-	t13.Mul(t9, t13)
+	t13.Mul(z, t13)
 
 	// Step 30: t13 = x^0x20b00
 	for s := 0; s < 8; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 31: t13 = x^0x20b19
-	t13.Mul(t0, t13)
+	t13.Mul(t1, t13)
 
 	// Step 37: t13 = x^0x82c640
 	for s := 0; s < 6; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 38: t13 = x^0x82c651
-	t13.Mul(t4, t13)
+	t13.Mul(t5, t13)
 
 	// Step 46: t13 = x^0x82c65100
 	for s := 0; s < 8; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 47: t13 = x^0x82c65113
@@ -711,15 +670,15 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 52: t13 = x^0x1058ca2260
 	for s := 0; s < 5; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 53: t13 = x^0x1058ca226f
-	t13.Mul(t8, t13)
+	t13.Mul(t9, t13)
 
 	// Step 56: t13 = x^0x82c6511378
 	for s := 0; s < 3; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 57: t13 = x^0x82c651137b
@@ -727,39 +686,39 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 67: t13 = x^0x20b19444dec00
 	for s := 0; s < 10; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 68: t13 = x^0x20b19444dec11
-	t13.Mul(t4, t13)
+	t13.Mul(t5, t13)
 
 	// Step 74: t13 = x^0x82c651137b0440
 	for s := 0; s < 6; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 75: t13 = x^0x82c651137b0449
-	t13.Mul(t2, t13)
+	t13.Mul(t3, t13)
 
 	// Step 81: t13 = x^0x20b19444dec11240
 	for s := 0; s < 6; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 82: t13 = x^0x20b19444dec11259
-	t13.Mul(t0, t13)
+	t13.Mul(t1, t13)
 
 	// Step 85: t13 = x^0x1058ca226f60892c8
 	for s := 0; s < 3; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 86: t13 = x^0x1058ca226f60892cf
-	t13.Mul(t7, t13)
+	t13.Mul(t8, t13)
 
 	// Step 91: t13 = x^0x20b19444dec11259e0
 	for s := 0; s < 5; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 92: t12 = x^0x20b19444dec11259e5
@@ -767,47 +726,47 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 101: t12 = x^0x41632889bd8224b3ca00
 	for s := 0; s < 9; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 102: t12 = x^0x41632889bd8224b3ca3f
-	t12.Mul(t5, t12)
+	t12.Mul(t6, t12)
 
 	// Step 109: t12 = x^0x20b19444dec11259e51f80
 	for s := 0; s < 7; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 110: t12 = x^0x20b19444dec11259e51f8b
-	t12.Mul(t9, t12)
+	t12.Mul(z, t12)
 
 	// Step 112: t12 = x^0x82c651137b044967947e2c
 	for s := 0; s < 2; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 113: t12 = x^0x82c651137b044967947e2d
-	t12.Mul(&x, t12)
+	t12.Mul(x, t12)
 
 	// Step 122: t12 = x^0x1058ca226f60892cf28fc5a00
 	for s := 0; s < 9; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 123: t12 = x^0x1058ca226f60892cf28fc5a0b
-	t12.Mul(t9, t12)
+	t12.Mul(z, t12)
 
 	// Step 130: t12 = x^0x82c651137b044967947e2d0580
 	for s := 0; s < 7; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 131: t12 = x^0x82c651137b044967947e2d05bf
-	t12.Mul(t5, t12)
+	t12.Mul(t6, t12)
 
 	// Step 133: t12 = x^0x20b19444dec11259e51f8b416fc
 	for s := 0; s < 2; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 134: t12 = x^0x20b19444dec11259e51f8b416ff
@@ -815,47 +774,47 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 141: t12 = x^0x1058ca226f60892cf28fc5a0b7f80
 	for s := 0; s < 7; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 142: t12 = x^0x1058ca226f60892cf28fc5a0b7f9d
-	t12.Mul(t1, t12)
+	t12.Mul(t2, t12)
 
 	// Step 151: t12 = x^0x20b19444dec11259e51f8b416ff3a00
 	for s := 0; s < 9; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 152: t12 = x^0x20b19444dec11259e51f8b416ff3a07
-	t12.Mul(t7, t12)
+	t12.Mul(t8, t12)
 
 	// Step 159: t12 = x^0x1058ca226f60892cf28fc5a0b7f9d0380
 	for s := 0; s < 7; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 160: t12 = x^0x1058ca226f60892cf28fc5a0b7f9d0391
-	t12.Mul(t4, t12)
+	t12.Mul(t5, t12)
 
 	// Step 165: t12 = x^0x20b19444dec11259e51f8b416ff3a07220
 	for s := 0; s < 5; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 166: t12 = x^0x20b19444dec11259e51f8b416ff3a0722d
-	t12.Mul(t6, t12)
+	t12.Mul(t7, t12)
 
 	// Step 172: t12 = x^0x82c651137b044967947e2d05bfce81c8b40
 	for s := 0; s < 6; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 173: t12 = x^0x82c651137b044967947e2d05bfce81c8b4d
-	t12.Mul(t6, t12)
+	t12.Mul(t7, t12)
 
 	// Step 177: t12 = x^0x82c651137b044967947e2d05bfce81c8b4d0
 	for s := 0; s < 4; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 178: t11 = x^0x82c651137b044967947e2d05bfce81c8b4d3
@@ -863,23 +822,23 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 186: t11 = x^0x82c651137b044967947e2d05bfce81c8b4d300
 	for s := 0; s < 8; s++ {
-		t11.Square(t11)
+		t11.Mul(t11, t11)
 	}
 
 	// Step 187: t11 = x^0x82c651137b044967947e2d05bfce81c8b4d30f
-	t11.Mul(t8, t11)
+	t11.Mul(t9, t11)
 
 	// Step 193: t11 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3c0
 	for s := 0; s < 6; s++ {
-		t11.Square(t11)
+		t11.Mul(t11, t11)
 	}
 
 	// Step 194: t11 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd
-	t11.Mul(t6, t11)
+	t11.Mul(t7, t11)
 
 	// Step 203: t11 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a00
 	for s := 0; s < 9; s++ {
-		t11.Square(t11)
+		t11.Mul(t11, t11)
 	}
 
 	// Step 204: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a13
@@ -887,111 +846,1149 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 210: t10 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c0
 	for s := 0; s < 6; s++ {
-		t10.Square(t10)
+		t10.Mul(t10, t10)
 	}
 
 	// Step 211: t10 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c7
-	t10.Mul(t7, t10)
+	t10.Mul(t8, t10)
 
 	// Step 217: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131c0
 	for s := 0; s < 6; s++ {
-		t10.Square(t10)
+		t10.Mul(t10, t10)
 	}
 
 	// Step 218: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd
-	t10.Mul(t6, t10)
+	t10.Mul(t7, t10)
 
 	// Step 226: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd00
 	for s := 0; s < 8; s++ {
-		t10.Square(t10)
+		t10.Mul(t10, t10)
 	}
 
 	// Step 227: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11
-	t10.Mul(t4, t10)
+	t10.Mul(t5, t10)
 
 	// Step 231: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd110
 	for s := 0; s < 4; s++ {
-		t10.Square(t10)
+		t10.Mul(t10, t10)
 	}
 
 	// Step 232: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b
-	t10.Mul(t9, t10)
+	t10.Mul(z, t10)
 
 	// Step 237: t10 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a2360
 	for s := 0; s < 5; s++ {
-		t10.Square(t10)
+		t10.Mul(t10, t10)
 	}
 
-	// Step 238: t9 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b
-	t9.Mul(t9, t10)
+	// Step 238: t10 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b
+	t10.Mul(z, t10)
 
-	// Step 243: t9 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d60
+	// Step 243: t10 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d60
 	for s := 0; s < 5; s++ {
-		t9.Square(t9)
+		t10.Mul(t10, t10)
 	}
 
-	// Step 244: t8 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f
-	t8.Mul(t8, t9)
+	// Step 244: t9 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f
+	t9.Mul(t9, t10)
 
-	// Step 251: t8 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b780
+	// Step 251: t9 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b780
 	for s := 0; s < 7; s++ {
-		t8.Square(t8)
+		t9.Mul(t9, t9)
 	}
 
-	// Step 252: t8 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799
-	t8.Mul(t0, t8)
+	// Step 252: t9 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799
+	t9.Mul(t1, t9)
 
-	// Step 257: t8 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f320
+	// Step 257: t9 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f320
 	for s := 0; s < 5; s++ {
-		t8.Square(t8)
+		t9.Mul(t9, t9)
 	}
 
-	// Step 258: t8 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339
-	t8.Mul(t0, t8)
+	// Step 258: t9 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339
+	t9.Mul(t1, t9)
 
-	// Step 261: t8 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799c8
+	// Step 261: t9 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799c8
 	for s := 0; s < 3; s++ {
-		t8.Square(t8)
+		t9.Mul(t9, t9)
 	}
 
-	// Step 262: t7 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf
-	t7.Mul(t7, t8)
+	// Step 262: t8 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf
+	t8.Mul(t8, t9)
 
-	// Step 265: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce78
+	// Step 265: t8 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce78
 	for s := 0; s < 3; s++ {
-		t7.Square(t7)
+		t8.Mul(t8, t8)
 	}
 
-	// Step 266: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce79
-	t7.Mul(&x, t7)
+	// Step 266: t8 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce79
+	t8.Mul(x, t8)
 
-	// Step 274: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce7900
+	// Step 274: t8 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce7900
 	for s := 0; s < 8; s++ {
-		t7.Square(t7)
+		t8.Mul(t8, t8)
 	}
 
-	// Step 275: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d
-	t6.Mul(t6, t7)
+	// Step 275: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d
+	t7.Mul(t7, t8)
 
-	// Step 283: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d00
+	// Step 283: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d00
 	for s := 0; s < 8; s++ {
-		t6.Square(t6)
+		t7.Mul(t7, t7)
 	}
 
-	// Step 284: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d09
-	t6.Mul(t2, t6)
+	// Step 284: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d09
+	t7.Mul(t3, t7)
 
-	// Step 287: t6 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c86848
+	// Step 287: t7 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c86848
 	for s := 0; s < 3; s++ {
-		t6.Square(t6)
+		t7.Mul(t7, t7)
 	}
 
-	// Step 288: t6 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c86849
+	// Step 288: t7 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c86849
+	t7.Mul(x, t7)
+
+	// Step 295: t7 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e4342480
+	for s := 0; s < 7; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 296: t7 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf
+	t7.Mul(t6, t7)
+
+	// Step 303: t7 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125f80
+	for s := 0; s < 7; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 304: t6 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf
+	t6.Mul(t6, t7)
+
+	// Step 310: t6 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efc0
+	for s := 0; s < 6; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 311: t6 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1
+	t6.Mul(t5, t6)
+
+	// Step 316: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa20
+	for s := 0; s < 5; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 317: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa35
+	t6.Mul(t0, t6)
+
+	// Step 323: t6 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d40
+	for s := 0; s < 6; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 324: t5 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d51
+	t5.Mul(t5, t6)
+
+	// Step 331: t5 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a880
+	for s := 0; s < 7; s++ {
+		t5.Mul(t5, t5)
+	}
+
+	// Step 332: t4 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a897
+	t4.Mul(t4, t5)
+
+	// Step 339: t4 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b80
+	for s := 0; s < 7; s++ {
+		t4.Mul(t4, t4)
+	}
+
+	// Step 340: t4 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b95
+	t4.Mul(t0, t4)
+
+	// Step 344: t4 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b950
+	for s := 0; s < 4; s++ {
+		t4.Mul(t4, t4)
+	}
+
+	// Step 345: t3 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b959
+	t3.Mul(t3, t4)
+
+	// Step 351: t3 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e5640
+	for s := 0; s < 6; s++ {
+		t3.Mul(t3, t3)
+	}
+
+	// Step 352: t2 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565d
+	t2.Mul(t2, t3)
+
+	// Step 357: t2 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacba0
+	for s := 0; s < 5; s++ {
+		t2.Mul(t2, t2)
+	}
+
+	// Step 358: t2 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5
+	t2.Mul(t0, t2)
+
+	// Step 364: t2 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed40
+	for s := 0; s < 6; s++ {
+		t2.Mul(t2, t2)
+	}
+
+	// Step 365: t1 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed59
+	t1.Mul(t1, t2)
+
+	// Step 371: t1 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5640
+	for s := 0; s < 6; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 372: t0 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5655
+	t0.Mul(t0, t1)
+
+	// Step 377: t0 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b95976acaa0
+	for s := 0; s < 5; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 378: z = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b95976acaab
+	z.Mul(z, t0)
+
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed59555)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_101     = _10 + _11
+	//	_111     = _10 + _101
+	//	_1001    = _10 + _111
+	//	_1011    = _10 + _1001
+	//	_1101    = _10 + _1011
+	//	_1111    = _10 + _1101
+	//	_10000   = 1 + _1111
+	//	_10001   = 1 + _10000
+	//	_10011   = _10 + _10001
+	//	_10101   = _10 + _10011
+	//	_10111   = _10 + _10101
+	//	_11001   = _10 + _10111
+	//	_11101   = _1101 + _10000
+	//	_111010  = 2*_11101
+	//	_111111  = _101 + _111010
+	//	_1000000 = 1 + _111111
+	//	i37      = ((_1000000 << 3 + _1011) << 8 + _11001) << 6
+	//	i53      = ((_10001 + i37) << 8 + _10011) << 5 + _1111
+	//	i74      = ((i53 << 3 + _11) << 10 + _10001) << 6
+	//	i86      = ((_1001 + i74) << 6 + _11001) << 3 + _111
+	//	i109     = ((i86 << 5 + _101) << 9 + _111111) << 7
+	//	i123     = ((_1011 + i109) << 2 + 1) << 9 + _1011
+	//	i141     = ((i123 << 7 + _111111) << 2 + _11) << 7
+	//	i160     = ((_11101 + i141) << 9 + _111) << 7 + _10001
+	//	i177     = ((i160 << 5 + _1101) << 6 + _1101) << 4
+	//	i194     = ((_11 + i177) << 8 + _1111) << 6 + _1101
+	//	i217     = ((i194 << 9 + _10011) << 6 + _111) << 6
+	//	i232     = ((_1101 + i217) << 8 + _10001) << 4 + _1011
+	//	i251     = ((i232 << 5 + _1011) << 5 + _1111) << 7
+	//	i262     = ((_11001 + i251) << 5 + _11001) << 3 + _111
+	//	i283     = ((i262 << 3 + 1) << 8 + _1101) << 8
+	//	i296     = ((_1001 + i283) << 3 + 1) << 7 + _111111
+	//	i316     = ((i296 << 7 + _111111) << 6 + _10001) << 5
+	//	i332     = ((_10101 + i316) << 6 + _10001) << 7 + _10111
+	//	i351     = ((i332 << 7 + _10101) << 4 + _1001) << 6
+	//	i365     = ((_11101 + i351) << 5 + _10101) << 6 + _11001
+	//	return     (i365 << 6 + _10101) << 6 + _10101
+	//
+	// Operations: 311 squares 68 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13 Element
+	// Step 1: t0 = x^0x2
+	t0.Square(&x)
+
+	// Step 2: t11 = x^0x3
+	t11.Mul(&x, t0)
+
+	// Step 3: t12 = x^0x5
+	t12.Mul(t0, t11)
+
+	// Step 4: t7 = x^0x7
+	t7.Mul(t0, t12)
+
+	// Step 5: t2 = x^0x9
+	t2.Mul(t0, t7)
+
+	// Step 6: t9 = x^0xb
+	t9.Mul(t0, t2)
+
+	// Step 7: t6 = x^0xd
+	t6.Mul(t0, t9)
+
+	// Step 8: t8 = x^0xf
+	t8.Mul(t0, t6)
+
+	// Step 9: t1 = x^0x10
+	t1.Mul(&x, t8)
+
+	// Step 10: t4 = x^0x11
+	t4.Mul(&x, t1)
+
+	// Step 11: t10 = x^0x13
+	t10.Mul(t0, t4)
+
+	// Step 12: z = x^0x15
+	z.Mul(t0, t10)
+
+	// Step 13: t3 = x^0x17
+	t3.Mul(t0, z)
+
+	// Step 14: t0 = x^0x19
+	t0.Mul(t0, t3)
+
+	// Step 15: t1 = x^0x1d
+	t1.Mul(t6, t1)
+
+	// Step 16: t5 = x^0x3a
+	t5.Square(t1)
+
+	// Step 17: t5 = x^0x3f
+	t5.Mul(t12, t5)
+
+	// Step 18: t13 = x^0x40
+	t13.Mul(&x, t5)
+
+	// Step 21: t13 = x^0x200
+	for s := 0; s < 3; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 22: t13 = x^0x20b
+	t13.Mul(t9, t13)
+
+	// Step 30: t13 = x^0x20b00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 31: t13 = x^0x20b19
+	t13.Mul(t0, t13)
+
+	// Step 37: t13 = x^0x82c640
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 38: t13 = x^0x82c651
+	t13.Mul(t4, t13)
+
+	// Step 46: t13 = x^0x82c65100
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 47: t13 = x^0x82c65113
+	t13.Mul(t10, t13)
+
+	// Step 52: t13 = x^0x1058ca2260
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 53: t13 = x^0x1058ca226f
+	t13.Mul(t8, t13)
+
+	// Step 56: t13 = x^0x82c6511378
+	for s := 0; s < 3; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 57: t13 = x^0x82c651137b
+	t13.Mul(t11, t13)
+
+	// Step 67: t13 = x^0x20b19444dec00
+	for s := 0; s < 10; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 68: t13 = x^0x20b19444dec11
+	t13.Mul(t4, t13)
+
+	// Step 74: t13 = x^0x82c651137b0440
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 75: t13 = x^0x82c651137b0449
+	t13.Mul(t2, t13)
+
+	// Step 81: t13 = x^0x20b19444dec11240
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 82: t13 = x^0x20b19444dec11259
+	t13.Mul(t0, t13)
+
+	// Step 85: t13 = x^0x1058ca226f60892c8
+	for s := 0; s < 3; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 86: t13 = x^0x1058ca226f60892cf
+	t13.Mul(t7, t13)
+
+	// Step 91: t13 = x^0x20b19444dec11259e0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 92: t12 = x^0x20b19444dec11259e5
+	t12.Mul(t12, t13)
+
+	// Step 101: t12 = x^0x41632889bd8224b3ca00
+	for s := 0; s < 9; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 102: t12 = x^0x41632889bd8224b3ca3f
+	t12.Mul(t5, t12)
+
+	// Step 109: t12 = x^0x20b19444dec11259e51f80
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 110: t12 = x^0x20b19444dec11259e51f8b
+	t12.Mul(t9, t12)
+
+	// Step 112: t12 = x^0x82c651137b044967947e2c
+	for s := 0; s < 2; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 113: t12 = x^0x82c651137b044967947e2d
+	t12.Mul(&x, t12)
+
+	// Step 122: t12 = x^0x1058ca226f60892cf28fc5a00
+	for s := 0; s < 9; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 123: t12 = x^0x1058ca226f60892cf28fc5a0b
+	t12.Mul(t9, t12)
+
+	// Step 130: t12 = x^0x82c651137b044967947e2d0580
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 131: t12 = x^0x82c651137b044967947e2d05bf
+	t12.Mul(t5, t12)
+
+	// Step 133: t12 = x^0x20b19444dec11259e51f8b416fc
+	for s := 0; s < 2; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 134: t12 = x^0x20b19444dec11259e51f8b416ff
+	t12.Mul(t11, t12)
+
+	// Step 141: t12 = x^0x1058ca226f60892cf28fc5a0b7f80
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 142: t12 = x^0x1058ca226f60892cf28fc5a0b7f9d
+	t12.Mul(t1, t12)
+
+	// Step 151: t12 = x^0x20b19444dec11259e51f8b416ff3a00
+	for s := 0; s < 9; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 152: t12 = x^0x20b19444dec11259e51f8b416ff3a07
+	t12.Mul(t7, t12)
+
+	// Step 159: t12 = x^0x1058ca226f60892cf28fc5a0b7f9d0380
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 160: t12 = x^0x1058ca226f60892cf28fc5a0b7f9d0391
+	t12.Mul(t4, t12)
+
+	// Step 165: t12 = x^0x20b19444dec11259e51f8b416ff3a07220
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 166: t12 = x^0x20b19444dec11259e51f8b416ff3a0722d
+	t12.Mul(t6, t12)
+
+	// Step 172: t12 = x^0x82c651137b044967947e2d05bfce81c8b40
+	for s := 0; s < 6; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 173: t12 = x^0x82c651137b044967947e2d05bfce81c8b4d
+	t12.Mul(t6, t12)
+
+	// Step 177: t12 = x^0x82c651137b044967947e2d05bfce81c8b4d0
+	for s := 0; s < 4; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 178: t11 = x^0x82c651137b044967947e2d05bfce81c8b4d3
+	t11.Mul(t11, t12)
+
+	// Step 186: t11 = x^0x82c651137b044967947e2d05bfce81c8b4d300
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 187: t11 = x^0x82c651137b044967947e2d05bfce81c8b4d30f
+	t11.Mul(t8, t11)
+
+	// Step 193: t11 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3c0
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 194: t11 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd
+	t11.Mul(t6, t11)
+
+	// Step 203: t11 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a00
+	for s := 0; s < 9; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 204: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a13
+	t10.Mul(t10, t11)
+
+	// Step 210: t10 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c0
+	for s := 0; s < 6; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 211: t10 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c7
+	t10.Mul(t7, t10)
+
+	// Step 217: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131c0
+	for s := 0; s < 6; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 218: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd
+	t10.Mul(t6, t10)
+
+	// Step 226: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd00
+	for s := 0; s < 8; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 227: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11
+	t10.Mul(t4, t10)
+
+	// Step 231: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd110
+	for s := 0; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 232: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b
+	t10.Mul(t9, t10)
+
+	// Step 237: t10 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a2360
+	for s := 0; s < 5; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 238: t9 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b
+	t9.Mul(t9, t10)
+
+	// Step 243: t9 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d60
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 244: t8 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f
+	t8.Mul(t8, t9)
+
+	// Step 251: t8 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b780
+	for s := 0; s < 7; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 252: t8 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799
+	t8.Mul(t0, t8)
+
+	// Step 257: t8 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f320
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 258: t8 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339
+	t8.Mul(t0, t8)
+
+	// Step 261: t8 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799c8
+	for s := 0; s < 3; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 262: t7 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf
+	t7.Mul(t7, t8)
+
+	// Step 265: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce78
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 266: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce79
+	t7.Mul(&x, t7)
+
+	// Step 274: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce7900
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 275: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d
+	t6.Mul(t6, t7)
+
+	// Step 283: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d00
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 284: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d09
+	t6.Mul(t2, t6)
+
+	// Step 287: t6 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c86848
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 288: t6 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c86849
 	t6.Mul(&x, t6)
 
 	// Step 295: t6 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e4342480
 	for s := 0; s < 7; s++ {
-		t6.Square(t6)
+		t6.Square(t6)
+	}
+
+	// Step 296: t6 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf
+	t6.Mul(t5, t6)
+
+	// Step 303: t6 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125f80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 304: t5 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf
+	t5.Mul(t5, t6)
+
+	// Step 310: t5 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efc0
+	for s := 0; s < 6; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 311: t5 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1
+	t5.Mul(t4, t5)
+
+	// Step 316: t5 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa20
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 317: t5 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa35
+	t5.Mul(z, t5)
+
+	// Step 323: t5 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d40
+	for s := 0; s < 6; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 324: t4 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d51
+	t4.Mul(t4, t5)
+
+	// Step 331: t4 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a880
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 332: t3 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a897
+	t3.Mul(t3, t4)
+
+	// Step 339: t3 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b80
+	for s := 0; s < 7; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 340: t3 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b95
+	t3.Mul(z, t3)
+
+	// Step 344: t3 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b950
+	for s := 0; s < 4; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 345: t2 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b959
+	t2.Mul(t2, t3)
+
+	// Step 351: t2 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e5640
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 352: t1 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565d
+	t1.Mul(t1, t2)
+
+	// Step 357: t1 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacba0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 358: t1 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5
+	t1.Mul(z, t1)
+
+	// Step 364: t1 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed40
+	for s := 0; s < 6; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 365: t0 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed59
+	t0.Mul(t0, t1)
+
+	// Step 371: t0 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5640
+	for s := 0; s < 6; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 372: t0 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5655
+	t0.Mul(z, t0)
+
+	// Step 378: t0 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed59540
+	for s := 0; s < 6; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 379: z = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed59555
+	z.Mul(z, t0)
+
+	return z
+}
+
+// vectorExpByLegendreExpNbTemporaries is the number of temporary vectors used by vectorExpByLegendreExp
+const vectorExpByLegendreExpNbTemporaries = 14
+
+// vectorExpByLegendreExp sets z[i] = x[i]^82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed59555 for all i, with the addition chain of expByLegendreExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpByLegendreExpNbTemporaries * len(x).
+func vectorExpByLegendreExp(z, x, scratch Vector) {
+	n := len(x)
+	var (
+		t0  = scratch[0*n : 0*n+n]
+		t1  = scratch[1*n : 1*n+n]
+		t2  = scratch[2*n : 2*n+n]
+		t3  = scratch[3*n : 3*n+n]
+		t4  = scratch[4*n : 4*n+n]
+		t5  = scratch[5*n : 5*n+n]
+		t6  = scratch[6*n : 6*n+n]
+		t7  = scratch[7*n : 7*n+n]
+		t8  = scratch[8*n : 8*n+n]
+		t9  = scratch[9*n : 9*n+n]
+		t10 = scratch[10*n : 10*n+n]
+		t11 = scratch[11*n : 11*n+n]
+		t12 = scratch[12*n : 12*n+n]
+		t13 = scratch[13*n : 13*n+n]
+	)
+
+	// Step 1: t0 = x^0x2
+	t0.Mul(x, x)
+
+	// Step 2: t11 = x^0x3
+	t11.Mul(x, t0)
+
+	// Step 3: t12 = x^0x5
+	t12.Mul(t0, t11)
+
+	// Step 4: t7 = x^0x7
+	t7.Mul(t0, t12)
+
+	// Step 5: t2 = x^0x9
+	t2.Mul(t0, t7)
+
+	// Step 6: t9 = x^0xb
+	t9.Mul(t0, t2)
+
+	// Step 7: t6 = x^0xd
+	t6.Mul(t0, t9)
+
+	// Step 8: t8 = x^0xf
+	t8.Mul(t0, t6)
+
+	// Step 9: t1 = x^0x10
+	t1.Mul(x, t8)
+
+	// Step 10: t4 = x^0x11
+	t4.Mul(x, t1)
+
+	// Step 11: t10 = x^0x13
+	t10.Mul(t0, t4)
+
+	// Step 12: z = x^0x15
+	z.Mul(t0, t10)
+
+	// Step 13: t3 = x^0x17
+	t3.Mul(t0, z)
+
+	// Step 14: t0 = x^0x19
+	t0.Mul(t0, t3)
+
+	// Step 15: t1 = x^0x1d
+	t1.Mul(t6, t1)
+
+	// Step 16: t5 = x^0x3a
+	t5.Mul(t1, t1)
+
+	// Step 17: t5 = x^0x3f
+	t5.Mul(t12, t5)
+
+	// Step 18: t13 = x^0x40
+	t13.Mul(x, t5)
+
+	// Step 21: t13 = x^0x200
+	for s := 0; s < 3; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 22: t13 = x^0x20b
+	t13.Mul(t9, t13)
+
+	// Step 30: t13 = x^0x20b00
+	for s := 0; s < 8; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 31: t13 = x^0x20b19
+	t13.Mul(t0, t13)
+
+	// Step 37: t13 = x^0x82c640
+	for s := 0; s < 6; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 38: t13 = x^0x82c651
+	t13.Mul(t4, t13)
+
+	// Step 46: t13 = x^0x82c65100
+	for s := 0; s < 8; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 47: t13 = x^0x82c65113
+	t13.Mul(t10, t13)
+
+	// Step 52: t13 = x^0x1058ca2260
+	for s := 0; s < 5; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 53: t13 = x^0x1058ca226f
+	t13.Mul(t8, t13)
+
+	// Step 56: t13 = x^0x82c6511378
+	for s := 0; s < 3; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 57: t13 = x^0x82c651137b
+	t13.Mul(t11, t13)
+
+	// Step 67: t13 = x^0x20b19444dec00
+	for s := 0; s < 10; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 68: t13 = x^0x20b19444dec11
+	t13.Mul(t4, t13)
+
+	// Step 74: t13 = x^0x82c651137b0440
+	for s := 0; s < 6; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 75: t13 = x^0x82c651137b0449
+	t13.Mul(t2, t13)
+
+	// Step 81: t13 = x^0x20b19444dec11240
+	for s := 0; s < 6; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 82: t13 = x^0x20b19444dec11259
+	t13.Mul(t0, t13)
+
+	// Step 85: t13 = x^0x1058ca226f60892c8
+	for s := 0; s < 3; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 86: t13 = x^0x1058ca226f60892cf
+	t13.Mul(t7, t13)
+
+	// Step 91: t13 = x^0x20b19444dec11259e0
+	for s := 0; s < 5; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 92: t12 = x^0x20b19444dec11259e5
+	t12.Mul(t12, t13)
+
+	// Step 101: t12 = x^0x41632889bd8224b3ca00
+	for s := 0; s < 9; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 102: t12 = x^0x41632889bd8224b3ca3f
+	t12.Mul(t5, t12)
+
+	// Step 109: t12 = x^0x20b19444dec11259e51f80
+	for s := 0; s < 7; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 110: t12 = x^0x20b19444dec11259e51f8b
+	t12.Mul(t9, t12)
+
+	// Step 112: t12 = x^0x82c651137b044967947e2c
+	for s := 0; s < 2; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 113: t12 = x^0x82c651137b044967947e2d
+	t12.Mul(x, t12)
+
+	// Step 122: t12 = x^0x1058ca226f60892cf28fc5a00
+	for s := 0; s < 9; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 123: t12 = x^0x1058ca226f60892cf28fc5a0b
+	t12.Mul(t9, t12)
+
+	// Step 130: t12 = x^0x82c651137b044967947e2d0580
+	for s := 0; s < 7; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 131: t12 = x^0x82c651137b044967947e2d05bf
+	t12.Mul(t5, t12)
+
+	// Step 133: t12 = x^0x20b19444dec11259e51f8b416fc
+	for s := 0; s < 2; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 134: t12 = x^0x20b19444dec11259e51f8b416ff
+	t12.Mul(t11, t12)
+
+	// Step 141: t12 = x^0x1058ca226f60892cf28fc5a0b7f80
+	for s := 0; s < 7; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 142: t12 = x^0x1058ca226f60892cf28fc5a0b7f9d
+	t12.Mul(t1, t12)
+
+	// Step 151: t12 = x^0x20b19444dec11259e51f8b416ff3a00
+	for s := 0; s < 9; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 152: t12 = x^0x20b19444dec11259e51f8b416ff3a07
+	t12.Mul(t7, t12)
+
+	// Step 159: t12 = x^0x1058ca226f60892cf28fc5a0b7f9d0380
+	for s := 0; s < 7; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 160: t12 = x^0x1058ca226f60892cf28fc5a0b7f9d0391
+	t12.Mul(t4, t12)
+
+	// Step 165: t12 = x^0x20b19444dec11259e51f8b416ff3a07220
+	for s := 0; s < 5; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 166: t12 = x^0x20b19444dec11259e51f8b416ff3a0722d
+	t12.Mul(t6, t12)
+
+	// Step 172: t12 = x^0x82c651137b044967947e2d05bfce81c8b40
+	for s := 0; s < 6; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 173: t12 = x^0x82c651137b044967947e2d05bfce81c8b4d
+	t12.Mul(t6, t12)
+
+	// Step 177: t12 = x^0x82c651137b044967947e2d05bfce81c8b4d0
+	for s := 0; s < 4; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 178: t11 = x^0x82c651137b044967947e2d05bfce81c8b4d3
+	t11.Mul(t11, t12)
+
+	// Step 186: t11 = x^0x82c651137b044967947e2d05bfce81c8b4d300
+	for s := 0; s < 8; s++ {
+		t11.Mul(t11, t11)
+	}
+
+	// Step 187: t11 = x^0x82c651137b044967947e2d05bfce81c8b4d30f
+	t11.Mul(t8, t11)
+
+	// Step 193: t11 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3c0
+	for s := 0; s < 6; s++ {
+		t11.Mul(t11, t11)
+	}
+
+	// Step 194: t11 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd
+	t11.Mul(t6, t11)
+
+	// Step 203: t11 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a00
+	for s := 0; s < 9; s++ {
+		t11.Mul(t11, t11)
+	}
+
+	// Step 204: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a13
+	t10.Mul(t10, t11)
+
+	// Step 210: t10 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c0
+	for s := 0; s < 6; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 211: t10 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c7
+	t10.Mul(t7, t10)
+
+	// Step 217: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131c0
+	for s := 0; s < 6; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 218: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd
+	t10.Mul(t6, t10)
+
+	// Step 226: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd00
+	for s := 0; s < 8; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 227: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11
+	t10.Mul(t4, t10)
+
+	// Step 231: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd110
+	for s := 0; s < 4; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 232: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b
+	t10.Mul(t9, t10)
+
+	// Step 237: t10 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a2360
+	for s := 0; s < 5; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 238: t9 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b
+	t9.Mul(t9, t10)
+
+	// Step 243: t9 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d60
+	for s := 0; s < 5; s++ {
+		t9.Mul(t9, t9)
+	}
+
+	// Step 244: t8 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f
+	t8.Mul(t8, t9)
+
+	// Step 251: t8 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b780
+	for s := 0; s < 7; s++ {
+		t8.Mul(t8, t8)
+	}
+
+	// Step 252: t8 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799
+	t8.Mul(t0, t8)
+
+	// Step 257: t8 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f320
+	for s := 0; s < 5; s++ {
+		t8.Mul(t8, t8)
+	}
+
+	// Step 258: t8 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339
+	t8.Mul(t0, t8)
+
+	// Step 261: t8 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799c8
+	for s := 0; s < 3; s++ {
+		t8.Mul(t8, t8)
+	}
+
+	// Step 262: t7 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf
+	t7.Mul(t7, t8)
+
+	// Step 265: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce78
+	for s := 0; s < 3; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 266: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce79
+	t7.Mul(x, t7)
+
+	// Step 274: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce7900
+	for s := 0; s < 8; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 275: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d
+	t6.Mul(t6, t7)
+
+	// Step 283: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d00
+	for s := 0; s < 8; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 284: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d09
+	t6.Mul(t2, t6)
+
+	// Step 287: t6 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c86848
+	for s := 0; s < 3; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 288: t6 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c86849
+	t6.Mul(x, t6)
+
+	// Step 295: t6 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e4342480
+	for s := 0; s < 7; s++ {
+		t6.Mul(t6, t6)
 	}
 
 	// Step 296: t6 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf
@@ -999,7 +1996,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 303: t6 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125f80
 	for s := 0; s < 7; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 304: t5 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf
@@ -1007,7 +2004,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 310: t5 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efc0
 	for s := 0; s < 6; s++ {
-		t5.Square(t5)
+		t5.Mul(t5, t5)
 	}
 
 	// Step 311: t5 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1
@@ -1015,7 +2012,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 316: t5 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa20
 	for s := 0; s < 5; s++ {
-		t5.Square(t5)
+		t5.Mul(t5, t5)
 	}
 
 	// Step 317: t5 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa35
@@ -1023,7 +2020,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 323: t5 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d40
 	for s := 0; s < 6; s++ {
-		t5.Square(t5)
+		t5.Mul(t5, t5)
 	}
 
 	// Step 324: t4 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d51
@@ -1031,7 +2028,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 331: t4 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a880
 	for s := 0; s < 7; s++ {
-		t4.Square(t4)
+		t4.Mul(t4, t4)
 	}
 
 	// Step 332: t3 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a897
@@ -1039,7 +2036,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 339: t3 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b80
 	for s := 0; s < 7; s++ {
-		t3.Square(t3)
+		t3.Mul(t3, t3)
 	}
 
 	// Step 340: t3 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b95
@@ -1047,7 +2044,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 344: t3 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b950
 	for s := 0; s < 4; s++ {
-		t3.Square(t3)
+		t3.Mul(t3, t3)
 	}
 
 	// Step 345: t2 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fdfa3544b959
@@ -1055,7 +2052,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 351: t2 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e5640
 	for s := 0; s < 6; s++ {
-		t2.Square(t2)
+		t2.Mul(t2, t2)
 	}
 
 	// Step 352: t1 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565d
@@ -1063,7 +2060,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 357: t1 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacba0
 	for s := 0; s < 5; s++ {
-		t1.Square(t1)
+		t1.Mul(t1, t1)
 	}
 
 	// Step 358: t1 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5
@@ -1071,7 +2068,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 364: t1 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed40
 	for s := 0; s < 6; s++ {
-		t1.Square(t1)
+		t1.Mul(t1, t1)
 	}
 
 	// Step 365: t0 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed59
@@ -1079,7 +2076,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 371: t0 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5640
 	for s := 0; s < 6; s++ {
-		t0.Square(t0)
+		t0.Mul(t0, t0)
 	}
 
 	// Step 372: t0 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5655
@@ -1087,11 +2084,498 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 378: t0 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed59540
 	for s := 0; s < 6; s++ {
-		t0.Square(t0)
+		t0.Mul(t0, t0)
 	}
 
 	// Step 379: z = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b2ed59555
 	z.Mul(z, t0)
 
+}
+
+// expByInverseExp is equivalent to z.Exp(x, 1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565dab2aa9)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_100      = 2*_10
+	//	_110      = _10 + _100
+	//	_1100     = 2*_110
+	//	_1101     = 1 + _1100
+	//	_1110     = 1 + _1101
+	//	_11000    = 2*_1100
+	//	_11011    = _1101 + _1110
+	//	_11110    = _110 + _11000
+	//	_100001   = _110 + _11011
+	//	_101001   = _1110 + _11011
+	//	_101011   = _10 + _101001
+	//	_101101   = _10 + _101011
+	//	_111001   = _1100 + _101101
+	//	_111111   = _110 + _111001
+	//	_1001001  = _11110 + _101011
+	//	_1010001  = _11000 + _111001
+	//	_1010101  = _100 + _1010001
+	//	_1100011  = _1110 + _1010101
+	//	_1100101  = _10 + _1100011
+	//	_1100111  = _10 + _1100101
+	//	_1101101  = _110 + _1100111
+	//	_1101111  = _10 + _1101101
+	//	_1111001  = _1100 + _1101101
+	//	_1111101  = _100 + _1111001
+	//	_10001001 = _1100 + _1111101
+	//	_10010111 = _1110 + _10001001
+	//	_10011011 = _100 + _10010111
+	//	_10110011 = _11000 + _10011011
+	//	_10110111 = _100 + _10110011
+	//	_10111011 = _100 + _10110111
+	//	_11000111 = _1100 + _10111011
+	//	_11010001 = _11110 + _10110011
+	//	_11010011 = _10 + _11010001
+	//	_11010101 = _10 + _11010011
+	//	_11110011 = _11110 + _11010101
+	//	_11111001 = _110 + _11110011
+	//	i55       = ((_1001001 + _10111011) << 6 + _1100011) << 9 + _1010001
+	//	i86       = ((i55 << 11 + _10011011) << 5 + _11011) << 13
+	//	i106      = ((_10001001 + i86) << 10 + _10110011) << 7 + _1100101
+	//	i139      = ((i106 << 9 + _111111) << 9 + _101101) << 13
+	//	i154      = ((_10110111 + i139) << 8 + _11111001) << 4 + _1101
+	//	i187      = ((i154 << 12 + _111001) << 9 + _101101) << 10
+	//	i209      = ((_11010011 + i187) << 12 + _11110011) << 7 + _100001
+	//	i241      = ((i209 << 10 + _11000111) << 10 + _11010001) << 10
+	//	i261      = ((_1101101 + i241) << 8 + _1101111) << 9 + _1100111
+	//	i291      = ((i261 << 9 + _1111001) << 8 + _1101) << 11
+	//	i310      = ((_1001001 + i291) << 9 + _100 + _11111001) << 7
+	//	i335      = ((_1111101 + i310) << 11 + _11010101) << 11 + _10010111
+	//	i362      = ((i335 << 8 + _101011) << 10 + _10111011) << 7
+	//	return      ((_101011 + i362) << 9 + _1010101) << 7 + _101001
+	//
+	// Operations: 312 squares 69 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+		t21 = new(Element)
+		t22 = new(Element)
+		t23 = new(Element)
+		t24 = new(Element)
+		t25 = new(Element)
+		t26 = new(Element)
+		t27 = new(Element)
+		t28 = new(Element)
+		t29 = new(Element)
+		t30 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21,t22,t23,t24,t25,t26,t27,t28,t29,t30 Element
+	// Step 1: t4 = x^0x2
+	t4.Square(&x)
+
+	// Step 2: t7 = x^0x4
+	t7.Square(t4)
+
+	// Step 3: t6 = x^0x6
+	t6.Mul(t4, t7)
+
+	// Step 4: t14 = x^0xc
+	t14.Square(t6)
+
+	// Step 5: t9 = x^0xd
+	t9.Mul(&x, t14)
+
+	// Step 6: t3 = x^0xe
+	t3.Mul(&x, t9)
+
+	// Step 7: t2 = x^0x18
+	t2.Square(t14)
+
+	// Step 8: t26 = x^0x1b
+	t26.Mul(t9, t3)
+
+	// Step 9: t17 = x^0x1e
+	t17.Mul(t6, t2)
+
+	// Step 10: t16 = x^0x21
+	t16.Mul(t6, t26)
+
+	// Step 11: z = x^0x29
+	z.Mul(t3, t26)
+
+	// Step 12: t1 = x^0x2b
+	t1.Mul(t4, z)
+
+	// Step 13: t19 = x^0x2d
+	t19.Mul(t4, t1)
+
+	// Step 14: t20 = x^0x39
+	t20.Mul(t14, t19)
+
+	// Step 15: t22 = x^0x3f
+	t22.Mul(t6, t20)
+
+	// Step 16: t8 = x^0x49
+	t8.Mul(t17, t1)
+
+	// Step 17: t28 = x^0x51
+	t28.Mul(t2, t20)
+
+	// Step 18: t0 = x^0x55
+	t0.Mul(t7, t28)
+
+	// Step 19: t29 = x^0x63
+	t29.Mul(t3, t0)
+
+	// Step 20: t23 = x^0x65
+	t23.Mul(t4, t29)
+
+	// Step 21: t11 = x^0x67
+	t11.Mul(t4, t23)
+
+	// Step 22: t13 = x^0x6d
+	t13.Mul(t6, t11)
+
+	// Step 23: t12 = x^0x6f
+	t12.Mul(t4, t13)
+
+	// Step 24: t10 = x^0x79
+	t10.Mul(t14, t13)
+
+	// Step 25: t5 = x^0x7d
+	t5.Mul(t7, t10)
+
+	// Step 26: t25 = x^0x89
+	t25.Mul(t14, t5)
+
+	// Step 27: t3 = x^0x97
+	t3.Mul(t3, t25)
+
+	// Step 28: t27 = x^0x9b
+	t27.Mul(t7, t3)
+
+	// Step 29: t24 = x^0xb3
+	t24.Mul(t2, t27)
+
+	// Step 30: t21 = x^0xb7
+	t21.Mul(t7, t24)
+
+	// Step 31: t2 = x^0xbb
+	t2.Mul(t7, t21)
+
+	// Step 32: t15 = x^0xc7
+	t15.Mul(t14, t2)
+
+	// Step 33: t14 = x^0xd1
+	t14.Mul(t17, t24)
+
+	// Step 34: t18 = x^0xd3
+	t18.Mul(t4, t14)
+
+	// Step 35: t4 = x^0xd5
+	t4.Mul(t4, t18)
+
+	// Step 36: t17 = x^0xf3
+	t17.Mul(t17, t4)
+
+	// Step 37: t6 = x^0xf9
+	t6.Mul(t6, t17)
+
+	// Step 38: t30 = x^0x104
+	t30.Mul(t8, t2)
+
+	// Step 44: t30 = x^0x4100
+	for s := 0; s < 6; s++ {
+		t30.Square(t30)
+	}
+
+	// Step 45: t29 = x^0x4163
+	t29.Mul(t29, t30)
+
+	// Step 54: t29 = x^0x82c600
+	for s := 0; s < 9; s++ {
+		t29.Square(t29)
+	}
+
+	// Step 55: t28 = x^0x82c651
+	t28.Mul(t28, t29)
+
+	// Step 66: t28 = x^0x416328800
+	for s := 0; s < 11; s++ {
+		t28.Square(t28)
+	}
+
+	// Step 67: t27 = x^0x41632889b
+	t27.Mul(t27, t28)
+
+	// Step 72: t27 = x^0x82c6511360
+	for s := 0; s < 5; s++ {
+		t27.Square(t27)
+	}
+
+	// Step 73: t26 = x^0x82c651137b
+	t26.Mul(t26, t27)
+
+	// Step 86: t26 = x^0x1058ca226f6000
+	for s := 0; s < 13; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 87: t25 = x^0x1058ca226f6089
+	t25.Mul(t25, t26)
+
+	// Step 97: t25 = x^0x41632889bd822400
+	for s := 0; s < 10; s++ {
+		t25.Square(t25)
+	}
+
+	// Step 98: t24 = x^0x41632889bd8224b3
+	t24.Mul(t24, t25)
+
+	// Step 105: t24 = x^0x20b19444dec1125980
+	for s := 0; s < 7; s++ {
+		t24.Square(t24)
+	}
+
+	// Step 106: t23 = x^0x20b19444dec11259e5
+	t23.Mul(t23, t24)
+
+	// Step 115: t23 = x^0x41632889bd8224b3ca00
+	for s := 0; s < 9; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 116: t22 = x^0x41632889bd8224b3ca3f
+	t22.Mul(t22, t23)
+
+	// Step 125: t22 = x^0x82c651137b044967947e00
+	for s := 0; s < 9; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 126: t22 = x^0x82c651137b044967947e2d
+	t22.Mul(t19, t22)
+
+	// Step 139: t22 = x^0x1058ca226f60892cf28fc5a000
+	for s := 0; s < 13; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 140: t21 = x^0x1058ca226f60892cf28fc5a0b7
+	t21.Mul(t21, t22)
+
+	// Step 148: t21 = x^0x1058ca226f60892cf28fc5a0b700
+	for s := 0; s < 8; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 149: t21 = x^0x1058ca226f60892cf28fc5a0b7f9
+	t21.Mul(t6, t21)
+
+	// Step 153: t21 = x^0x1058ca226f60892cf28fc5a0b7f90
+	for s := 0; s < 4; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 154: t21 = x^0x1058ca226f60892cf28fc5a0b7f9d
+	t21.Mul(t9, t21)
+
+	// Step 166: t21 = x^0x1058ca226f60892cf28fc5a0b7f9d000
+	for s := 0; s < 12; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 167: t20 = x^0x1058ca226f60892cf28fc5a0b7f9d039
+	t20.Mul(t20, t21)
+
+	// Step 176: t20 = x^0x20b19444dec11259e51f8b416ff3a07200
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 177: t19 = x^0x20b19444dec11259e51f8b416ff3a0722d
+	t19.Mul(t19, t20)
+
+	// Step 187: t19 = x^0x82c651137b044967947e2d05bfce81c8b400
+	for s := 0; s < 10; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 188: t18 = x^0x82c651137b044967947e2d05bfce81c8b4d3
+	t18.Mul(t18, t19)
+
+	// Step 200: t18 = x^0x82c651137b044967947e2d05bfce81c8b4d3000
+	for s := 0; s < 12; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 201: t17 = x^0x82c651137b044967947e2d05bfce81c8b4d30f3
+	t17.Mul(t17, t18)
+
+	// Step 208: t17 = x^0x41632889bd8224b3ca3f1682dfe740e45a6987980
+	for s := 0; s < 7; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 209: t16 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a1
+	t16.Mul(t16, t17)
+
+	// Step 219: t16 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e68400
+	for s := 0; s < 10; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 220: t15 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c7
+	t15.Mul(t15, t16)
+
+	// Step 230: t15 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131c00
+	for s := 0; s < 10; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 231: t14 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd1
+	t14.Mul(t14, t15)
+
+	// Step 241: t14 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c734400
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 242: t13 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d
+	t13.Mul(t13, t14)
+
+	// Step 250: t13 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 251: t12 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f
+	t12.Mul(t12, t13)
+
+	// Step 260: t12 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade00
+	for s := 0; s < 9; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 261: t11 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade67
+	t11.Mul(t11, t12)
+
+	// Step 270: t11 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce00
+	for s := 0; s < 9; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 271: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce79
+	t10.Mul(t10, t11)
+
+	// Step 279: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce7900
+	for s := 0; s < 8; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 280: t9 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d
+	t9.Mul(t9, t10)
+
+	// Step 291: t9 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c86800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 292: t8 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c86849
+	t8.Mul(t8, t9)
+
+	// Step 301: t8 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d09200
+	for s := 0; s < 9; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 302: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d09204
+	t7.Mul(t7, t8)
+
+	// Step 303: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fd
+	t6.Mul(t6, t7)
+
+	// Step 310: t6 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497e80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 311: t5 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd
+	t5.Mul(t5, t6)
+
+	// Step 322: t5 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e800
+	for s := 0; s < 11; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 323: t4 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d5
+	t4.Mul(t4, t5)
+
+	// Step 334: t4 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a800
+	for s := 0; s < 11; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 335: t3 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a897
+	t3.Mul(t3, t4)
+
+	// Step 343: t3 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a89700
+	for s := 0; s < 8; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 344: t3 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b
+	t3.Mul(t1, t3)
+
+	// Step 354: t3 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cac00
+	for s := 0; s < 10; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 355: t2 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb
+	t2.Mul(t2, t3)
+
+	// Step 362: t2 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565d80
+	for s := 0; s < 7; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 363: t1 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565dab
+	t1.Mul(t1, t2)
+
+	// Step 372: t1 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5600
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 373: t0 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5655
+	t0.Mul(t0, t1)
+
+	// Step 380: t0 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565dab2a80
+	for s := 0; s < 7; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 381: z = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565dab2aa9
+	z.Mul(z, t0)
+
 	return z
 }
@@ -1,5 +1,5 @@
-//go:build !amd64 || purego
-// +build !amd64 purego
+//go:build (!amd64 && !arm64) || purego
+// +build !amd64,!arm64 purego
 
 // Copyright 2020 ConsenSys Software Inc.
 //
@@ -330,15 +330,21 @@ func (z *Element) Mul(x, y *Element) *Element {
 	z[3] = t3
 	z[4] = t4
 
-	// if z ⩾ q → z -= q
-	if !z.smallerThanModulus() {
-		var b uint64
-		z[0], b = bits.Sub64(z[0], q0, 0)
-		z[1], b = bits.Sub64(z[1], q1, b)
-		z[2], b = bits.Sub64(z[2], q2, b)
-		z[3], b = bits.Sub64(z[3], q3, b)
-		z[4], _ = bits.Sub64(z[4], q4, b)
-	}
+	// if z ⩾ q → z -= q, without branching on z
+	var s [5]uint64
+	var b uint64
+	s[0], b = bits.Sub64(z[0], q0, 0)
+	s[1], b = bits.Sub64(z[1], q1, b)
+	s[2], b = bits.Sub64(z[2], q2, b)
+	s[3], b = bits.Sub64(z[3], q3, b)
+	s[4], b = bits.Sub64(z[4], q4, b)
+	// mask = 0xFF..FF if z ⩾ q (no borrow), 0 otherwise
+	mask := b - 1
+	z[0] ^= mask & (z[0] ^ s[0])
+	z[1] ^= mask & (z[1] ^ s[1])
+	z[2] ^= mask & (z[2] ^ s[2])
+	z[3] ^= mask & (z[3] ^ s[3])
+	z[4] ^= mask & (z[4] ^ s[4])
 	return z
 }
 
@@ -569,14 +575,20 @@ func (z *Element) Square(x *Element) *Element {
 	z[3] = t3
 	z[4] = t4
 
-	// if z ⩾ q → z -= q
-	if !z.smallerThanModulus() {
-		var b uint64
-		z[0], b = bits.Sub64(z[0], q0, 0)
-		z[1], b = bits.Sub64(z[1], q1, b)
-		z[2], b = bits.Sub64(z[2], q2, b)
-		z[3], b = bits.Sub64(z[3], q3, b)
-		z[4], _ = bits.Sub64(z[4], q4, b)
-	}
+	// if z ⩾ q → z -= q, without branching on z
+	var s [5]uint64
+	var b uint64
+	s[0], b = bits.Sub64(z[0], q0, 0)
+	s[1], b = bits.Sub64(z[1], q1, b)
+	s[2], b = bits.Sub64(z[2], q2, b)
+	s[3], b = bits.Sub64(z[3], q3, b)
+	s[4], b = bits.Sub64(z[4], q4, b)
+	// mask = 0xFF..FF if z ⩾ q (no borrow), 0 otherwise
+	mask := b - 1
+	z[0] ^= mask & (z[0] ^ s[0])
+	z[1] ^= mask & (z[1] ^ s[1])
+	z[2] ^= mask & (z[2] ^ s[2])
+	z[3] ^= mask & (z[3] ^ s[3])
+	z[4] ^= mask & (z[4] ^ s[4])
 	return z
 }
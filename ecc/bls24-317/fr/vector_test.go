@@ -19,6 +19,7 @@ package fr
 import (
 	"bytes"
 	"github.com/stretchr/testify/require"
+	mrand "math/rand"
 	"reflect"
 	"sort"
 	"testing"
@@ -80,6 +81,115 @@ func TestVectorEmptyRoundTrip(t *testing.T) {
 	assert.True(reflect.DeepEqual(v3, v2))
 }
 
+func TestVectorRandomFrom(t *testing.T) {
+	assert := require.New(t)
+
+	a, b := make(Vector, 64), make(Vector, 64)
+	assert.NoError(a.RandomFrom(mrand.New(mrand.NewSource(42)))) //#nosec G404 -- deterministic test fixture
+	assert.NoError(b.RandomFrom(mrand.New(mrand.NewSource(42)))) //#nosec G404 -- deterministic test fixture
+	assert.True(reflect.DeepEqual(a, b), "same seed should give the same vector")
+
+	assert.Error(a.RandomFrom(bytes.NewReader(nil)))
+}
+
+func TestVectorOps(t *testing.T) {
+	assert := require.New(t)
+
+	var qMinusOne Element
+	qMinusOne.SetOne().Neg(&qMinusOne)
+
+	for _, n := range []int{0, 1, 2, 7, 8, 9, 16, 31, 64, 257, 1000} {
+		a, b := make(Vector, n), make(Vector, n)
+		for i := 0; i < n; i++ {
+			if i%5 == 0 {
+				// edge cases
+				a[i] = qMinusOne
+				b[i] = qMinusOne
+				continue
+			}
+			a[i].SetRandom()
+			b[i].SetRandom()
+		}
+		var scalar Element
+		scalar.SetRandom()
+
+		res, expected := make(Vector, n), make(Vector, n)
+
+		res.Add(a, b)
+		addVecGeneric(expected, a, b)
+		assert.True(reflect.DeepEqual(expected, res), "Add, n = %d", n)
+
+		res.Sub(a, b)
+		subVecGeneric(expected, a, b)
+		assert.True(reflect.DeepEqual(expected, res), "Sub, n = %d", n)
+
+		res.Mul(a, b)
+		mulVecGeneric(expected, a, b)
+		assert.True(reflect.DeepEqual(expected, res), "Mul, n = %d", n)
+
+		res.ScalarMul(a, &scalar)
+		scalarMulVecGeneric(expected, a, &scalar)
+		assert.True(reflect.DeepEqual(expected, res), "ScalarMul, n = %d", n)
+
+		var expectedSum, expectedInnerProduct Element
+		sumVecGeneric(&expectedSum, a)
+		innerProductVecGeneric(&expectedInnerProduct, a, b)
+		sum, innerProduct := a.Sum(), a.InnerProduct(b)
+		assert.True(expectedSum.Equal(&sum), "Sum, n = %d", n)
+		assert.True(expectedInnerProduct.Equal(&innerProduct), "InnerProduct, n = %d", n)
+
+		// in place
+		res.Mul(a, b)
+		a.Mul(a, b)
+		assert.True(reflect.DeepEqual(a, res), "Mul in place, n = %d", n)
+	}
+
+	assert.Panics(func() {
+		v := make(Vector, 2)
+		v.Add(make(Vector, 2), make(Vector, 3))
+	})
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const N = 1 << 16
+	a, c, res := make(Vector, N), make(Vector, N), make(Vector, N)
+	for i := 0; i < N; i++ {
+		a[i].SetRandom()
+		c[i].SetRandom()
+	}
+
+	b.Run("Add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Add(a, c)
+		}
+	})
+	b.Run("Sub", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Sub(a, c)
+		}
+	})
+	b.Run("Mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Mul(a, c)
+		}
+	})
+	b.Run("ScalarMul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMul(a, &c[0])
+		}
+	})
+	b.Run("Sum", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = a.Sum()
+		}
+	})
+	b.Run("InnerProduct", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = a.InnerProduct(c)
+		}
+	})
+}
+
 func (vector *Vector) unmarshalBinaryAsync(data []byte) error {
 	r := bytes.NewReader(data)
 	_, err, chErr := vector.AsyncReadFrom(r)
@@ -15,7 +15,9 @@
 package fptower
 
 import (
+	"crypto/rand"
 	"errors"
+	"io"
 	"math/big"
 	"sync"
 
@@ -88,10 +90,15 @@ func (z *E24) Double(x *E24) *E24 {
 
 // SetRandom used only in tests
 func (z *E24) SetRandom() (*E24, error) {
-	if _, err := z.D0.SetRandom(); err != nil {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom is like SetRandom, reading the randomness from r
+func (z *E24) SetRandomFrom(r io.Reader) (*E24, error) {
+	if _, err := z.D0.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
-	if _, err := z.D1.SetRandom(); err != nil {
+	if _, err := z.D1.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
 	return z, nil
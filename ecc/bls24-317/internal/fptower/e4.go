@@ -15,6 +15,8 @@
 package fptower
 
 import (
+	"crypto/rand"
+	"io"
 	"math/big"
 
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fp"
@@ -30,6 +32,14 @@ func (z *E4) Equal(x *E4) bool {
 	return z.B0.Equal(&x.B0) && z.B1.Equal(&x.B1)
 }
 
+// Bits returns the coordinates of z in regular (non-Montgomery) form, stored in a E4
+func (z *E4) Bits() E4 {
+	r := E4{}
+	r.B0 = z.B0.Bits()
+	r.B1 = z.B1.Bits()
+	return r
+}
+
 // Cmp compares (lexicographic order) z and x and returns:
 //
 //	-1 if z <  x
@@ -124,10 +134,15 @@ func (z *E4) Neg(x *E4) *E4 {
 
 // SetRandom used only in tests
 func (z *E4) SetRandom() (*E4, error) {
-	if _, err := z.B0.SetRandom(); err != nil {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom is like SetRandom, reading the randomness from r
+func (z *E4) SetRandomFrom(r io.Reader) (*E4, error) {
+	if _, err := z.B0.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
-	if _, err := z.B1.SetRandom(); err != nil {
+	if _, err := z.B1.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
 	return z, nil
@@ -348,6 +363,14 @@ func BatchInvertE4(a []E4) []E4 {
 	return res
 }
 
+// Select is a constant-time conditional move.
+// If cond=0, z = caseZ. Else z = caseNz
+func (z *E4) Select(cond int, caseZ *E4, caseNz *E4) *E4 {
+	z.B0.Select(cond, &caseZ.B0, &caseNz.B0)
+	z.B1.Select(cond, &caseZ.B1, &caseNz.B1)
+	return z
+}
+
 func (z *E4) Div(x *E4, y *E4) *E4 {
 	var r E4
 	r.Inverse(y).Mul(x, &r)
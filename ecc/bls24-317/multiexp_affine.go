@@ -32,13 +32,16 @@ type batchOpG1Affine struct {
 //
 // this is derived from a PR by 0x0ece : https://github.com/ConsenSys/gnark-crypto/pull/249
 // See Section 5.3: ia.cr/2022/1396
+//
+// If chSum is not nil, the plain sum of the buckets is sent to it after the weighted sum is sent to chRes.
 func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP pG1Affine, TPP ppG1Affine, TQ qOpsG1Affine, TC cG1Affine](
 	chunk uint64,
 	chRes chan<- g1JacExtended,
 	c uint64,
 	points []G1Affine,
 	digits []uint16,
-	sem chan struct{}) {
+	sem chan struct{},
+	chSum chan<- g1JacExtended) {
 
 	if sem != nil {
 		// if we are limited, wait for a token in the semaphore
@@ -63,7 +66,7 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 	var buckets B
 	var bucketsJE BJE
 	for i := 0; i < len(buckets); i++ {
-		buckets[i].setInfinity()
+		buckets[i].SetInfinity()
 		bucketsJE[i].setInfinity()
 	}
 
@@ -107,7 +110,7 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 				bucketsJE[op.bucketID].addMixed(&op.point)
 				return
 			}
-			BK.setInfinity()
+			BK.SetInfinity()
 			return
 		}
 
@@ -135,12 +138,12 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 				if isAdd {
 					bucketsJE[bucketID].addMixed(PP)
 				} else {
-					BK.setInfinity()
+					BK.SetInfinity()
 				}
 				return
 			}
 			if isAdd {
-				BK.setInfinity()
+				BK.SetInfinity()
 			} else {
 				bucketsJE[bucketID].subMixed(PP)
 			}
@@ -239,6 +242,10 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 	}
 
 	chRes <- total
+	if chSum != nil {
+		// runningSum = bucket[0] + bucket[1] + ... + bucket[n-1]
+		chSum <- runningSum
+	}
 
 }
 
@@ -360,13 +367,16 @@ type batchOpG2Affine struct {
 //
 // this is derived from a PR by 0x0ece : https://github.com/ConsenSys/gnark-crypto/pull/249
 // See Section 5.3: ia.cr/2022/1396
+//
+// If chSum is not nil, the plain sum of the buckets is sent to it after the weighted sum is sent to chRes.
 func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP pG2Affine, TPP ppG2Affine, TQ qOpsG2Affine, TC cG2Affine](
 	chunk uint64,
 	chRes chan<- g2JacExtended,
 	c uint64,
 	points []G2Affine,
 	digits []uint16,
-	sem chan struct{}) {
+	sem chan struct{},
+	chSum chan<- g2JacExtended) {
 
 	if sem != nil {
 		// if we are limited, wait for a token in the semaphore
@@ -391,7 +401,7 @@ func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP
 	var buckets B
 	var bucketsJE BJE
 	for i := 0; i < len(buckets); i++ {
-		buckets[i].setInfinity()
+		buckets[i].SetInfinity()
 		bucketsJE[i].setInfinity()
 	}
 
@@ -435,7 +445,7 @@ func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP
 				bucketsJE[op.bucketID].addMixed(&op.point)
 				return
 			}
-			BK.setInfinity()
+			BK.SetInfinity()
 			return
 		}
 
@@ -463,12 +473,12 @@ func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP
 				if isAdd {
 					bucketsJE[bucketID].addMixed(PP)
 				} else {
-					BK.setInfinity()
+					BK.SetInfinity()
 				}
 				return
 			}
 			if isAdd {
-				BK.setInfinity()
+				BK.SetInfinity()
 			} else {
 				bucketsJE[bucketID].subMixed(PP)
 			}
@@ -567,6 +577,10 @@ func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP
 	}
 
 	chRes <- total
+	if chSum != nil {
+		// runningSum = bucket[0] + bucket[1] + ... + bucket[n-1]
+		chSum <- runningSum
+	}
 
 }
 
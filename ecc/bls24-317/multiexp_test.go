@@ -17,6 +17,7 @@
 package bls24317
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"math/bits"
@@ -59,10 +60,10 @@ func TestMultiExpG1(t *testing.T) {
 
 	// sprinkle some points at infinity
 	rand.Seed(time.Now().UnixNano())
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
 
 	// final scalar to use in double and add method (without mixer factor)
 	// n(n+1)(2n+1)/6  (sum of the squares from 1 to n)
@@ -156,7 +157,7 @@ func TestMultiExpG1(t *testing.T) {
 			for i := 1; i <= nbSamples; i++ {
 				sampleScalars[i-1].SetUint64(uint64(i)).
 					Mul(&sampleScalars[i-1], &mixer)
-				samplePointsZero[i-1].setInfinity()
+				samplePointsZero[i-1].SetInfinity()
 			}
 
 			results := make([]G1Jac, len(cRange))
@@ -244,10 +245,10 @@ func TestCrossMultiExpG1(t *testing.T) {
 
 	// sprinkle some points at infinity
 	rand.Seed(time.Now().UnixNano())
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
 
 	var sampleScalars [nbSamples]fr.Element
 	fillBenchScalars(sampleScalars[:])
@@ -306,7 +307,7 @@ func _innerMsmG1Reference(p *G1Jac, points []G1Affine, scalars []fr.Element, con
 	n := len(points)
 	for j := int(nbChunks - 1); j >= 0; j-- {
 		processChunk := processChunkG1Jacobian[bucketg1JacExtendedC16]
-		go processChunk(uint64(j), chChunks[j], 16, points, digits[j*n:(j+1)*n], nil)
+		go processChunk(uint64(j), chChunks[j], 16, points, digits[j*n:(j+1)*n], nil, nil)
 	}
 
 	return msmReduceChunkG1Affine(p, int(16), chChunks[:])
@@ -428,6 +429,266 @@ func BenchmarkManyMultiExpG1Reference(b *testing.B) {
 	}
 }
 
+func TestMultiExpPrecomputedG1(t *testing.T) {
+	const nbSamples = 1 << 10
+	// multi exp points
+	var samplePoints [nbSamples]G1Affine
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+
+	// sprinkle some points at infinity
+	rand.Seed(time.Now().UnixNano())
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+
+	var sampleScalars [nbSamples]fr.Element
+	fillBenchScalars(sampleScalars[:])
+
+	var expected, expectedPrefix G1Affine
+	expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+	expectedPrefix.MultiExp(samplePoints[:nbSamples/3], sampleScalars[:nbSamples/3], ecc.MultiExpConfig{})
+
+	// c == 0 picks the window size, and we test the smallest and largest ones too
+	cs := []uint64{0, g1MultiExpTableCs[0], g1MultiExpTableCs[len(g1MultiExpTableCs)-1]}
+	for _, c := range cs {
+		table, err := NewG1MultiExpTable(samplePoints[:], c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got G1Affine
+		if _, err := got.MultiExpPrecomputed(table, sampleScalars[:], ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatalf("precomputed msm failed with c=%d", table.C())
+		}
+
+		if _, err := got.MultiExpPrecomputed(table, sampleScalars[:nbSamples/3], ecc.MultiExpConfig{NbTasks: 3}); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expectedPrefix) {
+			t.Fatalf("precomputed msm on a prefix of the bases failed with c=%d", table.C())
+		}
+	}
+
+	// every plan a table with the smallest window size can run: multiples of its window size,
+	// buckets split in ranges and bases split in slices
+	table, err := NewG1MultiExpTable(samplePoints[:], g1MultiExpTableCs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range g1MultiExpTableCs {
+		if c%table.C() != 0 || !containsC(g1MultiExpTableCs, bucketsC(c)) {
+			continue
+		}
+		for nbRanges := 1; nbRanges <= 4; nbRanges *= 2 {
+			if cR := bucketsC(c) - uint64(bits.TrailingZeros(uint(nbRanges))); nbRanges > 1 && !containsC(g1MultiExpTableCs, cR) {
+				continue
+			}
+			for _, nbSplits := range []int{1, 3} {
+				plan := precomputedPlan{c: c, nbRanges: nbRanges, nbSplits: nbSplits}
+				var got G1Jac
+				var gotAff G1Affine
+				got.multiExpPrecomputed(table, sampleScalars[:], nbRanges*nbSplits, plan)
+				if !gotAff.FromJacobian(&got).Equal(&expected) {
+					t.Fatalf("precomputed msm failed with plan %+v", plan)
+				}
+			}
+		}
+	}
+
+	// serialization round trip
+	table, err = NewG1MultiExpTable(samplePoints[:100], 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected.MultiExp(samplePoints[:100], sampleScalars[:100], ecc.MultiExpConfig{})
+	for _, raw := range []bool{false, true} {
+		var buf bytes.Buffer
+		var written int64
+		if raw {
+			written, err = table.WriteRawTo(&buf)
+		} else {
+			written, err = table.WriteTo(&buf)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded G1MultiExpTable
+		var read int64
+		if raw {
+			read, err = decoded.UnsafeReadFrom(&buf)
+		} else {
+			read, err = decoded.ReadFrom(&buf)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if read != written {
+			t.Fatal("bytes read != bytes written")
+		}
+		if decoded.C() != table.C() || decoded.Len() != table.Len() {
+			t.Fatal("decoded table doesn't match")
+		}
+
+		var got G1Affine
+		if _, err := got.MultiExpPrecomputed(&decoded, sampleScalars[:100], ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatal("precomputed msm failed after serialization round trip")
+		}
+	}
+}
+
+func BenchmarkMultiExpPrecomputedG1(b *testing.B) {
+	const nbSamples = 1 << 16
+
+	var (
+		samplePoints  [nbSamples]G1Affine
+		sampleScalars [nbSamples]fr.Element
+	)
+
+	fillBenchScalars(sampleScalars[:])
+	fillBenchBasesG1(samplePoints[:])
+
+	var testPoint G1Affine
+
+	// the reference MultiExp and MultiExpPrecomputed run on the same bases and scalars at each size,
+	// with a table built for that size
+	for i := 8; i <= 16; i += 2 {
+		using := 1 << i
+
+		b.Run(fmt.Sprintf("%d points/MultiExp", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], sampleScalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+
+		table, err := NewG1MultiExpTable(samplePoints[:using], 0)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("%d points/MultiExpPrecomputed", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExpPrecomputed(table, sampleScalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+	}
+
+	// a few scalars against a table built for many bases
+	table, err := NewG1MultiExpTable(samplePoints[:], 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("64 points/MultiExp", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			testPoint.MultiExp(samplePoints[:64], sampleScalars[:64], ecc.MultiExpConfig{})
+		}
+	})
+	b.Run("64 points/MultiExpPrecomputed-large table", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			testPoint.MultiExpPrecomputed(table, sampleScalars[:64], ecc.MultiExpConfig{})
+		}
+	})
+}
+
+func TestMultiExpBatchG1(t *testing.T) {
+	const nbSamples = 1 << 10
+	// multi exp points
+	var samplePoints [nbSamples]G1Affine
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+
+	// sprinkle some points at infinity
+	rand.Seed(time.Now().UnixNano())
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+
+	// sprinkle some doublings
+	for i := 10; i < 100; i++ {
+		samplePoints[i] = samplePoints[0]
+	}
+
+	// scalar vectors of different sizes, including a redundant one and an empty one
+	sizes := []int{nbSamples, nbSamples, nbSamples / 2, 1, 0, nbSamples}
+	scalars := make([][]fr.Element, len(sizes))
+	for k, size := range sizes {
+		scalars[k] = make([]fr.Element, size)
+		fillBenchScalars(scalars[k])
+	}
+	copy(scalars[1], scalars[0])
+	for i := range scalars[5] {
+		scalars[5][i] = scalars[5][0]
+	}
+
+	for _, nbTasks := range []int{0, 1, 5} {
+		results, err := MultiExpBatchG1(samplePoints[:], scalars, ecc.MultiExpConfig{NbTasks: nbTasks})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != len(scalars) {
+			t.Fatal("wrong number of results")
+		}
+		for k := range scalars {
+			var expected G1Affine
+			expected.MultiExp(samplePoints[:len(scalars[k])], scalars[k], ecc.MultiExpConfig{})
+			if !results[k].Equal(&expected) {
+				t.Fatalf("batch msm failed for scalar vector %d", k)
+			}
+		}
+	}
+
+	if _, err := MultiExpBatchG1(samplePoints[:10], scalars, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("expected an error with scalar vectors longer than points")
+	}
+}
+
+func BenchmarkMultiExpBatchG1(b *testing.B) {
+	const (
+		nbSamples = 1 << 16
+		nbMSM     = 8
+	)
+
+	var samplePoints [nbSamples]G1Affine
+	fillBenchBasesG1(samplePoints[:])
+
+	scalars := make([][]fr.Element, nbMSM)
+	for k := range scalars {
+		scalars[k] = make([]fr.Element, nbSamples)
+		fillBenchScalars(scalars[k])
+	}
+
+	b.Run("independent", func(b *testing.B) {
+		var testPoint G1Affine
+		for j := 0; j < b.N; j++ {
+			for k := range scalars {
+				testPoint.MultiExp(samplePoints[:], scalars[k], ecc.MultiExpConfig{})
+			}
+		}
+	})
+
+	b.Run("batch", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			MultiExpBatchG1(samplePoints[:], scalars, ecc.MultiExpConfig{})
+		}
+	})
+}
+
 // WARNING: this return points that are NOT on the curve and is meant to be use for benchmarking
 // purposes only. We don't check that the result is valid but just measure "computational complexity".
 //
@@ -475,10 +736,10 @@ func TestMultiExpG2(t *testing.T) {
 
 	// sprinkle some points at infinity
 	rand.Seed(time.Now().UnixNano())
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
 
 	// final scalar to use in double and add method (without mixer factor)
 	// n(n+1)(2n+1)/6  (sum of the squares from 1 to n)
@@ -570,7 +831,7 @@ func TestMultiExpG2(t *testing.T) {
 			for i := 1; i <= nbSamples; i++ {
 				sampleScalars[i-1].SetUint64(uint64(i)).
 					Mul(&sampleScalars[i-1], &mixer)
-				samplePointsZero[i-1].setInfinity()
+				samplePointsZero[i-1].SetInfinity()
 			}
 
 			results := make([]G2Jac, len(cRange))
@@ -658,10 +919,10 @@ func TestCrossMultiExpG2(t *testing.T) {
 
 	// sprinkle some points at infinity
 	rand.Seed(time.Now().UnixNano())
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
-	samplePoints[rand.Intn(nbSamples)].setInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
 
 	var sampleScalars [nbSamples]fr.Element
 	fillBenchScalars(sampleScalars[:])
@@ -718,7 +979,7 @@ func _innerMsmG2Reference(p *G2Jac, points []G2Affine, scalars []fr.Element, con
 	n := len(points)
 	for j := int(nbChunks - 1); j >= 0; j-- {
 		processChunk := processChunkG2Jacobian[bucketg2JacExtendedC16]
-		go processChunk(uint64(j), chChunks[j], 16, points, digits[j*n:(j+1)*n], nil)
+		go processChunk(uint64(j), chChunks[j], 16, points, digits[j*n:(j+1)*n], nil, nil)
 	}
 
 	return msmReduceChunkG2Affine(p, int(16), chChunks[:])
@@ -840,6 +1101,266 @@ func BenchmarkManyMultiExpG2Reference(b *testing.B) {
 	}
 }
 
+func TestMultiExpPrecomputedG2(t *testing.T) {
+	const nbSamples = 1 << 10
+	// multi exp points
+	var samplePoints [nbSamples]G2Affine
+	var g G2Jac
+	g.Set(&g2Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g2Gen)
+	}
+
+	// sprinkle some points at infinity
+	rand.Seed(time.Now().UnixNano())
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+
+	var sampleScalars [nbSamples]fr.Element
+	fillBenchScalars(sampleScalars[:])
+
+	var expected, expectedPrefix G2Affine
+	expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+	expectedPrefix.MultiExp(samplePoints[:nbSamples/3], sampleScalars[:nbSamples/3], ecc.MultiExpConfig{})
+
+	// c == 0 picks the window size, and we test the smallest and largest ones too
+	cs := []uint64{0, g2MultiExpTableCs[0], g2MultiExpTableCs[len(g2MultiExpTableCs)-1]}
+	for _, c := range cs {
+		table, err := NewG2MultiExpTable(samplePoints[:], c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got G2Affine
+		if _, err := got.MultiExpPrecomputed(table, sampleScalars[:], ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatalf("precomputed msm failed with c=%d", table.C())
+		}
+
+		if _, err := got.MultiExpPrecomputed(table, sampleScalars[:nbSamples/3], ecc.MultiExpConfig{NbTasks: 3}); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expectedPrefix) {
+			t.Fatalf("precomputed msm on a prefix of the bases failed with c=%d", table.C())
+		}
+	}
+
+	// every plan a table with the smallest window size can run: multiples of its window size,
+	// buckets split in ranges and bases split in slices
+	table, err := NewG2MultiExpTable(samplePoints[:], g2MultiExpTableCs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range g2MultiExpTableCs {
+		if c%table.C() != 0 || !containsC(g2MultiExpTableCs, bucketsC(c)) {
+			continue
+		}
+		for nbRanges := 1; nbRanges <= 4; nbRanges *= 2 {
+			if cR := bucketsC(c) - uint64(bits.TrailingZeros(uint(nbRanges))); nbRanges > 1 && !containsC(g2MultiExpTableCs, cR) {
+				continue
+			}
+			for _, nbSplits := range []int{1, 3} {
+				plan := precomputedPlan{c: c, nbRanges: nbRanges, nbSplits: nbSplits}
+				var got G2Jac
+				var gotAff G2Affine
+				got.multiExpPrecomputed(table, sampleScalars[:], nbRanges*nbSplits, plan)
+				if !gotAff.FromJacobian(&got).Equal(&expected) {
+					t.Fatalf("precomputed msm failed with plan %+v", plan)
+				}
+			}
+		}
+	}
+
+	// serialization round trip
+	table, err = NewG2MultiExpTable(samplePoints[:100], 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected.MultiExp(samplePoints[:100], sampleScalars[:100], ecc.MultiExpConfig{})
+	for _, raw := range []bool{false, true} {
+		var buf bytes.Buffer
+		var written int64
+		if raw {
+			written, err = table.WriteRawTo(&buf)
+		} else {
+			written, err = table.WriteTo(&buf)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded G2MultiExpTable
+		var read int64
+		if raw {
+			read, err = decoded.UnsafeReadFrom(&buf)
+		} else {
+			read, err = decoded.ReadFrom(&buf)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if read != written {
+			t.Fatal("bytes read != bytes written")
+		}
+		if decoded.C() != table.C() || decoded.Len() != table.Len() {
+			t.Fatal("decoded table doesn't match")
+		}
+
+		var got G2Affine
+		if _, err := got.MultiExpPrecomputed(&decoded, sampleScalars[:100], ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatal("precomputed msm failed after serialization round trip")
+		}
+	}
+}
+
+func BenchmarkMultiExpPrecomputedG2(b *testing.B) {
+	const nbSamples = 1 << 16
+
+	var (
+		samplePoints  [nbSamples]G2Affine
+		sampleScalars [nbSamples]fr.Element
+	)
+
+	fillBenchScalars(sampleScalars[:])
+	fillBenchBasesG2(samplePoints[:])
+
+	var testPoint G2Affine
+
+	// the reference MultiExp and MultiExpPrecomputed run on the same bases and scalars at each size,
+	// with a table built for that size
+	for i := 8; i <= 16; i += 2 {
+		using := 1 << i
+
+		b.Run(fmt.Sprintf("%d points/MultiExp", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], sampleScalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+
+		table, err := NewG2MultiExpTable(samplePoints[:using], 0)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("%d points/MultiExpPrecomputed", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExpPrecomputed(table, sampleScalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+	}
+
+	// a few scalars against a table built for many bases
+	table, err := NewG2MultiExpTable(samplePoints[:], 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("64 points/MultiExp", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			testPoint.MultiExp(samplePoints[:64], sampleScalars[:64], ecc.MultiExpConfig{})
+		}
+	})
+	b.Run("64 points/MultiExpPrecomputed-large table", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			testPoint.MultiExpPrecomputed(table, sampleScalars[:64], ecc.MultiExpConfig{})
+		}
+	})
+}
+
+func TestMultiExpBatchG2(t *testing.T) {
+	const nbSamples = 1 << 10
+	// multi exp points
+	var samplePoints [nbSamples]G2Affine
+	var g G2Jac
+	g.Set(&g2Gen)
+	for i := 1; i <= nbSamples; i++ {
+		samplePoints[i-1].FromJacobian(&g)
+		g.AddAssign(&g2Gen)
+	}
+
+	// sprinkle some points at infinity
+	rand.Seed(time.Now().UnixNano())
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+	samplePoints[rand.Intn(nbSamples)].SetInfinity() //#nosec G404 weak rng is fine here
+
+	// sprinkle some doublings
+	for i := 10; i < 100; i++ {
+		samplePoints[i] = samplePoints[0]
+	}
+
+	// scalar vectors of different sizes, including a redundant one and an empty one
+	sizes := []int{nbSamples, nbSamples, nbSamples / 2, 1, 0, nbSamples}
+	scalars := make([][]fr.Element, len(sizes))
+	for k, size := range sizes {
+		scalars[k] = make([]fr.Element, size)
+		fillBenchScalars(scalars[k])
+	}
+	copy(scalars[1], scalars[0])
+	for i := range scalars[5] {
+		scalars[5][i] = scalars[5][0]
+	}
+
+	for _, nbTasks := range []int{0, 1, 5} {
+		results, err := MultiExpBatchG2(samplePoints[:], scalars, ecc.MultiExpConfig{NbTasks: nbTasks})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != len(scalars) {
+			t.Fatal("wrong number of results")
+		}
+		for k := range scalars {
+			var expected G2Affine
+			expected.MultiExp(samplePoints[:len(scalars[k])], scalars[k], ecc.MultiExpConfig{})
+			if !results[k].Equal(&expected) {
+				t.Fatalf("batch msm failed for scalar vector %d", k)
+			}
+		}
+	}
+
+	if _, err := MultiExpBatchG2(samplePoints[:10], scalars, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("expected an error with scalar vectors longer than points")
+	}
+}
+
+func BenchmarkMultiExpBatchG2(b *testing.B) {
+	const (
+		nbSamples = 1 << 16
+		nbMSM     = 8
+	)
+
+	var samplePoints [nbSamples]G2Affine
+	fillBenchBasesG2(samplePoints[:])
+
+	scalars := make([][]fr.Element, nbMSM)
+	for k := range scalars {
+		scalars[k] = make([]fr.Element, nbSamples)
+		fillBenchScalars(scalars[k])
+	}
+
+	b.Run("independent", func(b *testing.B) {
+		var testPoint G2Affine
+		for j := 0; j < b.N; j++ {
+			for k := range scalars {
+				testPoint.MultiExp(samplePoints[:], scalars[k], ecc.MultiExpConfig{})
+			}
+		}
+	})
+
+	b.Run("batch", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			MultiExpBatchG2(samplePoints[:], scalars, ecc.MultiExpConfig{})
+		}
+	})
+}
+
 // WARNING: this return points that are NOT on the curve and is meant to be use for benchmarking
 // purposes only. We don't check that the result is valid but just measure "computational complexity".
 //
@@ -411,60 +411,31 @@ func (z *Element) expBySqrtExp(x Element) *Element {
 	return z
 }
 
-// expByLegendreExp is equivalent to z.Exp(x, 183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f8000000)
-//
-// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
-func (z *Element) expByLegendreExp(x Element) *Element {
-	// addition chain:
-	//
-	//	_10    = 2*1
-	//	_11    = 1 + _10
-	//	_101   = _10 + _11
-	//	_111   = _10 + _101
-	//	_1001  = _10 + _111
-	//	_1011  = _10 + _1001
-	//	_1101  = _10 + _1011
-	//	_1111  = _10 + _1101
-	//	_11000 = _1001 + _1111
-	//	_11111 = _111 + _11000
-	//	i26    = ((_11000 << 4 + _11) << 3 + 1) << 7
-	//	i36    = ((_1001 + i26) << 2 + _11) << 5 + _111
-	//	i53    = (2*(i36 << 6 + _1011) + 1) << 8
-	//	i64    = (2*(_1001 + i53) + 1) << 7 + _1101
-	//	i84    = ((i64 << 10 + _101) << 6 + _1101) << 2
-	//	i100   = ((_11 + i84) << 7 + _101) << 6 + 1
-	//	i117   = ((i100 << 7 + _1011) << 5 + _1101) << 3
-	//	i137   = ((_101 + i117) << 8 + _11) << 9 + _101
-	//	i153   = ((i137 << 3 + _11) << 8 + _1011) << 3
-	//	i168   = ((_101 + i153) << 5 + _101) << 7 + _11
-	//	i187   = ((i168 << 7 + _11111) << 2 + 1) << 8
-	//	i204   = ((_1001 + i187) << 8 + _1111) << 6 + _1101
-	//	i215   = 2*((i204 << 2 + _11) << 6 + _1011)
-	//	i232   = ((1 + i215) << 8 + _1001) << 6 + _101
-	//	i257   = ((i232 << 9 + _11111) << 9 + _11111) << 5
-	//	i270   = ((_1011 + i257) << 3 + 1) << 7 + _11111
-	//	return   (2*i270 + 1) << 27
-	//
-	// Operations: 249 squares 50 multiplies
-
-	// Allocate Temporaries.
+// vectorExpBySqrtExpNbTemporaries is the number of temporary vectors used by vectorExpBySqrtExp
+const vectorExpBySqrtExpNbTemporaries = 8
+
+// vectorExpBySqrtExp sets z[i] = x[i]^183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f for all i, with the addition chain of expBySqrtExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpBySqrtExpNbTemporaries * len(x).
+func vectorExpBySqrtExp(z, x, scratch Vector) {
+	n := len(x)
 	var (
-		t0 = new(Element)
-		t1 = new(Element)
-		t2 = new(Element)
-		t3 = new(Element)
-		t4 = new(Element)
-		t5 = new(Element)
-		t6 = new(Element)
-		t7 = new(Element)
+		t0 = scratch[0*n : 0*n+n]
+		t1 = scratch[1*n : 1*n+n]
+		t2 = scratch[2*n : 2*n+n]
+		t3 = scratch[3*n : 3*n+n]
+		t4 = scratch[4*n : 4*n+n]
+		t5 = scratch[5*n : 5*n+n]
+		t6 = scratch[6*n : 6*n+n]
+		t7 = scratch[7*n : 7*n+n]
 	)
 
-	// var t0,t1,t2,t3,t4,t5,t6,t7 Element
 	// Step 1: z = x^0x2
-	z.Square(&x)
+	z.Mul(x, x)
 
 	// Step 2: t3 = x^0x3
-	t3.Mul(&x, z)
+	t3.Mul(x, z)
 
 	// Step 3: t1 = x^0x5
 	t1.Mul(z, t3)
@@ -492,7 +463,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 14: t7 = x^0x180
 	for s := 0; s < 4; s++ {
-		t7.Square(t7)
+		t7.Mul(t7, t7)
 	}
 
 	// Step 15: t7 = x^0x183
@@ -500,15 +471,15 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 18: t7 = x^0xc18
 	for s := 0; s < 3; s++ {
-		t7.Square(t7)
+		t7.Mul(t7, t7)
 	}
 
 	// Step 19: t7 = x^0xc19
-	t7.Mul(&x, t7)
+	t7.Mul(x, t7)
 
 	// Step 26: t7 = x^0x60c80
 	for s := 0; s < 7; s++ {
-		t7.Square(t7)
+		t7.Mul(t7, t7)
 	}
 
 	// Step 27: t7 = x^0x60c89
@@ -516,7 +487,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 29: t7 = x^0x183224
 	for s := 0; s < 2; s++ {
-		t7.Square(t7)
+		t7.Mul(t7, t7)
 	}
 
 	// Step 30: t7 = x^0x183227
@@ -524,7 +495,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 35: t7 = x^0x30644e0
 	for s := 0; s < 5; s++ {
-		t7.Square(t7)
+		t7.Mul(t7, t7)
 	}
 
 	// Step 36: t6 = x^0x30644e7
@@ -532,35 +503,35 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 42: t6 = x^0xc19139c0
 	for s := 0; s < 6; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 43: t6 = x^0xc19139cb
 	t6.Mul(t0, t6)
 
 	// Step 44: t6 = x^0x183227396
-	t6.Square(t6)
+	t6.Mul(t6, t6)
 
 	// Step 45: t6 = x^0x183227397
-	t6.Mul(&x, t6)
+	t6.Mul(x, t6)
 
 	// Step 53: t6 = x^0x18322739700
 	for s := 0; s < 8; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 54: t6 = x^0x18322739709
 	t6.Mul(t2, t6)
 
 	// Step 55: t6 = x^0x30644e72e12
-	t6.Square(t6)
+	t6.Mul(t6, t6)
 
 	// Step 56: t6 = x^0x30644e72e13
-	t6.Mul(&x, t6)
+	t6.Mul(x, t6)
 
 	// Step 63: t6 = x^0x1832273970980
 	for s := 0; s < 7; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 64: t6 = x^0x183227397098d
@@ -568,7 +539,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 74: t6 = x^0x60c89ce5c263400
 	for s := 0; s < 10; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 75: t6 = x^0x60c89ce5c263405
@@ -576,7 +547,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 81: t6 = x^0x183227397098d0140
 	for s := 0; s < 6; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 82: t6 = x^0x183227397098d014d
@@ -584,7 +555,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 84: t6 = x^0x60c89ce5c26340534
 	for s := 0; s < 2; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 85: t6 = x^0x60c89ce5c26340537
@@ -592,7 +563,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 92: t6 = x^0x30644e72e131a029b80
 	for s := 0; s < 7; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 93: t6 = x^0x30644e72e131a029b85
@@ -600,15 +571,15 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 99: t6 = x^0xc19139cb84c680a6e140
 	for s := 0; s < 6; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 100: t6 = x^0xc19139cb84c680a6e141
-	t6.Mul(&x, t6)
+	t6.Mul(x, t6)
 
 	// Step 107: t6 = x^0x60c89ce5c263405370a080
 	for s := 0; s < 7; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 108: t6 = x^0x60c89ce5c263405370a08b
@@ -616,7 +587,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 113: t6 = x^0xc19139cb84c680a6e141160
 	for s := 0; s < 5; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 114: t6 = x^0xc19139cb84c680a6e14116d
@@ -624,7 +595,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 117: t6 = x^0x60c89ce5c263405370a08b68
 	for s := 0; s < 3; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 118: t6 = x^0x60c89ce5c263405370a08b6d
@@ -632,7 +603,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 126: t6 = x^0x60c89ce5c263405370a08b6d00
 	for s := 0; s < 8; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 127: t6 = x^0x60c89ce5c263405370a08b6d03
@@ -640,7 +611,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 136: t6 = x^0xc19139cb84c680a6e14116da0600
 	for s := 0; s < 9; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 137: t6 = x^0xc19139cb84c680a6e14116da0605
@@ -648,7 +619,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 140: t6 = x^0x60c89ce5c263405370a08b6d03028
 	for s := 0; s < 3; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 141: t6 = x^0x60c89ce5c263405370a08b6d0302b
@@ -656,7 +627,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 149: t6 = x^0x60c89ce5c263405370a08b6d0302b00
 	for s := 0; s < 8; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 150: t6 = x^0x60c89ce5c263405370a08b6d0302b0b
@@ -664,7 +635,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 153: t6 = x^0x30644e72e131a029b85045b681815858
 	for s := 0; s < 3; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 154: t6 = x^0x30644e72e131a029b85045b68181585d
@@ -672,7 +643,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 159: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba0
 	for s := 0; s < 5; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 160: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5
@@ -680,7 +651,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 167: t6 = x^0x30644e72e131a029b85045b68181585d280
 	for s := 0; s < 7; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 168: t6 = x^0x30644e72e131a029b85045b68181585d283
@@ -688,7 +659,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 175: t6 = x^0x183227397098d014dc2822db40c0ac2e94180
 	for s := 0; s < 7; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 176: t6 = x^0x183227397098d014dc2822db40c0ac2e9419f
@@ -696,15 +667,15 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 178: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067c
 	for s := 0; s < 2; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 179: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d
-	t6.Mul(&x, t6)
+	t6.Mul(x, t6)
 
 	// Step 187: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d00
 	for s := 0; s < 8; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 188: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d09
@@ -712,7 +683,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 196: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d0900
 	for s := 0; s < 8; s++ {
-		t6.Square(t6)
+		t6.Mul(t6, t6)
 	}
 
 	// Step 197: t5 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f
@@ -720,7 +691,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 203: t5 = x^0x183227397098d014dc2822db40c0ac2e9419f4243c0
 	for s := 0; s < 6; s++ {
-		t5.Square(t5)
+		t5.Mul(t5, t5)
 	}
 
 	// Step 204: t4 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cd
@@ -728,7 +699,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 206: t4 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f34
 	for s := 0; s < 2; s++ {
-		t4.Square(t4)
+		t4.Mul(t4, t4)
 	}
 
 	// Step 207: t3 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f37
@@ -736,21 +707,21 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 213: t3 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdc0
 	for s := 0; s < 6; s++ {
-		t3.Square(t3)
+		t3.Mul(t3, t3)
 	}
 
 	// Step 214: t3 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb
 	t3.Mul(t0, t3)
 
 	// Step 215: t3 = x^0x30644e72e131a029b85045b68181585d2833e84879b96
-	t3.Square(t3)
+	t3.Mul(t3, t3)
 
 	// Step 216: t3 = x^0x30644e72e131a029b85045b68181585d2833e84879b97
-	t3.Mul(&x, t3)
+	t3.Mul(x, t3)
 
 	// Step 224: t3 = x^0x30644e72e131a029b85045b68181585d2833e84879b9700
 	for s := 0; s < 8; s++ {
-		t3.Square(t3)
+		t3.Mul(t3, t3)
 	}
 
 	// Step 225: t2 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709
@@ -758,7 +729,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 231: t2 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c240
 	for s := 0; s < 6; s++ {
-		t2.Square(t2)
+		t2.Mul(t2, t2)
 	}
 
 	// Step 232: t1 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c245
@@ -766,7 +737,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 241: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a00
 	for s := 0; s < 9; s++ {
-		t1.Square(t1)
+		t1.Mul(t1, t1)
 	}
 
 	// Step 242: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f
@@ -774,7 +745,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 251: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e00
 	for s := 0; s < 9; s++ {
-		t1.Square(t1)
+		t1.Mul(t1, t1)
 	}
 
 	// Step 252: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f
@@ -782,7 +753,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 257: t1 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3e0
 	for s := 0; s < 5; s++ {
-		t1.Square(t1)
+		t1.Mul(t1, t1)
 	}
 
 	// Step 258: t0 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb
@@ -790,30 +761,1248 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 261: t0 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f58
 	for s := 0; s < 3; s++ {
-		t0.Square(t0)
+		t0.Mul(t0, t0)
 	}
 
 	// Step 262: t0 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f59
-	t0.Mul(&x, t0)
+	t0.Mul(x, t0)
 
 	// Step 269: t0 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac80
 	for s := 0; s < 7; s++ {
-		t0.Square(t0)
+		t0.Mul(t0, t0)
 	}
 
 	// Step 270: z = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f
 	z.Mul(z, t0)
 
-	// Step 271: z = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593e
-	z.Square(z)
+}
 
-	// Step 272: z = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f
-	z.Mul(&x, z)
+// expByLegendreExp is equivalent to z.Exp(x, 183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f8000000)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10    = 2*1
+	//	_11    = 1 + _10
+	//	_101   = _10 + _11
+	//	_111   = _10 + _101
+	//	_1001  = _10 + _111
+	//	_1011  = _10 + _1001
+	//	_1101  = _10 + _1011
+	//	_1111  = _10 + _1101
+	//	_11000 = _1001 + _1111
+	//	_11111 = _111 + _11000
+	//	i26    = ((_11000 << 4 + _11) << 3 + 1) << 7
+	//	i36    = ((_1001 + i26) << 2 + _11) << 5 + _111
+	//	i53    = (2*(i36 << 6 + _1011) + 1) << 8
+	//	i64    = (2*(_1001 + i53) + 1) << 7 + _1101
+	//	i84    = ((i64 << 10 + _101) << 6 + _1101) << 2
+	//	i100   = ((_11 + i84) << 7 + _101) << 6 + 1
+	//	i117   = ((i100 << 7 + _1011) << 5 + _1101) << 3
+	//	i137   = ((_101 + i117) << 8 + _11) << 9 + _101
+	//	i153   = ((i137 << 3 + _11) << 8 + _1011) << 3
+	//	i168   = ((_101 + i153) << 5 + _101) << 7 + _11
+	//	i187   = ((i168 << 7 + _11111) << 2 + 1) << 8
+	//	i204   = ((_1001 + i187) << 8 + _1111) << 6 + _1101
+	//	i215   = 2*((i204 << 2 + _11) << 6 + _1011)
+	//	i232   = ((1 + i215) << 8 + _1001) << 6 + _101
+	//	i257   = ((i232 << 9 + _11111) << 9 + _11111) << 5
+	//	i270   = ((_1011 + i257) << 3 + 1) << 7 + _11111
+	//	return   (2*i270 + 1) << 27
+	//
+	// Operations: 249 squares 50 multiplies
 
-	// Step 299: z = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f8000000
-	for s := 0; s < 27; s++ {
-		z.Square(z)
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+		t3 = new(Element)
+		t4 = new(Element)
+		t5 = new(Element)
+		t6 = new(Element)
+		t7 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: t3 = x^0x3
+	t3.Mul(&x, z)
+
+	// Step 3: t1 = x^0x5
+	t1.Mul(z, t3)
+
+	// Step 4: t6 = x^0x7
+	t6.Mul(z, t1)
+
+	// Step 5: t2 = x^0x9
+	t2.Mul(z, t6)
+
+	// Step 6: t0 = x^0xb
+	t0.Mul(z, t2)
+
+	// Step 7: t4 = x^0xd
+	t4.Mul(z, t0)
+
+	// Step 8: t5 = x^0xf
+	t5.Mul(z, t4)
+
+	// Step 9: t7 = x^0x18
+	t7.Mul(t2, t5)
+
+	// Step 10: z = x^0x1f
+	z.Mul(t6, t7)
+
+	// Step 14: t7 = x^0x180
+	for s := 0; s < 4; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 15: t7 = x^0x183
+	t7.Mul(t3, t7)
+
+	// Step 18: t7 = x^0xc18
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
 	}
 
+	// Step 19: t7 = x^0xc19
+	t7.Mul(&x, t7)
+
+	// Step 26: t7 = x^0x60c80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 27: t7 = x^0x60c89
+	t7.Mul(t2, t7)
+
+	// Step 29: t7 = x^0x183224
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 30: t7 = x^0x183227
+	t7.Mul(t3, t7)
+
+	// Step 35: t7 = x^0x30644e0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 36: t6 = x^0x30644e7
+	t6.Mul(t6, t7)
+
+	// Step 42: t6 = x^0xc19139c0
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 43: t6 = x^0xc19139cb
+	t6.Mul(t0, t6)
+
+	// Step 44: t6 = x^0x183227396
+	t6.Square(t6)
+
+	// Step 45: t6 = x^0x183227397
+	t6.Mul(&x, t6)
+
+	// Step 53: t6 = x^0x18322739700
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 54: t6 = x^0x18322739709
+	t6.Mul(t2, t6)
+
+	// Step 55: t6 = x^0x30644e72e12
+	t6.Square(t6)
+
+	// Step 56: t6 = x^0x30644e72e13
+	t6.Mul(&x, t6)
+
+	// Step 63: t6 = x^0x1832273970980
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 64: t6 = x^0x183227397098d
+	t6.Mul(t4, t6)
+
+	// Step 74: t6 = x^0x60c89ce5c263400
+	for s := 0; s < 10; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 75: t6 = x^0x60c89ce5c263405
+	t6.Mul(t1, t6)
+
+	// Step 81: t6 = x^0x183227397098d0140
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 82: t6 = x^0x183227397098d014d
+	t6.Mul(t4, t6)
+
+	// Step 84: t6 = x^0x60c89ce5c26340534
+	for s := 0; s < 2; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 85: t6 = x^0x60c89ce5c26340537
+	t6.Mul(t3, t6)
+
+	// Step 92: t6 = x^0x30644e72e131a029b80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 93: t6 = x^0x30644e72e131a029b85
+	t6.Mul(t1, t6)
+
+	// Step 99: t6 = x^0xc19139cb84c680a6e140
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 100: t6 = x^0xc19139cb84c680a6e141
+	t6.Mul(&x, t6)
+
+	// Step 107: t6 = x^0x60c89ce5c263405370a080
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 108: t6 = x^0x60c89ce5c263405370a08b
+	t6.Mul(t0, t6)
+
+	// Step 113: t6 = x^0xc19139cb84c680a6e141160
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 114: t6 = x^0xc19139cb84c680a6e14116d
+	t6.Mul(t4, t6)
+
+	// Step 117: t6 = x^0x60c89ce5c263405370a08b68
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 118: t6 = x^0x60c89ce5c263405370a08b6d
+	t6.Mul(t1, t6)
+
+	// Step 126: t6 = x^0x60c89ce5c263405370a08b6d00
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 127: t6 = x^0x60c89ce5c263405370a08b6d03
+	t6.Mul(t3, t6)
+
+	// Step 136: t6 = x^0xc19139cb84c680a6e14116da0600
+	for s := 0; s < 9; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 137: t6 = x^0xc19139cb84c680a6e14116da0605
+	t6.Mul(t1, t6)
+
+	// Step 140: t6 = x^0x60c89ce5c263405370a08b6d03028
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 141: t6 = x^0x60c89ce5c263405370a08b6d0302b
+	t6.Mul(t3, t6)
+
+	// Step 149: t6 = x^0x60c89ce5c263405370a08b6d0302b00
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 150: t6 = x^0x60c89ce5c263405370a08b6d0302b0b
+	t6.Mul(t0, t6)
+
+	// Step 153: t6 = x^0x30644e72e131a029b85045b681815858
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 154: t6 = x^0x30644e72e131a029b85045b68181585d
+	t6.Mul(t1, t6)
+
+	// Step 159: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 160: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5
+	t6.Mul(t1, t6)
+
+	// Step 167: t6 = x^0x30644e72e131a029b85045b68181585d280
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 168: t6 = x^0x30644e72e131a029b85045b68181585d283
+	t6.Mul(t3, t6)
+
+	// Step 175: t6 = x^0x183227397098d014dc2822db40c0ac2e94180
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 176: t6 = x^0x183227397098d014dc2822db40c0ac2e9419f
+	t6.Mul(z, t6)
+
+	// Step 178: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067c
+	for s := 0; s < 2; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 179: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d
+	t6.Mul(&x, t6)
+
+	// Step 187: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d00
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 188: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d09
+	t6.Mul(t2, t6)
+
+	// Step 196: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d0900
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 197: t5 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f
+	t5.Mul(t5, t6)
+
+	// Step 203: t5 = x^0x183227397098d014dc2822db40c0ac2e9419f4243c0
+	for s := 0; s < 6; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 204: t4 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cd
+	t4.Mul(t4, t5)
+
+	// Step 206: t4 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f34
+	for s := 0; s < 2; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 207: t3 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f37
+	t3.Mul(t3, t4)
+
+	// Step 213: t3 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdc0
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 214: t3 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb
+	t3.Mul(t0, t3)
+
+	// Step 215: t3 = x^0x30644e72e131a029b85045b68181585d2833e84879b96
+	t3.Square(t3)
+
+	// Step 216: t3 = x^0x30644e72e131a029b85045b68181585d2833e84879b97
+	t3.Mul(&x, t3)
+
+	// Step 224: t3 = x^0x30644e72e131a029b85045b68181585d2833e84879b9700
+	for s := 0; s < 8; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 225: t2 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709
+	t2.Mul(t2, t3)
+
+	// Step 231: t2 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c240
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 232: t1 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c245
+	t1.Mul(t1, t2)
+
+	// Step 241: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 242: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f
+	t1.Mul(z, t1)
+
+	// Step 251: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 252: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f
+	t1.Mul(z, t1)
+
+	// Step 257: t1 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3e0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 258: t0 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb
+	t0.Mul(t0, t1)
+
+	// Step 261: t0 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f58
+	for s := 0; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 262: t0 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f59
+	t0.Mul(&x, t0)
+
+	// Step 269: t0 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac80
+	for s := 0; s < 7; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 270: z = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f
+	z.Mul(z, t0)
+
+	// Step 271: z = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593e
+	z.Square(z)
+
+	// Step 272: z = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f
+	z.Mul(&x, z)
+
+	// Step 299: z = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f8000000
+	for s := 0; s < 27; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
+
+// vectorExpByLegendreExpNbTemporaries is the number of temporary vectors used by vectorExpByLegendreExp
+const vectorExpByLegendreExpNbTemporaries = 8
+
+// vectorExpByLegendreExp sets z[i] = x[i]^183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f8000000 for all i, with the addition chain of expByLegendreExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpByLegendreExpNbTemporaries * len(x).
+func vectorExpByLegendreExp(z, x, scratch Vector) {
+	n := len(x)
+	var (
+		t0 = scratch[0*n : 0*n+n]
+		t1 = scratch[1*n : 1*n+n]
+		t2 = scratch[2*n : 2*n+n]
+		t3 = scratch[3*n : 3*n+n]
+		t4 = scratch[4*n : 4*n+n]
+		t5 = scratch[5*n : 5*n+n]
+		t6 = scratch[6*n : 6*n+n]
+		t7 = scratch[7*n : 7*n+n]
+	)
+
+	// Step 1: z = x^0x2
+	z.Mul(x, x)
+
+	// Step 2: t3 = x^0x3
+	t3.Mul(x, z)
+
+	// Step 3: t1 = x^0x5
+	t1.Mul(z, t3)
+
+	// Step 4: t6 = x^0x7
+	t6.Mul(z, t1)
+
+	// Step 5: t2 = x^0x9
+	t2.Mul(z, t6)
+
+	// Step 6: t0 = x^0xb
+	t0.Mul(z, t2)
+
+	// Step 7: t4 = x^0xd
+	t4.Mul(z, t0)
+
+	// Step 8: t5 = x^0xf
+	t5.Mul(z, t4)
+
+	// Step 9: t7 = x^0x18
+	t7.Mul(t2, t5)
+
+	// Step 10: z = x^0x1f
+	z.Mul(t6, t7)
+
+	// Step 14: t7 = x^0x180
+	for s := 0; s < 4; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 15: t7 = x^0x183
+	t7.Mul(t3, t7)
+
+	// Step 18: t7 = x^0xc18
+	for s := 0; s < 3; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 19: t7 = x^0xc19
+	t7.Mul(x, t7)
+
+	// Step 26: t7 = x^0x60c80
+	for s := 0; s < 7; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 27: t7 = x^0x60c89
+	t7.Mul(t2, t7)
+
+	// Step 29: t7 = x^0x183224
+	for s := 0; s < 2; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 30: t7 = x^0x183227
+	t7.Mul(t3, t7)
+
+	// Step 35: t7 = x^0x30644e0
+	for s := 0; s < 5; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 36: t6 = x^0x30644e7
+	t6.Mul(t6, t7)
+
+	// Step 42: t6 = x^0xc19139c0
+	for s := 0; s < 6; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 43: t6 = x^0xc19139cb
+	t6.Mul(t0, t6)
+
+	// Step 44: t6 = x^0x183227396
+	t6.Mul(t6, t6)
+
+	// Step 45: t6 = x^0x183227397
+	t6.Mul(x, t6)
+
+	// Step 53: t6 = x^0x18322739700
+	for s := 0; s < 8; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 54: t6 = x^0x18322739709
+	t6.Mul(t2, t6)
+
+	// Step 55: t6 = x^0x30644e72e12
+	t6.Mul(t6, t6)
+
+	// Step 56: t6 = x^0x30644e72e13
+	t6.Mul(x, t6)
+
+	// Step 63: t6 = x^0x1832273970980
+	for s := 0; s < 7; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 64: t6 = x^0x183227397098d
+	t6.Mul(t4, t6)
+
+	// Step 74: t6 = x^0x60c89ce5c263400
+	for s := 0; s < 10; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 75: t6 = x^0x60c89ce5c263405
+	t6.Mul(t1, t6)
+
+	// Step 81: t6 = x^0x183227397098d0140
+	for s := 0; s < 6; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 82: t6 = x^0x183227397098d014d
+	t6.Mul(t4, t6)
+
+	// Step 84: t6 = x^0x60c89ce5c26340534
+	for s := 0; s < 2; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 85: t6 = x^0x60c89ce5c26340537
+	t6.Mul(t3, t6)
+
+	// Step 92: t6 = x^0x30644e72e131a029b80
+	for s := 0; s < 7; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 93: t6 = x^0x30644e72e131a029b85
+	t6.Mul(t1, t6)
+
+	// Step 99: t6 = x^0xc19139cb84c680a6e140
+	for s := 0; s < 6; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 100: t6 = x^0xc19139cb84c680a6e141
+	t6.Mul(x, t6)
+
+	// Step 107: t6 = x^0x60c89ce5c263405370a080
+	for s := 0; s < 7; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 108: t6 = x^0x60c89ce5c263405370a08b
+	t6.Mul(t0, t6)
+
+	// Step 113: t6 = x^0xc19139cb84c680a6e141160
+	for s := 0; s < 5; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 114: t6 = x^0xc19139cb84c680a6e14116d
+	t6.Mul(t4, t6)
+
+	// Step 117: t6 = x^0x60c89ce5c263405370a08b68
+	for s := 0; s < 3; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 118: t6 = x^0x60c89ce5c263405370a08b6d
+	t6.Mul(t1, t6)
+
+	// Step 126: t6 = x^0x60c89ce5c263405370a08b6d00
+	for s := 0; s < 8; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 127: t6 = x^0x60c89ce5c263405370a08b6d03
+	t6.Mul(t3, t6)
+
+	// Step 136: t6 = x^0xc19139cb84c680a6e14116da0600
+	for s := 0; s < 9; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 137: t6 = x^0xc19139cb84c680a6e14116da0605
+	t6.Mul(t1, t6)
+
+	// Step 140: t6 = x^0x60c89ce5c263405370a08b6d03028
+	for s := 0; s < 3; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 141: t6 = x^0x60c89ce5c263405370a08b6d0302b
+	t6.Mul(t3, t6)
+
+	// Step 149: t6 = x^0x60c89ce5c263405370a08b6d0302b00
+	for s := 0; s < 8; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 150: t6 = x^0x60c89ce5c263405370a08b6d0302b0b
+	t6.Mul(t0, t6)
+
+	// Step 153: t6 = x^0x30644e72e131a029b85045b681815858
+	for s := 0; s < 3; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 154: t6 = x^0x30644e72e131a029b85045b68181585d
+	t6.Mul(t1, t6)
+
+	// Step 159: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba0
+	for s := 0; s < 5; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 160: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5
+	t6.Mul(t1, t6)
+
+	// Step 167: t6 = x^0x30644e72e131a029b85045b68181585d280
+	for s := 0; s < 7; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 168: t6 = x^0x30644e72e131a029b85045b68181585d283
+	t6.Mul(t3, t6)
+
+	// Step 175: t6 = x^0x183227397098d014dc2822db40c0ac2e94180
+	for s := 0; s < 7; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 176: t6 = x^0x183227397098d014dc2822db40c0ac2e9419f
+	t6.Mul(z, t6)
+
+	// Step 178: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067c
+	for s := 0; s < 2; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 179: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d
+	t6.Mul(x, t6)
+
+	// Step 187: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d00
+	for s := 0; s < 8; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 188: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d09
+	t6.Mul(t2, t6)
+
+	// Step 196: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d0900
+	for s := 0; s < 8; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 197: t5 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f
+	t5.Mul(t5, t6)
+
+	// Step 203: t5 = x^0x183227397098d014dc2822db40c0ac2e9419f4243c0
+	for s := 0; s < 6; s++ {
+		t5.Mul(t5, t5)
+	}
+
+	// Step 204: t4 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cd
+	t4.Mul(t4, t5)
+
+	// Step 206: t4 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f34
+	for s := 0; s < 2; s++ {
+		t4.Mul(t4, t4)
+	}
+
+	// Step 207: t3 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f37
+	t3.Mul(t3, t4)
+
+	// Step 213: t3 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdc0
+	for s := 0; s < 6; s++ {
+		t3.Mul(t3, t3)
+	}
+
+	// Step 214: t3 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb
+	t3.Mul(t0, t3)
+
+	// Step 215: t3 = x^0x30644e72e131a029b85045b68181585d2833e84879b96
+	t3.Mul(t3, t3)
+
+	// Step 216: t3 = x^0x30644e72e131a029b85045b68181585d2833e84879b97
+	t3.Mul(x, t3)
+
+	// Step 224: t3 = x^0x30644e72e131a029b85045b68181585d2833e84879b9700
+	for s := 0; s < 8; s++ {
+		t3.Mul(t3, t3)
+	}
+
+	// Step 225: t2 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709
+	t2.Mul(t2, t3)
+
+	// Step 231: t2 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c240
+	for s := 0; s < 6; s++ {
+		t2.Mul(t2, t2)
+	}
+
+	// Step 232: t1 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c245
+	t1.Mul(t1, t2)
+
+	// Step 241: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a00
+	for s := 0; s < 9; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 242: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f
+	t1.Mul(z, t1)
+
+	// Step 251: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e00
+	for s := 0; s < 9; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 252: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f
+	t1.Mul(z, t1)
+
+	// Step 257: t1 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3e0
+	for s := 0; s < 5; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 258: t0 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb
+	t0.Mul(t0, t1)
+
+	// Step 261: t0 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f58
+	for s := 0; s < 3; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 262: t0 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f59
+	t0.Mul(x, t0)
+
+	// Step 269: t0 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac80
+	for s := 0; s < 7; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 270: z = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f
+	z.Mul(z, t0)
+
+	// Step 271: z = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593e
+	z.Mul(z, z)
+
+	// Step 272: z = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f
+	z.Mul(x, z)
+
+	// Step 299: z = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f8000000
+	for s := 0; s < 27; s++ {
+		z.Mul(z, z)
+	}
+
+}
+
+// expByInverseExp is equivalent to z.Exp(x, 30644e72e131a029b85045b68181585d2833e84879b9709143e1f593efffffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10    = 2*1
+	//	_11    = 1 + _10
+	//	_101   = _10 + _11
+	//	_111   = _10 + _101
+	//	_1001  = _10 + _111
+	//	_1011  = _10 + _1001
+	//	_1101  = _10 + _1011
+	//	_1111  = _10 + _1101
+	//	_11000 = _1001 + _1111
+	//	_11111 = _111 + _11000
+	//	i26    = ((_11000 << 4 + _11) << 3 + 1) << 7
+	//	i36    = ((_1001 + i26) << 2 + _11) << 5 + _111
+	//	i53    = (2*(i36 << 6 + _1011) + 1) << 8
+	//	i64    = (2*(_1001 + i53) + 1) << 7 + _1101
+	//	i84    = ((i64 << 10 + _101) << 6 + _1101) << 2
+	//	i100   = ((_11 + i84) << 7 + _101) << 6 + 1
+	//	i117   = ((i100 << 7 + _1011) << 5 + _1101) << 3
+	//	i137   = ((_101 + i117) << 8 + _11) << 9 + _101
+	//	i153   = ((i137 << 3 + _11) << 8 + _1011) << 3
+	//	i168   = ((_101 + i153) << 5 + _101) << 7 + _11
+	//	i187   = ((i168 << 7 + _11111) << 2 + 1) << 8
+	//	i204   = ((_1001 + i187) << 8 + _1111) << 6 + _1101
+	//	i215   = 2*((i204 << 2 + _11) << 6 + _1011)
+	//	i232   = ((1 + i215) << 8 + _1001) << 6 + _101
+	//	i257   = ((i232 << 9 + _11111) << 9 + _11111) << 5
+	//	i270   = ((_1011 + i257) << 3 + 1) << 7 + _11111
+	//	i288   = ((i270 << 6 + _11111) << 5 + _11111) << 5
+	//	i301   = ((_11111 + i288) << 5 + _11111) << 5 + _11111
+	//	return   i301 << 3 + _111
+	//
+	// Operations: 250 squares 55 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+		t3 = new(Element)
+		t4 = new(Element)
+		t5 = new(Element)
+		t6 = new(Element)
+		t7 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7 Element
+	// Step 1: t0 = x^0x2
+	t0.Square(&x)
+
+	// Step 2: t4 = x^0x3
+	t4.Mul(&x, t0)
+
+	// Step 3: t2 = x^0x5
+	t2.Mul(t0, t4)
+
+	// Step 4: z = x^0x7
+	z.Mul(t0, t2)
+
+	// Step 5: t3 = x^0x9
+	t3.Mul(t0, z)
+
+	// Step 6: t1 = x^0xb
+	t1.Mul(t0, t3)
+
+	// Step 7: t5 = x^0xd
+	t5.Mul(t0, t1)
+
+	// Step 8: t6 = x^0xf
+	t6.Mul(t0, t5)
+
+	// Step 9: t7 = x^0x18
+	t7.Mul(t3, t6)
+
+	// Step 10: t0 = x^0x1f
+	t0.Mul(z, t7)
+
+	// Step 14: t7 = x^0x180
+	for s := 0; s < 4; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 15: t7 = x^0x183
+	t7.Mul(t4, t7)
+
+	// Step 18: t7 = x^0xc18
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 19: t7 = x^0xc19
+	t7.Mul(&x, t7)
+
+	// Step 26: t7 = x^0x60c80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 27: t7 = x^0x60c89
+	t7.Mul(t3, t7)
+
+	// Step 29: t7 = x^0x183224
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 30: t7 = x^0x183227
+	t7.Mul(t4, t7)
+
+	// Step 35: t7 = x^0x30644e0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 36: t7 = x^0x30644e7
+	t7.Mul(z, t7)
+
+	// Step 42: t7 = x^0xc19139c0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 43: t7 = x^0xc19139cb
+	t7.Mul(t1, t7)
+
+	// Step 44: t7 = x^0x183227396
+	t7.Square(t7)
+
+	// Step 45: t7 = x^0x183227397
+	t7.Mul(&x, t7)
+
+	// Step 53: t7 = x^0x18322739700
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 54: t7 = x^0x18322739709
+	t7.Mul(t3, t7)
+
+	// Step 55: t7 = x^0x30644e72e12
+	t7.Square(t7)
+
+	// Step 56: t7 = x^0x30644e72e13
+	t7.Mul(&x, t7)
+
+	// Step 63: t7 = x^0x1832273970980
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 64: t7 = x^0x183227397098d
+	t7.Mul(t5, t7)
+
+	// Step 74: t7 = x^0x60c89ce5c263400
+	for s := 0; s < 10; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 75: t7 = x^0x60c89ce5c263405
+	t7.Mul(t2, t7)
+
+	// Step 81: t7 = x^0x183227397098d0140
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 82: t7 = x^0x183227397098d014d
+	t7.Mul(t5, t7)
+
+	// Step 84: t7 = x^0x60c89ce5c26340534
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 85: t7 = x^0x60c89ce5c26340537
+	t7.Mul(t4, t7)
+
+	// Step 92: t7 = x^0x30644e72e131a029b80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 93: t7 = x^0x30644e72e131a029b85
+	t7.Mul(t2, t7)
+
+	// Step 99: t7 = x^0xc19139cb84c680a6e140
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 100: t7 = x^0xc19139cb84c680a6e141
+	t7.Mul(&x, t7)
+
+	// Step 107: t7 = x^0x60c89ce5c263405370a080
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 108: t7 = x^0x60c89ce5c263405370a08b
+	t7.Mul(t1, t7)
+
+	// Step 113: t7 = x^0xc19139cb84c680a6e141160
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 114: t7 = x^0xc19139cb84c680a6e14116d
+	t7.Mul(t5, t7)
+
+	// Step 117: t7 = x^0x60c89ce5c263405370a08b68
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 118: t7 = x^0x60c89ce5c263405370a08b6d
+	t7.Mul(t2, t7)
+
+	// Step 126: t7 = x^0x60c89ce5c263405370a08b6d00
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 127: t7 = x^0x60c89ce5c263405370a08b6d03
+	t7.Mul(t4, t7)
+
+	// Step 136: t7 = x^0xc19139cb84c680a6e14116da0600
+	for s := 0; s < 9; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 137: t7 = x^0xc19139cb84c680a6e14116da0605
+	t7.Mul(t2, t7)
+
+	// Step 140: t7 = x^0x60c89ce5c263405370a08b6d03028
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 141: t7 = x^0x60c89ce5c263405370a08b6d0302b
+	t7.Mul(t4, t7)
+
+	// Step 149: t7 = x^0x60c89ce5c263405370a08b6d0302b00
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 150: t7 = x^0x60c89ce5c263405370a08b6d0302b0b
+	t7.Mul(t1, t7)
+
+	// Step 153: t7 = x^0x30644e72e131a029b85045b681815858
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 154: t7 = x^0x30644e72e131a029b85045b68181585d
+	t7.Mul(t2, t7)
+
+	// Step 159: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 160: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba5
+	t7.Mul(t2, t7)
+
+	// Step 167: t7 = x^0x30644e72e131a029b85045b68181585d280
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 168: t7 = x^0x30644e72e131a029b85045b68181585d283
+	t7.Mul(t4, t7)
+
+	// Step 175: t7 = x^0x183227397098d014dc2822db40c0ac2e94180
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 176: t7 = x^0x183227397098d014dc2822db40c0ac2e9419f
+	t7.Mul(t0, t7)
+
+	// Step 178: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba5067c
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 179: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d
+	t7.Mul(&x, t7)
+
+	// Step 187: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d00
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 188: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d09
+	t7.Mul(t3, t7)
+
+	// Step 196: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d0900
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 197: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f
+	t6.Mul(t6, t7)
+
+	// Step 203: t6 = x^0x183227397098d014dc2822db40c0ac2e9419f4243c0
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 204: t5 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cd
+	t5.Mul(t5, t6)
+
+	// Step 206: t5 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f34
+	for s := 0; s < 2; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 207: t4 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f37
+	t4.Mul(t4, t5)
+
+	// Step 213: t4 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdc0
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 214: t4 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb
+	t4.Mul(t1, t4)
+
+	// Step 215: t4 = x^0x30644e72e131a029b85045b68181585d2833e84879b96
+	t4.Square(t4)
+
+	// Step 216: t4 = x^0x30644e72e131a029b85045b68181585d2833e84879b97
+	t4.Mul(&x, t4)
+
+	// Step 224: t4 = x^0x30644e72e131a029b85045b68181585d2833e84879b9700
+	for s := 0; s < 8; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 225: t3 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709
+	t3.Mul(t3, t4)
+
+	// Step 231: t3 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c240
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 232: t2 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c245
+	t2.Mul(t2, t3)
+
+	// Step 241: t2 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a00
+	for s := 0; s < 9; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 242: t2 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f
+	t2.Mul(t0, t2)
+
+	// Step 251: t2 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e00
+	for s := 0; s < 9; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 252: t2 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f
+	t2.Mul(t0, t2)
+
+	// Step 257: t2 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3e0
+	for s := 0; s < 5; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 258: t1 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb
+	t1.Mul(t1, t2)
+
+	// Step 261: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f58
+	for s := 0; s < 3; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 262: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f59
+	t1.Mul(&x, t1)
+
+	// Step 269: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 270: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f
+	t1.Mul(t0, t1)
+
+	// Step 276: t1 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb27c0
+	for s := 0; s < 6; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 277: t1 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb27df
+	t1.Mul(t0, t1)
+
+	// Step 282: t1 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c2450f87d64fbe0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 283: t1 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c2450f87d64fbff
+	t1.Mul(t0, t1)
+
+	// Step 288: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f7fe0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 289: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f7fff
+	t1.Mul(t0, t1)
+
+	// Step 294: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593efffe0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 295: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593efffff
+	t1.Mul(t0, t1)
+
+	// Step 300: t1 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb27dffffe0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 301: t0 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb27dffffff
+	t0.Mul(t0, t1)
+
+	// Step 304: t0 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593effffff8
+	for s := 0; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 305: z = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593efffffff
+	z.Mul(z, t0)
+
 	return z
 }
@@ -0,0 +1,249 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"reflect"
+	"testing"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// Test SRS re-used across tests of the inner pairing product arguments
+var testSrs *SRS
+
+func init() {
+	const srsSize = 8
+	testSrs, _ = NewSRS(srsSize, new(big.Int).SetInt64(42), new(big.Int).SetInt64(43))
+}
+
+// randomInstance returns random vectors A, B, C of size m and their commitments
+func randomInstance(t testing.TB, m int) ([]curve.G1Affine, []curve.G2Affine, []curve.G1Affine, Commitment, Commitment) {
+	_, _, g1, g2 := curve.Generators()
+	var s fr.Element
+	var b big.Int
+	A := make([]curve.G1Affine, m)
+	B := make([]curve.G2Affine, m)
+	C := make([]curve.G1Affine, m)
+	for i := 0; i < m; i++ {
+		s.SetRandom()
+		A[i].ScalarMultiplication(&g1, s.BigInt(&b))
+		s.SetRandom()
+		B[i].ScalarMultiplication(&g2, s.BigInt(&b))
+		s.SetRandom()
+		C[i].ScalarMultiplication(&g1, s.BigInt(&b))
+	}
+	comAB, err := testSrs.Pk.CommitPair(A, B)
+	if err != nil {
+		t.Fatal(err)
+	}
+	comC, err := testSrs.Pk.CommitSingle(C)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return A, B, C, comAB, comC
+}
+
+func TestProveVerify(t *testing.T) {
+	for _, m := range []int{1, 2, 8} {
+		A, B, C, comAB, comC := randomInstance(t, m)
+		var r fr.Element
+		r.SetRandom()
+
+		proof, err := Prove(&testSrs.Pk, comAB, comC, A, B, C, r, sha256.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := Verify(&testSrs.Vk, comAB, comC, r, &proof, sha256.New()); err != nil {
+			t.Fatal(m, err)
+		}
+
+		// the claimed values are the expected ones
+		var zAB, tmp curve.GT
+		var zC curve.G1Jac
+		var ri fr.Element
+		var b big.Int
+		zAB.SetOne()
+		ri.SetOne()
+		zC.SetInfinity()
+		for i := 0; i < m; i++ {
+			e, err := curve.Pair([]curve.G1Affine{A[i]}, []curve.G2Affine{B[i]})
+			if err != nil {
+				t.Fatal(err)
+			}
+			ri.BigInt(&b)
+			tmp.ExpGLV(e, &b)
+			zAB.Mul(&zAB, &tmp)
+			var p curve.G1Jac
+			p.ScalarMultiplicationAffine(&C[i], &b)
+			zC.AddAssign(&p)
+			ri.Mul(&ri, &r)
+		}
+		var zCAff curve.G1Affine
+		zCAff.FromJacobian(&zC)
+		if !zAB.Equal(&proof.ZAB) || !zCAff.Equal(&proof.ZC) {
+			t.Fatal("unexpected claimed values")
+		}
+
+		// wrong r
+		var r2 fr.Element
+		r2.Double(&r)
+		if err := Verify(&testSrs.Vk, comAB, comC, r2, &proof, sha256.New()); err == nil {
+			t.Fatal("verifying with a wrong r should fail")
+		}
+
+		// wrong claims
+		tampered := proof
+		tampered.ZAB.Mul(&tampered.ZAB, &zAB)
+		if err := Verify(&testSrs.Vk, comAB, comC, r, &tampered, sha256.New()); err == nil {
+			t.Fatal("verifying a wrong ZAB should fail")
+		}
+		tampered = proof
+		tampered.ZC.Add(&tampered.ZC, &C[0])
+		if err := Verify(&testSrs.Vk, comAB, comC, r, &tampered, sha256.New()); err == nil {
+			t.Fatal("verifying a wrong ZC should fail")
+		}
+
+		// wrong commitments
+		if err := Verify(&testSrs.Vk, comC, comAB, r, &proof, sha256.New()); err == nil {
+			t.Fatal("verifying with wrong commitments should fail")
+		}
+
+		// wrong final keys
+		tampered = proof
+		tampered.FinalW1.Add(&tampered.FinalW1, &C[0])
+		if err := Verify(&testSrs.Vk, comAB, comC, r, &tampered, sha256.New()); err == nil {
+			t.Fatal("verifying with a wrong final key should fail")
+		}
+	}
+}
+
+func TestInvalidSizes(t *testing.T) {
+	A, B, C, comAB, comC := randomInstance(t, 4)
+	var r fr.Element
+	r.SetRandom()
+
+	if _, err := Prove(&testSrs.Pk, comAB, comC, A[:3], B[:3], C[:3], r, sha256.New()); err != ErrInvalidNbElements {
+		t.Fatal("expected ErrInvalidNbElements")
+	}
+	if _, err := Prove(&testSrs.Pk, comAB, comC, A, B[:2], C, r, sha256.New()); err != ErrLengthMismatch {
+		t.Fatal("expected ErrLengthMismatch")
+	}
+	if _, err := testSrs.Pk.CommitSingle(make([]curve.G1Affine, 16)); err != ErrInvalidNbElements {
+		t.Fatal("expected ErrInvalidNbElements")
+	}
+
+	proof, err := Prove(&testSrs.Pk, comAB, comC, A, B, C, r, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof.CrossC = proof.CrossC[1:]
+	if err := Verify(&testSrs.Vk, comAB, comC, r, &proof, sha256.New()); err != ErrInvalidProof {
+		t.Fatal("expected ErrInvalidProof")
+	}
+}
+
+func TestSerialization(t *testing.T) {
+	A, B, C, comAB, comC := randomInstance(t, 4)
+	var r fr.Element
+	r.SetRandom()
+	proof, err := Prove(&testSrs.Pk, comAB, comC, A, B, C, r, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := proof.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var proof2 Proof
+	if _, err := proof2.ReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&proof, &proof2) {
+		t.Fatal("proof serialization failed")
+	}
+
+	buf.Reset()
+	if _, err := comAB.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var comAB2 Commitment
+	if _, err := comAB2.ReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&comAB, &comAB2) {
+		t.Fatal("commitment serialization failed")
+	}
+
+	buf.Reset()
+	if _, err := testSrs.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var srs SRS
+	if _, err := srs.ReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(testSrs, &srs) {
+		t.Fatal("srs serialization failed")
+	}
+
+	buf.Reset()
+	if _, err := testSrs.Pk.WriteRawTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var pk ProvingKey
+	if _, err := pk.UnsafeReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&testSrs.Pk, &pk) {
+		t.Fatal("proving key raw serialization failed")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkProve(b *testing.B) {
+	A, B, C, comAB, comC := randomInstance(b, 8)
+	var r fr.Element
+	r.SetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Prove(&testSrs.Pk, comAB, comC, A, B, C, r, sha256.New())
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	A, B, C, comAB, comC := randomInstance(b, 8)
+	var r fr.Element
+	r.SetRandom()
+	proof, err := Prove(&testSrs.Pk, comAB, comC, A, B, C, r, sha256.New())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(&testSrs.Vk, comAB, comC, r, &proof, sha256.New())
+	}
+}
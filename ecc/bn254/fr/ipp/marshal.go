@@ -0,0 +1,254 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipp
+
+import (
+	"errors"
+	"io"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+)
+
+// WriteTo writes binary encoding of the ProvingKey
+func (pk *ProvingKey) WriteTo(w io.Writer) (int64, error) {
+	return pk.writeTo(w)
+}
+
+// WriteRawTo writes binary encoding of ProvingKey to w without point compression
+func (pk *ProvingKey) WriteRawTo(w io.Writer) (int64, error) {
+	return pk.writeTo(w, curve.RawEncoding())
+}
+
+func (pk *ProvingKey) writeTo(w io.Writer, options ...func(*curve.Encoder)) (int64, error) {
+	enc := curve.NewEncoder(w, options...)
+	toEncode := []interface{}{
+		pk.G1A,
+		pk.G1B,
+		pk.G2A,
+		pk.G2B,
+	}
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes ProvingKey data from reader.
+func (pk *ProvingKey) ReadFrom(r io.Reader) (int64, error) {
+	return pk.readFrom(r)
+}
+
+// UnsafeReadFrom decodes ProvingKey data from reader without checking
+// that point are in the correct subgroup.
+func (pk *ProvingKey) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return pk.readFrom(r, curve.NoSubgroupChecks())
+}
+
+func (pk *ProvingKey) readFrom(r io.Reader, options ...func(*curve.Decoder)) (int64, error) {
+	dec := curve.NewDecoder(r, options...)
+	toDecode := []interface{}{
+		&pk.G1A,
+		&pk.G1B,
+		&pk.G2A,
+		&pk.G2B,
+	}
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of the VerifyingKey
+func (vk *VerifyingKey) WriteTo(w io.Writer) (int64, error) {
+	return vk.writeTo(w)
+}
+
+// WriteRawTo writes binary encoding of VerifyingKey to w without point compression
+func (vk *VerifyingKey) WriteRawTo(w io.Writer) (int64, error) {
+	return vk.writeTo(w, curve.RawEncoding())
+}
+
+func (vk *VerifyingKey) writeTo(w io.Writer, options ...func(*curve.Encoder)) (int64, error) {
+	enc := curve.NewEncoder(w, options...)
+	toEncode := []interface{}{
+		&vk.G1[0],
+		&vk.G1[1],
+		&vk.G1[2],
+		&vk.G2[0],
+		&vk.G2[1],
+		&vk.G2[2],
+		vk.Size,
+	}
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes VerifyingKey data from reader.
+func (vk *VerifyingKey) ReadFrom(r io.Reader) (int64, error) {
+	dec := curve.NewDecoder(r)
+	toDecode := []interface{}{
+		&vk.G1[0],
+		&vk.G1[1],
+		&vk.G1[2],
+		&vk.G2[0],
+		&vk.G2[1],
+		&vk.G2[2],
+		&vk.Size,
+	}
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of the entire SRS
+func (srs *SRS) WriteTo(w io.Writer) (int64, error) {
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.WriteTo(w); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.WriteTo(w)
+	return pn + vn, err
+}
+
+// ReadFrom decodes SRS data from reader.
+func (srs *SRS) ReadFrom(r io.Reader) (int64, error) {
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.ReadFrom(r); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.ReadFrom(r)
+	return pn + vn, err
+}
+
+// WriteTo writes binary encoding of the Proof; GT elements are torus-compressed.
+func (proof *Proof) WriteTo(w io.Writer) (int64, error) {
+	enc := curve.NewEncoder(w)
+	toEncode := []interface{}{
+		&proof.ZAB,
+		&proof.ZC,
+		flattenCommitments(proof.ComAB),
+		flattenCommitments(proof.ComC),
+		proof.CrossAB,
+		proof.CrossC,
+		&proof.FinalA,
+		&proof.FinalB,
+		&proof.FinalC,
+		&proof.FinalV1,
+		&proof.FinalV2,
+		&proof.FinalW1,
+		&proof.FinalW2,
+		&proof.OpeningV1,
+		&proof.OpeningV2,
+		&proof.OpeningW1,
+		&proof.OpeningW2,
+	}
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes Proof data from reader.
+func (proof *Proof) ReadFrom(r io.Reader) (int64, error) {
+	dec := curve.NewDecoder(r)
+	var comAB, comC []curve.GT
+	toDecode := []interface{}{
+		&proof.ZAB,
+		&proof.ZC,
+		&comAB,
+		&comC,
+		&proof.CrossAB,
+		&proof.CrossC,
+		&proof.FinalA,
+		&proof.FinalB,
+		&proof.FinalC,
+		&proof.FinalV1,
+		&proof.FinalV2,
+		&proof.FinalW1,
+		&proof.FinalW2,
+		&proof.OpeningV1,
+		&proof.OpeningV2,
+		&proof.OpeningW1,
+		&proof.OpeningW2,
+	}
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+	var err error
+	if proof.ComAB, err = unflattenCommitments(comAB); err != nil {
+		return dec.BytesRead(), err
+	}
+	proof.ComC, err = unflattenCommitments(comC)
+	return dec.BytesRead(), err
+}
+
+// WriteTo writes binary encoding of the Commitment; GT elements are torus-compressed.
+func (com *Commitment) WriteTo(w io.Writer) (int64, error) {
+	enc := curve.NewEncoder(w)
+	if err := enc.Encode(&com.T); err != nil {
+		return enc.BytesWritten(), err
+	}
+	err := enc.Encode(&com.U)
+	return enc.BytesWritten(), err
+}
+
+// ReadFrom decodes Commitment data from reader.
+func (com *Commitment) ReadFrom(r io.Reader) (int64, error) {
+	dec := curve.NewDecoder(r)
+	if err := dec.Decode(&com.T); err != nil {
+		return dec.BytesRead(), err
+	}
+	err := dec.Decode(&com.U)
+	return dec.BytesRead(), err
+}
+
+// flattenCommitments returns [T₀, U₀, T₁, U₁, ...]
+func flattenCommitments(coms []Commitment) []curve.GT {
+	res := make([]curve.GT, 0, 2*len(coms))
+	for i := range coms {
+		res = append(res, coms[i].T, coms[i].U)
+	}
+	return res
+}
+
+func unflattenCommitments(z []curve.GT) ([]Commitment, error) {
+	if len(z)%2 != 0 {
+		return nil, errors.New("invalid number of commitments")
+	}
+	res := make([]Commitment, len(z)/2)
+	for i := range res {
+		res[i].T, res[i].U = z[2*i], z[2*i+1]
+	}
+	return res, nil
+}
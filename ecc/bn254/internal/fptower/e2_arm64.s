@@ -0,0 +1,197 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0x3c208c16d87cfd47
+DATA q<>+8(SB)/8, $0x97816a916871ca8d
+DATA q<>+16(SB)/8, $0xb85045b68181585d
+DATA q<>+24(SB)/8, $0x30644e72e131a029
+GLOBL q<>(SB), (RODATA+NOPTR), $32
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x87d20782e4866389
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+TEXT ·addE2(SB), NOSPLIT, $0-24
+	MOVD $q<>(SB), R11
+	LDP  16(R11), (R13, R14)
+	LDP  0(R11), (R11, R12)
+	MOVD res+0(FP), R0
+	MOVD x+8(FP), R1
+	MOVD y+16(FP), R2
+	LDP  16(R1), (R5, R6)
+	LDP  0(R1), (R3, R4)
+	LDP  16(R2), (R9, R10)
+	LDP  0(R2), (R7, R8)
+	ADDS R3, R7, R3
+	ADCS R4, R8, R4
+	ADCS R5, R9, R5
+	ADCS R6, R10, R6
+	SUBS R11, R3, R7
+	SBCS R12, R4, R8
+	SBCS R13, R5, R9
+	SBCS R14, R6, R10
+	CSEL CS, R7, R3, R3
+	CSEL CS, R8, R4, R4
+	CSEL CS, R9, R5, R5
+	CSEL CS, R10, R6, R6
+	STP  (R3, R4), 0(R0)
+	STP  (R5, R6), 16(R0)
+	LDP  48(R1), (R5, R6)
+	LDP  32(R1), (R3, R4)
+	LDP  48(R2), (R9, R10)
+	LDP  32(R2), (R7, R8)
+	ADDS R3, R7, R3
+	ADCS R4, R8, R4
+	ADCS R5, R9, R5
+	ADCS R6, R10, R6
+	SUBS R11, R3, R7
+	SBCS R12, R4, R8
+	SBCS R13, R5, R9
+	SBCS R14, R6, R10
+	CSEL CS, R7, R3, R3
+	CSEL CS, R8, R4, R4
+	CSEL CS, R9, R5, R5
+	CSEL CS, R10, R6, R6
+	STP  (R3, R4), 32(R0)
+	STP  (R5, R6), 48(R0)
+	RET
+
+TEXT ·doubleE2(SB), NOSPLIT, $0-16
+	MOVD $q<>(SB), R10
+	LDP  16(R10), (R12, R13)
+	LDP  0(R10), (R10, R11)
+	MOVD res+0(FP), R0
+	MOVD x+8(FP), R1
+	LDP  16(R1), (R4, R5)
+	LDP  0(R1), (R2, R3)
+	ADDS R2, R2, R2
+	ADCS R3, R3, R3
+	ADCS R4, R4, R4
+	ADCS R5, R5, R5
+	SUBS R10, R2, R6
+	SBCS R11, R3, R7
+	SBCS R12, R4, R8
+	SBCS R13, R5, R9
+	CSEL CS, R6, R2, R2
+	CSEL CS, R7, R3, R3
+	CSEL CS, R8, R4, R4
+	CSEL CS, R9, R5, R5
+	STP  (R2, R3), 0(R0)
+	STP  (R4, R5), 16(R0)
+	LDP  48(R1), (R4, R5)
+	LDP  32(R1), (R2, R3)
+	ADDS R2, R2, R2
+	ADCS R3, R3, R3
+	ADCS R4, R4, R4
+	ADCS R5, R5, R5
+	SUBS R10, R2, R6
+	SBCS R11, R3, R7
+	SBCS R12, R4, R8
+	SBCS R13, R5, R9
+	CSEL CS, R6, R2, R2
+	CSEL CS, R7, R3, R3
+	CSEL CS, R8, R4, R4
+	CSEL CS, R9, R5, R5
+	STP  (R2, R3), 32(R0)
+	STP  (R4, R5), 48(R0)
+	RET
+
+TEXT ·subE2(SB), NOSPLIT, $0-24
+	MOVD $q<>(SB), R11
+	LDP  16(R11), (R13, R14)
+	LDP  0(R11), (R11, R12)
+	MOVD res+0(FP), R0
+	MOVD x+8(FP), R1
+	MOVD y+16(FP), R2
+	LDP  16(R1), (R5, R6)
+	LDP  0(R1), (R3, R4)
+	LDP  16(R2), (R9, R10)
+	LDP  0(R2), (R7, R8)
+	SUBS R7, R3, R3
+	SBCS R8, R4, R4
+	SBCS R9, R5, R5
+	SBCS R10, R6, R6
+	CSEL CS, ZR, R11, R7
+	CSEL CS, ZR, R12, R8
+	CSEL CS, ZR, R13, R9
+	CSEL CS, ZR, R14, R10
+	ADDS R3, R7, R3
+	ADCS R4, R8, R4
+	ADCS R5, R9, R5
+	ADCS R6, R10, R6
+	STP  (R3, R4), 0(R0)
+	STP  (R5, R6), 16(R0)
+	LDP  48(R1), (R5, R6)
+	LDP  32(R1), (R3, R4)
+	LDP  48(R2), (R9, R10)
+	LDP  32(R2), (R7, R8)
+	SUBS R7, R3, R3
+	SBCS R8, R4, R4
+	SBCS R9, R5, R5
+	SBCS R10, R6, R6
+	CSEL CS, ZR, R11, R7
+	CSEL CS, ZR, R12, R8
+	CSEL CS, ZR, R13, R9
+	CSEL CS, ZR, R14, R10
+	ADDS R3, R7, R3
+	ADCS R4, R8, R4
+	ADCS R5, R9, R5
+	ADCS R6, R10, R6
+	STP  (R3, R4), 32(R0)
+	STP  (R5, R6), 48(R0)
+	RET
+
+TEXT ·negE2(SB), NOSPLIT, $0-16
+	MOVD $q<>(SB), R7
+	LDP  16(R7), (R9, R10)
+	LDP  0(R7), (R7, R8)
+	MOVD res+0(FP), R0
+	MOVD x+8(FP), R1
+	LDP  16(R1), (R5, R6)
+	LDP  0(R1), (R3, R4)
+	ORR  R3, R4, R2
+	ORR  R5, R2, R2
+	ORR  R6, R2, R2
+	SUBS R3, R7, R3
+	SBCS R4, R8, R4
+	SBCS R5, R9, R5
+	SBCS R6, R10, R6
+	CMP  $0, R2
+	CSEL EQ, ZR, R3, R3
+	CSEL EQ, ZR, R4, R4
+	CSEL EQ, ZR, R5, R5
+	CSEL EQ, ZR, R6, R6
+	STP  (R3, R4), 0(R0)
+	STP  (R5, R6), 16(R0)
+	LDP  48(R1), (R5, R6)
+	LDP  32(R1), (R3, R4)
+	ORR  R3, R4, R2
+	ORR  R5, R2, R2
+	ORR  R6, R2, R2
+	SUBS R3, R7, R3
+	SBCS R4, R8, R4
+	SBCS R5, R9, R5
+	SBCS R6, R10, R6
+	CMP  $0, R2
+	CSEL EQ, ZR, R3, R3
+	CSEL EQ, ZR, R4, R4
+	CSEL EQ, ZR, R5, R5
+	CSEL EQ, ZR, R6, R6
+	STP  (R3, R4), 32(R0)
+	STP  (R5, R6), 48(R0)
+	RET
@@ -44,6 +44,17 @@ const (
 // SizeOfGT represents the size in bytes that a GT element need in binary form
 const SizeOfGT = fptower.SizeOfGT
 
+// SizeOfGTCompressed represents the size in bytes that a GT element need in binary form, torus-compressed
+const SizeOfGTCompressed = SizeOfGT / 2
+
+// To encode GT elements, the most significant bits of the first byte flag a torus-compressed element;
+// they are always 0 in the uncompressed encoding.
+const (
+	mGTMask          byte = 0b11 << 6
+	mGTCompressed    byte = 0b10 << 6
+	mGTCompressedOne byte = 0b11 << 6
+)
+
 var (
 	ErrInvalidInfinityEncoding = errors.New("invalid infinity point encoding")
 	ErrInvalidEncoding         = errors.New("invalid point encoding")
@@ -76,7 +87,7 @@ func NewDecoder(r io.Reader, options ...func(*Decoder)) *Decoder {
 }
 
 // Decode reads the binary encoding of v from the stream
-// type must be *uint64, *fr.Element, *fp.Element, *G1Affine, *G2Affine, *[]G1Affine or *[]G2Affine
+// type must be *uint64, *fr.Element, *fp.Element, *G1Affine, *G2Affine, *GT, *[]G1Affine, *[]G2Affine or *[]GT
 func (dec *Decoder) Decode(v interface{}) (err error) {
 	rv := reflect.ValueOf(v)
 	if v == nil || rv.Kind() != reflect.Ptr || rv.IsNil() || !rv.Elem().CanSet() {
@@ -248,21 +259,7 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 				compressed[i] = !r
 			}
 		}
-		var nbErrs uint64
-		parallel.Execute(len(compressed), func(start, end int) {
-			for i := start; i < end; i++ {
-				if compressed[i] {
-					if err := (*t)[i].unsafeComputeY(dec.subGroupCheck); err != nil {
-						atomic.AddUint64(&nbErrs, 1)
-					}
-				} else if dec.subGroupCheck {
-					if !(*t)[i].IsInSubGroup() {
-						atomic.AddUint64(&nbErrs, 1)
-					}
-				}
-			}
-		})
-		if nbErrs != 0 {
+		if nbErrs := batchComputeYG1Affine(*t, compressed, dec.subGroupCheck); nbErrs != 0 {
 			return errors.New("point decompression failed")
 		}
 
@@ -307,24 +304,25 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 				compressed[i] = !r
 			}
 		}
-		var nbErrs uint64
-		parallel.Execute(len(compressed), func(start, end int) {
-			for i := start; i < end; i++ {
-				if compressed[i] {
-					if err := (*t)[i].unsafeComputeY(dec.subGroupCheck); err != nil {
-						atomic.AddUint64(&nbErrs, 1)
-					}
-				} else if dec.subGroupCheck {
-					if !(*t)[i].IsInSubGroup() {
-						atomic.AddUint64(&nbErrs, 1)
-					}
-				}
-			}
-		})
-		if nbErrs != 0 {
+		if nbErrs := batchComputeYG2Affine(*t, compressed, dec.subGroupCheck); nbErrs != 0 {
 			return errors.New("point decompression failed")
 		}
 
+		return nil
+	case *GT:
+		return dec.readGT(t)
+	case *[]GT:
+		if sliceLen, err = dec.readUint32(); err != nil {
+			return
+		}
+		if len(*t) != int(sliceLen) || *t == nil {
+			*t = make([]GT, sliceLen)
+		}
+		for i := range *t {
+			if err = dec.readGT(&(*t)[i]); err != nil {
+				return
+			}
+		}
 		return nil
 	default:
 		n := binary.Size(t)
@@ -391,7 +389,7 @@ func NewEncoder(w io.Writer, options ...func(*Encoder)) *Encoder {
 }
 
 // Encode writes the binary encoding of v to the stream
-// type must be uint64, *fr.Element, *fp.Element, *G1Affine, *G2Affine, []G1Affine or []G2Affine
+// type must be uint64, *fr.Element, *fp.Element, *G1Affine, *G2Affine, *GT, []G1Affine, []G2Affine or []GT
 func (enc *Encoder) Encode(v interface{}) (err error) {
 	if enc.raw {
 		return enc.encodeRaw(v)
@@ -405,7 +403,7 @@ func (enc *Encoder) BytesWritten() int64 {
 }
 
 // RawEncoding returns an option to use in NewEncoder(...) which sets raw encoding mode to true
-// points will not be compressed using this option
+// points and GT elements will not be compressed using this option
 func RawEncoding() func(*Encoder) {
 	return func(enc *Encoder) {
 		enc.raw = true
@@ -433,6 +431,90 @@ func isZeroed(firstByte byte, buf []byte) bool {
 	return true
 }
 
+// GTBytesCompressed returns the torus-compressed binary encoding of z, of half the size of z.Bytes()
+// ("Compression in finite fields and torus-based cryptography", K. Rubin and A. Silverberg).
+//
+// z must be in GT; in particular, the only element of GT that can't be compressed on the torus (1)
+// is encoded with a dedicated flag.
+func GTBytesCompressed(z *GT) (res [SizeOfGTCompressed]byte, err error) {
+	if z.IsOne() {
+		res[0] = mGTCompressedOne
+		return
+	}
+	var t GT
+	if t.C0, err = z.CompressTorus(); err != nil {
+		return res, errors.New("invalid GT element: can't be torus-compressed")
+	}
+	b := t.Bytes()
+	copy(res[:], b[SizeOfGTCompressed:SizeOfGTCompressed+SizeOfGTCompressed])
+	res[0] |= mGTCompressed
+	return
+}
+
+// GTSetBytesCompressed sets z from its torus-compressed binary encoding (see GTBytesCompressed)
+// and checks that it is in GT.
+func GTSetBytesCompressed(z *GT, buf []byte) error {
+	return gtSetBytesCompressed(z, buf, true)
+}
+
+func gtSetBytesCompressed(z *GT, buf []byte, subGroupCheck bool) error {
+	if len(buf) != SizeOfGTCompressed {
+		return errors.New("invalid buffer size")
+	}
+	switch buf[0] & mGTMask {
+	case mGTCompressedOne:
+		if !isZeroed(buf[0] & ^mGTMask, buf[1:]) {
+			return ErrInvalidEncoding
+		}
+		z.SetOne()
+		return nil
+	case mGTCompressed:
+	default:
+		return ErrInvalidEncoding
+	}
+
+	// the compressed element is stored as the C0 half of a GT element
+	var b [SizeOfGT]byte
+	copy(b[SizeOfGTCompressed:SizeOfGTCompressed+SizeOfGTCompressed], buf)
+	b[SizeOfGTCompressed] &^= mGTMask
+	var t GT
+	if err := t.SetBytes(b[:]); err != nil {
+		return err
+	}
+	*z = t.C0.DecompressTorus()
+	if subGroupCheck && !z.IsInSubGroup() {
+		return errors.New("invalid GT element: subgroup check failed")
+	}
+	return nil
+}
+
+// readGT reads a GT element from the stream, torus-compressed or not.
+func (dec *Decoder) readGT(z *GT) (err error) {
+	var buf [SizeOfGT]byte
+	var read int
+	// we start by reading the compressed size, if metadata tells us it is uncompressed, we read more.
+	read, err = io.ReadFull(dec.r, buf[:SizeOfGTCompressed])
+	dec.n += int64(read)
+	if err != nil {
+		return
+	}
+	if buf[0]&mGTMask != 0 {
+		return gtSetBytesCompressed(z, buf[:SizeOfGTCompressed], dec.subGroupCheck)
+	}
+	read, err = io.ReadFull(dec.r, buf[SizeOfGTCompressed:])
+	dec.n += int64(read)
+	if err != nil {
+		return
+	}
+	if err = z.SetBytes(buf[:]); err != nil {
+		return
+	}
+	if dec.subGroupCheck && !z.IsInSubGroup() {
+		return errors.New("invalid GT element: subgroup check failed")
+	}
+	return nil
+}
+
 func (enc *Encoder) encode(v interface{}) (err error) {
 	rv := reflect.ValueOf(v)
 	if v == nil || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
@@ -540,6 +622,35 @@ func (enc *Encoder) encode(v interface{}) (err error) {
 			}
 		}
 		return nil
+	case *GT:
+		var buf [SizeOfGTCompressed]byte
+		if buf, err = GTBytesCompressed(t); err != nil {
+			return
+		}
+		written, err = enc.w.Write(buf[:])
+		enc.n += int64(written)
+		return
+	case []GT:
+		// write slice length
+		err = binary.Write(enc.w, binary.BigEndian, uint32(len(t)))
+		if err != nil {
+			return
+		}
+		enc.n += 4
+
+		var buf [SizeOfGTCompressed]byte
+
+		for i := 0; i < len(t); i++ {
+			if buf, err = GTBytesCompressed(&t[i]); err != nil {
+				return
+			}
+			written, err = enc.w.Write(buf[:])
+			enc.n += int64(written)
+			if err != nil {
+				return
+			}
+		}
+		return nil
 	default:
 		n := binary.Size(t)
 		if n == -1 {
@@ -658,6 +769,30 @@ func (enc *Encoder) encodeRaw(v interface{}) (err error) {
 			}
 		}
 		return nil
+	case *GT:
+		buf := t.Bytes()
+		written, err = enc.w.Write(buf[:])
+		enc.n += int64(written)
+		return
+	case []GT:
+		// write slice length
+		err = binary.Write(enc.w, binary.BigEndian, uint32(len(t)))
+		if err != nil {
+			return
+		}
+		enc.n += 4
+
+		var buf [SizeOfGT]byte
+
+		for i := 0; i < len(t); i++ {
+			buf = t[i].Bytes()
+			written, err = enc.w.Write(buf[:])
+			enc.n += int64(written)
+			if err != nil {
+				return
+			}
+		}
+		return nil
 	default:
 		n := binary.Size(t)
 		if n == -1 {
@@ -891,42 +1026,63 @@ func (p *G1Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 	return SizeOfG1AffineCompressed, nil
 }
 
-// unsafeComputeY called by Decoder when processing slices of compressed point in parallel (step 2)
-// it computes the Y coordinate from the already set X coordinate and is compute intensive
-func (p *G1Affine) unsafeComputeY(subGroupCheck bool) error {
+// unsafeSetY sets the Y coordinate to ±y, using the decompression metadata stored
+// in p.Y by unsafeSetCompressedBytes to pick the sign
+func (p *G1Affine) unsafeSetY(y *fp.Element) {
 	// stored in unsafeSetCompressedBytes
-
 	mData := byte(p.Y[0])
 
-	// we have a compressed coordinate, we need to solve the curve equation to compute Y
-	var YSquared, Y fp.Element
-
-	YSquared.Square(&p.X).Mul(&YSquared, &p.X)
-	YSquared.Add(&YSquared, &bCurveCoeff)
-	if Y.Sqrt(&YSquared) == nil {
-		return errors.New("invalid compressed coordinate: square root doesn't exist")
-	}
-
-	if Y.LexicographicallyLargest() {
+	p.Y = *y
+	if p.Y.LexicographicallyLargest() {
 		// Y ">" -Y
 		if mData == mCompressedSmallest {
-			Y.Neg(&Y)
+			p.Y.Neg(&p.Y)
 		}
 	} else {
 		// Y "<=" -Y
 		if mData == mCompressedLargest {
-			Y.Neg(&Y)
+			p.Y.Neg(&p.Y)
 		}
 	}
+}
 
-	p.Y = Y
-
-	// subgroup check
-	if subGroupCheck && !p.IsInSubGroup() {
-		return errors.New("invalid point: subgroup check failed")
-	}
-
-	return nil
+// batchComputeYG1Affine is called by Decoder when processing slices of points (step 2).
+// It computes the Y coordinates of the points flagged in compressed from their already set X
+// coordinates and, if subGroupCheck is set, checks that all points are in the subgroup.
+// The square roots are computed in batch (see fp.BatchSqrt).
+// It returns the number of points that failed to decode.
+func batchComputeYG1Affine(points []G1Affine, compressed []bool, subGroupCheck bool) uint64 {
+	var nbErrs uint64
+	parallel.Execute(len(points), func(start, end int) {
+		// collect the compressed points of the chunk and their Y² = X³ + b
+		indices := make([]int, 0, end-start)
+		ySquared := make([]fp.Element, 0, end-start)
+		for i := start; i < end; i++ {
+			if !compressed[i] {
+				if subGroupCheck && !points[i].IsInSubGroup() {
+					atomic.AddUint64(&nbErrs, 1)
+				}
+				continue
+			}
+			var y fp.Element
+			y.Square(&points[i].X).Mul(&y, &points[i].X)
+			y.Add(&y, &bCurveCoeff)
+			indices = append(indices, i)
+			ySquared = append(ySquared, y)
+		}
+		Y, isSquare := fp.BatchSqrt(ySquared)
+		for j, i := range indices {
+			if !isSquare[j] {
+				atomic.AddUint64(&nbErrs, 1)
+				continue
+			}
+			points[i].unsafeSetY(&Y[j])
+			if subGroupCheck && !points[i].IsInSubGroup() {
+				atomic.AddUint64(&nbErrs, 1)
+			}
+		}
+	})
+	return nbErrs
 }
 
 // unsafeSetCompressedBytes is called by Decoder when processing slices of compressed point in parallel (step 1)
@@ -1160,43 +1316,63 @@ func (p *G2Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 	return SizeOfG2AffineCompressed, nil
 }
 
-// unsafeComputeY called by Decoder when processing slices of compressed point in parallel (step 2)
-// it computes the Y coordinate from the already set X coordinate and is compute intensive
-func (p *G2Affine) unsafeComputeY(subGroupCheck bool) error {
+// unsafeSetY sets the Y coordinate to ±y, using the decompression metadata stored
+// in p.Y by unsafeSetCompressedBytes to pick the sign
+func (p *G2Affine) unsafeSetY(y *fptower.E2) {
 	// stored in unsafeSetCompressedBytes
-
 	mData := byte(p.Y.A0[0])
 
-	// we have a compressed coordinate, we need to solve the curve equation to compute Y
-	var YSquared, Y fptower.E2
-
-	YSquared.Square(&p.X).Mul(&YSquared, &p.X)
-	YSquared.Add(&YSquared, &bTwistCurveCoeff)
-	if YSquared.Legendre() == -1 {
-		return errors.New("invalid compressed coordinate: square root doesn't exist")
-	}
-	Y.Sqrt(&YSquared)
-
-	if Y.LexicographicallyLargest() {
+	p.Y = *y
+	if p.Y.LexicographicallyLargest() {
 		// Y ">" -Y
 		if mData == mCompressedSmallest {
-			Y.Neg(&Y)
+			p.Y.Neg(&p.Y)
 		}
 	} else {
 		// Y "<=" -Y
 		if mData == mCompressedLargest {
-			Y.Neg(&Y)
+			p.Y.Neg(&p.Y)
 		}
 	}
+}
 
-	p.Y = Y
-
-	// subgroup check
-	if subGroupCheck && !p.IsInSubGroup() {
-		return errors.New("invalid point: subgroup check failed")
-	}
-
-	return nil
+// batchComputeYG2Affine is called by Decoder when processing slices of points (step 2).
+// It computes the Y coordinates of the points flagged in compressed from their already set X
+// coordinates and, if subGroupCheck is set, checks that all points are in the subgroup.
+// The square roots are computed in batch (see fptower.BatchSqrtE2).
+// It returns the number of points that failed to decode.
+func batchComputeYG2Affine(points []G2Affine, compressed []bool, subGroupCheck bool) uint64 {
+	var nbErrs uint64
+	parallel.Execute(len(points), func(start, end int) {
+		// collect the compressed points of the chunk and their Y² = X³ + b
+		indices := make([]int, 0, end-start)
+		ySquared := make([]fptower.E2, 0, end-start)
+		for i := start; i < end; i++ {
+			if !compressed[i] {
+				if subGroupCheck && !points[i].IsInSubGroup() {
+					atomic.AddUint64(&nbErrs, 1)
+				}
+				continue
+			}
+			var y fptower.E2
+			y.Square(&points[i].X).Mul(&y, &points[i].X)
+			y.Add(&y, &bTwistCurveCoeff)
+			indices = append(indices, i)
+			ySquared = append(ySquared, y)
+		}
+		Y, isSquare := fptower.BatchSqrtE2(ySquared)
+		for j, i := range indices {
+			if !isSquare[j] {
+				atomic.AddUint64(&nbErrs, 1)
+				continue
+			}
+			points[i].unsafeSetY(&Y[j])
+			if subGroupCheck && !points[i].IsInSubGroup() {
+				atomic.AddUint64(&nbErrs, 1)
+			}
+		}
+	})
+	return nbErrs
 }
 
 // unsafeSetCompressedBytes is called by Decoder when processing slices of compressed point in parallel (step 1)
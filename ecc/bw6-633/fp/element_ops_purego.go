@@ -855,20 +855,31 @@ func (z *Element) Mul(x, y *Element) *Element {
 	z[8] = t8
 	z[9] = t9
 
-	// if z ⩾ q → z -= q
-	if !z.smallerThanModulus() {
-		var b uint64
-		z[0], b = bits.Sub64(z[0], q0, 0)
-		z[1], b = bits.Sub64(z[1], q1, b)
-		z[2], b = bits.Sub64(z[2], q2, b)
-		z[3], b = bits.Sub64(z[3], q3, b)
-		z[4], b = bits.Sub64(z[4], q4, b)
-		z[5], b = bits.Sub64(z[5], q5, b)
-		z[6], b = bits.Sub64(z[6], q6, b)
-		z[7], b = bits.Sub64(z[7], q7, b)
-		z[8], b = bits.Sub64(z[8], q8, b)
-		z[9], _ = bits.Sub64(z[9], q9, b)
-	}
+	// if z ⩾ q → z -= q, without branching on z
+	var s [10]uint64
+	var b uint64
+	s[0], b = bits.Sub64(z[0], q0, 0)
+	s[1], b = bits.Sub64(z[1], q1, b)
+	s[2], b = bits.Sub64(z[2], q2, b)
+	s[3], b = bits.Sub64(z[3], q3, b)
+	s[4], b = bits.Sub64(z[4], q4, b)
+	s[5], b = bits.Sub64(z[5], q5, b)
+	s[6], b = bits.Sub64(z[6], q6, b)
+	s[7], b = bits.Sub64(z[7], q7, b)
+	s[8], b = bits.Sub64(z[8], q8, b)
+	s[9], b = bits.Sub64(z[9], q9, b)
+	// mask = 0xFF..FF if z ⩾ q (no borrow), 0 otherwise
+	mask := b - 1
+	z[0] ^= mask & (z[0] ^ s[0])
+	z[1] ^= mask & (z[1] ^ s[1])
+	z[2] ^= mask & (z[2] ^ s[2])
+	z[3] ^= mask & (z[3] ^ s[3])
+	z[4] ^= mask & (z[4] ^ s[4])
+	z[5] ^= mask & (z[5] ^ s[5])
+	z[6] ^= mask & (z[6] ^ s[6])
+	z[7] ^= mask & (z[7] ^ s[7])
+	z[8] ^= mask & (z[8] ^ s[8])
+	z[9] ^= mask & (z[9] ^ s[9])
 	return z
 }
 
@@ -1619,19 +1630,30 @@ func (z *Element) Square(x *Element) *Element {
 	z[8] = t8
 	z[9] = t9
 
-	// if z ⩾ q → z -= q
-	if !z.smallerThanModulus() {
-		var b uint64
-		z[0], b = bits.Sub64(z[0], q0, 0)
-		z[1], b = bits.Sub64(z[1], q1, b)
-		z[2], b = bits.Sub64(z[2], q2, b)
-		z[3], b = bits.Sub64(z[3], q3, b)
-		z[4], b = bits.Sub64(z[4], q4, b)
-		z[5], b = bits.Sub64(z[5], q5, b)
-		z[6], b = bits.Sub64(z[6], q6, b)
-		z[7], b = bits.Sub64(z[7], q7, b)
-		z[8], b = bits.Sub64(z[8], q8, b)
-		z[9], _ = bits.Sub64(z[9], q9, b)
-	}
+	// if z ⩾ q → z -= q, without branching on z
+	var s [10]uint64
+	var b uint64
+	s[0], b = bits.Sub64(z[0], q0, 0)
+	s[1], b = bits.Sub64(z[1], q1, b)
+	s[2], b = bits.Sub64(z[2], q2, b)
+	s[3], b = bits.Sub64(z[3], q3, b)
+	s[4], b = bits.Sub64(z[4], q4, b)
+	s[5], b = bits.Sub64(z[5], q5, b)
+	s[6], b = bits.Sub64(z[6], q6, b)
+	s[7], b = bits.Sub64(z[7], q7, b)
+	s[8], b = bits.Sub64(z[8], q8, b)
+	s[9], b = bits.Sub64(z[9], q9, b)
+	// mask = 0xFF..FF if z ⩾ q (no borrow), 0 otherwise
+	mask := b - 1
+	z[0] ^= mask & (z[0] ^ s[0])
+	z[1] ^= mask & (z[1] ^ s[1])
+	z[2] ^= mask & (z[2] ^ s[2])
+	z[3] ^= mask & (z[3] ^ s[3])
+	z[4] ^= mask & (z[4] ^ s[4])
+	z[5] ^= mask & (z[5] ^ s[5])
+	z[6] ^= mask & (z[6] ^ s[6])
+	z[7] ^= mask & (z[7] ^ s[7])
+	z[8] ^= mask & (z[8] ^ s[8])
+	z[9] ^= mask & (z[9] ^ s[9])
 	return z
 }
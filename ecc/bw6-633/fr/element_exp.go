@@ -493,166 +493,121 @@ func (z *Element) expBySqrtExp(x Element) *Element {
 	return z
 }
 
-// expByLegendreExp is equivalent to z.Exp(x, 2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa0180000)
-//
-// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
-func (z *Element) expByLegendreExp(x Element) *Element {
-	// addition chain:
-	//
-	//	_10       = 2*1
-	//	_11       = 1 + _10
-	//	_101      = _10 + _11
-	//	_110      = 1 + _101
-	//	_1011     = _101 + _110
-	//	_1101     = _10 + _1011
-	//	_10001    = _110 + _1011
-	//	_10011    = _10 + _10001
-	//	_11001    = _110 + _10011
-	//	_11011    = _10 + _11001
-	//	_11101    = _10 + _11011
-	//	_11111    = _10 + _11101
-	//	_100001   = _10 + _11111
-	//	_100011   = _10 + _100001
-	//	_100101   = _10 + _100011
-	//	_101001   = _110 + _100011
-	//	_101011   = _10 + _101001
-	//	_101111   = _110 + _101001
-	//	_110101   = _110 + _101111
-	//	_110111   = _10 + _110101
-	//	_111011   = _110 + _110101
-	//	_111101   = _10 + _111011
-	//	_111111   = _10 + _111101
-	//	_1111110  = 2*_111111
-	//	_1111111  = 1 + _1111110
-	//	_10011000 = _11001 + _1111111
-	//	i51       = ((_10011000 << 7 + _100011) << 3 + _101) << 13
-	//	i68       = ((_101011 + i51) << 5 + _1011) << 9 + _11011
-	//	i88       = ((i68 << 5 + _1011) << 5 + _101) << 8
-	//	i105      = ((_1101 + i88) << 8 + _111111) << 6 + _11101
-	//	i129      = ((i105 << 7 + _10011) << 9 + _101111) << 6
-	//	i147      = ((_101001 + i129) << 6 + _11111) << 9 + _101111
-	//	i168      = ((i147 << 7 + _101011) << 6 + _111101) << 6
-	//	i194      = ((_111011 + i168) << 10 + _11101) << 13 + _110101
-	//	i220      = ((i194 << 6 + _10001) << 8 + _111101) << 10
-	//	i237      = ((_110101 + i220) << 2 + _11) << 12 + _100001
-	//	i261      = ((i237 << 10 + _111101) << 5 + _11001) << 7
-	//	i279      = ((_111011 + i261) << 7 + _100101) << 8 + _101011
-	//	i304      = ((i279 << 6 + _110111) << 6 + _100101) << 11
-	//	i317      = ((_10011 + i304) << 8 + _1111111) << 2 + 1
-	//	i338      = 2*((i317 << 10 + 1) << 8 + _1111111)
-	//	i353      = ((1 + i338) << 2 + 1) << 10 + _11
-	//	return      i353 << 19
-	//
-	// Operations: 308 squares 64 multiplies
-
-	// Allocate Temporaries.
+// vectorExpBySqrtExpNbTemporaries is the number of temporary vectors used by vectorExpBySqrtExp
+const vectorExpBySqrtExpNbTemporaries = 22
+
+// vectorExpBySqrtExp sets z[i] = x[i]^2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa01 for all i, with the addition chain of expBySqrtExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpBySqrtExpNbTemporaries * len(x).
+func vectorExpBySqrtExp(z, x, scratch Vector) {
+	n := len(x)
 	var (
-		t0  = new(Element)
-		t1  = new(Element)
-		t2  = new(Element)
-		t3  = new(Element)
-		t4  = new(Element)
-		t5  = new(Element)
-		t6  = new(Element)
-		t7  = new(Element)
-		t8  = new(Element)
-		t9  = new(Element)
-		t10 = new(Element)
-		t11 = new(Element)
-		t12 = new(Element)
-		t13 = new(Element)
-		t14 = new(Element)
-		t15 = new(Element)
-		t16 = new(Element)
-		t17 = new(Element)
-		t18 = new(Element)
-		t19 = new(Element)
-		t20 = new(Element)
-		t21 = new(Element)
+		t0  = scratch[0*n : 0*n+n]
+		t1  = scratch[1*n : 1*n+n]
+		t2  = scratch[2*n : 2*n+n]
+		t3  = scratch[3*n : 3*n+n]
+		t4  = scratch[4*n : 4*n+n]
+		t5  = scratch[5*n : 5*n+n]
+		t6  = scratch[6*n : 6*n+n]
+		t7  = scratch[7*n : 7*n+n]
+		t8  = scratch[8*n : 8*n+n]
+		t9  = scratch[9*n : 9*n+n]
+		t10 = scratch[10*n : 10*n+n]
+		t11 = scratch[11*n : 11*n+n]
+		t12 = scratch[12*n : 12*n+n]
+		t13 = scratch[13*n : 13*n+n]
+		t14 = scratch[14*n : 14*n+n]
+		t15 = scratch[15*n : 15*n+n]
+		t16 = scratch[16*n : 16*n+n]
+		t17 = scratch[17*n : 17*n+n]
+		t18 = scratch[18*n : 18*n+n]
+		t19 = scratch[19*n : 19*n+n]
+		t20 = scratch[20*n : 20*n+n]
+		t21 = scratch[21*n : 21*n+n]
 	)
 
-	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21 Element
-	// Step 1: t0 = x^0x2
-	t0.Square(&x)
+	// Step 1: z = x^0x2
+	z.Mul(x, x)
 
-	// Step 2: z = x^0x3
-	z.Mul(&x, t0)
+	// Step 2: t8 = x^0x3
+	t8.Mul(x, z)
 
 	// Step 3: t17 = x^0x5
-	t17.Mul(t0, z)
+	t17.Mul(z, t8)
 
-	// Step 4: t5 = x^0x6
-	t5.Mul(&x, t17)
+	// Step 4: t4 = x^0x6
+	t4.Mul(x, t17)
 
 	// Step 5: t18 = x^0xb
-	t18.Mul(t17, t5)
+	t18.Mul(t17, t4)
 
 	// Step 6: t16 = x^0xd
-	t16.Mul(t0, t18)
+	t16.Mul(z, t18)
 
 	// Step 7: t10 = x^0x11
-	t10.Mul(t5, t18)
+	t10.Mul(t4, t18)
 
-	// Step 8: t1 = x^0x13
-	t1.Mul(t0, t10)
+	// Step 8: t0 = x^0x13
+	t0.Mul(z, t10)
 
-	// Step 9: t6 = x^0x19
-	t6.Mul(t5, t1)
+	// Step 9: t5 = x^0x19
+	t5.Mul(t4, t0)
 
 	// Step 10: t19 = x^0x1b
-	t19.Mul(t0, t6)
+	t19.Mul(z, t5)
 
 	// Step 11: t11 = x^0x1d
-	t11.Mul(t0, t19)
+	t11.Mul(z, t19)
 
 	// Step 12: t13 = x^0x1f
-	t13.Mul(t0, t11)
+	t13.Mul(z, t11)
 
-	// Step 13: t8 = x^0x21
-	t8.Mul(t0, t13)
+	// Step 13: t7 = x^0x21
+	t7.Mul(z, t13)
 
 	// Step 14: t20 = x^0x23
-	t20.Mul(t0, t8)
+	t20.Mul(z, t7)
 
-	// Step 15: t2 = x^0x25
-	t2.Mul(t0, t20)
+	// Step 15: t1 = x^0x25
+	t1.Mul(z, t20)
 
 	// Step 16: t14 = x^0x29
-	t14.Mul(t5, t20)
+	t14.Mul(t4, t20)
 
-	// Step 17: t4 = x^0x2b
-	t4.Mul(t0, t14)
+	// Step 17: t3 = x^0x2b
+	t3.Mul(z, t14)
 
 	// Step 18: t12 = x^0x2f
-	t12.Mul(t5, t14)
+	t12.Mul(t4, t14)
 
 	// Step 19: t9 = x^0x35
-	t9.Mul(t5, t12)
+	t9.Mul(t4, t12)
 
-	// Step 20: t3 = x^0x37
-	t3.Mul(t0, t9)
+	// Step 20: t2 = x^0x37
+	t2.Mul(z, t9)
 
-	// Step 21: t5 = x^0x3b
-	t5.Mul(t5, t9)
+	// Step 21: t4 = x^0x3b
+	t4.Mul(t4, t9)
 
-	// Step 22: t7 = x^0x3d
-	t7.Mul(t0, t5)
+	// Step 22: t6 = x^0x3d
+	t6.Mul(z, t4)
 
 	// Step 23: t15 = x^0x3f
-	t15.Mul(t0, t7)
+	t15.Mul(z, t6)
 
-	// Step 24: t0 = x^0x7e
-	t0.Square(t15)
+	// Step 24: z = x^0x7e
+	z.Mul(t15, t15)
 
-	// Step 25: t0 = x^0x7f
-	t0.Mul(&x, t0)
+	// Step 25: z = x^0x7f
+	z.Mul(x, z)
 
 	// Step 26: t21 = x^0x98
-	t21.Mul(t6, t0)
+	t21.Mul(t5, z)
 
 	// Step 33: t21 = x^0x4c00
 	for s := 0; s < 7; s++ {
-		t21.Square(t21)
+		t21.Mul(t21, t21)
 	}
 
 	// Step 34: t20 = x^0x4c23
@@ -660,7 +615,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 37: t20 = x^0x26118
 	for s := 0; s < 3; s++ {
-		t20.Square(t20)
+		t20.Mul(t20, t20)
 	}
 
 	// Step 38: t20 = x^0x2611d
@@ -668,15 +623,15 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 51: t20 = x^0x4c23a000
 	for s := 0; s < 13; s++ {
-		t20.Square(t20)
+		t20.Mul(t20, t20)
 	}
 
 	// Step 52: t20 = x^0x4c23a02b
-	t20.Mul(t4, t20)
+	t20.Mul(t3, t20)
 
 	// Step 57: t20 = x^0x984740560
 	for s := 0; s < 5; s++ {
-		t20.Square(t20)
+		t20.Mul(t20, t20)
 	}
 
 	// Step 58: t20 = x^0x98474056b
@@ -684,7 +639,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 67: t20 = x^0x1308e80ad600
 	for s := 0; s < 9; s++ {
-		t20.Square(t20)
+		t20.Mul(t20, t20)
 	}
 
 	// Step 68: t19 = x^0x1308e80ad61b
@@ -692,7 +647,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 73: t19 = x^0x2611d015ac360
 	for s := 0; s < 5; s++ {
-		t19.Square(t19)
+		t19.Mul(t19, t19)
 	}
 
 	// Step 74: t18 = x^0x2611d015ac36b
@@ -700,7 +655,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 79: t18 = x^0x4c23a02b586d60
 	for s := 0; s < 5; s++ {
-		t18.Square(t18)
+		t18.Mul(t18, t18)
 	}
 
 	// Step 80: t17 = x^0x4c23a02b586d65
@@ -708,7 +663,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 88: t17 = x^0x4c23a02b586d6500
 	for s := 0; s < 8; s++ {
-		t17.Square(t17)
+		t17.Mul(t17, t17)
 	}
 
 	// Step 89: t16 = x^0x4c23a02b586d650d
@@ -716,7 +671,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 97: t16 = x^0x4c23a02b586d650d00
 	for s := 0; s < 8; s++ {
-		t16.Square(t16)
+		t16.Mul(t16, t16)
 	}
 
 	// Step 98: t15 = x^0x4c23a02b586d650d3f
@@ -724,7 +679,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 104: t15 = x^0x1308e80ad61b59434fc0
 	for s := 0; s < 6; s++ {
-		t15.Square(t15)
+		t15.Mul(t15, t15)
 	}
 
 	// Step 105: t15 = x^0x1308e80ad61b59434fdd
@@ -732,15 +687,15 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 112: t15 = x^0x98474056b0daca1a7ee80
 	for s := 0; s < 7; s++ {
-		t15.Square(t15)
+		t15.Mul(t15, t15)
 	}
 
 	// Step 113: t15 = x^0x98474056b0daca1a7ee93
-	t15.Mul(t1, t15)
+	t15.Mul(t0, t15)
 
 	// Step 122: t15 = x^0x1308e80ad61b59434fdd2600
 	for s := 0; s < 9; s++ {
-		t15.Square(t15)
+		t15.Mul(t15, t15)
 	}
 
 	// Step 123: t15 = x^0x1308e80ad61b59434fdd262f
@@ -748,7 +703,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 129: t15 = x^0x4c23a02b586d650d3f7498bc0
 	for s := 0; s < 6; s++ {
-		t15.Square(t15)
+		t15.Mul(t15, t15)
 	}
 
 	// Step 130: t14 = x^0x4c23a02b586d650d3f7498be9
@@ -756,7 +711,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 136: t14 = x^0x1308e80ad61b59434fdd262fa40
 	for s := 0; s < 6; s++ {
-		t14.Square(t14)
+		t14.Mul(t14, t14)
 	}
 
 	// Step 137: t13 = x^0x1308e80ad61b59434fdd262fa5f
@@ -764,7 +719,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 146: t13 = x^0x2611d015ac36b2869fba4c5f4be00
 	for s := 0; s < 9; s++ {
-		t13.Square(t13)
+		t13.Mul(t13, t13)
 	}
 
 	// Step 147: t12 = x^0x2611d015ac36b2869fba4c5f4be2f
@@ -772,31 +727,31 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 154: t12 = x^0x1308e80ad61b59434fdd262fa5f1780
 	for s := 0; s < 7; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 155: t12 = x^0x1308e80ad61b59434fdd262fa5f17ab
-	t12.Mul(t4, t12)
+	t12.Mul(t3, t12)
 
 	// Step 161: t12 = x^0x4c23a02b586d650d3f7498be97c5eac0
 	for s := 0; s < 6; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 162: t12 = x^0x4c23a02b586d650d3f7498be97c5eafd
-	t12.Mul(t7, t12)
+	t12.Mul(t6, t12)
 
 	// Step 168: t12 = x^0x1308e80ad61b59434fdd262fa5f17abf40
 	for s := 0; s < 6; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 169: t12 = x^0x1308e80ad61b59434fdd262fa5f17abf7b
-	t12.Mul(t5, t12)
+	t12.Mul(t4, t12)
 
 	// Step 179: t12 = x^0x4c23a02b586d650d3f7498be97c5eafdec00
 	for s := 0; s < 10; s++ {
-		t12.Square(t12)
+		t12.Mul(t12, t12)
 	}
 
 	// Step 180: t11 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d
@@ -804,7 +759,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 193: t11 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a000
 	for s := 0; s < 13; s++ {
-		t11.Square(t11)
+		t11.Mul(t11, t11)
 	}
 
 	// Step 194: t11 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035
@@ -812,7 +767,7 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 200: t11 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d40
 	for s := 0; s < 6; s++ {
-		t11.Square(t11)
+		t11.Mul(t11, t11)
 	}
 
 	// Step 201: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d51
@@ -820,15 +775,15 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 209: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5100
 	for s := 0; s < 8; s++ {
-		t10.Square(t10)
+		t10.Mul(t10, t10)
 	}
 
 	// Step 210: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d
-	t10.Mul(t7, t10)
+	t10.Mul(t6, t10)
 
 	// Step 220: t10 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f400
 	for s := 0; s < 10; s++ {
-		t10.Square(t10)
+		t10.Mul(t10, t10)
 	}
 
 	// Step 221: t9 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435
@@ -836,142 +791,1586 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	// Step 223: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d4
 	for s := 0; s < 2; s++ {
-		t9.Square(t9)
+		t9.Mul(t9, t9)
 	}
 
-	// Step 224: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7
-	t9.Mul(z, t9)
+	// Step 224: t8 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7
+	t8.Mul(t8, t9)
 
-	// Step 236: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7000
+	// Step 236: t8 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7000
 	for s := 0; s < 12; s++ {
-		t9.Square(t9)
+		t8.Mul(t8, t8)
 	}
 
-	// Step 237: t8 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7021
-	t8.Mul(t8, t9)
+	// Step 237: t7 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7021
+	t7.Mul(t7, t8)
 
-	// Step 247: t8 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c08400
+	// Step 247: t7 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c08400
 	for s := 0; s < 10; s++ {
-		t8.Square(t8)
+		t7.Mul(t7, t7)
 	}
 
-	// Step 248: t7 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843d
-	t7.Mul(t7, t8)
+	// Step 248: t6 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843d
+	t6.Mul(t6, t7)
 
-	// Step 253: t7 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087a0
+	// Step 253: t6 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087a0
 	for s := 0; s < 5; s++ {
-		t7.Square(t7)
+		t6.Mul(t6, t6)
 	}
 
-	// Step 254: t6 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b9
-	t6.Mul(t6, t7)
+	// Step 254: t5 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b9
+	t5.Mul(t5, t6)
 
-	// Step 261: t6 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dc80
+	// Step 261: t5 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dc80
 	for s := 0; s < 7; s++ {
-		t6.Square(t6)
+		t5.Mul(t5, t5)
 	}
 
-	// Step 262: t5 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb
-	t5.Mul(t5, t6)
+	// Step 262: t4 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb
+	t4.Mul(t4, t5)
 
-	// Step 269: t5 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5d80
+	// Step 269: t4 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5d80
 	for s := 0; s < 7; s++ {
-		t5.Square(t5)
+		t4.Mul(t4, t4)
 	}
 
-	// Step 270: t5 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da5
-	t5.Mul(t2, t5)
+	// Step 270: t4 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da5
+	t4.Mul(t1, t4)
 
-	// Step 278: t5 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da500
+	// Step 278: t4 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da500
 	for s := 0; s < 8; s++ {
-		t5.Square(t5)
+		t4.Mul(t4, t4)
 	}
 
-	// Step 279: t4 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52b
-	t4.Mul(t4, t5)
+	// Step 279: t3 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52b
+	t3.Mul(t3, t4)
 
-	// Step 285: t4 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694ac0
+	// Step 285: t3 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694ac0
 	for s := 0; s < 6; s++ {
-		t4.Square(t4)
+		t3.Mul(t3, t3)
 	}
 
-	// Step 286: t3 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af7
-	t3.Mul(t3, t4)
+	// Step 286: t2 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af7
+	t2.Mul(t2, t3)
 
-	// Step 292: t3 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bdc0
+	// Step 292: t2 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bdc0
 	for s := 0; s < 6; s++ {
-		t3.Square(t3)
+		t2.Mul(t2, t2)
 	}
 
-	// Step 293: t2 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5
-	t2.Mul(t2, t3)
+	// Step 293: t1 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5
+	t1.Mul(t1, t2)
 
-	// Step 304: t2 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef2800
+	// Step 304: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef2800
 	for s := 0; s < 11; s++ {
-		t2.Square(t2)
+		t1.Mul(t1, t1)
 	}
 
-	// Step 305: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef2813
-	t1.Mul(t1, t2)
+	// Step 305: t0 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef2813
+	t0.Mul(t0, t1)
 
-	// Step 313: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef281300
+	// Step 313: t0 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef281300
 	for s := 0; s < 8; s++ {
-		t1.Square(t1)
+		t0.Mul(t0, t0)
 	}
 
-	// Step 314: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f
+	// Step 314: t0 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f
+	t0.Mul(z, t0)
+
+	// Step 316: t0 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb4a57bca04dfc
+	for s := 0; s < 2; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 317: t0 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb4a57bca04dfd
+	t0.Mul(x, t0)
+
+	// Step 327: t0 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f400
+	for s := 0; s < 10; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 328: t0 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f401
+	t0.Mul(x, t0)
+
+	// Step 336: t0 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f40100
+	for s := 0; s < 8; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 337: z = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017f
+	z.Mul(z, t0)
+
+	// Step 338: z = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802fe
+	z.Mul(z, z)
+
+	// Step 339: z = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff
+	z.Mul(x, z)
+
+	// Step 341: z = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af79409bfa00bfc
+	for s := 0; s < 2; s++ {
+		z.Mul(z, z)
+	}
+
+	// Step 342: z = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af79409bfa00bfd
+	z.Mul(x, z)
+
+	// Step 351: z = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa00
+	for s := 0; s < 9; s++ {
+		z.Mul(z, z)
+	}
+
+	// Step 352: z = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa01
+	z.Mul(x, z)
+
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa0180000)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_101      = _10 + _11
+	//	_110      = 1 + _101
+	//	_1011     = _101 + _110
+	//	_1101     = _10 + _1011
+	//	_10001    = _110 + _1011
+	//	_10011    = _10 + _10001
+	//	_11001    = _110 + _10011
+	//	_11011    = _10 + _11001
+	//	_11101    = _10 + _11011
+	//	_11111    = _10 + _11101
+	//	_100001   = _10 + _11111
+	//	_100011   = _10 + _100001
+	//	_100101   = _10 + _100011
+	//	_101001   = _110 + _100011
+	//	_101011   = _10 + _101001
+	//	_101111   = _110 + _101001
+	//	_110101   = _110 + _101111
+	//	_110111   = _10 + _110101
+	//	_111011   = _110 + _110101
+	//	_111101   = _10 + _111011
+	//	_111111   = _10 + _111101
+	//	_1111110  = 2*_111111
+	//	_1111111  = 1 + _1111110
+	//	_10011000 = _11001 + _1111111
+	//	i51       = ((_10011000 << 7 + _100011) << 3 + _101) << 13
+	//	i68       = ((_101011 + i51) << 5 + _1011) << 9 + _11011
+	//	i88       = ((i68 << 5 + _1011) << 5 + _101) << 8
+	//	i105      = ((_1101 + i88) << 8 + _111111) << 6 + _11101
+	//	i129      = ((i105 << 7 + _10011) << 9 + _101111) << 6
+	//	i147      = ((_101001 + i129) << 6 + _11111) << 9 + _101111
+	//	i168      = ((i147 << 7 + _101011) << 6 + _111101) << 6
+	//	i194      = ((_111011 + i168) << 10 + _11101) << 13 + _110101
+	//	i220      = ((i194 << 6 + _10001) << 8 + _111101) << 10
+	//	i237      = ((_110101 + i220) << 2 + _11) << 12 + _100001
+	//	i261      = ((i237 << 10 + _111101) << 5 + _11001) << 7
+	//	i279      = ((_111011 + i261) << 7 + _100101) << 8 + _101011
+	//	i304      = ((i279 << 6 + _110111) << 6 + _100101) << 11
+	//	i317      = ((_10011 + i304) << 8 + _1111111) << 2 + 1
+	//	i338      = 2*((i317 << 10 + 1) << 8 + _1111111)
+	//	i353      = ((1 + i338) << 2 + 1) << 10 + _11
+	//	return      i353 << 19
+	//
+	// Operations: 308 squares 64 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+		t21 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21 Element
+	// Step 1: t0 = x^0x2
+	t0.Square(&x)
+
+	// Step 2: z = x^0x3
+	z.Mul(&x, t0)
+
+	// Step 3: t17 = x^0x5
+	t17.Mul(t0, z)
+
+	// Step 4: t5 = x^0x6
+	t5.Mul(&x, t17)
+
+	// Step 5: t18 = x^0xb
+	t18.Mul(t17, t5)
+
+	// Step 6: t16 = x^0xd
+	t16.Mul(t0, t18)
+
+	// Step 7: t10 = x^0x11
+	t10.Mul(t5, t18)
+
+	// Step 8: t1 = x^0x13
+	t1.Mul(t0, t10)
+
+	// Step 9: t6 = x^0x19
+	t6.Mul(t5, t1)
+
+	// Step 10: t19 = x^0x1b
+	t19.Mul(t0, t6)
+
+	// Step 11: t11 = x^0x1d
+	t11.Mul(t0, t19)
+
+	// Step 12: t13 = x^0x1f
+	t13.Mul(t0, t11)
+
+	// Step 13: t8 = x^0x21
+	t8.Mul(t0, t13)
+
+	// Step 14: t20 = x^0x23
+	t20.Mul(t0, t8)
+
+	// Step 15: t2 = x^0x25
+	t2.Mul(t0, t20)
+
+	// Step 16: t14 = x^0x29
+	t14.Mul(t5, t20)
+
+	// Step 17: t4 = x^0x2b
+	t4.Mul(t0, t14)
+
+	// Step 18: t12 = x^0x2f
+	t12.Mul(t5, t14)
+
+	// Step 19: t9 = x^0x35
+	t9.Mul(t5, t12)
+
+	// Step 20: t3 = x^0x37
+	t3.Mul(t0, t9)
+
+	// Step 21: t5 = x^0x3b
+	t5.Mul(t5, t9)
+
+	// Step 22: t7 = x^0x3d
+	t7.Mul(t0, t5)
+
+	// Step 23: t15 = x^0x3f
+	t15.Mul(t0, t7)
+
+	// Step 24: t0 = x^0x7e
+	t0.Square(t15)
+
+	// Step 25: t0 = x^0x7f
+	t0.Mul(&x, t0)
+
+	// Step 26: t21 = x^0x98
+	t21.Mul(t6, t0)
+
+	// Step 33: t21 = x^0x4c00
+	for s := 0; s < 7; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 34: t20 = x^0x4c23
+	t20.Mul(t20, t21)
+
+	// Step 37: t20 = x^0x26118
+	for s := 0; s < 3; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 38: t20 = x^0x2611d
+	t20.Mul(t17, t20)
+
+	// Step 51: t20 = x^0x4c23a000
+	for s := 0; s < 13; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 52: t20 = x^0x4c23a02b
+	t20.Mul(t4, t20)
+
+	// Step 57: t20 = x^0x984740560
+	for s := 0; s < 5; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 58: t20 = x^0x98474056b
+	t20.Mul(t18, t20)
+
+	// Step 67: t20 = x^0x1308e80ad600
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 68: t19 = x^0x1308e80ad61b
+	t19.Mul(t19, t20)
+
+	// Step 73: t19 = x^0x2611d015ac360
+	for s := 0; s < 5; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 74: t18 = x^0x2611d015ac36b
+	t18.Mul(t18, t19)
+
+	// Step 79: t18 = x^0x4c23a02b586d60
+	for s := 0; s < 5; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 80: t17 = x^0x4c23a02b586d65
+	t17.Mul(t17, t18)
+
+	// Step 88: t17 = x^0x4c23a02b586d6500
+	for s := 0; s < 8; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 89: t16 = x^0x4c23a02b586d650d
+	t16.Mul(t16, t17)
+
+	// Step 97: t16 = x^0x4c23a02b586d650d00
+	for s := 0; s < 8; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 98: t15 = x^0x4c23a02b586d650d3f
+	t15.Mul(t15, t16)
+
+	// Step 104: t15 = x^0x1308e80ad61b59434fc0
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 105: t15 = x^0x1308e80ad61b59434fdd
+	t15.Mul(t11, t15)
+
+	// Step 112: t15 = x^0x98474056b0daca1a7ee80
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 113: t15 = x^0x98474056b0daca1a7ee93
+	t15.Mul(t1, t15)
+
+	// Step 122: t15 = x^0x1308e80ad61b59434fdd2600
+	for s := 0; s < 9; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 123: t15 = x^0x1308e80ad61b59434fdd262f
+	t15.Mul(t12, t15)
+
+	// Step 129: t15 = x^0x4c23a02b586d650d3f7498bc0
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 130: t14 = x^0x4c23a02b586d650d3f7498be9
+	t14.Mul(t14, t15)
+
+	// Step 136: t14 = x^0x1308e80ad61b59434fdd262fa40
+	for s := 0; s < 6; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 137: t13 = x^0x1308e80ad61b59434fdd262fa5f
+	t13.Mul(t13, t14)
+
+	// Step 146: t13 = x^0x2611d015ac36b2869fba4c5f4be00
+	for s := 0; s < 9; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 147: t12 = x^0x2611d015ac36b2869fba4c5f4be2f
+	t12.Mul(t12, t13)
+
+	// Step 154: t12 = x^0x1308e80ad61b59434fdd262fa5f1780
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 155: t12 = x^0x1308e80ad61b59434fdd262fa5f17ab
+	t12.Mul(t4, t12)
+
+	// Step 161: t12 = x^0x4c23a02b586d650d3f7498be97c5eac0
+	for s := 0; s < 6; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 162: t12 = x^0x4c23a02b586d650d3f7498be97c5eafd
+	t12.Mul(t7, t12)
+
+	// Step 168: t12 = x^0x1308e80ad61b59434fdd262fa5f17abf40
+	for s := 0; s < 6; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 169: t12 = x^0x1308e80ad61b59434fdd262fa5f17abf7b
+	t12.Mul(t5, t12)
+
+	// Step 179: t12 = x^0x4c23a02b586d650d3f7498be97c5eafdec00
+	for s := 0; s < 10; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 180: t11 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d
+	t11.Mul(t11, t12)
+
+	// Step 193: t11 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a000
+	for s := 0; s < 13; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 194: t11 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035
+	t11.Mul(t9, t11)
+
+	// Step 200: t11 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d40
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 201: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d51
+	t10.Mul(t10, t11)
+
+	// Step 209: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5100
+	for s := 0; s < 8; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 210: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d
+	t10.Mul(t7, t10)
+
+	// Step 220: t10 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f400
+	for s := 0; s < 10; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 221: t9 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435
+	t9.Mul(t9, t10)
+
+	// Step 223: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d4
+	for s := 0; s < 2; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 224: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7
+	t9.Mul(z, t9)
+
+	// Step 236: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7000
+	for s := 0; s < 12; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 237: t8 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7021
+	t8.Mul(t8, t9)
+
+	// Step 247: t8 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c08400
+	for s := 0; s < 10; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 248: t7 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843d
+	t7.Mul(t7, t8)
+
+	// Step 253: t7 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087a0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 254: t6 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b9
+	t6.Mul(t6, t7)
+
+	// Step 261: t6 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dc80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 262: t5 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb
+	t5.Mul(t5, t6)
+
+	// Step 269: t5 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5d80
+	for s := 0; s < 7; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 270: t5 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da5
+	t5.Mul(t2, t5)
+
+	// Step 278: t5 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da500
+	for s := 0; s < 8; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 279: t4 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52b
+	t4.Mul(t4, t5)
+
+	// Step 285: t4 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694ac0
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 286: t3 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af7
+	t3.Mul(t3, t4)
+
+	// Step 292: t3 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bdc0
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 293: t2 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5
+	t2.Mul(t2, t3)
+
+	// Step 304: t2 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef2800
+	for s := 0; s < 11; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 305: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef2813
+	t1.Mul(t1, t2)
+
+	// Step 313: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef281300
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 314: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f
+	t1.Mul(t0, t1)
+
+	// Step 316: t1 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb4a57bca04dfc
+	for s := 0; s < 2; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 317: t1 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb4a57bca04dfd
+	t1.Mul(&x, t1)
+
+	// Step 327: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f400
+	for s := 0; s < 10; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 328: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f401
+	t1.Mul(&x, t1)
+
+	// Step 336: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f40100
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 337: t0 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017f
+	t0.Mul(t0, t1)
+
+	// Step 338: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802fe
+	t0.Square(t0)
+
+	// Step 339: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff
+	t0.Mul(&x, t0)
+
+	// Step 341: t0 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af79409bfa00bfc
+	for s := 0; s < 2; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 342: t0 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af79409bfa00bfd
+	t0.Mul(&x, t0)
+
+	// Step 352: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff400
+	for s := 0; s < 10; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 353: z = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff403
+	z.Mul(z, t0)
+
+	// Step 372: z = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa0180000
+	for s := 0; s < 19; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
+
+// vectorExpByLegendreExpNbTemporaries is the number of temporary vectors used by vectorExpByLegendreExp
+const vectorExpByLegendreExpNbTemporaries = 22
+
+// vectorExpByLegendreExp sets z[i] = x[i]^2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa0180000 for all i, with the addition chain of expByLegendreExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpByLegendreExpNbTemporaries * len(x).
+func vectorExpByLegendreExp(z, x, scratch Vector) {
+	n := len(x)
+	var (
+		t0  = scratch[0*n : 0*n+n]
+		t1  = scratch[1*n : 1*n+n]
+		t2  = scratch[2*n : 2*n+n]
+		t3  = scratch[3*n : 3*n+n]
+		t4  = scratch[4*n : 4*n+n]
+		t5  = scratch[5*n : 5*n+n]
+		t6  = scratch[6*n : 6*n+n]
+		t7  = scratch[7*n : 7*n+n]
+		t8  = scratch[8*n : 8*n+n]
+		t9  = scratch[9*n : 9*n+n]
+		t10 = scratch[10*n : 10*n+n]
+		t11 = scratch[11*n : 11*n+n]
+		t12 = scratch[12*n : 12*n+n]
+		t13 = scratch[13*n : 13*n+n]
+		t14 = scratch[14*n : 14*n+n]
+		t15 = scratch[15*n : 15*n+n]
+		t16 = scratch[16*n : 16*n+n]
+		t17 = scratch[17*n : 17*n+n]
+		t18 = scratch[18*n : 18*n+n]
+		t19 = scratch[19*n : 19*n+n]
+		t20 = scratch[20*n : 20*n+n]
+		t21 = scratch[21*n : 21*n+n]
+	)
+
+	// Step 1: t0 = x^0x2
+	t0.Mul(x, x)
+
+	// Step 2: z = x^0x3
+	z.Mul(x, t0)
+
+	// Step 3: t17 = x^0x5
+	t17.Mul(t0, z)
+
+	// Step 4: t5 = x^0x6
+	t5.Mul(x, t17)
+
+	// Step 5: t18 = x^0xb
+	t18.Mul(t17, t5)
+
+	// Step 6: t16 = x^0xd
+	t16.Mul(t0, t18)
+
+	// Step 7: t10 = x^0x11
+	t10.Mul(t5, t18)
+
+	// Step 8: t1 = x^0x13
+	t1.Mul(t0, t10)
+
+	// Step 9: t6 = x^0x19
+	t6.Mul(t5, t1)
+
+	// Step 10: t19 = x^0x1b
+	t19.Mul(t0, t6)
+
+	// Step 11: t11 = x^0x1d
+	t11.Mul(t0, t19)
+
+	// Step 12: t13 = x^0x1f
+	t13.Mul(t0, t11)
+
+	// Step 13: t8 = x^0x21
+	t8.Mul(t0, t13)
+
+	// Step 14: t20 = x^0x23
+	t20.Mul(t0, t8)
+
+	// Step 15: t2 = x^0x25
+	t2.Mul(t0, t20)
+
+	// Step 16: t14 = x^0x29
+	t14.Mul(t5, t20)
+
+	// Step 17: t4 = x^0x2b
+	t4.Mul(t0, t14)
+
+	// Step 18: t12 = x^0x2f
+	t12.Mul(t5, t14)
+
+	// Step 19: t9 = x^0x35
+	t9.Mul(t5, t12)
+
+	// Step 20: t3 = x^0x37
+	t3.Mul(t0, t9)
+
+	// Step 21: t5 = x^0x3b
+	t5.Mul(t5, t9)
+
+	// Step 22: t7 = x^0x3d
+	t7.Mul(t0, t5)
+
+	// Step 23: t15 = x^0x3f
+	t15.Mul(t0, t7)
+
+	// Step 24: t0 = x^0x7e
+	t0.Mul(t15, t15)
+
+	// Step 25: t0 = x^0x7f
+	t0.Mul(x, t0)
+
+	// Step 26: t21 = x^0x98
+	t21.Mul(t6, t0)
+
+	// Step 33: t21 = x^0x4c00
+	for s := 0; s < 7; s++ {
+		t21.Mul(t21, t21)
+	}
+
+	// Step 34: t20 = x^0x4c23
+	t20.Mul(t20, t21)
+
+	// Step 37: t20 = x^0x26118
+	for s := 0; s < 3; s++ {
+		t20.Mul(t20, t20)
+	}
+
+	// Step 38: t20 = x^0x2611d
+	t20.Mul(t17, t20)
+
+	// Step 51: t20 = x^0x4c23a000
+	for s := 0; s < 13; s++ {
+		t20.Mul(t20, t20)
+	}
+
+	// Step 52: t20 = x^0x4c23a02b
+	t20.Mul(t4, t20)
+
+	// Step 57: t20 = x^0x984740560
+	for s := 0; s < 5; s++ {
+		t20.Mul(t20, t20)
+	}
+
+	// Step 58: t20 = x^0x98474056b
+	t20.Mul(t18, t20)
+
+	// Step 67: t20 = x^0x1308e80ad600
+	for s := 0; s < 9; s++ {
+		t20.Mul(t20, t20)
+	}
+
+	// Step 68: t19 = x^0x1308e80ad61b
+	t19.Mul(t19, t20)
+
+	// Step 73: t19 = x^0x2611d015ac360
+	for s := 0; s < 5; s++ {
+		t19.Mul(t19, t19)
+	}
+
+	// Step 74: t18 = x^0x2611d015ac36b
+	t18.Mul(t18, t19)
+
+	// Step 79: t18 = x^0x4c23a02b586d60
+	for s := 0; s < 5; s++ {
+		t18.Mul(t18, t18)
+	}
+
+	// Step 80: t17 = x^0x4c23a02b586d65
+	t17.Mul(t17, t18)
+
+	// Step 88: t17 = x^0x4c23a02b586d6500
+	for s := 0; s < 8; s++ {
+		t17.Mul(t17, t17)
+	}
+
+	// Step 89: t16 = x^0x4c23a02b586d650d
+	t16.Mul(t16, t17)
+
+	// Step 97: t16 = x^0x4c23a02b586d650d00
+	for s := 0; s < 8; s++ {
+		t16.Mul(t16, t16)
+	}
+
+	// Step 98: t15 = x^0x4c23a02b586d650d3f
+	t15.Mul(t15, t16)
+
+	// Step 104: t15 = x^0x1308e80ad61b59434fc0
+	for s := 0; s < 6; s++ {
+		t15.Mul(t15, t15)
+	}
+
+	// Step 105: t15 = x^0x1308e80ad61b59434fdd
+	t15.Mul(t11, t15)
+
+	// Step 112: t15 = x^0x98474056b0daca1a7ee80
+	for s := 0; s < 7; s++ {
+		t15.Mul(t15, t15)
+	}
+
+	// Step 113: t15 = x^0x98474056b0daca1a7ee93
+	t15.Mul(t1, t15)
+
+	// Step 122: t15 = x^0x1308e80ad61b59434fdd2600
+	for s := 0; s < 9; s++ {
+		t15.Mul(t15, t15)
+	}
+
+	// Step 123: t15 = x^0x1308e80ad61b59434fdd262f
+	t15.Mul(t12, t15)
+
+	// Step 129: t15 = x^0x4c23a02b586d650d3f7498bc0
+	for s := 0; s < 6; s++ {
+		t15.Mul(t15, t15)
+	}
+
+	// Step 130: t14 = x^0x4c23a02b586d650d3f7498be9
+	t14.Mul(t14, t15)
+
+	// Step 136: t14 = x^0x1308e80ad61b59434fdd262fa40
+	for s := 0; s < 6; s++ {
+		t14.Mul(t14, t14)
+	}
+
+	// Step 137: t13 = x^0x1308e80ad61b59434fdd262fa5f
+	t13.Mul(t13, t14)
+
+	// Step 146: t13 = x^0x2611d015ac36b2869fba4c5f4be00
+	for s := 0; s < 9; s++ {
+		t13.Mul(t13, t13)
+	}
+
+	// Step 147: t12 = x^0x2611d015ac36b2869fba4c5f4be2f
+	t12.Mul(t12, t13)
+
+	// Step 154: t12 = x^0x1308e80ad61b59434fdd262fa5f1780
+	for s := 0; s < 7; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 155: t12 = x^0x1308e80ad61b59434fdd262fa5f17ab
+	t12.Mul(t4, t12)
+
+	// Step 161: t12 = x^0x4c23a02b586d650d3f7498be97c5eac0
+	for s := 0; s < 6; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 162: t12 = x^0x4c23a02b586d650d3f7498be97c5eafd
+	t12.Mul(t7, t12)
+
+	// Step 168: t12 = x^0x1308e80ad61b59434fdd262fa5f17abf40
+	for s := 0; s < 6; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 169: t12 = x^0x1308e80ad61b59434fdd262fa5f17abf7b
+	t12.Mul(t5, t12)
+
+	// Step 179: t12 = x^0x4c23a02b586d650d3f7498be97c5eafdec00
+	for s := 0; s < 10; s++ {
+		t12.Mul(t12, t12)
+	}
+
+	// Step 180: t11 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d
+	t11.Mul(t11, t12)
+
+	// Step 193: t11 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a000
+	for s := 0; s < 13; s++ {
+		t11.Mul(t11, t11)
+	}
+
+	// Step 194: t11 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035
+	t11.Mul(t9, t11)
+
+	// Step 200: t11 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d40
+	for s := 0; s < 6; s++ {
+		t11.Mul(t11, t11)
+	}
+
+	// Step 201: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d51
+	t10.Mul(t10, t11)
+
+	// Step 209: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5100
+	for s := 0; s < 8; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 210: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d
+	t10.Mul(t7, t10)
+
+	// Step 220: t10 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f400
+	for s := 0; s < 10; s++ {
+		t10.Mul(t10, t10)
+	}
+
+	// Step 221: t9 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435
+	t9.Mul(t9, t10)
+
+	// Step 223: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d4
+	for s := 0; s < 2; s++ {
+		t9.Mul(t9, t9)
+	}
+
+	// Step 224: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7
+	t9.Mul(z, t9)
+
+	// Step 236: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7000
+	for s := 0; s < 12; s++ {
+		t9.Mul(t9, t9)
+	}
+
+	// Step 237: t8 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7021
+	t8.Mul(t8, t9)
+
+	// Step 247: t8 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c08400
+	for s := 0; s < 10; s++ {
+		t8.Mul(t8, t8)
+	}
+
+	// Step 248: t7 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843d
+	t7.Mul(t7, t8)
+
+	// Step 253: t7 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087a0
+	for s := 0; s < 5; s++ {
+		t7.Mul(t7, t7)
+	}
+
+	// Step 254: t6 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b9
+	t6.Mul(t6, t7)
+
+	// Step 261: t6 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dc80
+	for s := 0; s < 7; s++ {
+		t6.Mul(t6, t6)
+	}
+
+	// Step 262: t5 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb
+	t5.Mul(t5, t6)
+
+	// Step 269: t5 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5d80
+	for s := 0; s < 7; s++ {
+		t5.Mul(t5, t5)
+	}
+
+	// Step 270: t5 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da5
+	t5.Mul(t2, t5)
+
+	// Step 278: t5 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da500
+	for s := 0; s < 8; s++ {
+		t5.Mul(t5, t5)
+	}
+
+	// Step 279: t4 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52b
+	t4.Mul(t4, t5)
+
+	// Step 285: t4 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694ac0
+	for s := 0; s < 6; s++ {
+		t4.Mul(t4, t4)
+	}
+
+	// Step 286: t3 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af7
+	t3.Mul(t3, t4)
+
+	// Step 292: t3 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bdc0
+	for s := 0; s < 6; s++ {
+		t3.Mul(t3, t3)
+	}
+
+	// Step 293: t2 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5
+	t2.Mul(t2, t3)
+
+	// Step 304: t2 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef2800
+	for s := 0; s < 11; s++ {
+		t2.Mul(t2, t2)
+	}
+
+	// Step 305: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef2813
+	t1.Mul(t1, t2)
+
+	// Step 313: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef281300
+	for s := 0; s < 8; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 314: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f
 	t1.Mul(t0, t1)
 
-	// Step 316: t1 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb4a57bca04dfc
+	// Step 316: t1 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb4a57bca04dfc
+	for s := 0; s < 2; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 317: t1 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb4a57bca04dfd
+	t1.Mul(x, t1)
+
+	// Step 327: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f400
+	for s := 0; s < 10; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 328: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f401
+	t1.Mul(x, t1)
+
+	// Step 336: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f40100
+	for s := 0; s < 8; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 337: t0 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017f
+	t0.Mul(t0, t1)
+
+	// Step 338: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802fe
+	t0.Mul(t0, t0)
+
+	// Step 339: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff
+	t0.Mul(x, t0)
+
+	// Step 341: t0 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af79409bfa00bfc
+	for s := 0; s < 2; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 342: t0 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af79409bfa00bfd
+	t0.Mul(x, t0)
+
+	// Step 352: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff400
+	for s := 0; s < 10; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 353: z = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff403
+	z.Mul(z, t0)
+
+	// Step 372: z = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa0180000
+	for s := 0; s < 19; s++ {
+		z.Mul(z, z)
+	}
+
+}
+
+// expByInverseExp is equivalent to z.Exp(x, 4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff402fffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_100      = 1 + _11
+	//	_101      = 1 + _100
+	//	_111      = _10 + _101
+	//	_1011     = _100 + _111
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_10001    = _10 + _1111
+	//	_10011    = _10 + _10001
+	//	_10101    = _10 + _10011
+	//	_10111    = _10 + _10101
+	//	_11011    = _100 + _10111
+	//	_11101    = _10 + _11011
+	//	_111010   = 2*_11101
+	//	_111111   = _101 + _111010
+	//	_1001100  = _1101 + _111111
+	//	_1111110  = 2*_111111
+	//	_1111111  = 1 + _1111110
+	//	_10011000 = 2*_1001100
+	//	_11111110 = 2*_1111111
+	//	_11111111 = 1 + _11111110
+	//	i46       = ((_10011000 << 6 + _10001) << 4 + _1101) << 12
+	//	i55       = 2*((_10101 + i46) << 5 + _10101) + 1
+	//	i76       = ((i55 << 9 + _11011) << 5 + _1011) << 5
+	//	i95       = ((_101 + i76) << 8 + _1101) << 8 + _111111
+	//	i118      = ((i95 << 6 + _11101) << 7 + _10011) << 8
+	//	i132      = ((_10111 + i118) << 4 + _1101) << 7 + _10111
+	//	i149      = ((i132 << 2 + _11) << 8 + _10111) << 5
+	//	i164      = ((_10101 + i149) << 7 + _111111) << 5 + _1111
+	//	i190      = ((i164 << 3 + _11) << 10 + _11101) << 11
+	//	i205      = ((_1101 + i190) << 4 + _101) << 8 + _10011
+	//	i223      = ((i205 << 4 + _1101) << 8 + _1101) << 4
+	//	i238      = ((_111 + i223) << 7 + 1) << 5 + 1
+	//	i259      = ((i238 << 8 + _1111) << 4 + _111) << 7
+	//	i272      = ((_10111 + i259) << 5 + _1101) << 5 + _101
+	//	i289      = ((i272 << 7 + _10101) << 5 + _11101) << 3
+	//	i308      = ((_111 + i289) << 5 + _101) << 11 + _10011
+	//	i330      = ((i308 << 8 + _1111111) << 2 + 1) << 10
+	//	i344      = ((1 + i330) << 9 + _11111111) << 2 + 1
+	//	i372      = ((i344 << 9 + 1) << 9 + _11111111) << 8
+	//	return      (_11111111 + i372) << 4 + _1111
+	//
+	// Operations: 312 squares 66 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14 Element
+	// Step 1: t0 = x^0x2
+	t0.Square(&x)
+
+	// Step 2: t9 = x^0x3
+	t9.Mul(&x, t0)
+
+	// Step 3: t1 = x^0x4
+	t1.Mul(&x, t9)
+
+	// Step 4: t3 = x^0x5
+	t3.Mul(&x, t1)
+
+	// Step 5: t4 = x^0x7
+	t4.Mul(t0, t3)
+
+	// Step 6: t11 = x^0xb
+	t11.Mul(t1, t4)
+
+	// Step 7: t7 = x^0xd
+	t7.Mul(t0, t11)
+
+	// Step 8: z = x^0xf
+	z.Mul(t0, t7)
+
+	// Step 9: t13 = x^0x11
+	t13.Mul(t0, z)
+
+	// Step 10: t2 = x^0x13
+	t2.Mul(t0, t13)
+
+	// Step 11: t6 = x^0x15
+	t6.Mul(t0, t2)
+
+	// Step 12: t8 = x^0x17
+	t8.Mul(t0, t6)
+
+	// Step 13: t12 = x^0x1b
+	t12.Mul(t1, t8)
+
+	// Step 14: t5 = x^0x1d
+	t5.Mul(t0, t12)
+
+	// Step 15: t0 = x^0x3a
+	t0.Square(t5)
+
+	// Step 16: t10 = x^0x3f
+	t10.Mul(t3, t0)
+
+	// Step 17: t0 = x^0x4c
+	t0.Mul(t7, t10)
+
+	// Step 18: t1 = x^0x7e
+	t1.Square(t10)
+
+	// Step 19: t1 = x^0x7f
+	t1.Mul(&x, t1)
+
+	// Step 20: t14 = x^0x98
+	t14.Square(t0)
+
+	// Step 21: t0 = x^0xfe
+	t0.Square(t1)
+
+	// Step 22: t0 = x^0xff
+	t0.Mul(&x, t0)
+
+	// Step 28: t14 = x^0x2600
+	for s := 0; s < 6; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 29: t13 = x^0x2611
+	t13.Mul(t13, t14)
+
+	// Step 33: t13 = x^0x26110
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 34: t13 = x^0x2611d
+	t13.Mul(t7, t13)
+
+	// Step 46: t13 = x^0x2611d000
+	for s := 0; s < 12; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 47: t13 = x^0x2611d015
+	t13.Mul(t6, t13)
+
+	// Step 52: t13 = x^0x4c23a02a0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 53: t13 = x^0x4c23a02b5
+	t13.Mul(t6, t13)
+
+	// Step 54: t13 = x^0x98474056a
+	t13.Square(t13)
+
+	// Step 55: t13 = x^0x98474056b
+	t13.Mul(&x, t13)
+
+	// Step 64: t13 = x^0x1308e80ad600
+	for s := 0; s < 9; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 65: t12 = x^0x1308e80ad61b
+	t12.Mul(t12, t13)
+
+	// Step 70: t12 = x^0x2611d015ac360
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 71: t11 = x^0x2611d015ac36b
+	t11.Mul(t11, t12)
+
+	// Step 76: t11 = x^0x4c23a02b586d60
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 77: t11 = x^0x4c23a02b586d65
+	t11.Mul(t3, t11)
+
+	// Step 85: t11 = x^0x4c23a02b586d6500
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 86: t11 = x^0x4c23a02b586d650d
+	t11.Mul(t7, t11)
+
+	// Step 94: t11 = x^0x4c23a02b586d650d00
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 95: t11 = x^0x4c23a02b586d650d3f
+	t11.Mul(t10, t11)
+
+	// Step 101: t11 = x^0x1308e80ad61b59434fc0
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 102: t11 = x^0x1308e80ad61b59434fdd
+	t11.Mul(t5, t11)
+
+	// Step 109: t11 = x^0x98474056b0daca1a7ee80
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 110: t11 = x^0x98474056b0daca1a7ee93
+	t11.Mul(t2, t11)
+
+	// Step 118: t11 = x^0x98474056b0daca1a7ee9300
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 119: t11 = x^0x98474056b0daca1a7ee9317
+	t11.Mul(t8, t11)
+
+	// Step 123: t11 = x^0x98474056b0daca1a7ee93170
+	for s := 0; s < 4; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 124: t11 = x^0x98474056b0daca1a7ee9317d
+	t11.Mul(t7, t11)
+
+	// Step 131: t11 = x^0x4c23a02b586d650d3f7498be80
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 132: t11 = x^0x4c23a02b586d650d3f7498be97
+	t11.Mul(t8, t11)
+
+	// Step 134: t11 = x^0x1308e80ad61b59434fdd262fa5c
+	for s := 0; s < 2; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 135: t11 = x^0x1308e80ad61b59434fdd262fa5f
+	t11.Mul(t9, t11)
+
+	// Step 143: t11 = x^0x1308e80ad61b59434fdd262fa5f00
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 144: t11 = x^0x1308e80ad61b59434fdd262fa5f17
+	t11.Mul(t8, t11)
+
+	// Step 149: t11 = x^0x2611d015ac36b2869fba4c5f4be2e0
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 150: t11 = x^0x2611d015ac36b2869fba4c5f4be2f5
+	t11.Mul(t6, t11)
+
+	// Step 157: t11 = x^0x1308e80ad61b59434fdd262fa5f17a80
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 158: t10 = x^0x1308e80ad61b59434fdd262fa5f17abf
+	t10.Mul(t10, t11)
+
+	// Step 163: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57e0
+	for s := 0; s < 5; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 164: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef
+	t10.Mul(z, t10)
+
+	// Step 167: t10 = x^0x1308e80ad61b59434fdd262fa5f17abf78
+	for s := 0; s < 3; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 168: t9 = x^0x1308e80ad61b59434fdd262fa5f17abf7b
+	t9.Mul(t9, t10)
+
+	// Step 178: t9 = x^0x4c23a02b586d650d3f7498be97c5eafdec00
+	for s := 0; s < 10; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 179: t9 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d
+	t9.Mul(t5, t9)
+
+	// Step 190: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 191: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d
+	t9.Mul(t7, t9)
+
+	// Step 195: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d0
+	for s := 0; s < 4; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 196: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5
+	t9.Mul(t3, t9)
+
+	// Step 204: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d500
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 205: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513
+	t9.Mul(t2, t9)
+
+	// Step 209: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5130
+	for s := 0; s < 4; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 210: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d
+	t9.Mul(t7, t9)
+
+	// Step 218: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d00
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 219: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d
+	t9.Mul(t7, t9)
+
+	// Step 223: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d0
+	for s := 0; s < 4; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 224: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7
+	t9.Mul(t4, t9)
+
+	// Step 231: t9 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b80
+	for s := 0; s < 7; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 232: t9 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81
+	t9.Mul(&x, t9)
+
+	// Step 237: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7020
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 238: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7021
+	t9.Mul(&x, t9)
+
+	// Step 246: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d702100
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 247: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f
+	t9.Mul(z, t9)
+
+	// Step 251: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f0
+	for s := 0; s < 4; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 252: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f7
+	t9.Mul(t4, t9)
+
+	// Step 259: t9 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b80
+	for s := 0; s < 7; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 260: t8 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97
+	t8.Mul(t8, t9)
+
+	// Step 265: t8 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72e0
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 266: t7 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed
+	t7.Mul(t7, t8)
+
+	// Step 271: t7 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 272: t7 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da5
+	t7.Mul(t3, t7)
+
+	// Step 279: t7 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed280
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 280: t6 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295
+	t6.Mul(t6, t7)
+
+	// Step 285: t6 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52a0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 286: t5 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bd
+	t5.Mul(t5, t6)
+
+	// Step 289: t5 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295e8
+	for s := 0; s < 3; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 290: t4 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef
+	t4.Mul(t4, t5)
+
+	// Step 295: t4 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde0
+	for s := 0; s < 5; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 296: t3 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5
+	t3.Mul(t3, t4)
+
+	// Step 307: t3 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef2800
+	for s := 0; s < 11; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 308: t2 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef2813
+	t2.Mul(t2, t3)
+
+	// Step 316: t2 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef281300
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 317: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f
+	t1.Mul(t1, t2)
+
+	// Step 319: t1 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb4a57bca04dfc
 	for s := 0; s < 2; s++ {
 		t1.Square(t1)
 	}
 
-	// Step 317: t1 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb4a57bca04dfd
+	// Step 320: t1 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb4a57bca04dfd
 	t1.Mul(&x, t1)
 
-	// Step 327: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f400
+	// Step 330: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f400
 	for s := 0; s < 10; s++ {
 		t1.Square(t1)
 	}
 
-	// Step 328: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f401
+	// Step 331: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f401
 	t1.Mul(&x, t1)
 
-	// Step 336: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f40100
-	for s := 0; s < 8; s++ {
+	// Step 340: t1 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe80200
+	for s := 0; s < 9; s++ {
 		t1.Square(t1)
 	}
 
-	// Step 337: t0 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017f
-	t0.Mul(t0, t1)
+	// Step 341: t1 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff
+	t1.Mul(t0, t1)
 
-	// Step 338: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802fe
-	t0.Square(t0)
+	// Step 343: t1 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af79409bfa00bfc
+	for s := 0; s < 2; s++ {
+		t1.Square(t1)
+	}
 
-	// Step 339: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff
-	t0.Mul(&x, t0)
+	// Step 344: t1 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af79409bfa00bfd
+	t1.Mul(&x, t1)
 
-	// Step 341: t0 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af79409bfa00bfc
-	for s := 0; s < 2; s++ {
-		t0.Square(t0)
+	// Step 353: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
 	}
 
-	// Step 342: t0 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af79409bfa00bfd
-	t0.Mul(&x, t0)
+	// Step 354: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa01
+	t1.Mul(&x, t1)
 
-	// Step 352: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff400
-	for s := 0; s < 10; s++ {
-		t0.Square(t0)
+	// Step 363: t1 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff40200
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
 	}
 
-	// Step 353: z = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff403
-	z.Mul(z, t0)
+	// Step 364: t1 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff402ff
+	t1.Mul(t0, t1)
 
-	// Step 372: z = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa0180000
-	for s := 0; s < 19; s++ {
-		z.Square(z)
+	// Step 372: t1 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff402ff00
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 373: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff402ffff
+	t0.Mul(t0, t1)
+
+	// Step 377: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff402ffff0
+	for s := 0; s < 4; s++ {
+		t0.Square(t0)
 	}
 
+	// Step 378: z = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff402fffff
+	z.Mul(z, t0)
+
 	return z
 }
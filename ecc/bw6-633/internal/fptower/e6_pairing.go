@@ -56,6 +56,28 @@ func (z *E6) MulBy034(c0, c3, c4 *fp.Element) *E6 {
 	return z
 }
 
+// MulBy014 multiplication by sparse element (c0,c1,0,0,c4,0)
+func (z *E6) MulBy014(c0, c1, c4 *fp.Element) *E6 {
+
+	var a, b, d E3
+	var c14 fp.Element
+
+	a.Set(&z.B0)
+	a.MulBy01(c0, c1)
+
+	b.Set(&z.B1)
+	b.MulBy1(c4)
+
+	c14.Add(c1, c4)
+	d.Add(&z.B0, &z.B1)
+	d.MulBy01(c0, &c14)
+
+	z.B1.Sub(&d, &a).Sub(&z.B1, &b)
+	z.B0.MulByNonResidue(&b).Add(&z.B0, &a)
+
+	return z
+}
+
 // Mul034By034 multiplication of sparse element (c0,0,0,c3,c4,0) by sparse element (d0,0,0,d3,d4,0)
 func Mul034By034(d0, d3, d4, c0, c3, c4 *fp.Element) [5]fp.Element {
 	var z00, tmp, x0, x3, x4, x04, x03, x34 fp.Element
@@ -31,13 +31,16 @@ type batchOpG1Affine struct {
 //
 // this is derived from a PR by 0x0ece : https://github.com/ConsenSys/gnark-crypto/pull/249
 // See Section 5.3: ia.cr/2022/1396
+//
+// If chSum is not nil, the plain sum of the buckets is sent to it after the weighted sum is sent to chRes.
 func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP pG1Affine, TPP ppG1Affine, TQ qOpsG1Affine, TC cG1Affine](
 	chunk uint64,
 	chRes chan<- g1JacExtended,
 	c uint64,
 	points []G1Affine,
 	digits []uint16,
-	sem chan struct{}) {
+	sem chan struct{},
+	chSum chan<- g1JacExtended) {
 
 	if sem != nil {
 		// if we are limited, wait for a token in the semaphore
@@ -62,7 +65,7 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 	var buckets B
 	var bucketsJE BJE
 	for i := 0; i < len(buckets); i++ {
-		buckets[i].setInfinity()
+		buckets[i].SetInfinity()
 		bucketsJE[i].setInfinity()
 	}
 
@@ -106,7 +109,7 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 				bucketsJE[op.bucketID].addMixed(&op.point)
 				return
 			}
-			BK.setInfinity()
+			BK.SetInfinity()
 			return
 		}
 
@@ -134,12 +137,12 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 				if isAdd {
 					bucketsJE[bucketID].addMixed(PP)
 				} else {
-					BK.setInfinity()
+					BK.SetInfinity()
 				}
 				return
 			}
 			if isAdd {
-				BK.setInfinity()
+				BK.SetInfinity()
 			} else {
 				bucketsJE[bucketID].subMixed(PP)
 			}
@@ -238,6 +241,10 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 	}
 
 	chRes <- total
+	if chSum != nil {
+		// runningSum = bucket[0] + bucket[1] + ... + bucket[n-1]
+		chSum <- runningSum
+	}
 
 }
 
@@ -299,13 +306,16 @@ type batchOpG2Affine struct {
 //
 // this is derived from a PR by 0x0ece : https://github.com/ConsenSys/gnark-crypto/pull/249
 // See Section 5.3: ia.cr/2022/1396
+//
+// If chSum is not nil, the plain sum of the buckets is sent to it after the weighted sum is sent to chRes.
 func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP pG2Affine, TPP ppG2Affine, TQ qOpsG2Affine, TC cG2Affine](
 	chunk uint64,
 	chRes chan<- g2JacExtended,
 	c uint64,
 	points []G2Affine,
 	digits []uint16,
-	sem chan struct{}) {
+	sem chan struct{},
+	chSum chan<- g2JacExtended) {
 
 	if sem != nil {
 		// if we are limited, wait for a token in the semaphore
@@ -330,7 +340,7 @@ func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP
 	var buckets B
 	var bucketsJE BJE
 	for i := 0; i < len(buckets); i++ {
-		buckets[i].setInfinity()
+		buckets[i].SetInfinity()
 		bucketsJE[i].setInfinity()
 	}
 
@@ -374,7 +384,7 @@ func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP
 				bucketsJE[op.bucketID].addMixed(&op.point)
 				return
 			}
-			BK.setInfinity()
+			BK.SetInfinity()
 			return
 		}
 
@@ -402,12 +412,12 @@ func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP
 				if isAdd {
 					bucketsJE[bucketID].addMixed(PP)
 				} else {
-					BK.setInfinity()
+					BK.SetInfinity()
 				}
 				return
 			}
 			if isAdd {
-				BK.setInfinity()
+				BK.SetInfinity()
 			} else {
 				bucketsJE[bucketID].subMixed(PP)
 			}
@@ -506,6 +516,10 @@ func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP
 	}
 
 	chRes <- total
+	if chSum != nil {
+		// runningSum = bucket[0] + bucket[1] + ... + bucket[n-1]
+		chSum <- runningSum
+	}
 
 }
 
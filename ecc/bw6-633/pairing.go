@@ -533,3 +533,281 @@ func (p *g1Proj) lineCompute(evaluations *lineEvaluation, a *G1Affine) {
 	evaluations.r1.Neg(&O)
 	evaluations.r2.Set(&J)
 }
+
+// PrecomputedLines holds the lines of the Miller loop of a fixed G2 point, see PrecomputeLines.
+//
+// MillerLoop iterates on the G1 points, so its lines depend on both arguments. The lines of the
+// optimal ate Miller loop, which iterates on the G2 points, only depend on the G2 point; when it is
+// reused in many pairings (typically, in a verifying key), they can be computed once and evaluated
+// at each G1 point with MillerLoopFixedQ. The zero value is not usable.
+type PrecomputedLines struct {
+	isInfinity bool
+	// lines[j] holds the lines multiplied into the Miller loop accumulator after its j-th squaring
+	// (lines[0] before the first one)
+	lines [][]lineEvaluation
+}
+
+// PrecomputeLines computes the lines of the optimal ate Miller loop of Q, to be used in MillerLoopFixedQ.
+//
+// This function doesn't check that Q is in the correct subgroup. See IsInSubGroup.
+func PrecomputeLines(Q G2Affine) PrecomputedLines {
+	if Q.IsInfinity() {
+		return PrecomputedLines{isInfinity: true}
+	}
+
+	// f_{a0+p*a1,Q}(P), with the same 2-NAF digits as MillerLoop, where [p]Q = π(Q) is
+	// (ωx, -y) on the twist. As in MillerLoop, the digits are combined as
+	// j = 3*loopCounter1[i] + loopCounter0[i] and the point added is ±Q0, ±Q1 or ±(Q0±Q1),
+	// together with the line through its two summands.
+	var q0, q1, q01, q10 G2Affine
+	q0.Set(&Q)
+	q1.X.Mul(&Q.X, &thirdRootOneG1)
+	q1.Y.Neg(&Q.Y)
+	var tmp G2Jac
+	tmp.FromAffine(&q0)
+	tmp.AddMixed(&q1)
+	q01.FromJacobian(&tmp)
+	var q1Neg G2Affine
+	q1Neg.Neg(&q1)
+	tmp.FromAffine(&q0)
+	tmp.AddMixed(&q1Neg)
+	q10.FromJacobian(&tmp)
+
+	// l01 is the line through Q0 and Q1, l10 the line through Q0 and -Q1; the lines through the
+	// opposite points are their reflections, with r0 negated.
+	var proj g2Proj
+	var l01, l10 lineEvaluation
+	proj.FromAffine(&q1)
+	proj.lineCompute(&l01, &q0)
+	proj.FromAffine(&q1Neg)
+	proj.lineCompute(&l10, &q0)
+	neg := func(l lineEvaluation) lineEvaluation {
+		l.r0.Neg(&l.r0)
+		return l
+	}
+
+	addends := map[int8]struct {
+		point G2Affine
+		lines []lineEvaluation
+	}{
+		1:  {q0, nil},
+		3:  {q1, nil},
+		4:  {q01, []lineEvaluation{l01}},
+		-2: {q10, []lineEvaluation{l10}},
+	}
+	for _, j := range []int8{1, 3, 4, -2} {
+		a := addends[j]
+		var l []lineEvaluation
+		for _, li := range a.lines {
+			l = append(l, neg(li))
+		}
+		var pNeg G2Affine
+		pNeg.Neg(&a.point)
+		addends[-j] = struct {
+			point G2Affine
+			lines []lineEvaluation
+		}{pNeg, l}
+	}
+
+	// the accumulator starts at Q1 (loopCounter1 has the leading digit)
+	var qProj g2Proj
+	qProj.FromAffine(&q1)
+	n := len(loopCounter0) - 1
+	lines := make([][]lineEvaluation, n)
+	var l0, l lineEvaluation
+	for i := n - 1; i >= 0; i-- {
+		k := n - 1 - i
+		qProj.doubleStep(&l0)
+		lines[k] = []lineEvaluation{l0}
+		j := loopCounter1[i]*3 + loopCounter0[i]
+		if j == 0 {
+			continue
+		}
+		a := addends[j]
+		if i == 0 {
+			// the last addition sums to infinity, we only need its line
+			qProj.lineCompute(&l, &a.point)
+		} else {
+			qProj.addMixedStep(&l, &a.point)
+		}
+		lines[k] = append(lines[k], l)
+		lines[k] = append(lines[k], a.lines...)
+	}
+
+	return PrecomputedLines{lines: lines}
+}
+
+// MillerLoopFixedQ computes the optimal ate multi-Miller loop ∏ᵢ fᵢ_{x₀+1+p(x₀³-x₀²-x₀),Qᵢ}(Pᵢ),
+// where the lines of the Qᵢ are precomputed with PrecomputeLines.
+//
+// After the final exponentiation, the result is e(Pᵢ, Qᵢ)ᵐ, where e is the pairing computed by MillerLoop
+// and m ≠ 1 is an integer coprime to r, the same for all inputs: it is not the value of MillerLoop.
+// In a pairing-product check ∏ᵢ e(Pᵢ, Qᵢ) == 1, every term must come from MillerLoopFixedQ, or every
+// term from MillerLoop; multiplying a term of MillerLoopFixedQ by a term of MillerLoop doesn't give
+// the pairing product. It can't replace MillerLoop in Pair.
+//
+// It returns an error if the inputs sizes don't match or if some lines are not precomputed.
+func MillerLoopFixedQ(P []G1Affine, lines []PrecomputedLines) (GT, error) {
+	n := len(P)
+	if n == 0 || n != len(lines) {
+		return GT{}, errors.New("invalid inputs sizes")
+	}
+
+	// filter infinity points
+	p := make([]G1Affine, 0, n)
+	l := make([][][]lineEvaluation, 0, n)
+
+	for k := 0; k < n; k++ {
+		if !lines[k].isInfinity && len(lines[k].lines) != len(loopCounter0)-1 {
+			return GT{}, errors.New("lines not precomputed")
+		}
+		if P[k].IsInfinity() || lines[k].isInfinity {
+			continue
+		}
+		p = append(p, P[k])
+		l = append(l, lines[k].lines)
+	}
+	n = len(p)
+
+	var result GT
+	result.SetOne()
+	var c1, c4 fp.Element
+
+	for j := 0; j < len(loopCounter0)-1; j++ {
+		if j != 0 {
+			// mutualize the square among n Miller loops
+			// (∏ᵢfᵢ)²
+			result.Square(&result)
+		}
+
+		for k := 0; k < n; k++ {
+			for _, line := range l[k][j] {
+				// line evaluation at P[k] (untwisted to (x·u, y·uv))
+				c1.Mul(&line.r1, &p[k].X)
+				c4.Mul(&line.r0, &p[k].Y)
+				// ℓ × res
+				result.MulBy014(&line.r2, &c1, &c4)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// g2Proj point in projective coordinates on the twist, used to compute the lines of the
+// optimal ate Miller loop.
+type g2Proj struct {
+	x, y, z fp.Element
+}
+
+// FromAffine sets p = Q, p in homogenous projective, Q in affine
+func (p *g2Proj) FromAffine(Q *G2Affine) *g2Proj {
+	if Q.X.IsZero() && Q.Y.IsZero() {
+		p.z.SetZero()
+		p.x.SetOne()
+		p.y.SetOne()
+		return p
+	}
+	p.z.SetOne()
+	p.x.Set(&Q.X)
+	p.y.Set(&Q.Y)
+	return p
+}
+
+// doubleStep doubles a point in Homogenous projective coordinates, and evaluates the line in Miller loop
+// https://eprint.iacr.org/2013/722.pdf (Section 4.3)
+func (p *g2Proj) doubleStep(evaluations *lineEvaluation) {
+
+	// get some Element from our pool
+	var t1, A, B, C, D, E, EE, F, G, H, I, J, K fp.Element
+	A.Mul(&p.x, &p.y)
+	A.Halve()
+	B.Square(&p.y)
+	C.Square(&p.z)
+	D.Double(&C).
+		Add(&D, &C)
+	E.Mul(&D, &bTwistCurveCoeff)
+	F.Double(&E).
+		Add(&F, &E)
+	G.Add(&B, &F)
+	G.Halve()
+	H.Add(&p.y, &p.z).
+		Square(&H)
+	t1.Add(&B, &C)
+	H.Sub(&H, &t1)
+	I.Sub(&E, &B)
+	J.Square(&p.x)
+	EE.Square(&E)
+	K.Double(&EE).
+		Add(&K, &EE)
+
+	// X, Y, Z
+	p.x.Sub(&B, &F).
+		Mul(&p.x, &A)
+	p.y.Square(&G).
+		Sub(&p.y, &K)
+	p.z.Mul(&B, &H)
+
+	// Line evaluation
+	evaluations.r0.Neg(&H)
+	evaluations.r1.Double(&J).
+		Add(&evaluations.r1, &J)
+	evaluations.r2.Set(&I)
+}
+
+// addMixedStep point addition in Mixed Homogenous projective and Affine coordinates
+// https://eprint.iacr.org/2013/722.pdf (Section 4.3)
+func (p *g2Proj) addMixedStep(evaluations *lineEvaluation, a *G2Affine) {
+
+	// get some Element from our pool
+	var Y2Z1, X2Z1, O, L, C, D, E, F, G, H, t0, t1, t2, J fp.Element
+	Y2Z1.Mul(&a.Y, &p.z)
+	O.Sub(&p.y, &Y2Z1)
+	X2Z1.Mul(&a.X, &p.z)
+	L.Sub(&p.x, &X2Z1)
+	C.Square(&O)
+	D.Square(&L)
+	E.Mul(&L, &D)
+	F.Mul(&p.z, &C)
+	G.Mul(&p.x, &D)
+	t0.Double(&G)
+	H.Add(&E, &F).
+		Sub(&H, &t0)
+	t1.Mul(&p.y, &E)
+
+	// X, Y, Z
+	p.x.Mul(&L, &H)
+	p.y.Sub(&G, &H).
+		Mul(&p.y, &O).
+		Sub(&p.y, &t1)
+	p.z.Mul(&E, &p.z)
+
+	t2.Mul(&L, &a.Y)
+	J.Mul(&a.X, &O).
+		Sub(&J, &t2)
+
+	// Line evaluation
+	evaluations.r0.Set(&L)
+	evaluations.r1.Neg(&O)
+	evaluations.r2.Set(&J)
+}
+
+// lineCompute computes the line through p in Homogenous projective coordinates
+// and a in affine coordinates. It does not compute the resulting point p+a.
+func (p *g2Proj) lineCompute(evaluations *lineEvaluation, a *G2Affine) {
+
+	// get some Element from our pool
+	var Y2Z1, X2Z1, O, L, t2, J fp.Element
+	Y2Z1.Mul(&a.Y, &p.z)
+	O.Sub(&p.y, &Y2Z1)
+	X2Z1.Mul(&a.X, &p.z)
+	L.Sub(&p.x, &X2Z1)
+	t2.Mul(&L, &a.Y)
+	J.Mul(&a.X, &O).
+		Sub(&J, &t2)
+
+	// Line evaluation
+	evaluations.r0.Set(&L)
+	evaluations.r1.Neg(&O)
+	evaluations.r2.Set(&J)
+}
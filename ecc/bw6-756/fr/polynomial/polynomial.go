@@ -31,14 +31,54 @@ func (p *Polynomial) Degree() uint64 {
 	return uint64(len(*p) - 1)
 }
 
+// evalBlockSize is the number of coefficients Eval sums with lazy reduction before
+// moving on to the next block
+const evalBlockSize = 16
+
 // Eval evaluates p at v
 // returns a fr.Element
+//
+// The coefficients are processed in blocks of evalBlockSize: each block is evaluated
+// as a sum of products with the precomputed powers of v in an unreduced accumulator,
+// and the blocks are combined with Horner's rule in v^evalBlockSize.
 func (p *Polynomial) Eval(v *fr.Element) fr.Element {
+	n := len(*p)
+	if n < 2*evalBlockSize {
+		res := (*p)[n-1]
+		for i := n - 2; i >= 0; i-- {
+			res.Mul(&res, v)
+			res.Add(&res, &(*p)[i])
+		}
+		return res
+	}
 
-	res := (*p)[len(*p)-1]
-	for i := len(*p) - 2; i >= 0; i-- {
-		res.Mul(&res, v)
-		res.Add(&res, &(*p)[i])
+	// powers[j] = vʲ, vk = v^evalBlockSize
+	var powers [evalBlockSize]fr.Element
+	var vk fr.Element
+	powers[0].SetOne()
+	for j := 1; j < evalBlockSize; j++ {
+		powers[j].Mul(&powers[j-1], v)
+	}
+	vk.Mul(&powers[evalBlockSize-1], v)
+
+	var res, block fr.Element
+	var acc fr.UnreducedAccumulator
+	res.SetZero()
+
+	// the top block may be partial
+	start := (n - 1) / evalBlockSize * evalBlockSize
+	for ; start >= 0; start -= evalBlockSize {
+		end := start + evalBlockSize
+		if end > n {
+			end = n
+		}
+		acc.Reset()
+		for i := start; i < end; i++ {
+			acc.MulAcc(&(*p)[i], &powers[i-start])
+		}
+		acc.Reduce(&block)
+		res.Mul(&res, &vk)
+		res.Add(&res, &block)
 	}
 
 	return res
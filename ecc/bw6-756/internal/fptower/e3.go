@@ -13,7 +13,9 @@
 package fptower
 
 import (
+	"crypto/rand"
 	"github.com/consensys/gnark-crypto/ecc/bw6-756/fp"
+	"io"
 )
 
 // E3 is a degree-three finite field extension of fp3
@@ -66,13 +68,18 @@ func (z *E3) SetOne() *E3 {
 
 // SetRandom set z to a random elmt
 func (z *E3) SetRandom() (*E3, error) {
-	if _, err := z.A0.SetRandom(); err != nil {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom is like SetRandom, reading the randomness from r
+func (z *E3) SetRandomFrom(r io.Reader) (*E3, error) {
+	if _, err := z.A0.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
-	if _, err := z.A1.SetRandom(); err != nil {
+	if _, err := z.A1.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
-	if _, err := z.A2.SetRandom(); err != nil {
+	if _, err := z.A2.SetRandomFrom(r); err != nil {
 		return nil, err
 	}
 	return z, nil
@@ -281,6 +281,87 @@ func TestMillerLoop(t *testing.T) {
 		genR2,
 	))
 
+	properties.Property("[BW6-756] MillerLoopFixedQ should output a bilinear pairing-product check", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var ag1, abg1, g1Inf G1Affine
+			var bg2, g2Inf G2Affine
+
+			var abigint, bbigint, ab big.Int
+
+			a.BigInt(&abigint)
+			b.BigInt(&bbigint)
+			ab.Mul(&abigint, &bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+			abg1.ScalarMultiplication(&g1GenAff, &ab)
+			abg1.Neg(&abg1)
+
+			g1Inf.FromJacobian(&g1Infinity)
+			g2Inf.FromJacobian(&g2Infinity)
+
+			check := func(P []G1Affine, Q []G2Affine) GT {
+				lines := make([]PrecomputedLines, len(Q))
+				for i := range Q {
+					lines[i] = PrecomputeLines(Q[i])
+				}
+				ml, err := MillerLoopFixedQ(P, lines)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return FinalExponentiation(&ml)
+			}
+
+			var one GT
+			one.SetOne()
+
+			// e(aG₁, bG₂)·e(-abG₁, G₂) == 1, with points at infinity that must be skipped
+			res := check([]G1Affine{ag1, abg1, g1Inf, ag1}, []G2Affine{bg2, g2GenAff, bg2, g2Inf})
+			if !res.Equal(&one) {
+				return false
+			}
+
+			// e(aG₁, G₂) == e(G₁, G₂)ᵃ != 1
+			base := check([]G1Affine{g1GenAff}, []G2Affine{g2GenAff})
+			res = check([]G1Affine{ag1}, []G2Affine{g2GenAff})
+			var expected GT
+			expected.Exp(base, &abigint)
+			if base.Equal(&one) || !res.Equal(&expected) {
+				return false
+			}
+
+			// the zero value is rejected
+			_, err := MillerLoopFixedQ([]G1Affine{ag1}, make([]PrecomputedLines, 1))
+			if err == nil {
+				return false
+			}
+
+			if abigint.Sign() == 0 || bbigint.Sign() == 0 {
+				return true
+			}
+
+			// MillerLoopFixedQ is a power e(P, Q)ᵐ of MillerLoop, m ≠ 1, so that e(aG₁, bG₂)ᵐ·e(-abG₁, G₂) != 1:
+			// a term of MillerLoopFixedQ can't be multiplied by a term of MillerLoop in a check
+			mlFixed, err := MillerLoopFixedQ([]G1Affine{ag1}, []PrecomputedLines{PrecomputeLines(bg2)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			ml, err := MillerLoop([]G1Affine{abg1}, []G2Affine{g2GenAff})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var mixed GT
+			mixed.Mul(&mlFixed, &ml)
+			mixed = FinalExponentiation(&mixed)
+			fixed := FinalExponentiation(&mlFixed)
+			pair, _ := Pair([]G1Affine{ag1}, []G2Affine{bg2})
+			return !mixed.Equal(&one) && !fixed.Equal(&pair)
+		},
+		genR1,
+		genR2,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
@@ -315,6 +396,21 @@ func BenchmarkMillerLoop(b *testing.B) {
 	}
 }
 
+func BenchmarkMillerLoopFixedQ(b *testing.B) {
+
+	var g1GenAff G1Affine
+	var g2GenAff G2Affine
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+	lines := []PrecomputedLines{PrecomputeLines(g2GenAff)}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MillerLoopFixedQ([]G1Affine{g1GenAff}, lines)
+	}
+}
+
 func BenchmarkFinalExponentiation(b *testing.B) {
 
 	var a GT
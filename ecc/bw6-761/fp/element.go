@@ -396,6 +396,15 @@ func (z *Element) LexicographicallyLargest() bool {
 // This might error only if reading from crypto/rand.Reader errors,
 // in which case, value of z is undefined.
 func (z *Element) SetRandom() (*Element, error) {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom sets z to a uniform random value in [0, q), reading the randomness from r.
+// With a deterministic (seeded) reader, the sequence of values is reproducible.
+//
+// This might error only if reading from r errors,
+// in which case, value of z is undefined.
+func (z *Element) SetRandomFrom(r io.Reader) (*Element, error) {
 	// this code is generated for all modulus
 	// and derived from go/src/crypto/rand/util.go
 
@@ -418,7 +427,7 @@ func (z *Element) SetRandom() (*Element, error) {
 
 	for {
 		// note that bytes[k:l] is always 0
-		if _, err := io.ReadFull(rand.Reader, bytes[:k]); err != nil {
+		if _, err := io.ReadFull(r, bytes[:k]); err != nil {
 			return nil, err
 		}
 
@@ -648,6 +657,91 @@ func (z *Element) Select(c int, x0 *Element, x1 *Element) *Element {
 	return z
 }
 
+// AddCT z = x + y (mod q), without branching on x or y (as Add does);
+// it is meant for secret operands.
+func (z *Element) AddCT(x, y *Element) *Element {
+	var carry uint64
+	z[0], carry = bits.Add64(x[0], y[0], 0)
+	z[1], carry = bits.Add64(x[1], y[1], carry)
+	z[2], carry = bits.Add64(x[2], y[2], carry)
+	z[3], carry = bits.Add64(x[3], y[3], carry)
+	z[4], carry = bits.Add64(x[4], y[4], carry)
+	z[5], carry = bits.Add64(x[5], y[5], carry)
+	z[6], carry = bits.Add64(x[6], y[6], carry)
+	z[7], carry = bits.Add64(x[7], y[7], carry)
+	z[8], carry = bits.Add64(x[8], y[8], carry)
+	z[9], carry = bits.Add64(x[9], y[9], carry)
+	z[10], carry = bits.Add64(x[10], y[10], carry)
+	z[11], carry = bits.Add64(x[11], y[11], carry)
+
+	// if x + y ⩾ q → z -= q, without branching on z
+	var s [12]uint64
+	var b uint64
+	s[0], b = bits.Sub64(z[0], q0, 0)
+	s[1], b = bits.Sub64(z[1], q1, b)
+	s[2], b = bits.Sub64(z[2], q2, b)
+	s[3], b = bits.Sub64(z[3], q3, b)
+	s[4], b = bits.Sub64(z[4], q4, b)
+	s[5], b = bits.Sub64(z[5], q5, b)
+	s[6], b = bits.Sub64(z[6], q6, b)
+	s[7], b = bits.Sub64(z[7], q7, b)
+	s[8], b = bits.Sub64(z[8], q8, b)
+	s[9], b = bits.Sub64(z[9], q9, b)
+	s[10], b = bits.Sub64(z[10], q10, b)
+	s[11], b = bits.Sub64(z[11], q11, b)
+	// mask = 0xFF..FF if the addition overflowed or the subtraction didn't borrow, 0 otherwise
+	mask := -(carry | (b ^ 1))
+	z[0] ^= mask & (z[0] ^ s[0])
+	z[1] ^= mask & (z[1] ^ s[1])
+	z[2] ^= mask & (z[2] ^ s[2])
+	z[3] ^= mask & (z[3] ^ s[3])
+	z[4] ^= mask & (z[4] ^ s[4])
+	z[5] ^= mask & (z[5] ^ s[5])
+	z[6] ^= mask & (z[6] ^ s[6])
+	z[7] ^= mask & (z[7] ^ s[7])
+	z[8] ^= mask & (z[8] ^ s[8])
+	z[9] ^= mask & (z[9] ^ s[9])
+	z[10] ^= mask & (z[10] ^ s[10])
+	z[11] ^= mask & (z[11] ^ s[11])
+	return z
+}
+
+// SubCT z = x - y (mod q), without branching on x or y (as Sub does);
+// it is meant for secret operands.
+func (z *Element) SubCT(x, y *Element) *Element {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	z[1], b = bits.Sub64(x[1], y[1], b)
+	z[2], b = bits.Sub64(x[2], y[2], b)
+	z[3], b = bits.Sub64(x[3], y[3], b)
+	z[4], b = bits.Sub64(x[4], y[4], b)
+	z[5], b = bits.Sub64(x[5], y[5], b)
+	z[6], b = bits.Sub64(x[6], y[6], b)
+	z[7], b = bits.Sub64(x[7], y[7], b)
+	z[8], b = bits.Sub64(x[8], y[8], b)
+	z[9], b = bits.Sub64(x[9], y[9], b)
+	z[10], b = bits.Sub64(x[10], y[10], b)
+	z[11], b = bits.Sub64(x[11], y[11], b)
+
+	// if x < y → z += q, without branching on z
+	// mask = 0xFF..FF if the subtraction borrowed, 0 otherwise
+	mask := -b
+	var c uint64
+	z[0], c = bits.Add64(z[0], q0&mask, 0)
+	z[1], c = bits.Add64(z[1], q1&mask, c)
+	z[2], c = bits.Add64(z[2], q2&mask, c)
+	z[3], c = bits.Add64(z[3], q3&mask, c)
+	z[4], c = bits.Add64(z[4], q4&mask, c)
+	z[5], c = bits.Add64(z[5], q5&mask, c)
+	z[6], c = bits.Add64(z[6], q6&mask, c)
+	z[7], c = bits.Add64(z[7], q7&mask, c)
+	z[8], c = bits.Add64(z[8], q8&mask, c)
+	z[9], c = bits.Add64(z[9], q9&mask, c)
+	z[10], c = bits.Add64(z[10], q10&mask, c)
+	z[11], _ = bits.Add64(z[11], q11&mask, c)
+	return z
+}
+
 // _mulGeneric is unoptimized textbook CIOS
 // it is a fallback solution on x86 when ADX instruction set is not available
 // and is used for testing purposes.
@@ -1140,54 +1234,36 @@ func _mulGeneric(z, x, y *Element) {
 	t[11], C = bits.Add64(t[12], C, 0)
 	t[12], _ = bits.Add64(0, D, C)
 
-	if t[12] != 0 {
-		// we need to reduce, we have a result on 13 words
-		var b uint64
-		z[0], b = bits.Sub64(t[0], q0, 0)
-		z[1], b = bits.Sub64(t[1], q1, b)
-		z[2], b = bits.Sub64(t[2], q2, b)
-		z[3], b = bits.Sub64(t[3], q3, b)
-		z[4], b = bits.Sub64(t[4], q4, b)
-		z[5], b = bits.Sub64(t[5], q5, b)
-		z[6], b = bits.Sub64(t[6], q6, b)
-		z[7], b = bits.Sub64(t[7], q7, b)
-		z[8], b = bits.Sub64(t[8], q8, b)
-		z[9], b = bits.Sub64(t[9], q9, b)
-		z[10], b = bits.Sub64(t[10], q10, b)
-		z[11], _ = bits.Sub64(t[11], q11, b)
-		return
-	}
-
-	// copy t into z
-	z[0] = t[0]
-	z[1] = t[1]
-	z[2] = t[2]
-	z[3] = t[3]
-	z[4] = t[4]
-	z[5] = t[5]
-	z[6] = t[6]
-	z[7] = t[7]
-	z[8] = t[8]
-	z[9] = t[9]
-	z[10] = t[10]
-	z[11] = t[11]
+	// t < 2q: we subtract q if t[12] != 0 or t ⩾ q, without branching on secret data
+	var s [12]uint64
+	var b uint64
+	s[0], b = bits.Sub64(t[0], q0, 0)
+	s[1], b = bits.Sub64(t[1], q1, b)
+	s[2], b = bits.Sub64(t[2], q2, b)
+	s[3], b = bits.Sub64(t[3], q3, b)
+	s[4], b = bits.Sub64(t[4], q4, b)
+	s[5], b = bits.Sub64(t[5], q5, b)
+	s[6], b = bits.Sub64(t[6], q6, b)
+	s[7], b = bits.Sub64(t[7], q7, b)
+	s[8], b = bits.Sub64(t[8], q8, b)
+	s[9], b = bits.Sub64(t[9], q9, b)
+	s[10], b = bits.Sub64(t[10], q10, b)
+	s[11], b = bits.Sub64(t[11], q11, b)
+	// mask = 0xFF..FF if we need to subtract, 0 otherwise
+	mask := -(t[12] | (b ^ 1))
+	z[0] = t[0] ^ (mask & (t[0] ^ s[0]))
+	z[1] = t[1] ^ (mask & (t[1] ^ s[1]))
+	z[2] = t[2] ^ (mask & (t[2] ^ s[2]))
+	z[3] = t[3] ^ (mask & (t[3] ^ s[3]))
+	z[4] = t[4] ^ (mask & (t[4] ^ s[4]))
+	z[5] = t[5] ^ (mask & (t[5] ^ s[5]))
+	z[6] = t[6] ^ (mask & (t[6] ^ s[6]))
+	z[7] = t[7] ^ (mask & (t[7] ^ s[7]))
+	z[8] = t[8] ^ (mask & (t[8] ^ s[8]))
+	z[9] = t[9] ^ (mask & (t[9] ^ s[9]))
+	z[10] = t[10] ^ (mask & (t[10] ^ s[10]))
+	z[11] = t[11] ^ (mask & (t[11] ^ s[11]))
 
-	// if z ⩾ q → z -= q
-	if !z.smallerThanModulus() {
-		var b uint64
-		z[0], b = bits.Sub64(z[0], q0, 0)
-		z[1], b = bits.Sub64(z[1], q1, b)
-		z[2], b = bits.Sub64(z[2], q2, b)
-		z[3], b = bits.Sub64(z[3], q3, b)
-		z[4], b = bits.Sub64(z[4], q4, b)
-		z[5], b = bits.Sub64(z[5], q5, b)
-		z[6], b = bits.Sub64(z[6], q6, b)
-		z[7], b = bits.Sub64(z[7], q7, b)
-		z[8], b = bits.Sub64(z[8], q8, b)
-		z[9], b = bits.Sub64(z[9], q9, b)
-		z[10], b = bits.Sub64(z[10], q10, b)
-		z[11], _ = bits.Sub64(z[11], q11, b)
-	}
 }
 
 func _fromMontGeneric(z *Element) {
@@ -1516,15 +1592,18 @@ func (z *Element) BitLen() int {
 }
 
 // Hash msg to count prime field elements.
-// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-5.2
-func Hash(msg, dst []byte, count int) ([]Element, error) {
-	// 128 bits of security
-	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter = 128
-	const Bytes = 1 + (Bits-1)/8
-	const L = 16 + Bytes
+// https://www.rfc-editor.org/rfc/rfc9380#section-5.2
+//
+// By default, expand_message_xmd with SHA-256 is used with a security level of 128 bits;
+// the ciphersuite can be changed with hash.Option (e.g. hash.WithExpandMsgXof).
+func Hash(msg, dst []byte, count int, opts ...hash.Option) ([]Element, error) {
+	cfg := hash.NewConfig(opts...)
+
+	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter
+	L := (Bits + cfg.SecurityLevel + 7) / 8
 
 	lenInBytes := count * L
-	pseudoRandomBytes, err := hash.ExpandMsgXmd(msg, dst, lenInBytes)
+	pseudoRandomBytes, err := cfg.Expand(msg, dst, lenInBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -1996,16 +2075,95 @@ func (z *Element) Legendre() int {
 func (z *Element) Sqrt(x *Element) *Element {
 	// q ≡ 3 (mod 4)
 	// using  z ≡ ± x^((p+1)/4) (mod q)
-	var y, square Element
+	var y Element
 	y.expBySqrtExp(*x)
+	return z.sqrtFromExp(x, &y)
+}
+
+// SqrtCT z = √x (mod q) in constant time: the sequence of operations doesn't depend on x,
+// only the returned value reveals whether x is a square. It is suitable for secret data.
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil
+func (z *Element) SqrtCT(x *Element) *Element {
+	// Sqrt is a fixed exponentiation followed by a check of the candidate root
+	return z.Sqrt(x)
+}
+
+// sqrtFromExp sets z = √x (mod q) and returns z, or returns nil if x is not a square,
+// given y = x^((q+1)/4) (see Sqrt)
+func (z *Element) sqrtFromExp(x, y *Element) *Element {
 	// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
-	square.Square(&y)
+	var square Element
+	square.Square(y)
 	if square.Equal(x) {
-		return z.Set(&y)
+		return z.Set(y)
 	}
 	return nil
 }
 
+// batchExpSize is the number of elements whose exponentiations are computed together
+// by BatchSqrt and BatchLegendre; it bounds the size of the temporary vectors.
+const batchExpSize = 256
+
+// BatchLegendre returns the Legendre symbols of the elements of a (see Legendre).
+//
+// The exponentiations share the same addition chain and are computed on vectors
+// of elements, in parallel.
+func BatchLegendre(a []Element) []int {
+	res := make([]int, len(a))
+	batchExp(a, vectorExpByLegendreExp, vectorExpByLegendreExpNbTemporaries, func(i int, l *Element) {
+		switch {
+		case l.IsZero():
+			res[i] = 0
+		case l.IsOne():
+			res[i] = 1
+		default:
+			res[i] = -1
+		}
+	})
+	return res
+}
+
+// BatchSqrt returns the square roots of the elements of a (see Sqrt), and for each of them
+// whether it is a square; if a[i] is not a square, res[i] is 0 and isSquare[i] is false.
+//
+// The exponentiations share the same addition chain and are computed on vectors
+// of elements, in parallel.
+func BatchSqrt(a []Element) (res []Element, isSquare []bool) {
+	res = make([]Element, len(a))
+	isSquare = make([]bool, len(a))
+	batchExp(a, vectorExpBySqrtExp, vectorExpBySqrtExpNbTemporaries, func(i int, w *Element) {
+		isSquare[i] = res[i].sqrtFromExp(&a[i], w) != nil
+	})
+	return
+}
+
+// batchExp calls f(i, aᵢᵏ) for all i, where the exponentiations are computed in parallel
+// by chunks of batchExpSize elements.
+// exp sets z[j] = x[j]ᵏ for all j, with z and x not overlapping, using nbTemporaries
+// temporary vectors from scratch.
+func batchExp(a []Element, exp func(z, x, scratch Vector), nbTemporaries int, f func(i int, ak *Element)) {
+	execute(len(a), func(start, end int) {
+		// the result and the temporaries of all the chunks of the worker share one buffer
+		size := end - start
+		if size > batchExpSize {
+			size = batchExpSize
+		}
+		buf := make(Vector, (nbTemporaries+1)*size)
+		for i := start; i < end; i += batchExpSize {
+			n := end - i
+			if n > batchExpSize {
+				n = batchExpSize
+			}
+			ak := buf[:n]
+			exp(ak, Vector(a[i:i+n]), buf[size:size+nbTemporaries*n])
+			for j := range ak {
+				f(i+j, &ak[j])
+			}
+		}
+	})
+}
+
 const (
 	k               = 32 // word size / 2
 	signBitSelector = uint64(1) << 63
@@ -2588,6 +2746,14 @@ func updateFactorsDecompose(c int64) (int64, int64) {
 	return f, g
 }
 
+// InverseCT z = x⁻¹ (mod q) in constant time, computed as x^(q-2) (Fermat's little theorem);
+// unlike Inverse, the sequence of operations doesn't depend on x and it is suitable for secret data.
+//
+// if x == 0, sets and returns z = x
+func (z *Element) InverseCT(x *Element) *Element {
+	return z.expByInverseExp(*x)
+}
+
 // negL negates in place [x | xHi] and return the new most significant word xHi
 func negL(x *Element, xHi uint64) uint64 {
 	var b uint64
@@ -2662,3 +2828,431 @@ func (z *Element) linearCombNonModular(x *Element, xC int64, y *Element, yC int6
 
 	return yHi
 }
+
+// UnreducedAccumulator accumulates sums of products of field elements on 25 words,
+// without reducing them modulo q: MulAcc costs a bare multiplication, and the modular
+// reduction is done once, by Reduce.
+//
+// The zero value is an empty accumulator; it can hold up to 2⁶³ products.
+type UnreducedAccumulator struct {
+	t [25]uint64
+}
+
+// MulAcc sets acc = acc + x * y, without modular reduction
+func (acc *UnreducedAccumulator) MulAcc(x, y *Element) {
+	var t [24]uint64
+
+	// t = x * y (schoolbook)
+	var C uint64
+	C, t[0] = bits.Mul64(x[0], y[0])
+	C, t[1] = madd1(x[1], y[0], C)
+	C, t[2] = madd1(x[2], y[0], C)
+	C, t[3] = madd1(x[3], y[0], C)
+	C, t[4] = madd1(x[4], y[0], C)
+	C, t[5] = madd1(x[5], y[0], C)
+	C, t[6] = madd1(x[6], y[0], C)
+	C, t[7] = madd1(x[7], y[0], C)
+	C, t[8] = madd1(x[8], y[0], C)
+	C, t[9] = madd1(x[9], y[0], C)
+	C, t[10] = madd1(x[10], y[0], C)
+	C, t[11] = madd1(x[11], y[0], C)
+	t[12] = C
+	C, t[1] = madd1(x[0], y[1], t[1])
+	C, t[2] = madd2(x[1], y[1], t[2], C)
+	C, t[3] = madd2(x[2], y[1], t[3], C)
+	C, t[4] = madd2(x[3], y[1], t[4], C)
+	C, t[5] = madd2(x[4], y[1], t[5], C)
+	C, t[6] = madd2(x[5], y[1], t[6], C)
+	C, t[7] = madd2(x[6], y[1], t[7], C)
+	C, t[8] = madd2(x[7], y[1], t[8], C)
+	C, t[9] = madd2(x[8], y[1], t[9], C)
+	C, t[10] = madd2(x[9], y[1], t[10], C)
+	C, t[11] = madd2(x[10], y[1], t[11], C)
+	C, t[12] = madd2(x[11], y[1], t[12], C)
+	t[13] = C
+	C, t[2] = madd1(x[0], y[2], t[2])
+	C, t[3] = madd2(x[1], y[2], t[3], C)
+	C, t[4] = madd2(x[2], y[2], t[4], C)
+	C, t[5] = madd2(x[3], y[2], t[5], C)
+	C, t[6] = madd2(x[4], y[2], t[6], C)
+	C, t[7] = madd2(x[5], y[2], t[7], C)
+	C, t[8] = madd2(x[6], y[2], t[8], C)
+	C, t[9] = madd2(x[7], y[2], t[9], C)
+	C, t[10] = madd2(x[8], y[2], t[10], C)
+	C, t[11] = madd2(x[9], y[2], t[11], C)
+	C, t[12] = madd2(x[10], y[2], t[12], C)
+	C, t[13] = madd2(x[11], y[2], t[13], C)
+	t[14] = C
+	C, t[3] = madd1(x[0], y[3], t[3])
+	C, t[4] = madd2(x[1], y[3], t[4], C)
+	C, t[5] = madd2(x[2], y[3], t[5], C)
+	C, t[6] = madd2(x[3], y[3], t[6], C)
+	C, t[7] = madd2(x[4], y[3], t[7], C)
+	C, t[8] = madd2(x[5], y[3], t[8], C)
+	C, t[9] = madd2(x[6], y[3], t[9], C)
+	C, t[10] = madd2(x[7], y[3], t[10], C)
+	C, t[11] = madd2(x[8], y[3], t[11], C)
+	C, t[12] = madd2(x[9], y[3], t[12], C)
+	C, t[13] = madd2(x[10], y[3], t[13], C)
+	C, t[14] = madd2(x[11], y[3], t[14], C)
+	t[15] = C
+	C, t[4] = madd1(x[0], y[4], t[4])
+	C, t[5] = madd2(x[1], y[4], t[5], C)
+	C, t[6] = madd2(x[2], y[4], t[6], C)
+	C, t[7] = madd2(x[3], y[4], t[7], C)
+	C, t[8] = madd2(x[4], y[4], t[8], C)
+	C, t[9] = madd2(x[5], y[4], t[9], C)
+	C, t[10] = madd2(x[6], y[4], t[10], C)
+	C, t[11] = madd2(x[7], y[4], t[11], C)
+	C, t[12] = madd2(x[8], y[4], t[12], C)
+	C, t[13] = madd2(x[9], y[4], t[13], C)
+	C, t[14] = madd2(x[10], y[4], t[14], C)
+	C, t[15] = madd2(x[11], y[4], t[15], C)
+	t[16] = C
+	C, t[5] = madd1(x[0], y[5], t[5])
+	C, t[6] = madd2(x[1], y[5], t[6], C)
+	C, t[7] = madd2(x[2], y[5], t[7], C)
+	C, t[8] = madd2(x[3], y[5], t[8], C)
+	C, t[9] = madd2(x[4], y[5], t[9], C)
+	C, t[10] = madd2(x[5], y[5], t[10], C)
+	C, t[11] = madd2(x[6], y[5], t[11], C)
+	C, t[12] = madd2(x[7], y[5], t[12], C)
+	C, t[13] = madd2(x[8], y[5], t[13], C)
+	C, t[14] = madd2(x[9], y[5], t[14], C)
+	C, t[15] = madd2(x[10], y[5], t[15], C)
+	C, t[16] = madd2(x[11], y[5], t[16], C)
+	t[17] = C
+	C, t[6] = madd1(x[0], y[6], t[6])
+	C, t[7] = madd2(x[1], y[6], t[7], C)
+	C, t[8] = madd2(x[2], y[6], t[8], C)
+	C, t[9] = madd2(x[3], y[6], t[9], C)
+	C, t[10] = madd2(x[4], y[6], t[10], C)
+	C, t[11] = madd2(x[5], y[6], t[11], C)
+	C, t[12] = madd2(x[6], y[6], t[12], C)
+	C, t[13] = madd2(x[7], y[6], t[13], C)
+	C, t[14] = madd2(x[8], y[6], t[14], C)
+	C, t[15] = madd2(x[9], y[6], t[15], C)
+	C, t[16] = madd2(x[10], y[6], t[16], C)
+	C, t[17] = madd2(x[11], y[6], t[17], C)
+	t[18] = C
+	C, t[7] = madd1(x[0], y[7], t[7])
+	C, t[8] = madd2(x[1], y[7], t[8], C)
+	C, t[9] = madd2(x[2], y[7], t[9], C)
+	C, t[10] = madd2(x[3], y[7], t[10], C)
+	C, t[11] = madd2(x[4], y[7], t[11], C)
+	C, t[12] = madd2(x[5], y[7], t[12], C)
+	C, t[13] = madd2(x[6], y[7], t[13], C)
+	C, t[14] = madd2(x[7], y[7], t[14], C)
+	C, t[15] = madd2(x[8], y[7], t[15], C)
+	C, t[16] = madd2(x[9], y[7], t[16], C)
+	C, t[17] = madd2(x[10], y[7], t[17], C)
+	C, t[18] = madd2(x[11], y[7], t[18], C)
+	t[19] = C
+	C, t[8] = madd1(x[0], y[8], t[8])
+	C, t[9] = madd2(x[1], y[8], t[9], C)
+	C, t[10] = madd2(x[2], y[8], t[10], C)
+	C, t[11] = madd2(x[3], y[8], t[11], C)
+	C, t[12] = madd2(x[4], y[8], t[12], C)
+	C, t[13] = madd2(x[5], y[8], t[13], C)
+	C, t[14] = madd2(x[6], y[8], t[14], C)
+	C, t[15] = madd2(x[7], y[8], t[15], C)
+	C, t[16] = madd2(x[8], y[8], t[16], C)
+	C, t[17] = madd2(x[9], y[8], t[17], C)
+	C, t[18] = madd2(x[10], y[8], t[18], C)
+	C, t[19] = madd2(x[11], y[8], t[19], C)
+	t[20] = C
+	C, t[9] = madd1(x[0], y[9], t[9])
+	C, t[10] = madd2(x[1], y[9], t[10], C)
+	C, t[11] = madd2(x[2], y[9], t[11], C)
+	C, t[12] = madd2(x[3], y[9], t[12], C)
+	C, t[13] = madd2(x[4], y[9], t[13], C)
+	C, t[14] = madd2(x[5], y[9], t[14], C)
+	C, t[15] = madd2(x[6], y[9], t[15], C)
+	C, t[16] = madd2(x[7], y[9], t[16], C)
+	C, t[17] = madd2(x[8], y[9], t[17], C)
+	C, t[18] = madd2(x[9], y[9], t[18], C)
+	C, t[19] = madd2(x[10], y[9], t[19], C)
+	C, t[20] = madd2(x[11], y[9], t[20], C)
+	t[21] = C
+	C, t[10] = madd1(x[0], y[10], t[10])
+	C, t[11] = madd2(x[1], y[10], t[11], C)
+	C, t[12] = madd2(x[2], y[10], t[12], C)
+	C, t[13] = madd2(x[3], y[10], t[13], C)
+	C, t[14] = madd2(x[4], y[10], t[14], C)
+	C, t[15] = madd2(x[5], y[10], t[15], C)
+	C, t[16] = madd2(x[6], y[10], t[16], C)
+	C, t[17] = madd2(x[7], y[10], t[17], C)
+	C, t[18] = madd2(x[8], y[10], t[18], C)
+	C, t[19] = madd2(x[9], y[10], t[19], C)
+	C, t[20] = madd2(x[10], y[10], t[20], C)
+	C, t[21] = madd2(x[11], y[10], t[21], C)
+	t[22] = C
+	C, t[11] = madd1(x[0], y[11], t[11])
+	C, t[12] = madd2(x[1], y[11], t[12], C)
+	C, t[13] = madd2(x[2], y[11], t[13], C)
+	C, t[14] = madd2(x[3], y[11], t[14], C)
+	C, t[15] = madd2(x[4], y[11], t[15], C)
+	C, t[16] = madd2(x[5], y[11], t[16], C)
+	C, t[17] = madd2(x[6], y[11], t[17], C)
+	C, t[18] = madd2(x[7], y[11], t[18], C)
+	C, t[19] = madd2(x[8], y[11], t[19], C)
+	C, t[20] = madd2(x[9], y[11], t[20], C)
+	C, t[21] = madd2(x[10], y[11], t[21], C)
+	C, t[22] = madd2(x[11], y[11], t[22], C)
+	t[23] = C
+
+	// acc = acc + t
+	var carry uint64
+	acc.t[0], carry = bits.Add64(acc.t[0], t[0], carry)
+	acc.t[1], carry = bits.Add64(acc.t[1], t[1], carry)
+	acc.t[2], carry = bits.Add64(acc.t[2], t[2], carry)
+	acc.t[3], carry = bits.Add64(acc.t[3], t[3], carry)
+	acc.t[4], carry = bits.Add64(acc.t[4], t[4], carry)
+	acc.t[5], carry = bits.Add64(acc.t[5], t[5], carry)
+	acc.t[6], carry = bits.Add64(acc.t[6], t[6], carry)
+	acc.t[7], carry = bits.Add64(acc.t[7], t[7], carry)
+	acc.t[8], carry = bits.Add64(acc.t[8], t[8], carry)
+	acc.t[9], carry = bits.Add64(acc.t[9], t[9], carry)
+	acc.t[10], carry = bits.Add64(acc.t[10], t[10], carry)
+	acc.t[11], carry = bits.Add64(acc.t[11], t[11], carry)
+	acc.t[12], carry = bits.Add64(acc.t[12], t[12], carry)
+	acc.t[13], carry = bits.Add64(acc.t[13], t[13], carry)
+	acc.t[14], carry = bits.Add64(acc.t[14], t[14], carry)
+	acc.t[15], carry = bits.Add64(acc.t[15], t[15], carry)
+	acc.t[16], carry = bits.Add64(acc.t[16], t[16], carry)
+	acc.t[17], carry = bits.Add64(acc.t[17], t[17], carry)
+	acc.t[18], carry = bits.Add64(acc.t[18], t[18], carry)
+	acc.t[19], carry = bits.Add64(acc.t[19], t[19], carry)
+	acc.t[20], carry = bits.Add64(acc.t[20], t[20], carry)
+	acc.t[21], carry = bits.Add64(acc.t[21], t[21], carry)
+	acc.t[22], carry = bits.Add64(acc.t[22], t[22], carry)
+	acc.t[23], carry = bits.Add64(acc.t[23], t[23], carry)
+	acc.t[24] += carry
+}
+
+// Reduce sets z = acc (mod q) and returns z; acc is left unchanged
+func (acc *UnreducedAccumulator) Reduce(z *Element) *Element {
+	// with xᵢ = aᵢR and yᵢ = bᵢR in Montgomery form, acc holds T = Σ xᵢyᵢ = R²·Σ aᵢbᵢ
+	// and we need z = T·R⁻¹ (mod q). T may exceed q·R, so we reduce it twice,
+	// to T·R⁻¹ < q·R and then to T·R⁻² < 2q, and multiply the result by R² (mod q).
+	t := acc.t
+	montgomeryReduceWide(&t)
+
+	var u [25]uint64
+	copy(u[:], t[12:])
+	montgomeryReduceWide(&u)
+
+	copy(z[:], u[12:24])
+	if u[24] != 0 || !z.smallerThanModulus() {
+		var b uint64
+		for i := 0; i < 12; i++ {
+			z[i], b = bits.Sub64(z[i], qElement[i], b)
+		}
+	}
+	return z.Mul(z, &rSquare)
+}
+
+// Reset empties the accumulator
+func (acc *UnreducedAccumulator) Reset() {
+	acc.t = [25]uint64{}
+}
+
+// montgomeryReduceWide sets t[12:] = t * R⁻¹ (mod q) on 13 words, with a
+// word-by-word Montgomery reduction; the result is less than t / R + q.
+func montgomeryReduceWide(t *[25]uint64) {
+	// the carry out of t[i+12] is added at the next row
+	var m, C, c uint64
+
+	// t[0] becomes 0
+	m = t[0] * qInvNeg
+	C = madd0(m, qElement[0], t[0])
+	C, t[1] = madd2(m, qElement[1], t[1], C)
+	C, t[2] = madd2(m, qElement[2], t[2], C)
+	C, t[3] = madd2(m, qElement[3], t[3], C)
+	C, t[4] = madd2(m, qElement[4], t[4], C)
+	C, t[5] = madd2(m, qElement[5], t[5], C)
+	C, t[6] = madd2(m, qElement[6], t[6], C)
+	C, t[7] = madd2(m, qElement[7], t[7], C)
+	C, t[8] = madd2(m, qElement[8], t[8], C)
+	C, t[9] = madd2(m, qElement[9], t[9], C)
+	C, t[10] = madd2(m, qElement[10], t[10], C)
+	C, t[11] = madd2(m, qElement[11], t[11], C)
+	t[12], c = bits.Add64(t[12], C, 0)
+
+	// t[1] becomes 0
+	m = t[1] * qInvNeg
+	C = madd0(m, qElement[0], t[1])
+	C, t[2] = madd2(m, qElement[1], t[2], C)
+	C, t[3] = madd2(m, qElement[2], t[3], C)
+	C, t[4] = madd2(m, qElement[3], t[4], C)
+	C, t[5] = madd2(m, qElement[4], t[5], C)
+	C, t[6] = madd2(m, qElement[5], t[6], C)
+	C, t[7] = madd2(m, qElement[6], t[7], C)
+	C, t[8] = madd2(m, qElement[7], t[8], C)
+	C, t[9] = madd2(m, qElement[8], t[9], C)
+	C, t[10] = madd2(m, qElement[9], t[10], C)
+	C, t[11] = madd2(m, qElement[10], t[11], C)
+	C, t[12] = madd2(m, qElement[11], t[12], C)
+	t[13], c = bits.Add64(t[13], C, c)
+
+	// t[2] becomes 0
+	m = t[2] * qInvNeg
+	C = madd0(m, qElement[0], t[2])
+	C, t[3] = madd2(m, qElement[1], t[3], C)
+	C, t[4] = madd2(m, qElement[2], t[4], C)
+	C, t[5] = madd2(m, qElement[3], t[5], C)
+	C, t[6] = madd2(m, qElement[4], t[6], C)
+	C, t[7] = madd2(m, qElement[5], t[7], C)
+	C, t[8] = madd2(m, qElement[6], t[8], C)
+	C, t[9] = madd2(m, qElement[7], t[9], C)
+	C, t[10] = madd2(m, qElement[8], t[10], C)
+	C, t[11] = madd2(m, qElement[9], t[11], C)
+	C, t[12] = madd2(m, qElement[10], t[12], C)
+	C, t[13] = madd2(m, qElement[11], t[13], C)
+	t[14], c = bits.Add64(t[14], C, c)
+
+	// t[3] becomes 0
+	m = t[3] * qInvNeg
+	C = madd0(m, qElement[0], t[3])
+	C, t[4] = madd2(m, qElement[1], t[4], C)
+	C, t[5] = madd2(m, qElement[2], t[5], C)
+	C, t[6] = madd2(m, qElement[3], t[6], C)
+	C, t[7] = madd2(m, qElement[4], t[7], C)
+	C, t[8] = madd2(m, qElement[5], t[8], C)
+	C, t[9] = madd2(m, qElement[6], t[9], C)
+	C, t[10] = madd2(m, qElement[7], t[10], C)
+	C, t[11] = madd2(m, qElement[8], t[11], C)
+	C, t[12] = madd2(m, qElement[9], t[12], C)
+	C, t[13] = madd2(m, qElement[10], t[13], C)
+	C, t[14] = madd2(m, qElement[11], t[14], C)
+	t[15], c = bits.Add64(t[15], C, c)
+
+	// t[4] becomes 0
+	m = t[4] * qInvNeg
+	C = madd0(m, qElement[0], t[4])
+	C, t[5] = madd2(m, qElement[1], t[5], C)
+	C, t[6] = madd2(m, qElement[2], t[6], C)
+	C, t[7] = madd2(m, qElement[3], t[7], C)
+	C, t[8] = madd2(m, qElement[4], t[8], C)
+	C, t[9] = madd2(m, qElement[5], t[9], C)
+	C, t[10] = madd2(m, qElement[6], t[10], C)
+	C, t[11] = madd2(m, qElement[7], t[11], C)
+	C, t[12] = madd2(m, qElement[8], t[12], C)
+	C, t[13] = madd2(m, qElement[9], t[13], C)
+	C, t[14] = madd2(m, qElement[10], t[14], C)
+	C, t[15] = madd2(m, qElement[11], t[15], C)
+	t[16], c = bits.Add64(t[16], C, c)
+
+	// t[5] becomes 0
+	m = t[5] * qInvNeg
+	C = madd0(m, qElement[0], t[5])
+	C, t[6] = madd2(m, qElement[1], t[6], C)
+	C, t[7] = madd2(m, qElement[2], t[7], C)
+	C, t[8] = madd2(m, qElement[3], t[8], C)
+	C, t[9] = madd2(m, qElement[4], t[9], C)
+	C, t[10] = madd2(m, qElement[5], t[10], C)
+	C, t[11] = madd2(m, qElement[6], t[11], C)
+	C, t[12] = madd2(m, qElement[7], t[12], C)
+	C, t[13] = madd2(m, qElement[8], t[13], C)
+	C, t[14] = madd2(m, qElement[9], t[14], C)
+	C, t[15] = madd2(m, qElement[10], t[15], C)
+	C, t[16] = madd2(m, qElement[11], t[16], C)
+	t[17], c = bits.Add64(t[17], C, c)
+
+	// t[6] becomes 0
+	m = t[6] * qInvNeg
+	C = madd0(m, qElement[0], t[6])
+	C, t[7] = madd2(m, qElement[1], t[7], C)
+	C, t[8] = madd2(m, qElement[2], t[8], C)
+	C, t[9] = madd2(m, qElement[3], t[9], C)
+	C, t[10] = madd2(m, qElement[4], t[10], C)
+	C, t[11] = madd2(m, qElement[5], t[11], C)
+	C, t[12] = madd2(m, qElement[6], t[12], C)
+	C, t[13] = madd2(m, qElement[7], t[13], C)
+	C, t[14] = madd2(m, qElement[8], t[14], C)
+	C, t[15] = madd2(m, qElement[9], t[15], C)
+	C, t[16] = madd2(m, qElement[10], t[16], C)
+	C, t[17] = madd2(m, qElement[11], t[17], C)
+	t[18], c = bits.Add64(t[18], C, c)
+
+	// t[7] becomes 0
+	m = t[7] * qInvNeg
+	C = madd0(m, qElement[0], t[7])
+	C, t[8] = madd2(m, qElement[1], t[8], C)
+	C, t[9] = madd2(m, qElement[2], t[9], C)
+	C, t[10] = madd2(m, qElement[3], t[10], C)
+	C, t[11] = madd2(m, qElement[4], t[11], C)
+	C, t[12] = madd2(m, qElement[5], t[12], C)
+	C, t[13] = madd2(m, qElement[6], t[13], C)
+	C, t[14] = madd2(m, qElement[7], t[14], C)
+	C, t[15] = madd2(m, qElement[8], t[15], C)
+	C, t[16] = madd2(m, qElement[9], t[16], C)
+	C, t[17] = madd2(m, qElement[10], t[17], C)
+	C, t[18] = madd2(m, qElement[11], t[18], C)
+	t[19], c = bits.Add64(t[19], C, c)
+
+	// t[8] becomes 0
+	m = t[8] * qInvNeg
+	C = madd0(m, qElement[0], t[8])
+	C, t[9] = madd2(m, qElement[1], t[9], C)
+	C, t[10] = madd2(m, qElement[2], t[10], C)
+	C, t[11] = madd2(m, qElement[3], t[11], C)
+	C, t[12] = madd2(m, qElement[4], t[12], C)
+	C, t[13] = madd2(m, qElement[5], t[13], C)
+	C, t[14] = madd2(m, qElement[6], t[14], C)
+	C, t[15] = madd2(m, qElement[7], t[15], C)
+	C, t[16] = madd2(m, qElement[8], t[16], C)
+	C, t[17] = madd2(m, qElement[9], t[17], C)
+	C, t[18] = madd2(m, qElement[10], t[18], C)
+	C, t[19] = madd2(m, qElement[11], t[19], C)
+	t[20], c = bits.Add64(t[20], C, c)
+
+	// t[9] becomes 0
+	m = t[9] * qInvNeg
+	C = madd0(m, qElement[0], t[9])
+	C, t[10] = madd2(m, qElement[1], t[10], C)
+	C, t[11] = madd2(m, qElement[2], t[11], C)
+	C, t[12] = madd2(m, qElement[3], t[12], C)
+	C, t[13] = madd2(m, qElement[4], t[13], C)
+	C, t[14] = madd2(m, qElement[5], t[14], C)
+	C, t[15] = madd2(m, qElement[6], t[15], C)
+	C, t[16] = madd2(m, qElement[7], t[16], C)
+	C, t[17] = madd2(m, qElement[8], t[17], C)
+	C, t[18] = madd2(m, qElement[9], t[18], C)
+	C, t[19] = madd2(m, qElement[10], t[19], C)
+	C, t[20] = madd2(m, qElement[11], t[20], C)
+	t[21], c = bits.Add64(t[21], C, c)
+
+	// t[10] becomes 0
+	m = t[10] * qInvNeg
+	C = madd0(m, qElement[0], t[10])
+	C, t[11] = madd2(m, qElement[1], t[11], C)
+	C, t[12] = madd2(m, qElement[2], t[12], C)
+	C, t[13] = madd2(m, qElement[3], t[13], C)
+	C, t[14] = madd2(m, qElement[4], t[14], C)
+	C, t[15] = madd2(m, qElement[5], t[15], C)
+	C, t[16] = madd2(m, qElement[6], t[16], C)
+	C, t[17] = madd2(m, qElement[7], t[17], C)
+	C, t[18] = madd2(m, qElement[8], t[18], C)
+	C, t[19] = madd2(m, qElement[9], t[19], C)
+	C, t[20] = madd2(m, qElement[10], t[20], C)
+	C, t[21] = madd2(m, qElement[11], t[21], C)
+	t[22], c = bits.Add64(t[22], C, c)
+
+	// t[11] becomes 0
+	m = t[11] * qInvNeg
+	C = madd0(m, qElement[0], t[11])
+	C, t[12] = madd2(m, qElement[1], t[12], C)
+	C, t[13] = madd2(m, qElement[2], t[13], C)
+	C, t[14] = madd2(m, qElement[3], t[14], C)
+	C, t[15] = madd2(m, qElement[4], t[15], C)
+	C, t[16] = madd2(m, qElement[5], t[16], C)
+	C, t[17] = madd2(m, qElement[6], t[17], C)
+	C, t[18] = madd2(m, qElement[7], t[18], C)
+	C, t[19] = madd2(m, qElement[8], t[19], C)
+	C, t[20] = madd2(m, qElement[9], t[20], C)
+	C, t[21] = madd2(m, qElement[10], t[21], C)
+	C, t[22] = madd2(m, qElement[11], t[22], C)
+	t[23], c = bits.Add64(t[23], C, c)
+	t[24] += c
+}
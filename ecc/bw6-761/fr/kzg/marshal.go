@@ -119,6 +119,9 @@ func (vk *VerifyingKey) ReadFrom(r io.Reader) (int64, error) {
 		}
 	}
 
+	// the lines are not serialized
+	vk.PrecomputeLines()
+
 	return dec.BytesRead(), nil
 }
 
@@ -0,0 +1,32 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ecvrf provides the verifiable random functions ECVRF-EDWARDS25519-SHA512-TAI and
+// ECVRF-EDWARDS25519-SHA512-ELL2 of RFC 9381 on edwards25519.
+//
+// A proof π = (Γ, c, s) binds the public key Y = x⋅B to the VRF input α through Γ = x⋅H, where H is
+// obtained by hashing α to the prime order subgroup; the output β is a hash of cofactor⋅Γ.
+// The two ciphersuites only differ in the way α is hashed to the curve:
+//   - EDWARDS25519_SHA512_TAI (suite_string 0x03): try-and-increment, section 5.4.1.1 of RFC 9381,
+//   - EDWARDS25519_SHA512_ELL2 (suite_string 0x04): edwards25519_XMD:SHA-512_ELL2_NU_ of RFC 9380,
+//     see edwards25519.EncodeToCurve.
+//
+// As in Ed25519, the private key is a 32-byte seed, from which the secret scalar x and the key of the
+// deterministic nonce generation are derived (section 5.4.2.2 of RFC 9381). Both suites are checked
+// against the test vectors of RFC 9381, appendix B.
+//
+// Documentation:
+// - RFC 9381: https://www.rfc-editor.org/rfc/rfc9381.html
+// - RFC 8032: https://www.rfc-editor.org/rfc/rfc8032.html
+package ecvrf
@@ -0,0 +1,374 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecvrf
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/edwards25519"
+	"github.com/consensys/gnark-crypto/ecc/edwards25519/fr"
+)
+
+const (
+	sizeFr         = fr.Bytes
+	sizePoint      = edwards25519.SizePointCompressed
+	sizeChallenge  = 16 // cLen, for 128 bits of security
+	sizePublicKey  = sizePoint
+	sizePrivateKey = SizeSeed
+
+	// SizeSeed is the size in bytes of the seed of a private key, as in Ed25519
+	SizeSeed = 32
+	// SizeProof is the size in bytes of a proof π = Γ ∥ c ∥ s
+	SizeProof = sizePoint + sizeChallenge + sizeFr
+	// SizeOutput is the size in bytes of a VRF output β
+	SizeOutput = sha512.Size
+)
+
+// Suite is an ECVRF ciphersuite of RFC 9381 on edwards25519, identified by its suite_string
+type Suite byte
+
+const (
+	// EDWARDS25519_SHA512_TAI is ECVRF-EDWARDS25519-SHA512-TAI, which hashes to the curve by try-and-increment
+	EDWARDS25519_SHA512_TAI Suite = 0x03
+	// EDWARDS25519_SHA512_ELL2 is ECVRF-EDWARDS25519-SHA512-ELL2, which hashes to the curve with Elligator 2
+	EDWARDS25519_SHA512_ELL2 Suite = 0x04
+)
+
+// String returns the name of the suite in RFC 9381
+func (suite Suite) String() string {
+	switch suite {
+	case EDWARDS25519_SHA512_TAI:
+		return "ECVRF-EDWARDS25519-SHA512-TAI"
+	case EDWARDS25519_SHA512_ELL2:
+		return "ECVRF-EDWARDS25519-SHA512-ELL2"
+	default:
+		return "unknown ECVRF suite"
+	}
+}
+
+// domain separators of RFC 9381, section 5
+const (
+	encodeToCurveDomainSeparatorFront       = 0x01
+	encodeToCurveDomainSeparatorBack        = 0x00
+	challengeGenerationDomainSeparatorFront = 0x02
+	challengeGenerationDomainSeparatorBack  = 0x00
+	proofToHashDomainSeparatorFront         = 0x03
+	proofToHashDomainSeparatorBack          = 0x00
+)
+
+// h2cSuiteID is the hash-to-curve suite of ECVRF-EDWARDS25519-SHA512-ELL2 (RFC 9380)
+const h2cSuiteID = "edwards25519_XMD:SHA-512_ELL2_NU_"
+
+var (
+	errInvalidSeed  = errors.New("invalid seed size")
+	errInvalidProof = errors.New("invalid proof")
+	errInvalidSuite = errors.New("unknown ECVRF suite")
+	errEncode       = errors.New("try-and-increment found no point")
+)
+
+var cofactor = big.NewInt(edwards25519.Cofactor)
+
+// 2²⁴⁸, the weight of a chunk of 31 bytes in setBytesLE
+var twoTo248 fr.Element
+
+func init() {
+	twoTo248.SetString("0x100000000000000000000000000000000000000000000000000000000000000")
+}
+
+// PublicKey represents an ECVRF public key Y = x⋅B
+type PublicKey struct {
+	A edwards25519.PointAffine
+}
+
+// PrivateKey represents an ECVRF private key
+type PrivateKey struct {
+	PublicKey PublicKey      // copy of the associated public key
+	seed      [SizeSeed]byte // the private key SK of RFC 9381
+	scalar    fr.Element     // secret scalar x, from the first half of SHA-512(seed)
+	prefix    [32]byte       // second half of SHA-512(seed), keys the nonce generation
+}
+
+// GenerateKey generates a public and private key pair, reading the seed from rand.
+func GenerateKey(rand io.Reader) (*PrivateKey, error) {
+	var seed [SizeSeed]byte
+	if _, err := io.ReadFull(rand, seed[:]); err != nil {
+		return nil, err
+	}
+	return NewKeyFromSeed(seed[:])
+}
+
+// NewKeyFromSeed derives a private key from its 32-byte seed, as in Ed25519
+// (https://www.rfc-editor.org/rfc/rfc8032#section-5.1.5).
+func NewKeyFromSeed(seed []byte) (*PrivateKey, error) {
+	if len(seed) != SizeSeed {
+		return nil, errInvalidSeed
+	}
+	privateKey := new(PrivateKey)
+	copy(privateKey.seed[:], seed)
+
+	h := sha512.Sum512(seed)
+	// x is the little-endian integer of the first half of h, with the three lowest bits and
+	// the highest bit cleared, and the second highest bit set
+	h[0] &= 248
+	h[31] &= 127
+	h[31] |= 64
+	setBytesLE(&privateKey.scalar, h[:32])
+	copy(privateKey.prefix[:], h[32:])
+
+	base := edwards25519.Generator()
+	privateKey.PublicKey.A.ScalarMultiplicationCT(&base, &privateKey.scalar)
+	return privateKey, nil
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() *PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Prove computes the VRF proof π and output β of the input alpha under the private key sk.
+//
+// The proof is deterministic: proving the same input with the same key yields the same π.
+func (suite Suite) Prove(sk *PrivateKey, alpha []byte) (pi, beta []byte, err error) {
+	yString := sk.PublicKey.A.Bytes()
+	H, err := suite.encodeToCurve(yString[:], alpha)
+	if err != nil {
+		return nil, nil, err
+	}
+	hString := H.Bytes()
+
+	// Γ = x⋅H
+	var gamma edwards25519.PointAffine
+	gamma.ScalarMultiplicationCT(&H, &sk.scalar)
+
+	// k = nonce_generation(sk, h_string)
+	k := nonce(sk, hString[:])
+
+	// U = k⋅B, V = k⋅H
+	var U, V edwards25519.PointAffine
+	base := edwards25519.Generator()
+	U.ScalarMultiplicationCT(&base, &k)
+	V.ScalarMultiplicationCT(&H, &k)
+
+	cString := suite.challenge(&sk.PublicKey.A, &H, &gamma, &U, &V)
+
+	// s = k + c⋅x mod q, in fr.Element: the secrets x and k are not handled by big.Int
+	var c, s fr.Element
+	setBytesLE(&c, cString[:])
+	s.Mul(&c, &sk.scalar).AddCT(&s, &k)
+
+	pi = make([]byte, SizeProof)
+	gammaString := gamma.Bytes()
+	copy(pi[:sizePoint], gammaString[:])
+	copy(pi[sizePoint:sizePoint+sizeChallenge], cString[:])
+	var sString [sizeFr]byte
+	fr.LittleEndian.PutElement(&sString, s)
+	copy(pi[sizePoint+sizeChallenge:], sString[:])
+
+	return pi, suite.gammaToHash(&gamma), nil
+}
+
+// Verify checks the VRF proof pi of the input alpha under the public key pk.
+// It returns the VRF output β and true if the proof is valid, nil and false otherwise.
+func (suite Suite) Verify(pk *PublicKey, alpha, pi []byte) (beta []byte, ok bool) {
+	// validate_key: Y must be on the curve and not of low order
+	var cY edwards25519.PointAffine
+	cY.ScalarMultiplication(&pk.A, cofactor)
+	if !pk.A.IsOnCurve() || cY.IsZero() {
+		return nil, false
+	}
+
+	gamma, c, s, err := decodeProof(pi)
+	if err != nil {
+		return nil, false
+	}
+
+	yString := pk.A.Bytes()
+	H, err := suite.encodeToCurve(yString[:], alpha)
+	if err != nil {
+		return nil, false
+	}
+
+	// U = s⋅B - c⋅Y
+	var U, cPk edwards25519.PointAffine
+	base := edwards25519.Generator()
+	U.ScalarMultiplication(&base, s)
+	cPk.ScalarMultiplication(&pk.A, c)
+	cPk.Neg(&cPk)
+	U.Add(&U, &cPk)
+
+	// V = s⋅H - c⋅Γ
+	var V, cGamma edwards25519.PointAffine
+	V.ScalarMultiplication(&H, s)
+	cGamma.ScalarMultiplication(&gamma, c)
+	cGamma.Neg(&cGamma)
+	V.Add(&V, &cGamma)
+
+	cPrime := suite.challenge(&pk.A, &H, &gamma, &U, &V)
+	if subtle.ConstantTimeCompare(pi[sizePoint:sizePoint+sizeChallenge], cPrime[:]) != 1 {
+		return nil, false
+	}
+
+	return suite.gammaToHash(&gamma), true
+}
+
+// ProofToHash returns the VRF output β of the proof pi.
+//
+// It does not verify pi: the output should only be used once the proof has been
+// checked with Verify.
+func (suite Suite) ProofToHash(pi []byte) ([]byte, error) {
+	if suite != EDWARDS25519_SHA512_TAI && suite != EDWARDS25519_SHA512_ELL2 {
+		return nil, errInvalidSuite
+	}
+	gamma, _, _, err := decodeProof(pi)
+	if err != nil {
+		return nil, err
+	}
+	return suite.gammaToHash(&gamma), nil
+}
+
+// encodeToCurve maps alpha to the subgroup of order ℓ, salted with the encoding of the public key
+func (suite Suite) encodeToCurve(salt, alpha []byte) (edwards25519.PointAffine, error) {
+	switch suite {
+	case EDWARDS25519_SHA512_TAI:
+		return encodeToCurveTAI(salt, alpha)
+	case EDWARDS25519_SHA512_ELL2:
+		msg := make([]byte, 0, len(salt)+len(alpha))
+		msg = append(msg, salt...)
+		msg = append(msg, alpha...)
+		// dst = "ECVRF_" ∥ h2c_suite_ID_string ∥ suite_string
+		dst := append([]byte("ECVRF_"+h2cSuiteID), byte(suite))
+		return edwards25519.EncodeToCurve(msg, dst)
+	default:
+		return edwards25519.PointAffine{}, errInvalidSuite
+	}
+}
+
+// encodeToCurveTAI implements ECVRF_encode_to_curve_try_and_increment (RFC 9381, section 5.4.1.1):
+// the first H = Hash(suite_string ∥ 0x01 ∥ salt ∥ alpha ∥ ctr ∥ 0x00)[:32], ctr = 0, 1, ..., which
+// decodes to a point is multiplied by the cofactor.
+func encodeToCurveTAI(salt, alpha []byte) (edwards25519.PointAffine, error) {
+	var H edwards25519.PointAffine
+	h := sha512.New()
+	for ctr := 0; ctr < 256; ctr++ {
+		h.Reset()
+		h.Write([]byte{byte(EDWARDS25519_SHA512_TAI), encodeToCurveDomainSeparatorFront})
+		h.Write(salt)
+		h.Write(alpha)
+		h.Write([]byte{byte(ctr), encodeToCurveDomainSeparatorBack})
+		if _, err := H.SetBytes(h.Sum(nil)[:sizePoint]); err == nil {
+			H.ScalarMultiplication(&H, cofactor)
+			return H, nil
+		}
+	}
+	return H, errEncode
+}
+
+// challenge returns c = Hash(suite_string ∥ 0x02 ∥ P1 ∥ ... ∥ P5 ∥ 0x00) truncated to cLen bytes,
+// the little-endian encoding of c
+func (suite Suite) challenge(points ...*edwards25519.PointAffine) [sizeChallenge]byte {
+	h := sha512.New()
+	h.Write([]byte{byte(suite), challengeGenerationDomainSeparatorFront})
+	for _, p := range points {
+		b := p.Bytes()
+		h.Write(b[:])
+	}
+	h.Write([]byte{challengeGenerationDomainSeparatorBack})
+	var c [sizeChallenge]byte
+	copy(c[:], h.Sum(nil))
+	return c
+}
+
+// gammaToHash returns β = Hash(suite_string ∥ 0x03 ∥ point_to_string(cofactor⋅Γ) ∥ 0x00)
+func (suite Suite) gammaToHash(gamma *edwards25519.PointAffine) []byte {
+	var cGamma edwards25519.PointAffine
+	cGamma.ScalarMultiplication(gamma, cofactor)
+
+	h := sha512.New()
+	h.Write([]byte{byte(suite), proofToHashDomainSeparatorFront})
+	b := cGamma.Bytes()
+	h.Write(b[:])
+	h.Write([]byte{proofToHashDomainSeparatorBack})
+	return h.Sum(nil)
+}
+
+// decodeProof parses pi as Γ ∥ c ∥ s, with c and s in little endian, and checks that Γ is
+// a valid point and s < q
+func decodeProof(pi []byte) (gamma edwards25519.PointAffine, c, s *big.Int, err error) {
+	if len(pi) != SizeProof {
+		err = errInvalidProof
+		return
+	}
+	if _, err = gamma.SetBytes(pi[:sizePoint]); err != nil {
+		return
+	}
+	var sString [sizeFr]byte
+	copy(sString[:], pi[sizePoint+sizeChallenge:])
+	sFr, err := fr.LittleEndian.Element(&sString)
+	if err != nil {
+		err = errInvalidProof
+		return
+	}
+	c = new(big.Int).SetBytes(reverse(pi[sizePoint : sizePoint+sizeChallenge]))
+	s = sFr.BigInt(new(big.Int))
+	return
+}
+
+// nonce returns k = Hash(prefix ∥ h_string) mod q, where prefix is the second half of
+// SHA-512(seed), as in Ed25519 (RFC 9381, section 5.4.2.2).
+func nonce(privateKey *PrivateKey, hString []byte) fr.Element {
+	h := sha512.New()
+	h.Write(privateKey.prefix[:])
+	h.Write(hString)
+
+	var k fr.Element
+	setBytesLE(&k, h.Sum(nil))
+	return k
+}
+
+// setBytesLE sets z to the little-endian integer b modulo q. b is processed in chunks of 31 bytes,
+// which are smaller than q, so that the reduction doesn't branch on the (secret) value of b.
+func setBytesLE(z *fr.Element, b []byte) *fr.Element {
+	const chunkSize = 31
+	z.SetZero()
+	var chunk fr.Element
+	var buf [sizeFr]byte
+	// most significant chunk first
+	for i := (len(b)+chunkSize-1)/chunkSize - 1; i >= 0; i-- {
+		start, stop := i*chunkSize, (i+1)*chunkSize
+		if stop > len(b) {
+			stop = len(b)
+		}
+		buf = [sizeFr]byte{}
+		copy(buf[:], b[start:stop])
+		chunk, _ = fr.LittleEndian.Element(&buf)
+		z.Mul(z, &twoTo248).Add(z, &chunk)
+	}
+	return z
+}
+
+// reverse returns a reversed copy of b, to switch between little and big endian
+func reverse(b []byte) []byte {
+	res := make([]byte, len(b))
+	for i := range b {
+		res[len(b)-1-i] = b[i]
+	}
+	return res
+}
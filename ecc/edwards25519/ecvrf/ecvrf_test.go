@@ -0,0 +1,178 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecvrf
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestECVRF(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+
+	for _, suite := range suites {
+		suite := suite
+
+		properties.Property("["+suite.String()+"] test the proving and verification", prop.ForAll(
+			func(alpha []byte) bool {
+
+				privKey, _ := GenerateKey(rand.Reader)
+				publicKey := privKey.PublicKey
+
+				pi, beta, err := suite.Prove(privKey, alpha)
+				if err != nil || len(pi) != SizeProof || len(beta) != SizeOutput {
+					return false
+				}
+				betaVerify, ok := suite.Verify(&publicKey, alpha, pi)
+				if !ok || !bytes.Equal(beta, betaVerify) {
+					return false
+				}
+				betaProof, err := suite.ProofToHash(pi)
+				return err == nil && bytes.Equal(beta, betaProof)
+			},
+			gen.SliceOf(gen.UInt8()),
+		))
+
+		properties.Property("["+suite.String()+"] proofs should be deterministic", prop.ForAll(
+			func(alpha []byte) bool {
+
+				privKey, _ := GenerateKey(rand.Reader)
+
+				pi1, beta1, _ := suite.Prove(privKey, alpha)
+				pi2, beta2, _ := suite.Prove(privKey, alpha)
+
+				return bytes.Equal(pi1, pi2) && bytes.Equal(beta1, beta2)
+			},
+			gen.SliceOf(gen.UInt8()),
+		))
+
+		properties.Property("["+suite.String()+"] verification should fail on a different input or key", prop.ForAll(
+			func(alpha []byte) bool {
+
+				privKey, _ := GenerateKey(rand.Reader)
+				otherKey, _ := GenerateKey(rand.Reader)
+
+				pi, beta, _ := suite.Prove(privKey, alpha)
+				_, beta2, _ := suite.Prove(privKey, append(alpha, 0))
+				if bytes.Equal(beta, beta2) {
+					return false
+				}
+
+				if _, ok := suite.Verify(&privKey.PublicKey, append(alpha, 0), pi); ok {
+					return false
+				}
+				_, ok := suite.Verify(&otherKey.PublicKey, alpha, pi)
+				return !ok
+			},
+			gen.SliceOf(gen.UInt8()),
+		))
+
+		properties.Property("["+suite.String()+"] verification should fail on a tampered proof", prop.ForAll(
+			func(i int, bit uint) bool {
+
+				privKey, _ := GenerateKey(rand.Reader)
+				alpha := []byte("testing ECVRF")
+
+				pi, _, _ := suite.Prove(privKey, alpha)
+				pi[i] ^= 1 << bit
+				_, ok := suite.Verify(&privKey.PublicKey, alpha, pi)
+				return !ok
+			},
+			gen.IntRange(0, SizeProof-1),
+			gen.UIntRange(0, 7),
+		))
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestMalformedProof(t *testing.T) {
+	t.Parallel()
+
+	for _, suite := range suites {
+		privKey, _ := GenerateKey(rand.Reader)
+		alpha := []byte("testing ECVRF")
+		pi, _, _ := suite.Prove(privKey, alpha)
+
+		if _, ok := suite.Verify(&privKey.PublicKey, alpha, pi[:SizeProof-1]); ok {
+			t.Fatalf("%s: truncated proof should not verify", suite)
+		}
+		if _, err := suite.ProofToHash(append(pi, 0)); err == nil {
+			t.Fatalf("%s: proof of wrong size should be rejected", suite)
+		}
+
+		// s ≥ q
+		tampered := make([]byte, SizeProof)
+		copy(tampered, pi)
+		for i := sizePoint + sizeChallenge; i < SizeProof; i++ {
+			tampered[i] = 0xff
+		}
+		if _, err := suite.ProofToHash(tampered); err == nil {
+			t.Fatalf("%s: proof with s ≥ q should be rejected", suite)
+		}
+
+		var pk PublicKey
+		if _, ok := suite.Verify(&pk, alpha, pi); ok {
+			t.Fatalf("%s: proof should not verify under the identity public key", suite)
+		}
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkProveECVRF(b *testing.B) {
+
+	privKey, _ := GenerateKey(rand.Reader)
+
+	alpha := []byte("benchmarking ECVRF prove()")
+	for _, suite := range suites {
+		b.Run(suite.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				suite.Prove(privKey, alpha)
+			}
+		})
+	}
+}
+
+func BenchmarkVerifyECVRF(b *testing.B) {
+
+	privKey, _ := GenerateKey(rand.Reader)
+	alpha := []byte("benchmarking ECVRF prove()")
+
+	for _, suite := range suites {
+		pi, _, _ := suite.Prove(privKey, alpha)
+		b.Run(suite.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				suite.Verify(&privKey.PublicKey, alpha, pi)
+			}
+		})
+	}
+}
+
+var suites = []Suite{EDWARDS25519_SHA512_TAI, EDWARDS25519_SHA512_ELL2}
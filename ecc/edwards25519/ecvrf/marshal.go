@@ -0,0 +1,61 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecvrf
+
+import (
+	"io"
+)
+
+// Bytes returns the binary representation of the public key,
+// as the compressed encoding of the point Y (see edwards25519.PointAffine.Bytes).
+func (pk *PublicKey) Bytes() []byte {
+	res := pk.A.Bytes()
+	return res[:]
+}
+
+// SetBytes sets pk from its compressed encoding in buf.
+// It returns the number of bytes read from the buffer.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizePublicKey {
+		return 0, io.ErrShortBuffer
+	}
+	return pk.A.SetBytes(buf[:sizePublicKey])
+}
+
+// Equal compares 2 public keys
+func (pk *PublicKey) Equal(x *PublicKey) bool {
+	return pk.A.Equal(&x.A)
+}
+
+// Bytes returns the binary representation of privKey, its 32-byte seed.
+func (privKey *PrivateKey) Bytes() []byte {
+	var res [sizePrivateKey]byte
+	copy(res[:], privKey.seed[:])
+	return res[:]
+}
+
+// SetBytes sets privKey from its 32-byte seed in buf, and derives the public key.
+// It returns the number byte read.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizePrivateKey {
+		return 0, io.ErrShortBuffer
+	}
+	sk, err := NewKeyFromSeed(buf[:sizePrivateKey])
+	if err != nil {
+		return 0, err
+	}
+	*privKey = *sk
+	return sizePrivateKey, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecvrf
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// vector is a test vector of RFC 9381, appendix B.3 (ECVRF-EDWARDS25519-SHA512-TAI, examples 16 to 18)
+// and appendix B.4 (ECVRF-EDWARDS25519-SHA512-ELL2, examples 19 to 21). The keys are those of the
+// test vectors of RFC 8032, section 7.1.
+type vector struct {
+	suite Suite
+	sk    string // the seed SK
+	pk    string // the public key Y
+	alpha string
+	h     string // the encoding of H = encode_to_curve(Y, alpha)
+	pi    string
+	beta  string
+}
+
+var vectors = []vector{
+	{
+		suite: EDWARDS25519_SHA512_TAI,
+		sk:    "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+		pk:    "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		alpha: "",
+		h:     "91bbed02a99461df1ad4c6564a5f5d829d0b90cfc7903e7a5797bd658abf3318",
+		pi:    "8657106690b5526245a92b003bb079ccd1a92130477671f6fc01ad16f26f723f26f8a57ccaed74ee1b190bed1f479d9727d2d0f9b005a6e456a35d4fb0daab1268a1b0db10836d9826a528ca76567805",
+		beta:  "90cf1df3b703cce59e2a35b925d411164068269d7b2d29f3301c03dd757876ff66b71dda49d2de59d03450451af026798e8f81cd2e333de5cdf4f3e140fdd8ae",
+	},
+	{
+		suite: EDWARDS25519_SHA512_TAI,
+		sk:    "4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+		pk:    "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+		alpha: "72",
+		h:     "5b659fc3d4e9263fd9a4ed1d022d75eaacc20df5e09f9ea937502396598dc551",
+		pi:    "f3141cd382dc42909d19ec5110469e4feae18300e94f304590abdced48aed5933bf0864a62558b3ed7f2fea45c92a465301b3bbf5e3e54ddf2d935be3b67926da3ef39226bbc355bdc9850112c8f4b02",
+		beta:  "eb4440665d3891d668e7e0fcaf587f1b4bd7fbfe99d0eb2211ccec90496310eb5e33821bc613efb94db5e5b54c70a848a0bef4553a41befc57663b56373a5031",
+	},
+	{
+		suite: EDWARDS25519_SHA512_TAI,
+		sk:    "c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7",
+		pk:    "fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025",
+		alpha: "af82",
+		h:     "bf4339376f5542811de615e3313d2b36f6f53c0acfebb482159711201192576a",
+		pi:    "9bc0f79119cc5604bf02d23b4caede71393cedfbb191434dd016d30177ccbf8096bb474e53895c362d8628ee9f9ea3c0e52c7a5c691b6c18c9979866568add7a2d41b00b05081ed0f58ee5e31b3a970e",
+		beta:  "645427e5d00c62a23fb703732fa5d892940935942101e456ecca7bb217c61c452118fec1219202a0edcf038bb6373241578be7217ba85a2687f7a0310b2df19f",
+	},
+	{
+		suite: EDWARDS25519_SHA512_ELL2,
+		sk:    "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+		pk:    "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		alpha: "",
+		h:     "b8066ebbb706c72b64390324e4a3276f129569eab100c26b9f05011200c1bad9",
+		pi:    "7d9c633ffeee27349264cf5c667579fc583b4bda63ab71d001f89c10003ab46f14adf9a3cd8b8412d9038531e865c341cafa73589b023d14311c331a9ad15ff2fb37831e00f0acaa6d73bc9997b06501",
+		beta:  "9d574bf9b8302ec0fc1e21c3ec5368269527b87b462ce36dab2d14ccf80c53cccf6758f058c5b1c856b116388152bbe509ee3b9ecfe63d93c3b4346c1fbc6c54",
+	},
+	{
+		suite: EDWARDS25519_SHA512_ELL2,
+		sk:    "4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+		pk:    "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+		alpha: "72",
+		h:     "76ac3ccb86158a9104dff819b1ca293426d305fd76b39b13c9356d9b58c08e57",
+		pi:    "47b327393ff2dd81336f8a2ef10339112401253b3c714eeda879f12c509072ef055b48372bb82efbdce8e10c8cb9a2f9d60e93908f93df1623ad78a86a028d6bc064dbfc75a6a57379ef855dc6733801",
+		beta:  "38561d6b77b71d30eb97a062168ae12b667ce5c28caccdf76bc88e093e4635987cd96814ce55b4689b3dd2947f80e59aac7b7675f8083865b46c89b2ce9cc735",
+	},
+	{
+		suite: EDWARDS25519_SHA512_ELL2,
+		sk:    "c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7",
+		pk:    "fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025",
+		alpha: "af82",
+		h:     "13d2a8b5ca32db7e98094a61f656a08c6c964344e058879a386a947a4e189ed1",
+		pi:    "926e895d308f5e328e7aa159c06eddbe56d06846abf5d98c2512235eaa57fdce35b46edfc655bc828d44ad09d1150f31374e7ef73027e14760d42e77341fe05467bb286cc2c9d7fde29120a0b2320d04",
+		beta:  "121b7f9b9aaaa29099fc04a94ba52784d44eac976dd1a3cca458733be5cd090a7b5fbd148444f17f8daf1fb55cb04b1ae85a626e30a54b4b0f8abf4a43314a58",
+	},
+}
+
+func TestVectors(t *testing.T) {
+	assert := require.New(t)
+
+	decodeHex := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		assert.NoError(err)
+		return b
+	}
+
+	for _, v := range vectors {
+		sk, err := NewKeyFromSeed(decodeHex(v.sk))
+		assert.NoError(err)
+		assert.Equal(v.pk, hex.EncodeToString(sk.PublicKey.Bytes()))
+
+		alpha := decodeHex(v.alpha)
+		H, err := v.suite.encodeToCurve(sk.PublicKey.Bytes(), alpha)
+		assert.NoError(err)
+		hString := H.Bytes()
+		assert.Equal(v.h, hex.EncodeToString(hString[:]))
+
+		pi, beta, err := v.suite.Prove(sk, alpha)
+		assert.NoError(err)
+		assert.Equal(v.pi, hex.EncodeToString(pi))
+		assert.Equal(v.beta, hex.EncodeToString(beta))
+
+		var pk PublicKey
+		_, err = pk.SetBytes(decodeHex(v.pk))
+		assert.NoError(err)
+		betaVerify, ok := v.suite.Verify(&pk, alpha, decodeHex(v.pi))
+		assert.True(ok)
+		assert.True(bytes.Equal(beta, betaVerify))
+
+		betaProof, err := v.suite.ProofToHash(decodeHex(v.pi))
+		assert.NoError(err)
+		assert.Equal(v.beta, hex.EncodeToString(betaProof))
+	}
+}
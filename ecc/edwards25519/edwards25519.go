@@ -0,0 +1,79 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package edwards25519 implements the twisted Edwards curve edwards25519 of RFC 8032 (the curve of Ed25519),
+// with the point encoding of https://www.rfc-editor.org/rfc/rfc8032#section-5.1.2 and the hash to curve
+// suites of https://www.rfc-editor.org/rfc/rfc9380#section-8.5.
+//
+// edwards25519: a twisted Edwards curve birationally equivalent to curve25519, with
+//
+//	𝔽r: ℓ=7237005577332262213973186563042994240857116359379907606001950938285454250989 (2²⁵²+27742317777372353535851937790883648493)
+//	𝔽p: p=57896044618658097711785492504343953926634992332820282019728792003956564819949 (2²⁵⁵-19)
+//	(E/𝔽p): -x²+y²=1+d⋅x²⋅y² where d=-121665/121666
+//	cofactor: 8
+//
+// Unlike the twisted Edwards companion curves of the pairing-friendly curves, its base field 𝔽p is not the
+// scalar field of another curve of this module: the fields fp and fr are generated, the curve arithmetic is not.
+//
+// Security: estimated 126-bit level using Pollard's \rho attack
+// (ℓ is 253 bits)
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. Only ScalarMultiplicationCT is meant for secret scalars.
+package edwards25519
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/edwards25519/fp"
+)
+
+const (
+	// SizePointCompressed is the size in bytes of a point encoded as in RFC 8032
+	SizePointCompressed = fp.Bytes
+	// Cofactor of the curve, whose order is Cofactor⋅ℓ with ℓ = fr.Modulus()
+	Cofactor = 8
+)
+
+var (
+	// d is the coefficient of the curve equation
+	d fp.Element
+	// d2 = 2⋅d, used in the addition formulas
+	d2 fp.Element
+	// sqrtM1 is the square root of -1 with an even representative
+	sqrtM1 fp.Element
+
+	// base point B of RFC 8032, generator of the subgroup of order ℓ
+	baseAff PointAffine
+	base    PointExtended
+)
+
+func init() {
+	d.SetString("37095705934669439343138083508754565189542113879843219016388785533085940283555")
+	d2.Double(&d)
+	sqrtM1.SetString("19681161376707505956807079304988542015446066515923890162744021073123829784752")
+
+	baseAff.X.SetString("15112221349535400772501151409588531511454012693041857206046113283949847762202")
+	baseAff.Y.SetString("46316835694926478169428394003475163141307993866256225615783033603165251855960")
+	base.FromAffine(&baseAff)
+}
+
+// Generator returns the base point B of RFC 8032, which generates the subgroup of order ℓ
+func Generator() PointAffine {
+	return baseAff
+}
+
+// CurveCoefficient returns the coefficient d of the curve equation -x²+y²=1+d⋅x²⋅y²
+func CurveCoefficient() fp.Element {
+	return d
+}
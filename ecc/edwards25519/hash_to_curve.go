@@ -0,0 +1,161 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edwards25519
+
+import (
+	"crypto/sha512"
+
+	"github.com/consensys/gnark-crypto/ecc/edwards25519/fp"
+	"github.com/consensys/gnark-crypto/field/hash"
+)
+
+// Elligator 2 constants of curve25519: t² = s³ + J·s² + s, with J = 486662 (K = 1)
+var (
+	elligator2J fp.Element
+	elligator2Z fp.Element // non-square Z = 2
+	// sqrtM486664 = √-486664 with an even representative, scales the birational map to edwards25519
+	sqrtM486664 fp.Element
+)
+
+func init() {
+	elligator2J.SetUint64(486662)
+	elligator2Z.SetUint64(2)
+	sqrtM486664.SetString("6853475219497561581579357271197624642482790079785650197046958215289687604742")
+}
+
+// MapToCurve maps a field element to a point of the curve, with the Elligator 2 method
+// (https://www.rfc-editor.org/rfc/rfc9380#section-6.7.1) on curve25519, followed by the birational map
+// to edwards25519 of https://www.rfc-editor.org/rfc/rfc7748#section-4.1, as in map_to_curve_elligator2_edwards25519
+// (https://www.rfc-editor.org/rfc/rfc9380#appendix-G.2.2).
+//
+// The returned point is not in the prime order subgroup in general.
+func MapToCurve(u *fp.Element) PointAffine {
+	var one, tv, x1, x2, gx, y fp.Element
+	one.SetOne()
+
+	// x1 = -J · inv0(1 + Z·u²)
+	tv.Square(u).
+		Mul(&tv, &elligator2Z).
+		Add(&tv, &one).
+		Inverse(&tv)
+	x1.Mul(&tv, &elligator2J).Neg(&x1)
+	if x1.IsZero() {
+		x1.Neg(&elligator2J)
+	}
+
+	// x2 = -x1 - J
+	x2.Add(&x1, &elligator2J).Neg(&x2)
+
+	// gx1 = x1³ + J·x1² + x1
+	elligator2G(&gx, &x1)
+
+	x := &x1
+	sgn0 := uint64(1)
+	if gx.Legendre() == -1 {
+		// gx2 = gx1·Z·u² is a square
+		elligator2G(&gx, &x2)
+		x = &x2
+		sgn0 = 0
+	}
+	y.Sqrt(&gx)
+	if y.Bits()[0]&1 != sgn0 {
+		y.Neg(&y)
+	}
+
+	// (v, w) = (√-486664·s/t, (s-1)/(s+1)), exceptional cases are mapped to the identity
+	var p PointAffine
+	tv.Add(x, &one)
+	if y.IsZero() || tv.IsZero() {
+		p.setInfinity()
+		return p
+	}
+	p.X.Mul(x, &sqrtM486664).Div(&p.X, &y)
+	p.Y.Sub(x, &one).Div(&p.Y, &tv)
+
+	return p
+}
+
+// elligator2G sets z = x³ + J·x² + x
+func elligator2G(z, x *fp.Element) {
+	var tv fp.Element
+	tv.Add(x, &elligator2J).
+		Mul(&tv, x).
+		Add(&tv, new(fp.Element).SetOne())
+	z.Mul(&tv, x)
+}
+
+// EncodeToCurve hashes a message to a point of the prime order subgroup of the curve,
+// following the encode_to_curve method of https://www.rfc-editor.org/rfc/rfc9380#section-3
+// with expand_message_xmd (SHA-512) and the Elligator 2 map: this is the suite
+// edwards25519_XMD:SHA-512_ELL2_NU_ of https://www.rfc-editor.org/rfc/rfc9380#section-8.5.
+//
+// The output distribution is not uniform: use HashToCurve unless the protocol only requires
+// an encoding. dst is the domain separation tag, it must be unique to the application.
+// The ciphersuite defaults to expand_message_xmd with SHA-512, and can be changed with hash.Option.
+func EncodeToCurve(msg, dst []byte, opts ...hash.Option) (PointAffine, error) {
+	var res PointAffine
+	u, err := fp.Hash(msg, dst, 1, withSHA512(opts)...)
+	if err != nil {
+		return res, err
+	}
+
+	q := MapToCurve(&u[0])
+	var p PointExtended
+	p.FromAffine(&q)
+	clearCofactor(&p)
+
+	res.FromExtended(&p)
+	return res, nil
+}
+
+// HashToCurve hashes a message to a point of the prime order subgroup of the curve,
+// following the hash_to_curve method of https://www.rfc-editor.org/rfc/rfc9380#section-3
+// with expand_message_xmd (SHA-512) and the Elligator 2 map: this is the suite
+// edwards25519_XMD:SHA-512_ELL2_RO_ of https://www.rfc-editor.org/rfc/rfc9380#section-8.5.
+//
+// The output is indistinguishable from a uniformly random point of the subgroup.
+// dst is the domain separation tag, it must be unique to the application.
+// The ciphersuite defaults to expand_message_xmd with SHA-512, and can be changed with hash.Option.
+func HashToCurve(msg, dst []byte, opts ...hash.Option) (PointAffine, error) {
+	var res PointAffine
+	u, err := fp.Hash(msg, dst, 2, withSHA512(opts)...)
+	if err != nil {
+		return res, err
+	}
+
+	q0 := MapToCurve(&u[0])
+	q1 := MapToCurve(&u[1])
+	var p, p1 PointExtended
+	p.FromAffine(&q0)
+	p1.FromAffine(&q1)
+	p.Add(&p, &p1)
+	clearCofactor(&p)
+
+	res.FromExtended(&p)
+	return res, nil
+}
+
+// withSHA512 prepends expand_message_xmd with SHA-512, the hash function of the edwards25519 suites,
+// to the options, so that it is the default.
+func withSHA512(opts []hash.Option) []hash.Option {
+	return append([]hash.Option{hash.WithExpandMsgXmd(sha512.New)}, opts...)
+}
+
+// clearCofactor multiplies p by the cofactor 8
+func clearCofactor(p *PointExtended) {
+	p.Double(p)
+	p.Double(p)
+	p.Double(p)
+}
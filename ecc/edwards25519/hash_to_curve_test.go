@@ -0,0 +1,88 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edwards25519
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/edwards25519/fp"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestMapToCurve(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[EDWARDS25519] MapToCurve should output a point on the curve", prop.ForAll(
+		func(s big.Int) bool {
+			var u fp.Element
+			u.SetBigInt(&s)
+			p := MapToCurve(&u)
+			return p.IsOnCurve()
+		},
+		GenBigInt(),
+	))
+
+	properties.Property("[EDWARDS25519] EncodeToCurve and HashToCurve should output points of the subgroup", prop.ForAll(
+		func(s big.Int) bool {
+			msg := s.Bytes()
+			p, err := EncodeToCurve(msg, []byte("test"))
+			if err != nil || !p.IsOnCurve() || !p.IsInSubGroup() {
+				return false
+			}
+			p, err = HashToCurve(msg, []byte("test"))
+			return err == nil && p.IsOnCurve() && p.IsInSubGroup()
+		},
+		GenBigInt(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// TestEncodeToCurveVector checks the test vector of the suite edwards25519_XMD:SHA-512_ELL2_NU_
+// for the empty message, from https://www.rfc-editor.org/rfc/rfc9380#appendix-J.5.2.
+func TestEncodeToCurveVector(t *testing.T) {
+	t.Parallel()
+	dst := []byte("QUUX-V01-CS02-with-edwards25519_XMD:SHA-512_ELL2_NU_")
+
+	var u, qx, qy, px, py fp.Element
+	u.SetString("0x7f3e7fb9428103ad7f52db32f9df32505d7b427d894c5093f7a0f0374a30641d")
+	qx.SetString("0x42836f691d05211ebc65ef8fcf01e0fb6328ec9c4737c26050471e50803022eb")
+	qy.SetString("0x22cb4aaa555e23bd460262d2130d6a3c9207aa8bbb85060928beb263d6d42a95")
+	px.SetString("0x1ff2b70ecf862799e11b7ae744e3489aa058ce805dd323a936375a84695e76da")
+	py.SetString("0x222e314d04a4d5725e9f2aff9fb2a6b69ef375a1214eb19021ceab2d687f0f9b")
+
+	q := MapToCurve(&u)
+	if !q.X.Equal(&qx) || !q.Y.Equal(&qy) {
+		t.Fatal("MapToCurve: unexpected point")
+	}
+
+	p, err := EncodeToCurve([]byte{}, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.X.Equal(&px) || !p.Y.Equal(&py) {
+		t.Fatal("EncodeToCurve: unexpected point")
+	}
+}
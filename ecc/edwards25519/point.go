@@ -0,0 +1,379 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edwards25519
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/edwards25519/fp"
+	"github.com/consensys/gnark-crypto/ecc/edwards25519/fr"
+)
+
+var errInvalidEncoding = errors.New("invalid point encoding")
+
+// PointAffine point on edwards25519 in affine coordinates (x, y)
+type PointAffine struct {
+	X, Y fp.Element
+}
+
+// PointExtended point on edwards25519 in extended coordinates (X:Y:Z:T), with x=X/Z, y=Y/Z and x⋅y=T/Z
+type PointExtended struct {
+	X, Y, Z, T fp.Element
+}
+
+//-------- Affine coordinates
+
+// Bytes returns the compressed point as a byte array, as in https://www.rfc-editor.org/rfc/rfc8032#section-5.1.2:
+// y in little endian, with the least significant bit of x in the most significant bit of the last byte.
+func (p *PointAffine) Bytes() [SizePointCompressed]byte {
+	var res [SizePointCompressed]byte
+	fp.LittleEndian.PutElement(&res, p.Y)
+	res[SizePointCompressed-1] |= byte(p.X.Bits()[0]&1) << 7
+	return res
+}
+
+// Marshal converts p to a byte slice
+func (p *PointAffine) Marshal() []byte {
+	b := p.Bytes()
+	return b[:]
+}
+
+// SetBytes sets p from buf, following https://www.rfc-editor.org/rfc/rfc8032#section-5.1.3.
+// len(buf) >= SizePointCompressed
+//
+// It rejects non-canonical encodings of y, encodings of points which are not on the curve, and
+// x=0 with the sign bit set. It doesn't check that p is in the prime order subgroup.
+// Returns the number of read bytes and an error if the buffer is too short or the encoding is invalid.
+func (p *PointAffine) SetBytes(buf []byte) (int, error) {
+	if len(buf) < SizePointCompressed {
+		return 0, io.ErrShortBuffer
+	}
+	var b [SizePointCompressed]byte
+	copy(b[:], buf[:SizePointCompressed])
+	sign := uint64(b[SizePointCompressed-1] >> 7)
+	b[SizePointCompressed-1] &= 0x7f
+
+	y, err := fp.LittleEndian.Element(&b)
+	if err != nil {
+		return 0, errInvalidEncoding
+	}
+
+	// x² = (y²-1) / (d⋅y²+1)
+	var one, num, den, x fp.Element
+	one.SetOne()
+	num.Square(&y)
+	den.Mul(&num, &d).Add(&den, &one)
+	num.Sub(&num, &one)
+	x.Div(&num, &den)
+	if x.Sqrt(&x) == nil {
+		return 0, errInvalidEncoding
+	}
+	if x.IsZero() && sign == 1 {
+		return 0, errInvalidEncoding
+	}
+	if x.Bits()[0]&1 != sign {
+		x.Neg(&x)
+	}
+
+	p.X, p.Y = x, y
+	return SizePointCompressed, nil
+}
+
+// Unmarshal alias to SetBytes()
+func (p *PointAffine) Unmarshal(b []byte) error {
+	_, err := p.SetBytes(b)
+	return err
+}
+
+// Set sets p to p1 and return it
+func (p *PointAffine) Set(p1 *PointAffine) *PointAffine {
+	p.X.Set(&p1.X)
+	p.Y.Set(&p1.Y)
+	return p
+}
+
+// Equal returns true if p=p1 false otherwise
+func (p *PointAffine) Equal(p1 *PointAffine) bool {
+	return p.X.Equal(&p1.X) && p.Y.Equal(&p1.Y)
+}
+
+// IsZero returns true if p=0 false otherwise
+func (p *PointAffine) IsZero() bool {
+	var one fp.Element
+	one.SetOne()
+	return p.X.IsZero() && p.Y.Equal(&one)
+}
+
+// IsOnCurve checks if a point is on the curve
+func (p *PointAffine) IsOnCurve() bool {
+	var lhs, rhs, xx, yy fp.Element
+	xx.Square(&p.X)
+	yy.Square(&p.Y)
+
+	// -x²+y²
+	lhs.Sub(&yy, &xx)
+
+	// 1+d⋅x²⋅y²
+	rhs.Mul(&xx, &yy).Mul(&rhs, &d)
+	rhs.Add(&rhs, new(fp.Element).SetOne())
+
+	return lhs.Equal(&rhs)
+}
+
+// IsInSubGroup checks that p is in the subgroup of order ℓ
+func (p *PointAffine) IsInSubGroup() bool {
+	var q PointExtended
+	q.FromAffine(p)
+	q.ScalarMultiplication(&q, fr.Modulus())
+	return q.IsZero()
+}
+
+// Neg sets p to -p1 and returns it
+func (p *PointAffine) Neg(p1 *PointAffine) *PointAffine {
+	p.X.Neg(&p1.X)
+	p.Y.Set(&p1.Y)
+	return p
+}
+
+// Add sets p to p1+p2 and returns it
+func (p *PointAffine) Add(p1, p2 *PointAffine) *PointAffine {
+	var q1, q2 PointExtended
+	q1.FromAffine(p1)
+	q2.FromAffine(p2)
+	q1.Add(&q1, &q2)
+	return p.FromExtended(&q1)
+}
+
+// Double sets p to 2⋅p1 and returns it
+func (p *PointAffine) Double(p1 *PointAffine) *PointAffine {
+	var q PointExtended
+	q.FromAffine(p1)
+	q.Double(&q)
+	return p.FromExtended(&q)
+}
+
+// FromExtended sets p in affine from p in extended coordinates
+func (p *PointAffine) FromExtended(p1 *PointExtended) *PointAffine {
+	var I fp.Element
+	I.Inverse(&p1.Z)
+	p.X.Mul(&p1.X, &I)
+	p.Y.Mul(&p1.Y, &I)
+	return p
+}
+
+// ScalarMultiplication scalar multiplication of a point
+// p1 in affine coordinates with a scalar in big.Int
+func (p *PointAffine) ScalarMultiplication(p1 *PointAffine, scalar *big.Int) *PointAffine {
+	var q PointExtended
+	q.FromAffine(p1)
+	q.ScalarMultiplication(&q, scalar)
+	return p.FromExtended(&q)
+}
+
+// ScalarMultiplicationCT scalar multiplication of a point p1 in affine coordinates
+// with a scalar in fr.Element, in constant time with respect to the scalar.
+//
+// See PointExtended.ScalarMultiplicationCT.
+func (p *PointAffine) ScalarMultiplicationCT(p1 *PointAffine, scalar *fr.Element) *PointAffine {
+	var q PointExtended
+	q.FromAffine(p1)
+	q.ScalarMultiplicationCT(&q, scalar)
+	return p.FromExtended(&q)
+}
+
+// setInfinity sets p to O (0:1)
+func (p *PointAffine) setInfinity() *PointAffine {
+	p.X.SetZero()
+	p.Y.SetOne()
+	return p
+}
+
+// ------- Extended coordinates
+
+// Set sets p to p1 and return it
+func (p *PointExtended) Set(p1 *PointExtended) *PointExtended {
+	p.X.Set(&p1.X)
+	p.Y.Set(&p1.Y)
+	p.Z.Set(&p1.Z)
+	p.T.Set(&p1.T)
+	return p
+}
+
+// IsZero returns true if p=0 false otherwise
+func (p *PointExtended) IsZero() bool {
+	return p.X.IsZero() && p.Y.Equal(&p.Z)
+}
+
+// Equal returns true if p=p1 false otherwise
+func (p *PointExtended) Equal(p1 *PointExtended) bool {
+	var lhs, rhs fp.Element
+	lhs.Mul(&p.X, &p1.Z)
+	rhs.Mul(&p1.X, &p.Z)
+	if !lhs.Equal(&rhs) {
+		return false
+	}
+	lhs.Mul(&p.Y, &p1.Z)
+	rhs.Mul(&p1.Y, &p.Z)
+	return lhs.Equal(&rhs)
+}
+
+// Neg sets p to -p1 and returns it
+func (p *PointExtended) Neg(p1 *PointExtended) *PointExtended {
+	p.X.Neg(&p1.X)
+	p.Y.Set(&p1.Y)
+	p.Z.Set(&p1.Z)
+	p.T.Neg(&p1.T)
+	return p
+}
+
+// FromAffine sets p in extended from p in affine
+func (p *PointExtended) FromAffine(p1 *PointAffine) *PointExtended {
+	p.X.Set(&p1.X)
+	p.Y.Set(&p1.Y)
+	p.Z.SetOne()
+	p.T.Mul(&p1.X, &p1.Y)
+	return p
+}
+
+// Add adds points in extended coordinates. The formulas are complete on edwards25519,
+// since a=-1 is a square and d is not.
+// See https://hyperelliptic.org/EFD/g1p/auto-twisted-extended-1.html#addition-add-2008-hwcd-3
+func (p *PointExtended) Add(p1, p2 *PointExtended) *PointExtended {
+	var A, B, C, D, E, F, G, H, tmp fp.Element
+	A.Sub(&p1.Y, &p1.X)
+	tmp.Sub(&p2.Y, &p2.X)
+	A.Mul(&A, &tmp)
+	B.Add(&p1.Y, &p1.X)
+	tmp.Add(&p2.Y, &p2.X)
+	B.Mul(&B, &tmp)
+	C.Mul(&p1.T, &p2.T).Mul(&C, &d2)
+	D.Mul(&p1.Z, &p2.Z).Double(&D)
+	E.Sub(&B, &A)
+	F.Sub(&D, &C)
+	G.Add(&D, &C)
+	H.Add(&B, &A)
+
+	p.X.Mul(&E, &F)
+	p.Y.Mul(&G, &H)
+	p.T.Mul(&E, &H)
+	p.Z.Mul(&F, &G)
+	return p
+}
+
+// Double doubles points in extended coordinates
+// See https://hyperelliptic.org/EFD/g1p/auto-twisted-extended-1.html#doubling-dbl-2008-hwcd
+func (p *PointExtended) Double(p1 *PointExtended) *PointExtended {
+	var A, B, C, D, E, F, G, H fp.Element
+	A.Square(&p1.X)
+	B.Square(&p1.Y)
+	C.Square(&p1.Z).Double(&C)
+	D.Neg(&A) // a = -1
+	E.Add(&p1.X, &p1.Y).Square(&E).Sub(&E, &A).Sub(&E, &B)
+	G.Add(&D, &B)
+	F.Sub(&G, &C)
+	H.Sub(&D, &B)
+
+	p.X.Mul(&E, &F)
+	p.Y.Mul(&G, &H)
+	p.T.Mul(&E, &H)
+	p.Z.Mul(&F, &G)
+	return p
+}
+
+// setInfinity sets p to O (0:1:1:0)
+func (p *PointExtended) setInfinity() *PointExtended {
+	p.X.SetZero()
+	p.Y.SetOne()
+	p.Z.SetOne()
+	p.T.SetZero()
+	return p
+}
+
+// ScalarMultiplication scalar multiplication of a point
+// p1 in extended coordinates with a scalar in big.Int.
+//
+// It runs in variable time and is meant for public scalars.
+func (p *PointExtended) ScalarMultiplication(p1 *PointExtended, scalar *big.Int) *PointExtended {
+	var _scalar big.Int
+	_scalar.Abs(scalar)
+
+	var resExtended, tmp PointExtended
+	resExtended.setInfinity()
+	tmp.Set(p1)
+	if scalar.Sign() == -1 {
+		tmp.Neg(&tmp)
+	}
+
+	for i := _scalar.BitLen() - 1; i >= 0; i-- {
+		resExtended.Double(&resExtended)
+		if _scalar.Bit(i) == 1 {
+			resExtended.Add(&resExtended, &tmp)
+		}
+	}
+
+	return p.Set(&resExtended)
+}
+
+// ctWindowSize is the window size of the constant-time scalar multiplication;
+// the lookup table holds the 2^ctWindowSize first multiples of the point.
+const ctWindowSize = 4
+
+// ScalarMultiplicationCT scalar multiplication of a point p1 in extended coordinates
+// with a scalar in fr.Element, in constant time with respect to the scalar.
+//
+// The 256 bits of the scalar are processed in fixed windows: the multiples of p1 are selected
+// from a lookup table with a constant-time scan and added with the complete formulas, so that
+// the sequence of group operations and memory accesses doesn't depend on the scalar.
+// The scalar is taken modulo ℓ: p1 should be in the prime order subgroup.
+func (p *PointExtended) ScalarMultiplicationCT(p1 *PointExtended, scalar *fr.Element) *PointExtended {
+	var table [1 << ctWindowSize]PointExtended
+	table[0].setInfinity()
+	table[1].Set(p1)
+	for i := 2; i < len(table); i++ {
+		table[i].Add(&table[i-1], p1)
+	}
+
+	b := scalar.Bytes() // big endian
+
+	var res, q PointExtended
+	res.setInfinity()
+	for i := 0; i < len(b); i++ {
+		for _, w := range [2]byte{b[i] >> 4, b[i] & 0xf} {
+			for j := 0; j < ctWindowSize; j++ {
+				res.Double(&res)
+			}
+			q.lookupCT(&table, w)
+			res.Add(&res, &q)
+		}
+	}
+
+	return p.Set(&res)
+}
+
+// lookupCT sets p = table[w], reading all the entries of the table
+func (p *PointExtended) lookupCT(table *[1 << ctWindowSize]PointExtended, w byte) *PointExtended {
+	p.setInfinity()
+	for i := 1; i < len(table); i++ {
+		c := subtle.ConstantTimeByteEq(byte(i), w)
+		p.X.Select(c, &p.X, &table[i].X)
+		p.Y.Select(c, &p.Y, &table[i].Y)
+		p.Z.Select(c, &p.Z, &table[i].Z)
+		p.T.Select(c, &p.T, &table[i].T)
+	}
+	return p
+}
@@ -0,0 +1,236 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edwards25519
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/edwards25519/fp"
+	"github.com/consensys/gnark-crypto/ecc/edwards25519/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+const (
+	nbFuzzShort = 10
+	nbFuzz      = 100
+)
+
+func TestGenerator(t *testing.T) {
+	t.Parallel()
+	g := Generator()
+	if !g.IsOnCurve() {
+		t.Fatal("generator is not on the curve")
+	}
+	if !g.IsInSubGroup() {
+		t.Fatal("generator is not in the subgroup of order ℓ")
+	}
+	// the generator is the point with y = 4/5 and an even x
+	var y fp.Element
+	y.SetUint64(5).Inverse(&y).Mul(&y, new(fp.Element).SetUint64(4))
+	if !g.Y.Equal(&y) || g.X.Bits()[0]&1 != 0 {
+		t.Fatal("unexpected generator")
+	}
+}
+
+// TestPublicKeyEd25519 checks the arithmetic and the encoding against the Ed25519 public keys of
+// the standard library, which are [s]B for the clamped scalar s derived from the seed.
+func TestPublicKeyEd25519(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genSeed := gen.SliceOfN(ed25519.SeedSize, gen.UInt8())
+
+	properties.Property("[s]B should match the Ed25519 public key", prop.ForAll(
+		func(seed []byte) bool {
+			expected := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+
+			h := sha512.Sum512(seed)
+			h[0] &= 248
+			h[31] &= 127
+			h[31] |= 64
+			var s big.Int
+			s.SetBytes(reverse(h[:32]))
+
+			g := Generator()
+			var p, pCT PointAffine
+			p.ScalarMultiplication(&g, &s)
+
+			var sFr fr.Element
+			sFr.SetBigInt(&s)
+			pCT.ScalarMultiplicationCT(&g, &sFr)
+
+			b, bCT := p.Bytes(), pCT.Bytes()
+			return bytes.Equal(b[:], expected) && bytes.Equal(bCT[:], expected)
+		},
+		genSeed,
+	))
+
+	properties.Property("SetBytes should invert Bytes", prop.ForAll(
+		func(seed []byte) bool {
+			pk := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+			var p PointAffine
+			if _, err := p.SetBytes(pk); err != nil {
+				return false
+			}
+			b := p.Bytes()
+			return p.IsOnCurve() && p.IsInSubGroup() && bytes.Equal(b[:], pk)
+		},
+		genSeed,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestGroupLaw(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := gen.UInt64()
+
+	properties.Property("[a]B+[b]B should be [a+b]B", prop.ForAll(
+		func(a, b uint64) bool {
+			g := Generator()
+			var pa, pb, pab PointAffine
+			var sa, sb, sab big.Int
+			sa.SetUint64(a)
+			sb.SetUint64(b)
+			sab.Add(&sa, &sb)
+			pa.ScalarMultiplication(&g, &sa)
+			pb.ScalarMultiplication(&g, &sb)
+			pab.ScalarMultiplication(&g, &sab)
+			pa.Add(&pa, &pb)
+			return pa.Equal(&pab) && pa.IsOnCurve()
+		},
+		genScalar, genScalar,
+	))
+
+	properties.Property("P+P should be 2P and P-P the identity", prop.ForAll(
+		func(a uint64) bool {
+			g := Generator()
+			var p, sum, dbl, neg PointAffine
+			p.ScalarMultiplication(&g, new(big.Int).SetUint64(a))
+			sum.Add(&p, &p)
+			dbl.Double(&p)
+			neg.Neg(&p)
+			neg.Add(&neg, &p)
+			return sum.Equal(&dbl) && neg.IsZero()
+		},
+		genScalar,
+	))
+
+	properties.Property("[-s]P should be -[s]P", prop.ForAll(
+		func(a uint64) bool {
+			g := Generator()
+			var s big.Int
+			s.SetUint64(a)
+			var p, q PointAffine
+			p.ScalarMultiplication(&g, &s)
+			p.Neg(&p)
+			q.ScalarMultiplication(&g, s.Neg(&s))
+			return p.Equal(&q)
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestSetBytesRejects(t *testing.T) {
+	t.Parallel()
+	var p PointAffine
+
+	// y = p is not canonical
+	var buf [SizePointCompressed]byte
+	pb := fp.Modulus().Bytes()
+	copy(buf[:], reverse(pb))
+	if _, err := p.SetBytes(buf[:]); err == nil {
+		t.Fatal("non-canonical y accepted")
+	}
+
+	// y = 1 gives x = 0, which can't have the sign bit set
+	buf = [SizePointCompressed]byte{1}
+	if _, err := p.SetBytes(buf[:]); err != nil || !p.IsZero() {
+		t.Fatal("identity rejected")
+	}
+	buf[SizePointCompressed-1] |= 0x80
+	if _, err := p.SetBytes(buf[:]); err == nil {
+		t.Fatal("x = 0 with the sign bit set accepted")
+	}
+
+	// y = 2 is not the y-coordinate of a point
+	buf = [SizePointCompressed]byte{2}
+	if _, err := p.SetBytes(buf[:]); err == nil {
+		t.Fatal("point not on the curve accepted")
+	}
+
+	if _, err := p.SetBytes(buf[:SizePointCompressed-1]); err == nil {
+		t.Fatal("short buffer accepted")
+	}
+}
+
+func BenchmarkScalarMultiplicationCT(b *testing.B) {
+	g := Generator()
+	var s fr.Element
+	s.SetRandom()
+	var p PointAffine
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.ScalarMultiplicationCT(&g, &s)
+	}
+}
+
+// GenBigInt generates a big.Int
+func GenBigInt() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var s big.Int
+		var b [fp.Bytes]byte
+		_, err := genParams.Rng.Read(b[:])
+		if err != nil {
+			panic(err)
+		}
+		s.SetBytes(b[:])
+		genResult := gopter.NewGenResult(s, gopter.NoShrinker)
+		return genResult
+	}
+}
+
+// reverse returns a reversed copy of b, to switch between little and big endian
+func reverse(b []byte) []byte {
+	res := make([]byte, len(b))
+	for i := range b {
+		res[len(b)-1-i] = b[i]
+	}
+	return res
+}
@@ -0,0 +1,97 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecc
+
+import "math/big"
+
+// FieldElement is a type constraint satisfied by the pointer to the generated field elements
+// (fr.Element and fp.Element of the curve packages, goldilocks.Element, ...).
+//
+// It lets protocol code be written once, generic over the field:
+//
+//	func eval[E any, PE FieldElement[E]](p []E, x *E) E {
+//		var res E
+//		for i := len(p) - 1; i >= 0; i-- {
+//			PE(&res).Mul(&res, x)
+//			PE(&res).Add(&res, &p[i])
+//		}
+//		return res
+//	}
+//
+//	y := eval(p, &x) // with p []fr.Element, x fr.Element; PE is inferred
+type FieldElement[T any] interface {
+	*T
+
+	Set(x *T) *T
+	SetZero() *T
+	SetOne() *T
+	SetUint64(v uint64) *T
+	SetInt64(v int64) *T
+	SetBigInt(v *big.Int) *T
+	SetString(number string) (*T, error)
+	SetRandom() (*T, error)
+	// SetBytes interprets e as the bytes of a big-endian unsigned integer, reduced modulo q.
+	SetBytes(e []byte) *T
+
+	Add(x, y *T) *T
+	Sub(x, y *T) *T
+	Double(x *T) *T
+	Neg(x *T) *T
+	Mul(x, y *T) *T
+	Square(x *T) *T
+	Inverse(x *T) *T
+	Div(x, y *T) *T
+	Exp(x T, k *big.Int) *T
+	// Sqrt sets z to √x and returns z, or returns nil if x is not a square.
+	Sqrt(x *T) *T
+	Legendre() int
+
+	Equal(x *T) bool
+	IsZero() bool
+	IsOne() bool
+	Cmp(x *T) int
+
+	BigInt(res *big.Int) *big.Int
+	// Marshal returns the big-endian encoding of the canonical representation of z.
+	Marshal() []byte
+	String() string
+}
+
+// Group is a type constraint satisfied by the pointer to the points of the generated curve packages
+// (G1Affine, G1Jac, G2Affine, G2Jac), S being the element type of the scalar field (fr.Element).
+//
+// The operations on affine points are much slower than in Jacobian coordinates; protocol code
+// should be instantiated with the Jacobian types, and convert to affine for serialization only.
+type Group[P, S any] interface {
+	*P
+
+	Set(a *P) *P
+	SetInfinity() *P
+
+	Add(a, b *P) *P
+	Sub(a, b *P) *P
+	Double(a *P) *P
+	Neg(a *P) *P
+	ScalarMultiplication(a *P, s *big.Int) *P
+	ScalarMul(a *P, s *S) *P
+
+	Equal(a *P) bool
+	IsInfinity() bool
+	IsOnCurve() bool
+	IsInSubGroup() bool
+	String() string
+}
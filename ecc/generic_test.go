@@ -0,0 +1,133 @@
+package ecc_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	frbls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	frbn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	frsecp256k1 "github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// eval returns ∑ᵢ pᵢxⁱ
+func eval[E any, PE ecc.FieldElement[E]](p []E, x *E) E {
+	var res E
+	for i := len(p) - 1; i >= 0; i-- {
+		PE(&res).Mul(&res, x)
+		PE(&res).Add(&res, &p[i])
+	}
+	return res
+}
+
+func testFieldElement[E any, PE ecc.FieldElement[E]](t *testing.T) {
+	p := make([]E, 10)
+	for i := range p {
+		PE(&p[i]).SetRandom()
+	}
+	var x E
+	PE(&x).SetRandom()
+
+	// naive evaluation ∑ᵢ pᵢxⁱ
+	var expected, xi, tmp E
+	for i := range p {
+		PE(&xi).Exp(x, big.NewInt(int64(i)))
+		PE(&tmp).Mul(&p[i], &xi)
+		PE(&expected).Add(&expected, &tmp)
+	}
+
+	res := eval[E, PE](p, &x)
+	if !PE(&res).Equal(&expected) {
+		t.Fatal("polynomial evaluation mismatch")
+	}
+
+	// (x/y)·y == x
+	var y E
+	PE(&y).SetUint64(42)
+	PE(&tmp).Div(&x, &y)
+	PE(&tmp).Mul(&tmp, &y)
+	if !PE(&tmp).Equal(&x) {
+		t.Fatal("(x/y)·y != x")
+	}
+}
+
+func TestFieldElement(t *testing.T) {
+	t.Run("bn254/fr", testFieldElement[frbn254.Element])
+	t.Run("secp256k1/fr", testFieldElement[frsecp256k1.Element])
+	t.Run("goldilocks", testFieldElement[goldilocks.Element])
+}
+
+// pedersenCommit returns ∑ᵢ [vᵢ]Gᵢ + [r]H
+func pedersenCommit[P, S any, PP ecc.Group[P, S]](bases []P, h *P, values []S, r *S) P {
+	var res, tmp P
+	PP(&res).SetInfinity()
+	for i := range values {
+		PP(&tmp).ScalarMul(&bases[i], &values[i])
+		PP(&res).Add(&res, &tmp)
+	}
+	PP(&tmp).ScalarMul(h, r)
+	PP(&res).Add(&res, &tmp)
+	return res
+}
+
+func testGroup[P, S any, PP ecc.Group[P, S], PS ecc.FieldElement[S]](t *testing.T, g P) {
+	const n = 4
+	bases := make([]P, n)
+	var s S
+	for i := range bases {
+		PS(&s).SetRandom()
+		PP(&bases[i]).ScalarMul(&g, &s)
+	}
+	var h P
+	PS(&s).SetRandom()
+	PP(&h).ScalarMul(&g, &s)
+
+	v1, v2, v := make([]S, n), make([]S, n), make([]S, n)
+	for i := 0; i < n; i++ {
+		PS(&v1[i]).SetRandom()
+		PS(&v2[i]).SetRandom()
+		PS(&v[i]).Add(&v1[i], &v2[i])
+	}
+	var r1, r2, r S
+	PS(&r1).SetRandom()
+	PS(&r2).SetRandom()
+	PS(&r).Add(&r1, &r2)
+
+	// the commitment is additively homomorphic
+	c1 := pedersenCommit[P, S, PP](bases, &h, v1, &r1)
+	c2 := pedersenCommit[P, S, PP](bases, &h, v2, &r2)
+	c := pedersenCommit[P, S, PP](bases, &h, v, &r)
+	var sum P
+	PP(&sum).Add(&c1, &c2)
+	if !PP(&sum).Equal(&c) || !PP(&c).IsInSubGroup() {
+		t.Fatal("commitment is not homomorphic")
+	}
+
+	// c - c == O, c + c == 2c
+	var tmp P
+	if PP(&tmp).Sub(&c, &c); !PP(&tmp).IsInfinity() {
+		t.Fatal("c - c != O")
+	}
+	PP(&tmp).Add(&c, &c)
+	if PP(&sum).Double(&c); !PP(&sum).Equal(&tmp) {
+		t.Fatal("c + c != 2c")
+	}
+}
+
+func TestGroup(t *testing.T) {
+	g1Jac, g2Jac, g1Aff, g2Aff := bn254.Generators()
+	t.Run("bn254/G1Jac", func(t *testing.T) { testGroup[bn254.G1Jac, frbn254.Element](t, g1Jac) })
+	t.Run("bn254/G1Affine", func(t *testing.T) { testGroup[bn254.G1Affine, frbn254.Element](t, g1Aff) })
+	t.Run("bn254/G2Jac", func(t *testing.T) { testGroup[bn254.G2Jac, frbn254.Element](t, g2Jac) })
+	t.Run("bn254/G2Affine", func(t *testing.T) { testGroup[bn254.G2Affine, frbn254.Element](t, g2Aff) })
+
+	_, g2Jac381, _, _ := bls12381.Generators()
+	t.Run("bls12-381/G2Jac", func(t *testing.T) { testGroup[bls12381.G2Jac, frbls12381.Element](t, g2Jac381) })
+
+	g1JacK1, _ := secp256k1.Generators()
+	t.Run("secp256k1/G1Jac", func(t *testing.T) { testGroup[secp256k1.G1Jac, frsecp256k1.Element](t, g1JacK1) })
+}
@@ -0,0 +1,343 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pairing
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+)
+
+// bls12378G1 wraps a bls12378.G1Affine to implement the G1 interface.
+type bls12378G1 struct {
+	p bls12378.G1Affine
+}
+
+func bls12378G1Of(q G1) *bls12378G1 {
+	p, ok := q.(*bls12378G1)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return p
+}
+
+func (p *bls12378G1) Curve() ecc.ID {
+	return ecc.BLS12_378
+}
+
+func (p *bls12378G1) Add(q G1) G1 {
+	var res bls12378G1
+	res.p.Add(&p.p, &bls12378G1Of(q).p)
+	return &res
+}
+
+func (p *bls12378G1) Neg() G1 {
+	var res bls12378G1
+	res.p.Neg(&p.p)
+	return &res
+}
+
+func (p *bls12378G1) ScalarMultiplication(s *big.Int) G1 {
+	var res bls12378G1
+	res.p.ScalarMultiplication(&p.p, s)
+	return &res
+}
+
+func (p *bls12378G1) Equal(q G1) bool {
+	_q, ok := q.(*bls12378G1)
+	return ok && p.p.Equal(&_q.p)
+}
+
+func (p *bls12378G1) IsInfinity() bool {
+	return p.p.IsInfinity()
+}
+
+func (p *bls12378G1) IsInSubGroup() bool {
+	return p.p.IsInSubGroup()
+}
+
+func (p *bls12378G1) Bytes() []byte {
+	b := p.p.Bytes()
+	return b[:]
+}
+
+func (p *bls12378G1) Marshal() []byte {
+	return p.p.Marshal()
+}
+
+func (p *bls12378G1) String() string {
+	return p.p.String()
+}
+
+// bls12378G2 wraps a bls12378.G2Affine to implement the G2 interface.
+type bls12378G2 struct {
+	p bls12378.G2Affine
+}
+
+func bls12378G2Of(q G2) *bls12378G2 {
+	p, ok := q.(*bls12378G2)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return p
+}
+
+func (p *bls12378G2) Curve() ecc.ID {
+	return ecc.BLS12_378
+}
+
+func (p *bls12378G2) Add(q G2) G2 {
+	var res bls12378G2
+	res.p.Add(&p.p, &bls12378G2Of(q).p)
+	return &res
+}
+
+func (p *bls12378G2) Neg() G2 {
+	var res bls12378G2
+	res.p.Neg(&p.p)
+	return &res
+}
+
+func (p *bls12378G2) ScalarMultiplication(s *big.Int) G2 {
+	var res bls12378G2
+	res.p.ScalarMultiplication(&p.p, s)
+	return &res
+}
+
+func (p *bls12378G2) Equal(q G2) bool {
+	_q, ok := q.(*bls12378G2)
+	return ok && p.p.Equal(&_q.p)
+}
+
+func (p *bls12378G2) IsInfinity() bool {
+	return p.p.IsInfinity()
+}
+
+func (p *bls12378G2) IsInSubGroup() bool {
+	return p.p.IsInSubGroup()
+}
+
+func (p *bls12378G2) Bytes() []byte {
+	b := p.p.Bytes()
+	return b[:]
+}
+
+func (p *bls12378G2) Marshal() []byte {
+	return p.p.Marshal()
+}
+
+func (p *bls12378G2) String() string {
+	return p.p.String()
+}
+
+// bls12378GT wraps a bls12378.GT to implement the GT interface.
+type bls12378GT struct {
+	z bls12378.GT
+}
+
+func bls12378GTOf(x GT) *bls12378GT {
+	z, ok := x.(*bls12378GT)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return z
+}
+
+func (z *bls12378GT) Curve() ecc.ID {
+	return ecc.BLS12_378
+}
+
+func (z *bls12378GT) Mul(x GT) GT {
+	var res bls12378GT
+	res.z.Mul(&z.z, &bls12378GTOf(x).z)
+	return &res
+}
+
+func (z *bls12378GT) Inverse() GT {
+	var res bls12378GT
+	res.z.Inverse(&z.z)
+	return &res
+}
+
+func (z *bls12378GT) Exp(k *big.Int) GT {
+	var res bls12378GT
+	res.z.Exp(z.z, k)
+	return &res
+}
+
+func (z *bls12378GT) Equal(x GT) bool {
+	_x, ok := x.(*bls12378GT)
+	return ok && z.z.Equal(&_x.z)
+}
+
+func (z *bls12378GT) IsOne() bool {
+	return z.z.IsOne()
+}
+
+func (z *bls12378GT) Bytes() []byte {
+	b := z.z.Bytes()
+	return b[:]
+}
+
+func (z *bls12378GT) String() string {
+	return z.z.String()
+}
+
+// bls12378Pairing implements the Pairing interface with the pairing of the bls12-378 package.
+type bls12378Pairing struct{}
+
+func (e bls12378Pairing) Curve() ecc.ID {
+	return ecc.BLS12_378
+}
+
+func (e bls12378Pairing) ScalarField() *big.Int {
+	return ecc.BLS12_378.ScalarField()
+}
+
+func (e bls12378Pairing) G1Generator() G1 {
+	_, _, g1, _ := bls12378.Generators()
+	return &bls12378G1{p: g1}
+}
+
+func (e bls12378Pairing) G2Generator() G2 {
+	_, _, _, g2 := bls12378.Generators()
+	return &bls12378G2{p: g2}
+}
+
+func (e bls12378Pairing) G1Infinity() G1 {
+	return &bls12378G1{}
+}
+
+func (e bls12378Pairing) G2Infinity() G2 {
+	return &bls12378G2{}
+}
+
+func (e bls12378Pairing) GTOne() GT {
+	var res bls12378GT
+	res.z.SetOne()
+	return &res
+}
+
+func (e bls12378Pairing) NewG1(buf []byte) (G1, error) {
+	var res bls12378G1
+	n, err := res.p.SetBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(buf) {
+		return nil, errors.New("invalid buffer size")
+	}
+	return &res, nil
+}
+
+func (e bls12378Pairing) NewG2(buf []byte) (G2, error) {
+	var res bls12378G2
+	n, err := res.p.SetBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(buf) {
+		return nil, errors.New("invalid buffer size")
+	}
+	return &res, nil
+}
+
+func (e bls12378Pairing) NewGT(buf []byte) (GT, error) {
+	var res bls12378GT
+	if err := res.z.SetBytes(buf); err != nil {
+		return nil, err
+	}
+	if !res.z.IsInSubGroup() {
+		return nil, errors.New("invalid GT element: subgroup check failed")
+	}
+	return &res, nil
+}
+
+func (e bls12378Pairing) HashToG1(msg, dst []byte) (G1, error) {
+	p, err := bls12378.HashToG1(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &bls12378G1{p: p}, nil
+}
+
+func (e bls12378Pairing) HashToG2(msg, dst []byte) (G2, error) {
+	p, err := bls12378.HashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &bls12378G2{p: p}, nil
+}
+
+func (e bls12378Pairing) Pair(P []G1, Q []G2) (GT, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	z, err := bls12378.Pair(_P, _Q)
+	if err != nil {
+		return nil, err
+	}
+	return &bls12378GT{z: z}, nil
+}
+
+func (e bls12378Pairing) PairingCheck(P []G1, Q []G2) (bool, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return false, err
+	}
+	return bls12378.PairingCheck(_P, _Q)
+}
+
+func (e bls12378Pairing) MillerLoop(P []G1, Q []G2) (GT, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	z, err := bls12378.MillerLoop(_P, _Q)
+	if err != nil {
+		return nil, err
+	}
+	return &bls12378GT{z: z}, nil
+}
+
+func (e bls12378Pairing) FinalExponentiation(z GT) GT {
+	return &bls12378GT{z: bls12378.FinalExponentiation(&bls12378GTOf(z).z)}
+}
+
+// unwrap returns the bls12-378 points wrapped in P and Q, or ErrCurveMismatch.
+func (e bls12378Pairing) unwrap(P []G1, Q []G2) ([]bls12378.G1Affine, []bls12378.G2Affine, error) {
+	_P := make([]bls12378.G1Affine, len(P))
+	for i := range P {
+		p, ok := P[i].(*bls12378G1)
+		if !ok {
+			return nil, nil, ErrCurveMismatch
+		}
+		_P[i] = p.p
+	}
+	_Q := make([]bls12378.G2Affine, len(Q))
+	for i := range Q {
+		q, ok := Q[i].(*bls12378G2)
+		if !ok {
+			return nil, nil, ErrCurveMismatch
+		}
+		_Q[i] = q.p
+	}
+	return _P, _Q, nil
+}
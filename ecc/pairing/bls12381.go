@@ -0,0 +1,343 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pairing
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+)
+
+// bls12381G1 wraps a bls12381.G1Affine to implement the G1 interface.
+type bls12381G1 struct {
+	p bls12381.G1Affine
+}
+
+func bls12381G1Of(q G1) *bls12381G1 {
+	p, ok := q.(*bls12381G1)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return p
+}
+
+func (p *bls12381G1) Curve() ecc.ID {
+	return ecc.BLS12_381
+}
+
+func (p *bls12381G1) Add(q G1) G1 {
+	var res bls12381G1
+	res.p.Add(&p.p, &bls12381G1Of(q).p)
+	return &res
+}
+
+func (p *bls12381G1) Neg() G1 {
+	var res bls12381G1
+	res.p.Neg(&p.p)
+	return &res
+}
+
+func (p *bls12381G1) ScalarMultiplication(s *big.Int) G1 {
+	var res bls12381G1
+	res.p.ScalarMultiplication(&p.p, s)
+	return &res
+}
+
+func (p *bls12381G1) Equal(q G1) bool {
+	_q, ok := q.(*bls12381G1)
+	return ok && p.p.Equal(&_q.p)
+}
+
+func (p *bls12381G1) IsInfinity() bool {
+	return p.p.IsInfinity()
+}
+
+func (p *bls12381G1) IsInSubGroup() bool {
+	return p.p.IsInSubGroup()
+}
+
+func (p *bls12381G1) Bytes() []byte {
+	b := p.p.Bytes()
+	return b[:]
+}
+
+func (p *bls12381G1) Marshal() []byte {
+	return p.p.Marshal()
+}
+
+func (p *bls12381G1) String() string {
+	return p.p.String()
+}
+
+// bls12381G2 wraps a bls12381.G2Affine to implement the G2 interface.
+type bls12381G2 struct {
+	p bls12381.G2Affine
+}
+
+func bls12381G2Of(q G2) *bls12381G2 {
+	p, ok := q.(*bls12381G2)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return p
+}
+
+func (p *bls12381G2) Curve() ecc.ID {
+	return ecc.BLS12_381
+}
+
+func (p *bls12381G2) Add(q G2) G2 {
+	var res bls12381G2
+	res.p.Add(&p.p, &bls12381G2Of(q).p)
+	return &res
+}
+
+func (p *bls12381G2) Neg() G2 {
+	var res bls12381G2
+	res.p.Neg(&p.p)
+	return &res
+}
+
+func (p *bls12381G2) ScalarMultiplication(s *big.Int) G2 {
+	var res bls12381G2
+	res.p.ScalarMultiplication(&p.p, s)
+	return &res
+}
+
+func (p *bls12381G2) Equal(q G2) bool {
+	_q, ok := q.(*bls12381G2)
+	return ok && p.p.Equal(&_q.p)
+}
+
+func (p *bls12381G2) IsInfinity() bool {
+	return p.p.IsInfinity()
+}
+
+func (p *bls12381G2) IsInSubGroup() bool {
+	return p.p.IsInSubGroup()
+}
+
+func (p *bls12381G2) Bytes() []byte {
+	b := p.p.Bytes()
+	return b[:]
+}
+
+func (p *bls12381G2) Marshal() []byte {
+	return p.p.Marshal()
+}
+
+func (p *bls12381G2) String() string {
+	return p.p.String()
+}
+
+// bls12381GT wraps a bls12381.GT to implement the GT interface.
+type bls12381GT struct {
+	z bls12381.GT
+}
+
+func bls12381GTOf(x GT) *bls12381GT {
+	z, ok := x.(*bls12381GT)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return z
+}
+
+func (z *bls12381GT) Curve() ecc.ID {
+	return ecc.BLS12_381
+}
+
+func (z *bls12381GT) Mul(x GT) GT {
+	var res bls12381GT
+	res.z.Mul(&z.z, &bls12381GTOf(x).z)
+	return &res
+}
+
+func (z *bls12381GT) Inverse() GT {
+	var res bls12381GT
+	res.z.Inverse(&z.z)
+	return &res
+}
+
+func (z *bls12381GT) Exp(k *big.Int) GT {
+	var res bls12381GT
+	res.z.Exp(z.z, k)
+	return &res
+}
+
+func (z *bls12381GT) Equal(x GT) bool {
+	_x, ok := x.(*bls12381GT)
+	return ok && z.z.Equal(&_x.z)
+}
+
+func (z *bls12381GT) IsOne() bool {
+	return z.z.IsOne()
+}
+
+func (z *bls12381GT) Bytes() []byte {
+	b := z.z.Bytes()
+	return b[:]
+}
+
+func (z *bls12381GT) String() string {
+	return z.z.String()
+}
+
+// bls12381Pairing implements the Pairing interface with the pairing of the bls12-381 package.
+type bls12381Pairing struct{}
+
+func (e bls12381Pairing) Curve() ecc.ID {
+	return ecc.BLS12_381
+}
+
+func (e bls12381Pairing) ScalarField() *big.Int {
+	return ecc.BLS12_381.ScalarField()
+}
+
+func (e bls12381Pairing) G1Generator() G1 {
+	_, _, g1, _ := bls12381.Generators()
+	return &bls12381G1{p: g1}
+}
+
+func (e bls12381Pairing) G2Generator() G2 {
+	_, _, _, g2 := bls12381.Generators()
+	return &bls12381G2{p: g2}
+}
+
+func (e bls12381Pairing) G1Infinity() G1 {
+	return &bls12381G1{}
+}
+
+func (e bls12381Pairing) G2Infinity() G2 {
+	return &bls12381G2{}
+}
+
+func (e bls12381Pairing) GTOne() GT {
+	var res bls12381GT
+	res.z.SetOne()
+	return &res
+}
+
+func (e bls12381Pairing) NewG1(buf []byte) (G1, error) {
+	var res bls12381G1
+	n, err := res.p.SetBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(buf) {
+		return nil, errors.New("invalid buffer size")
+	}
+	return &res, nil
+}
+
+func (e bls12381Pairing) NewG2(buf []byte) (G2, error) {
+	var res bls12381G2
+	n, err := res.p.SetBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(buf) {
+		return nil, errors.New("invalid buffer size")
+	}
+	return &res, nil
+}
+
+func (e bls12381Pairing) NewGT(buf []byte) (GT, error) {
+	var res bls12381GT
+	if err := res.z.SetBytes(buf); err != nil {
+		return nil, err
+	}
+	if !res.z.IsInSubGroup() {
+		return nil, errors.New("invalid GT element: subgroup check failed")
+	}
+	return &res, nil
+}
+
+func (e bls12381Pairing) HashToG1(msg, dst []byte) (G1, error) {
+	p, err := bls12381.HashToG1(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &bls12381G1{p: p}, nil
+}
+
+func (e bls12381Pairing) HashToG2(msg, dst []byte) (G2, error) {
+	p, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &bls12381G2{p: p}, nil
+}
+
+func (e bls12381Pairing) Pair(P []G1, Q []G2) (GT, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	z, err := bls12381.Pair(_P, _Q)
+	if err != nil {
+		return nil, err
+	}
+	return &bls12381GT{z: z}, nil
+}
+
+func (e bls12381Pairing) PairingCheck(P []G1, Q []G2) (bool, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return false, err
+	}
+	return bls12381.PairingCheck(_P, _Q)
+}
+
+func (e bls12381Pairing) MillerLoop(P []G1, Q []G2) (GT, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	z, err := bls12381.MillerLoop(_P, _Q)
+	if err != nil {
+		return nil, err
+	}
+	return &bls12381GT{z: z}, nil
+}
+
+func (e bls12381Pairing) FinalExponentiation(z GT) GT {
+	return &bls12381GT{z: bls12381.FinalExponentiation(&bls12381GTOf(z).z)}
+}
+
+// unwrap returns the bls12-381 points wrapped in P and Q, or ErrCurveMismatch.
+func (e bls12381Pairing) unwrap(P []G1, Q []G2) ([]bls12381.G1Affine, []bls12381.G2Affine, error) {
+	_P := make([]bls12381.G1Affine, len(P))
+	for i := range P {
+		p, ok := P[i].(*bls12381G1)
+		if !ok {
+			return nil, nil, ErrCurveMismatch
+		}
+		_P[i] = p.p
+	}
+	_Q := make([]bls12381.G2Affine, len(Q))
+	for i := range Q {
+		q, ok := Q[i].(*bls12381G2)
+		if !ok {
+			return nil, nil, ErrCurveMismatch
+		}
+		_Q[i] = q.p
+	}
+	return _P, _Q, nil
+}
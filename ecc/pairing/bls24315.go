@@ -0,0 +1,343 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pairing
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315"
+)
+
+// bls24315G1 wraps a bls24315.G1Affine to implement the G1 interface.
+type bls24315G1 struct {
+	p bls24315.G1Affine
+}
+
+func bls24315G1Of(q G1) *bls24315G1 {
+	p, ok := q.(*bls24315G1)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return p
+}
+
+func (p *bls24315G1) Curve() ecc.ID {
+	return ecc.BLS24_315
+}
+
+func (p *bls24315G1) Add(q G1) G1 {
+	var res bls24315G1
+	res.p.Add(&p.p, &bls24315G1Of(q).p)
+	return &res
+}
+
+func (p *bls24315G1) Neg() G1 {
+	var res bls24315G1
+	res.p.Neg(&p.p)
+	return &res
+}
+
+func (p *bls24315G1) ScalarMultiplication(s *big.Int) G1 {
+	var res bls24315G1
+	res.p.ScalarMultiplication(&p.p, s)
+	return &res
+}
+
+func (p *bls24315G1) Equal(q G1) bool {
+	_q, ok := q.(*bls24315G1)
+	return ok && p.p.Equal(&_q.p)
+}
+
+func (p *bls24315G1) IsInfinity() bool {
+	return p.p.IsInfinity()
+}
+
+func (p *bls24315G1) IsInSubGroup() bool {
+	return p.p.IsInSubGroup()
+}
+
+func (p *bls24315G1) Bytes() []byte {
+	b := p.p.Bytes()
+	return b[:]
+}
+
+func (p *bls24315G1) Marshal() []byte {
+	return p.p.Marshal()
+}
+
+func (p *bls24315G1) String() string {
+	return p.p.String()
+}
+
+// bls24315G2 wraps a bls24315.G2Affine to implement the G2 interface.
+type bls24315G2 struct {
+	p bls24315.G2Affine
+}
+
+func bls24315G2Of(q G2) *bls24315G2 {
+	p, ok := q.(*bls24315G2)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return p
+}
+
+func (p *bls24315G2) Curve() ecc.ID {
+	return ecc.BLS24_315
+}
+
+func (p *bls24315G2) Add(q G2) G2 {
+	var res bls24315G2
+	res.p.Add(&p.p, &bls24315G2Of(q).p)
+	return &res
+}
+
+func (p *bls24315G2) Neg() G2 {
+	var res bls24315G2
+	res.p.Neg(&p.p)
+	return &res
+}
+
+func (p *bls24315G2) ScalarMultiplication(s *big.Int) G2 {
+	var res bls24315G2
+	res.p.ScalarMultiplication(&p.p, s)
+	return &res
+}
+
+func (p *bls24315G2) Equal(q G2) bool {
+	_q, ok := q.(*bls24315G2)
+	return ok && p.p.Equal(&_q.p)
+}
+
+func (p *bls24315G2) IsInfinity() bool {
+	return p.p.IsInfinity()
+}
+
+func (p *bls24315G2) IsInSubGroup() bool {
+	return p.p.IsInSubGroup()
+}
+
+func (p *bls24315G2) Bytes() []byte {
+	b := p.p.Bytes()
+	return b[:]
+}
+
+func (p *bls24315G2) Marshal() []byte {
+	return p.p.Marshal()
+}
+
+func (p *bls24315G2) String() string {
+	return p.p.String()
+}
+
+// bls24315GT wraps a bls24315.GT to implement the GT interface.
+type bls24315GT struct {
+	z bls24315.GT
+}
+
+func bls24315GTOf(x GT) *bls24315GT {
+	z, ok := x.(*bls24315GT)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return z
+}
+
+func (z *bls24315GT) Curve() ecc.ID {
+	return ecc.BLS24_315
+}
+
+func (z *bls24315GT) Mul(x GT) GT {
+	var res bls24315GT
+	res.z.Mul(&z.z, &bls24315GTOf(x).z)
+	return &res
+}
+
+func (z *bls24315GT) Inverse() GT {
+	var res bls24315GT
+	res.z.Inverse(&z.z)
+	return &res
+}
+
+func (z *bls24315GT) Exp(k *big.Int) GT {
+	var res bls24315GT
+	res.z.Exp(z.z, k)
+	return &res
+}
+
+func (z *bls24315GT) Equal(x GT) bool {
+	_x, ok := x.(*bls24315GT)
+	return ok && z.z.Equal(&_x.z)
+}
+
+func (z *bls24315GT) IsOne() bool {
+	return z.z.IsOne()
+}
+
+func (z *bls24315GT) Bytes() []byte {
+	b := z.z.Bytes()
+	return b[:]
+}
+
+func (z *bls24315GT) String() string {
+	return z.z.String()
+}
+
+// bls24315Pairing implements the Pairing interface with the pairing of the bls24-315 package.
+type bls24315Pairing struct{}
+
+func (e bls24315Pairing) Curve() ecc.ID {
+	return ecc.BLS24_315
+}
+
+func (e bls24315Pairing) ScalarField() *big.Int {
+	return ecc.BLS24_315.ScalarField()
+}
+
+func (e bls24315Pairing) G1Generator() G1 {
+	_, _, g1, _ := bls24315.Generators()
+	return &bls24315G1{p: g1}
+}
+
+func (e bls24315Pairing) G2Generator() G2 {
+	_, _, _, g2 := bls24315.Generators()
+	return &bls24315G2{p: g2}
+}
+
+func (e bls24315Pairing) G1Infinity() G1 {
+	return &bls24315G1{}
+}
+
+func (e bls24315Pairing) G2Infinity() G2 {
+	return &bls24315G2{}
+}
+
+func (e bls24315Pairing) GTOne() GT {
+	var res bls24315GT
+	res.z.SetOne()
+	return &res
+}
+
+func (e bls24315Pairing) NewG1(buf []byte) (G1, error) {
+	var res bls24315G1
+	n, err := res.p.SetBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(buf) {
+		return nil, errors.New("invalid buffer size")
+	}
+	return &res, nil
+}
+
+func (e bls24315Pairing) NewG2(buf []byte) (G2, error) {
+	var res bls24315G2
+	n, err := res.p.SetBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(buf) {
+		return nil, errors.New("invalid buffer size")
+	}
+	return &res, nil
+}
+
+func (e bls24315Pairing) NewGT(buf []byte) (GT, error) {
+	var res bls24315GT
+	if err := res.z.SetBytes(buf); err != nil {
+		return nil, err
+	}
+	if !res.z.IsInSubGroup() {
+		return nil, errors.New("invalid GT element: subgroup check failed")
+	}
+	return &res, nil
+}
+
+func (e bls24315Pairing) HashToG1(msg, dst []byte) (G1, error) {
+	p, err := bls24315.HashToG1(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &bls24315G1{p: p}, nil
+}
+
+func (e bls24315Pairing) HashToG2(msg, dst []byte) (G2, error) {
+	p, err := bls24315.HashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &bls24315G2{p: p}, nil
+}
+
+func (e bls24315Pairing) Pair(P []G1, Q []G2) (GT, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	z, err := bls24315.Pair(_P, _Q)
+	if err != nil {
+		return nil, err
+	}
+	return &bls24315GT{z: z}, nil
+}
+
+func (e bls24315Pairing) PairingCheck(P []G1, Q []G2) (bool, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return false, err
+	}
+	return bls24315.PairingCheck(_P, _Q)
+}
+
+func (e bls24315Pairing) MillerLoop(P []G1, Q []G2) (GT, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	z, err := bls24315.MillerLoop(_P, _Q)
+	if err != nil {
+		return nil, err
+	}
+	return &bls24315GT{z: z}, nil
+}
+
+func (e bls24315Pairing) FinalExponentiation(z GT) GT {
+	return &bls24315GT{z: bls24315.FinalExponentiation(&bls24315GTOf(z).z)}
+}
+
+// unwrap returns the bls24-315 points wrapped in P and Q, or ErrCurveMismatch.
+func (e bls24315Pairing) unwrap(P []G1, Q []G2) ([]bls24315.G1Affine, []bls24315.G2Affine, error) {
+	_P := make([]bls24315.G1Affine, len(P))
+	for i := range P {
+		p, ok := P[i].(*bls24315G1)
+		if !ok {
+			return nil, nil, ErrCurveMismatch
+		}
+		_P[i] = p.p
+	}
+	_Q := make([]bls24315.G2Affine, len(Q))
+	for i := range Q {
+		q, ok := Q[i].(*bls24315G2)
+		if !ok {
+			return nil, nil, ErrCurveMismatch
+		}
+		_Q[i] = q.p
+	}
+	return _P, _Q, nil
+}
@@ -0,0 +1,343 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pairing
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+)
+
+// bn254G1 wraps a bn254.G1Affine to implement the G1 interface.
+type bn254G1 struct {
+	p bn254.G1Affine
+}
+
+func bn254G1Of(q G1) *bn254G1 {
+	p, ok := q.(*bn254G1)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return p
+}
+
+func (p *bn254G1) Curve() ecc.ID {
+	return ecc.BN254
+}
+
+func (p *bn254G1) Add(q G1) G1 {
+	var res bn254G1
+	res.p.Add(&p.p, &bn254G1Of(q).p)
+	return &res
+}
+
+func (p *bn254G1) Neg() G1 {
+	var res bn254G1
+	res.p.Neg(&p.p)
+	return &res
+}
+
+func (p *bn254G1) ScalarMultiplication(s *big.Int) G1 {
+	var res bn254G1
+	res.p.ScalarMultiplication(&p.p, s)
+	return &res
+}
+
+func (p *bn254G1) Equal(q G1) bool {
+	_q, ok := q.(*bn254G1)
+	return ok && p.p.Equal(&_q.p)
+}
+
+func (p *bn254G1) IsInfinity() bool {
+	return p.p.IsInfinity()
+}
+
+func (p *bn254G1) IsInSubGroup() bool {
+	return p.p.IsInSubGroup()
+}
+
+func (p *bn254G1) Bytes() []byte {
+	b := p.p.Bytes()
+	return b[:]
+}
+
+func (p *bn254G1) Marshal() []byte {
+	return p.p.Marshal()
+}
+
+func (p *bn254G1) String() string {
+	return p.p.String()
+}
+
+// bn254G2 wraps a bn254.G2Affine to implement the G2 interface.
+type bn254G2 struct {
+	p bn254.G2Affine
+}
+
+func bn254G2Of(q G2) *bn254G2 {
+	p, ok := q.(*bn254G2)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return p
+}
+
+func (p *bn254G2) Curve() ecc.ID {
+	return ecc.BN254
+}
+
+func (p *bn254G2) Add(q G2) G2 {
+	var res bn254G2
+	res.p.Add(&p.p, &bn254G2Of(q).p)
+	return &res
+}
+
+func (p *bn254G2) Neg() G2 {
+	var res bn254G2
+	res.p.Neg(&p.p)
+	return &res
+}
+
+func (p *bn254G2) ScalarMultiplication(s *big.Int) G2 {
+	var res bn254G2
+	res.p.ScalarMultiplication(&p.p, s)
+	return &res
+}
+
+func (p *bn254G2) Equal(q G2) bool {
+	_q, ok := q.(*bn254G2)
+	return ok && p.p.Equal(&_q.p)
+}
+
+func (p *bn254G2) IsInfinity() bool {
+	return p.p.IsInfinity()
+}
+
+func (p *bn254G2) IsInSubGroup() bool {
+	return p.p.IsInSubGroup()
+}
+
+func (p *bn254G2) Bytes() []byte {
+	b := p.p.Bytes()
+	return b[:]
+}
+
+func (p *bn254G2) Marshal() []byte {
+	return p.p.Marshal()
+}
+
+func (p *bn254G2) String() string {
+	return p.p.String()
+}
+
+// bn254GT wraps a bn254.GT to implement the GT interface.
+type bn254GT struct {
+	z bn254.GT
+}
+
+func bn254GTOf(x GT) *bn254GT {
+	z, ok := x.(*bn254GT)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return z
+}
+
+func (z *bn254GT) Curve() ecc.ID {
+	return ecc.BN254
+}
+
+func (z *bn254GT) Mul(x GT) GT {
+	var res bn254GT
+	res.z.Mul(&z.z, &bn254GTOf(x).z)
+	return &res
+}
+
+func (z *bn254GT) Inverse() GT {
+	var res bn254GT
+	res.z.Inverse(&z.z)
+	return &res
+}
+
+func (z *bn254GT) Exp(k *big.Int) GT {
+	var res bn254GT
+	res.z.Exp(z.z, k)
+	return &res
+}
+
+func (z *bn254GT) Equal(x GT) bool {
+	_x, ok := x.(*bn254GT)
+	return ok && z.z.Equal(&_x.z)
+}
+
+func (z *bn254GT) IsOne() bool {
+	return z.z.IsOne()
+}
+
+func (z *bn254GT) Bytes() []byte {
+	b := z.z.Bytes()
+	return b[:]
+}
+
+func (z *bn254GT) String() string {
+	return z.z.String()
+}
+
+// bn254Pairing implements the Pairing interface with the pairing of the bn254 package.
+type bn254Pairing struct{}
+
+func (e bn254Pairing) Curve() ecc.ID {
+	return ecc.BN254
+}
+
+func (e bn254Pairing) ScalarField() *big.Int {
+	return ecc.BN254.ScalarField()
+}
+
+func (e bn254Pairing) G1Generator() G1 {
+	_, _, g1, _ := bn254.Generators()
+	return &bn254G1{p: g1}
+}
+
+func (e bn254Pairing) G2Generator() G2 {
+	_, _, _, g2 := bn254.Generators()
+	return &bn254G2{p: g2}
+}
+
+func (e bn254Pairing) G1Infinity() G1 {
+	return &bn254G1{}
+}
+
+func (e bn254Pairing) G2Infinity() G2 {
+	return &bn254G2{}
+}
+
+func (e bn254Pairing) GTOne() GT {
+	var res bn254GT
+	res.z.SetOne()
+	return &res
+}
+
+func (e bn254Pairing) NewG1(buf []byte) (G1, error) {
+	var res bn254G1
+	n, err := res.p.SetBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(buf) {
+		return nil, errors.New("invalid buffer size")
+	}
+	return &res, nil
+}
+
+func (e bn254Pairing) NewG2(buf []byte) (G2, error) {
+	var res bn254G2
+	n, err := res.p.SetBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(buf) {
+		return nil, errors.New("invalid buffer size")
+	}
+	return &res, nil
+}
+
+func (e bn254Pairing) NewGT(buf []byte) (GT, error) {
+	var res bn254GT
+	if err := res.z.SetBytes(buf); err != nil {
+		return nil, err
+	}
+	if !res.z.IsInSubGroup() {
+		return nil, errors.New("invalid GT element: subgroup check failed")
+	}
+	return &res, nil
+}
+
+func (e bn254Pairing) HashToG1(msg, dst []byte) (G1, error) {
+	p, err := bn254.HashToG1(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &bn254G1{p: p}, nil
+}
+
+func (e bn254Pairing) HashToG2(msg, dst []byte) (G2, error) {
+	p, err := bn254.HashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &bn254G2{p: p}, nil
+}
+
+func (e bn254Pairing) Pair(P []G1, Q []G2) (GT, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	z, err := bn254.Pair(_P, _Q)
+	if err != nil {
+		return nil, err
+	}
+	return &bn254GT{z: z}, nil
+}
+
+func (e bn254Pairing) PairingCheck(P []G1, Q []G2) (bool, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return false, err
+	}
+	return bn254.PairingCheck(_P, _Q)
+}
+
+func (e bn254Pairing) MillerLoop(P []G1, Q []G2) (GT, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	z, err := bn254.MillerLoop(_P, _Q)
+	if err != nil {
+		return nil, err
+	}
+	return &bn254GT{z: z}, nil
+}
+
+func (e bn254Pairing) FinalExponentiation(z GT) GT {
+	return &bn254GT{z: bn254.FinalExponentiation(&bn254GTOf(z).z)}
+}
+
+// unwrap returns the bn254 points wrapped in P and Q, or ErrCurveMismatch.
+func (e bn254Pairing) unwrap(P []G1, Q []G2) ([]bn254.G1Affine, []bn254.G2Affine, error) {
+	_P := make([]bn254.G1Affine, len(P))
+	for i := range P {
+		p, ok := P[i].(*bn254G1)
+		if !ok {
+			return nil, nil, ErrCurveMismatch
+		}
+		_P[i] = p.p
+	}
+	_Q := make([]bn254.G2Affine, len(Q))
+	for i := range Q {
+		q, ok := Q[i].(*bn254G2)
+		if !ok {
+			return nil, nil, ErrCurveMismatch
+		}
+		_Q[i] = q.p
+	}
+	return _P, _Q, nil
+}
@@ -0,0 +1,343 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pairing
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756"
+)
+
+// bw6756G1 wraps a bw6756.G1Affine to implement the G1 interface.
+type bw6756G1 struct {
+	p bw6756.G1Affine
+}
+
+func bw6756G1Of(q G1) *bw6756G1 {
+	p, ok := q.(*bw6756G1)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return p
+}
+
+func (p *bw6756G1) Curve() ecc.ID {
+	return ecc.BW6_756
+}
+
+func (p *bw6756G1) Add(q G1) G1 {
+	var res bw6756G1
+	res.p.Add(&p.p, &bw6756G1Of(q).p)
+	return &res
+}
+
+func (p *bw6756G1) Neg() G1 {
+	var res bw6756G1
+	res.p.Neg(&p.p)
+	return &res
+}
+
+func (p *bw6756G1) ScalarMultiplication(s *big.Int) G1 {
+	var res bw6756G1
+	res.p.ScalarMultiplication(&p.p, s)
+	return &res
+}
+
+func (p *bw6756G1) Equal(q G1) bool {
+	_q, ok := q.(*bw6756G1)
+	return ok && p.p.Equal(&_q.p)
+}
+
+func (p *bw6756G1) IsInfinity() bool {
+	return p.p.IsInfinity()
+}
+
+func (p *bw6756G1) IsInSubGroup() bool {
+	return p.p.IsInSubGroup()
+}
+
+func (p *bw6756G1) Bytes() []byte {
+	b := p.p.Bytes()
+	return b[:]
+}
+
+func (p *bw6756G1) Marshal() []byte {
+	return p.p.Marshal()
+}
+
+func (p *bw6756G1) String() string {
+	return p.p.String()
+}
+
+// bw6756G2 wraps a bw6756.G2Affine to implement the G2 interface.
+type bw6756G2 struct {
+	p bw6756.G2Affine
+}
+
+func bw6756G2Of(q G2) *bw6756G2 {
+	p, ok := q.(*bw6756G2)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return p
+}
+
+func (p *bw6756G2) Curve() ecc.ID {
+	return ecc.BW6_756
+}
+
+func (p *bw6756G2) Add(q G2) G2 {
+	var res bw6756G2
+	res.p.Add(&p.p, &bw6756G2Of(q).p)
+	return &res
+}
+
+func (p *bw6756G2) Neg() G2 {
+	var res bw6756G2
+	res.p.Neg(&p.p)
+	return &res
+}
+
+func (p *bw6756G2) ScalarMultiplication(s *big.Int) G2 {
+	var res bw6756G2
+	res.p.ScalarMultiplication(&p.p, s)
+	return &res
+}
+
+func (p *bw6756G2) Equal(q G2) bool {
+	_q, ok := q.(*bw6756G2)
+	return ok && p.p.Equal(&_q.p)
+}
+
+func (p *bw6756G2) IsInfinity() bool {
+	return p.p.IsInfinity()
+}
+
+func (p *bw6756G2) IsInSubGroup() bool {
+	return p.p.IsInSubGroup()
+}
+
+func (p *bw6756G2) Bytes() []byte {
+	b := p.p.Bytes()
+	return b[:]
+}
+
+func (p *bw6756G2) Marshal() []byte {
+	return p.p.Marshal()
+}
+
+func (p *bw6756G2) String() string {
+	return p.p.String()
+}
+
+// bw6756GT wraps a bw6756.GT to implement the GT interface.
+type bw6756GT struct {
+	z bw6756.GT
+}
+
+func bw6756GTOf(x GT) *bw6756GT {
+	z, ok := x.(*bw6756GT)
+	if !ok {
+		panic(ErrCurveMismatch)
+	}
+	return z
+}
+
+func (z *bw6756GT) Curve() ecc.ID {
+	return ecc.BW6_756
+}
+
+func (z *bw6756GT) Mul(x GT) GT {
+	var res bw6756GT
+	res.z.Mul(&z.z, &bw6756GTOf(x).z)
+	return &res
+}
+
+func (z *bw6756GT) Inverse() GT {
+	var res bw6756GT
+	res.z.Inverse(&z.z)
+	return &res
+}
+
+func (z *bw6756GT) Exp(k *big.Int) GT {
+	var res bw6756GT
+	res.z.Exp(z.z, k)
+	return &res
+}
+
+func (z *bw6756GT) Equal(x GT) bool {
+	_x, ok := x.(*bw6756GT)
+	return ok && z.z.Equal(&_x.z)
+}
+
+func (z *bw6756GT) IsOne() bool {
+	return z.z.IsOne()
+}
+
+func (z *bw6756GT) Bytes() []byte {
+	b := z.z.Bytes()
+	return b[:]
+}
+
+func (z *bw6756GT) String() string {
+	return z.z.String()
+}
+
+// bw6756Pairing implements the Pairing interface with the pairing of the bw6-756 package.
+type bw6756Pairing struct{}
+
+func (e bw6756Pairing) Curve() ecc.ID {
+	return ecc.BW6_756
+}
+
+func (e bw6756Pairing) ScalarField() *big.Int {
+	return ecc.BW6_756.ScalarField()
+}
+
+func (e bw6756Pairing) G1Generator() G1 {
+	_, _, g1, _ := bw6756.Generators()
+	return &bw6756G1{p: g1}
+}
+
+func (e bw6756Pairing) G2Generator() G2 {
+	_, _, _, g2 := bw6756.Generators()
+	return &bw6756G2{p: g2}
+}
+
+func (e bw6756Pairing) G1Infinity() G1 {
+	return &bw6756G1{}
+}
+
+func (e bw6756Pairing) G2Infinity() G2 {
+	return &bw6756G2{}
+}
+
+func (e bw6756Pairing) GTOne() GT {
+	var res bw6756GT
+	res.z.SetOne()
+	return &res
+}
+
+func (e bw6756Pairing) NewG1(buf []byte) (G1, error) {
+	var res bw6756G1
+	n, err := res.p.SetBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(buf) {
+		return nil, errors.New("invalid buffer size")
+	}
+	return &res, nil
+}
+
+func (e bw6756Pairing) NewG2(buf []byte) (G2, error) {
+	var res bw6756G2
+	n, err := res.p.SetBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(buf) {
+		return nil, errors.New("invalid buffer size")
+	}
+	return &res, nil
+}
+
+func (e bw6756Pairing) NewGT(buf []byte) (GT, error) {
+	var res bw6756GT
+	if err := res.z.SetBytes(buf); err != nil {
+		return nil, err
+	}
+	if !res.z.IsInSubGroup() {
+		return nil, errors.New("invalid GT element: subgroup check failed")
+	}
+	return &res, nil
+}
+
+func (e bw6756Pairing) HashToG1(msg, dst []byte) (G1, error) {
+	p, err := bw6756.HashToG1(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &bw6756G1{p: p}, nil
+}
+
+func (e bw6756Pairing) HashToG2(msg, dst []byte) (G2, error) {
+	p, err := bw6756.HashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &bw6756G2{p: p}, nil
+}
+
+func (e bw6756Pairing) Pair(P []G1, Q []G2) (GT, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	z, err := bw6756.Pair(_P, _Q)
+	if err != nil {
+		return nil, err
+	}
+	return &bw6756GT{z: z}, nil
+}
+
+func (e bw6756Pairing) PairingCheck(P []G1, Q []G2) (bool, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return false, err
+	}
+	return bw6756.PairingCheck(_P, _Q)
+}
+
+func (e bw6756Pairing) MillerLoop(P []G1, Q []G2) (GT, error) {
+	_P, _Q, err := e.unwrap(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	z, err := bw6756.MillerLoop(_P, _Q)
+	if err != nil {
+		return nil, err
+	}
+	return &bw6756GT{z: z}, nil
+}
+
+func (e bw6756Pairing) FinalExponentiation(z GT) GT {
+	return &bw6756GT{z: bw6756.FinalExponentiation(&bw6756GTOf(z).z)}
+}
+
+// unwrap returns the bw6-756 points wrapped in P and Q, or ErrCurveMismatch.
+func (e bw6756Pairing) unwrap(P []G1, Q []G2) ([]bw6756.G1Affine, []bw6756.G2Affine, error) {
+	_P := make([]bw6756.G1Affine, len(P))
+	for i := range P {
+		p, ok := P[i].(*bw6756G1)
+		if !ok {
+			return nil, nil, ErrCurveMismatch
+		}
+		_P[i] = p.p
+	}
+	_Q := make([]bw6756.G2Affine, len(Q))
+	for i := range Q {
+		q, ok := Q[i].(*bw6756G2)
+		if !ok {
+			return nil, nil, ErrCurveMismatch
+		}
+		_Q[i] = q.p
+	}
+	return _P, _Q, nil
+}
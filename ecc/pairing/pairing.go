@@ -0,0 +1,187 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pairing provides a curve-agnostic API over the pairing-friendly curves of gnark-crypto.
+//
+// Each curve package (bn254, bls12-381, bw6-761, ...) exposes its own pairing with concrete types;
+// this package wraps them behind the G1, G2, GT and Pairing interfaces, such that protocol code
+// (KZG, Groth16 verification, BLS signatures, ...) can be written once and run on any curve
+// selected by its ecc.ID:
+//
+//	e, err := pairing.New(ecc.BLS12_381)
+//	...
+//	// BLS signature verification: e(σ, -g₂)·e(H(m), pk) == 1
+//	h, err := e.HashToG1(msg, dst)
+//	...
+//	ok, err := e.PairingCheck([]pairing.G1{sig, h}, []pairing.G2{e.G2Generator().Neg(), pk})
+//
+// The group elements are immutable: operations return new elements. Mixing elements of different
+// curves is a programming error; methods that can return an error do so, the others panic.
+//
+// The wrappers add an allocation per operation; performance critical code should use the curve
+// packages directly.
+package pairing
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+)
+
+var (
+	ErrCurveMismatch  = errors.New("elements of different curves")
+	ErrNotImplemented = errors.New("no pairing implemented for this curve")
+)
+
+// G1 is an element of the first source group of a pairing, in affine coordinates.
+type G1 interface {
+	// Curve returns the curve the element belongs to.
+	Curve() ecc.ID
+	// Add returns p + q.
+	Add(q G1) G1
+	// Neg returns -p.
+	Neg() G1
+	// ScalarMultiplication returns [s]p.
+	ScalarMultiplication(s *big.Int) G1
+	// Equal returns true if p == q.
+	Equal(q G1) bool
+	// IsInfinity returns true if p is the point at infinity.
+	IsInfinity() bool
+	// IsInSubGroup returns true if p is in the r-torsion subgroup.
+	IsInSubGroup() bool
+	// Bytes returns the compressed binary encoding of p, as in the curve package.
+	Bytes() []byte
+	// Marshal returns the uncompressed binary encoding of p, as in the curve package.
+	Marshal() []byte
+	String() string
+}
+
+// G2 is an element of the second source group of a pairing, in affine coordinates.
+type G2 interface {
+	// Curve returns the curve the element belongs to.
+	Curve() ecc.ID
+	// Add returns p + q.
+	Add(q G2) G2
+	// Neg returns -p.
+	Neg() G2
+	// ScalarMultiplication returns [s]p.
+	ScalarMultiplication(s *big.Int) G2
+	// Equal returns true if p == q.
+	Equal(q G2) bool
+	// IsInfinity returns true if p is the point at infinity.
+	IsInfinity() bool
+	// IsInSubGroup returns true if p is in the r-torsion subgroup.
+	IsInSubGroup() bool
+	// Bytes returns the compressed binary encoding of p, as in the curve package.
+	Bytes() []byte
+	// Marshal returns the uncompressed binary encoding of p, as in the curve package.
+	Marshal() []byte
+	String() string
+}
+
+// GT is an element of the target group of a pairing.
+type GT interface {
+	// Curve returns the curve the element belongs to.
+	Curve() ecc.ID
+	// Mul returns z · x.
+	Mul(x GT) GT
+	// Inverse returns z⁻¹.
+	Inverse() GT
+	// Exp returns zᵏ.
+	Exp(k *big.Int) GT
+	// Equal returns true if z == x.
+	Equal(x GT) bool
+	// IsOne returns true if z == 1.
+	IsOne() bool
+	// Bytes returns the binary encoding of z, as in the curve package.
+	Bytes() []byte
+	String() string
+}
+
+// Pairing is a pairing engine e: G1 × G2 → GT over a given curve.
+type Pairing interface {
+	// Curve returns the curve of the pairing.
+	Curve() ecc.ID
+
+	// ScalarField returns the order r of G1, G2 and GT.
+	ScalarField() *big.Int
+
+	// G1Generator and G2Generator return the generators of G1 and G2.
+	G1Generator() G1
+	G2Generator() G2
+
+	// G1Infinity and G2Infinity return the points at infinity of G1 and G2.
+	G1Infinity() G1
+	G2Infinity() G2
+
+	// GTOne returns the neutral element of GT.
+	GTOne() GT
+
+	// NewG1 decodes a point of G1 from its compressed or uncompressed encoding,
+	// and checks that it is in the subgroup.
+	NewG1(buf []byte) (G1, error)
+	// NewG2 decodes a point of G2 from its compressed or uncompressed encoding,
+	// and checks that it is in the subgroup.
+	NewG2(buf []byte) (G2, error)
+	// NewGT decodes an element of GT, and checks that it is in the subgroup.
+	NewGT(buf []byte) (GT, error)
+
+	// HashToG1 and HashToG2 hash a message to G1 and G2 with the curve package hash-to-curve
+	// suites (https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html).
+	HashToG1(msg, dst []byte) (G1, error)
+	HashToG2(msg, dst []byte) (G2, error)
+
+	// Pair computes the reduced pairing ∏ᵢ e(Pᵢ, Qᵢ).
+	Pair(P []G1, Q []G2) (GT, error)
+	// PairingCheck returns true if ∏ᵢ e(Pᵢ, Qᵢ) == 1.
+	PairingCheck(P []G1, Q []G2) (bool, error)
+	// MillerLoop computes the multi-Miller loop ∏ᵢ MillerLoop(Pᵢ, Qᵢ).
+	MillerLoop(P []G1, Q []G2) (GT, error)
+	// FinalExponentiation computes z^((pᵏ-1)/r) (or a power of it, see the curve package).
+	FinalExponentiation(z GT) GT
+}
+
+// New returns the pairing engine of the curve id.
+//
+// It returns ErrNotImplemented if id is not a pairing-friendly curve.
+func New(id ecc.ID) (Pairing, error) {
+	switch id {
+	case ecc.BN254:
+		return bn254Pairing{}, nil
+	case ecc.BLS12_377:
+		return bls12377Pairing{}, nil
+	case ecc.BLS12_378:
+		return bls12378Pairing{}, nil
+	case ecc.BLS12_381:
+		return bls12381Pairing{}, nil
+	case ecc.BLS24_315:
+		return bls24315Pairing{}, nil
+	case ecc.BLS24_317:
+		return bls24317Pairing{}, nil
+	case ecc.BW6_633:
+		return bw6633Pairing{}, nil
+	case ecc.BW6_756:
+		return bw6756Pairing{}, nil
+	case ecc.BW6_761:
+		return bw6761Pairing{}, nil
+	default:
+		return nil, ErrNotImplemented
+	}
+}
+
+// Implemented returns the list of curves with a pairing engine.
+func Implemented() []ecc.ID {
+	return []ecc.ID{ecc.BN254, ecc.BLS12_377, ecc.BLS12_378, ecc.BLS12_381, ecc.BLS24_315, ecc.BLS24_317, ecc.BW6_633, ecc.BW6_756, ecc.BW6_761}
+}
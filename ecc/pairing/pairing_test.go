@@ -0,0 +1,217 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pairing
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+)
+
+func TestPairing(t *testing.T) {
+	for _, id := range Implemented() {
+		id := id
+		t.Run(id.String(), func(t *testing.T) {
+			t.Parallel()
+			e, err := New(id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if e.Curve() != id {
+				t.Fatal("wrong curve")
+			}
+			a, _ := rand.Int(rand.Reader, e.ScalarField())
+			b, _ := rand.Int(rand.Reader, e.ScalarField())
+			var ab big.Int
+			ab.Mul(a, b)
+
+			g1, g2 := e.G1Generator(), e.G2Generator()
+			ag1 := g1.ScalarMultiplication(a)
+			bg2 := g2.ScalarMultiplication(b)
+
+			// bilinearity: e([a]g₁, [b]g₂) == e(g₁, g₂)ᵃᵇ
+			res, err := e.Pair([]G1{ag1}, []G2{bg2})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected, err := e.Pair([]G1{g1}, []G2{g2})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !res.Equal(expected.Exp(&ab)) {
+				t.Fatal("pairing is not bilinear")
+			}
+
+			// e([a]g₁, [b]g₂)·e(-[ab]g₁, g₂) == 1
+			ok, err := e.PairingCheck([]G1{ag1, g1.ScalarMultiplication(&ab).Neg()}, []G2{bg2, g2})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok {
+				t.Fatal("pairing check failed")
+			}
+
+			// MillerLoop and FinalExponentiation
+			ml, err := e.MillerLoop([]G1{ag1}, []G2{bg2})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !e.FinalExponentiation(ml).Equal(res) {
+				t.Fatal("FinalExponentiation(MillerLoop) != Pair")
+			}
+
+			// group laws
+			if !ag1.Add(ag1.Neg()).IsInfinity() || !bg2.Add(bg2.Neg()).IsInfinity() {
+				t.Fatal("p + (-p) != 0")
+			}
+			if !ag1.Add(e.G1Infinity()).Equal(ag1) || !bg2.Add(e.G2Infinity()).Equal(bg2) {
+				t.Fatal("p + 0 != p")
+			}
+			if !res.Mul(res.Inverse()).IsOne() || !res.Mul(e.GTOne()).Equal(res) {
+				t.Fatal("z · z⁻¹ != 1")
+			}
+
+			// encodings
+			for _, buf := range [][]byte{ag1.Bytes(), ag1.Marshal()} {
+				p, err := e.NewG1(buf)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !p.Equal(ag1) {
+					t.Fatal("G1 decoding mismatch")
+				}
+			}
+			for _, buf := range [][]byte{bg2.Bytes(), bg2.Marshal()} {
+				q, err := e.NewG2(buf)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !q.Equal(bg2) {
+					t.Fatal("G2 decoding mismatch")
+				}
+			}
+			z, err := e.NewGT(res.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !z.Equal(res) {
+				t.Fatal("GT decoding mismatch")
+			}
+			if _, err := e.NewGT(ml.Bytes()); err == nil {
+				t.Fatal("decoding a GT element out of the subgroup should fail")
+			}
+
+			// hash to curve
+			h1, err := e.HashToG1([]byte("message"), []byte("dst"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			h2, err := e.HashToG2([]byte("message"), []byte("dst"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !h1.IsInSubGroup() || !h2.IsInSubGroup() {
+				t.Fatal("hash to curve output not in the subgroup")
+			}
+		})
+	}
+}
+
+func TestPairingMatchesCurvePackage(t *testing.T) {
+	e, err := New(ecc.BN254)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, g1, g2 := bn254.Generators()
+	expected, err := bn254.Pair([]bn254.G1Affine{g1}, []bn254.G2Affine{g2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := e.Pair([]G1{e.G1Generator()}, []G2{e.G2Generator()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := expected.Bytes()
+	if string(res.Bytes()) != string(b[:]) {
+		t.Fatal("pairing mismatch with the bn254 package")
+	}
+}
+
+func TestCurveMismatch(t *testing.T) {
+	bn, _ := New(ecc.BN254)
+	bls, _ := New(ecc.BLS12_381)
+
+	if _, err := bn.Pair([]G1{bls.G1Generator()}, []G2{bn.G2Generator()}); err != ErrCurveMismatch {
+		t.Fatal("expected ErrCurveMismatch")
+	}
+	if bn.G1Generator().Equal(bls.G1Generator()) {
+		t.Fatal("elements of different curves can't be equal")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("adding elements of different curves should panic")
+		}
+	}()
+	bn.G1Generator().Add(bls.G1Generator())
+}
+
+func TestNotImplemented(t *testing.T) {
+	for _, id := range []ecc.ID{ecc.UNKNOWN, ecc.SECP256K1, ecc.STARK_CURVE} {
+		if _, err := New(id); err != ErrNotImplemented {
+			t.Fatal("expected ErrNotImplemented for", id)
+		}
+	}
+}
+
+// Example_blsSignature signs and verifies a BLS signature (signatures in G1, public keys in G2)
+// on any pairing-friendly curve.
+func Example_blsSignature() {
+	dst := []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_")
+	msg := []byte("message")
+
+	for _, id := range []ecc.ID{ecc.BN254, ecc.BLS12_381, ecc.BW6_761} {
+		e, _ := New(id)
+
+		// key generation
+		sk, _ := rand.Int(rand.Reader, e.ScalarField())
+		pk := e.G2Generator().ScalarMultiplication(sk)
+
+		// signature σ = [sk]H(m)
+		h, _ := e.HashToG1(msg, dst)
+		sig := h.ScalarMultiplication(sk)
+
+		// verification e(σ, -g₂)·e(H(m), pk) == 1
+		ok, _ := e.PairingCheck([]G1{sig, h}, []G2{e.G2Generator().Neg(), pk})
+		fmt.Println(id, ok)
+	}
+	// Output:
+	// bn254 true
+	// bls12_381 true
+	// bw6_761 true
+}
+
+func BenchmarkPairing(b *testing.B) {
+	e, _ := New(ecc.BN254)
+	P := []G1{e.G1Generator()}
+	Q := []G2{e.G2Generator()}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = e.Pair(P, Q)
+	}
+}
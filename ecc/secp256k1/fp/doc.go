@@ -50,4 +50,5 @@
 // # Warning
 //
 // This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// AddCT, SubCT, InverseCT and SqrtCT avoid branching on their input and are meant for secret data, but are not audited either.
 package fp
@@ -0,0 +1,274 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr/polynomial"
+)
+
+var (
+	// ErrInvalidProof is returned, wrapped with the identifier of the culprit, when the proof
+	// of knowledge broadcast by a participant during the key generation does not verify.
+	ErrInvalidProof = errors.New("invalid proof of knowledge")
+
+	errInvalidThreshold   = errors.New("threshold must be between 1 and the number of participants")
+	errInvalidParticipant = errors.New("invalid participant identifier")
+	errUnexpectedMessage  = errors.New("unexpected message")
+	errMissingMessage     = errors.New("missing message")
+	errRoundOrder         = errors.New("key generation rounds called out of order")
+)
+
+var _, g1GenAff = secp256k1.Generators()
+
+// Round1Message is broadcast by each participant in the first round of the key generation.
+type Round1Message struct {
+	ID          int
+	Commitments []secp256k1.G1Affine // Feldman commitments aₖ⋅G to the coefficients of the secret polynomial
+	ProofR      secp256k1.G1Affine   // Schnorr proof of knowledge of a₀
+	ProofZ      fr.Element
+}
+
+// Round2Message is sent privately by participant From to participant To in the second
+// round of the key generation.
+type Round2Message struct {
+	From, To int
+	Share    fr.Element // f_From(To)
+}
+
+// KeyGen holds the state of a participant during the distributed key generation.
+type KeyGen struct {
+	id, threshold, nbParticipants int
+	poly                          polynomial.Polynomial  // secret polynomial f, of degree threshold-1
+	commitments                   [][]secp256k1.G1Affine // commitments[j-1] are the commitments of participant j
+}
+
+// NewKeyGen returns the key generation state of participant id, among nbParticipants
+// participants of which threshold will be needed to sign.
+func NewKeyGen(id, threshold, nbParticipants int) (*KeyGen, error) {
+	if threshold < 1 || threshold > nbParticipants {
+		return nil, errInvalidThreshold
+	}
+	if id < 1 || id > nbParticipants {
+		return nil, errInvalidParticipant
+	}
+	return &KeyGen{id: id, threshold: threshold, nbParticipants: nbParticipants}, nil
+}
+
+// Round1 samples the secret polynomial of the participant and returns the message to
+// broadcast to the other participants.
+func (kg *KeyGen) Round1(rand io.Reader) (*Round1Message, error) {
+	kg.poly = make(polynomial.Polynomial, kg.threshold)
+	for i := range kg.poly {
+		if err := randScalar(&kg.poly[i], rand); err != nil {
+			return nil, err
+		}
+	}
+
+	msg := &Round1Message{ID: kg.id, Commitments: make([]secp256k1.G1Affine, kg.threshold)}
+	var b big.Int
+	for i := range kg.poly {
+		msg.Commitments[i].ScalarMultiplicationBaseCT(kg.poly[i].BigInt(&b))
+	}
+
+	// proof of knowledge of a₀: R = k⋅G, z = k + a₀⋅c with c = H(id ∥ a₀⋅G ∥ R)
+	var k fr.Element
+	if err := randScalar(&k, rand); err != nil {
+		return nil, err
+	}
+	msg.ProofR.ScalarMultiplicationBaseCT(k.BigInt(&b))
+	c, err := dkgChallenge(kg.id, &msg.Commitments[0], &msg.ProofR)
+	if err != nil {
+		return nil, err
+	}
+	msg.ProofZ.Mul(&kg.poly[0], &c).Add(&msg.ProofZ, &k)
+
+	return msg, nil
+}
+
+// Round2 checks the messages broadcast by the other participants in the first round and
+// returns the shares to send them privately, one per participant.
+func (kg *KeyGen) Round2(msgs []Round1Message) ([]Round2Message, error) {
+	if kg.poly == nil {
+		return nil, errRoundOrder
+	}
+	kg.commitments = make([][]secp256k1.G1Affine, kg.nbParticipants)
+	for i := range msgs {
+		id := msgs[i].ID
+		if id < 1 || id > kg.nbParticipants || id == kg.id || kg.commitments[id-1] != nil {
+			return nil, errUnexpectedMessage
+		}
+		if len(msgs[i].Commitments) != kg.threshold || !verifyProofOfKnowledge(&msgs[i]) {
+			return nil, fmt.Errorf("%w: participant %d", ErrInvalidProof, id)
+		}
+		kg.commitments[id-1] = msgs[i].Commitments
+	}
+	if len(msgs) != kg.nbParticipants-1 {
+		return nil, errMissingMessage
+	}
+
+	kg.commitments[kg.id-1] = make([]secp256k1.G1Affine, kg.threshold)
+	var b big.Int
+	for i := range kg.poly {
+		kg.commitments[kg.id-1][i].ScalarMultiplicationBase(kg.poly[i].BigInt(&b))
+	}
+
+	res := make([]Round2Message, 0, kg.nbParticipants-1)
+	for j := 1; j <= kg.nbParticipants; j++ {
+		if j == kg.id {
+			continue
+		}
+		var x fr.Element
+		x.SetUint64(uint64(j))
+		res = append(res, Round2Message{From: kg.id, To: j, Share: kg.poly.Eval(&x)})
+	}
+	return res, nil
+}
+
+// Finalize checks the shares received from the other participants in the second round
+// against their commitments and returns the key share of the participant.
+//
+// If a share does not verify, the returned error wraps ErrInvalidShare and names the sender.
+func (kg *KeyGen) Finalize(shares []Round2Message) (*KeyShare, error) {
+	if kg.commitments == nil {
+		return nil, errRoundOrder
+	}
+	if len(shares) != kg.nbParticipants-1 {
+		return nil, errMissingMessage
+	}
+
+	var x fr.Element
+	x.SetUint64(uint64(kg.id))
+	ks := &KeyShare{ID: kg.id}
+	ks.secret = kg.poly.Eval(&x)
+
+	seen := make([]bool, kg.nbParticipants)
+	for i := range shares {
+		from := shares[i].From
+		if from < 1 || from > kg.nbParticipants || from == kg.id || shares[i].To != kg.id || seen[from-1] {
+			return nil, errUnexpectedMessage
+		}
+		seen[from-1] = true
+
+		// f_j(i)⋅G = ∑ₖ iᵏ⋅φⱼₖ
+		var lhs, rhs secp256k1.G1Jac
+		var b big.Int
+		lhs.ScalarMultiplicationAffine(&g1GenAff, shares[i].Share.BigInt(&b))
+		rhs = evalCommitments(kg.commitments[from-1], &x)
+		if !lhs.Equal(&rhs) {
+			return nil, fmt.Errorf("%w: participant %d", ErrInvalidShare, from)
+		}
+		ks.secret.Add(&ks.secret, &shares[i].Share)
+	}
+
+	// the commitments to the polynomial ∑ⱼ fⱼ give the group key and the verification shares
+	sum := make([]secp256k1.G1Jac, kg.threshold)
+	for j := range kg.commitments {
+		for k := range sum {
+			sum[k].AddMixed(&kg.commitments[j][k])
+		}
+	}
+	commitments := secp256k1.BatchJacobianToAffineG1(sum)
+
+	ks.Public.Threshold = kg.threshold
+	ks.Public.Key = commitments[0]
+	shareCommitments := make([]secp256k1.G1Jac, kg.nbParticipants)
+	for j := range shareCommitments {
+		x.SetUint64(uint64(j + 1))
+		shareCommitments[j] = evalCommitments(commitments, &x)
+	}
+	ks.Public.Shares = secp256k1.BatchJacobianToAffineG1(shareCommitments)
+
+	// erase the secret polynomial
+	for i := range kg.poly {
+		kg.poly[i].SetZero()
+	}
+
+	return ks, nil
+}
+
+// evalCommitments returns ∑ₖ xᵏ⋅φₖ, the commitment to the evaluation at x of the
+// polynomial committed to by φ
+func evalCommitments(commitments []secp256k1.G1Affine, x *fr.Element) secp256k1.G1Jac {
+	var res secp256k1.G1Jac
+	res.FromAffine(&commitments[len(commitments)-1])
+	for k := len(commitments) - 2; k >= 0; k-- {
+		res.ScalarMul(&res, x)
+		res.AddMixed(&commitments[k])
+	}
+	return res
+}
+
+// verifyProofOfKnowledge checks that the commitments are on the curve and that z⋅G - c⋅φ₀ = R
+func verifyProofOfKnowledge(msg *Round1Message) bool {
+	for i := range msg.Commitments {
+		if !msg.Commitments[i].IsOnCurve() {
+			return false
+		}
+	}
+	if msg.Commitments[0].IsInfinity() {
+		return false
+	}
+	c, err := dkgChallenge(msg.ID, &msg.Commitments[0], &msg.ProofR)
+	if err != nil {
+		return false
+	}
+	var z, negC big.Int
+	msg.ProofZ.BigInt(&z)
+	c.Neg(&c).BigInt(&negC)
+	var _R secp256k1.G1Jac
+	_R.JointScalarMultiplicationBase(&msg.Commitments[0], &z, &negC)
+	var R secp256k1.G1Affine
+	R.FromJacobian(&_R)
+	return R.Equal(&msg.ProofR)
+}
+
+// dkgChallenge returns the challenge H(id ∥ φ₀ ∥ R) of the proof of knowledge of participant id
+func dkgChallenge(id int, phi0, R *secp256k1.G1Affine) (fr.Element, error) {
+	i := encodeID(id)
+	p := encodePoint(phi0)
+	r := encodePoint(R)
+	buf := make([]byte, 0, sizeFr+2*sizePoint)
+	buf = append(buf, i[:]...)
+	buf = append(buf, p[:]...)
+	buf = append(buf, r[:]...)
+	u, err := fr.Hash(buf, []byte(contextString+"dkg"), 1)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	return u[0], nil
+}
+
+// randScalar sets k to a uniformly random non-zero scalar read from rand
+func randScalar(k *fr.Element, rand io.Reader) error {
+	// 64 more bits than the size of the field make the bias negligible
+	var buf [sizeFr + 8]byte
+	for {
+		if _, err := io.ReadFull(rand, buf[:]); err != nil {
+			return err
+		}
+		if !k.SetBytes(buf[:]).IsZero() {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+// contextString is the context string of the ciphersuite (RFC 9591, section 6.5)
+const contextString = "FROST-secp256k1-SHA256-v1"
+
+const (
+	sizeFr    = fr.Bytes
+	sizePoint = 1 + fp.Bytes // SEC 1 compressed point
+)
+
+var (
+	// ErrInvalidShare is returned, wrapped with the identifier of the culprit, when a
+	// participant sends a secret share or a signature share which does not verify.
+	ErrInvalidShare = errors.New("invalid share")
+
+	errNonceReused           = errors.New("nonces have already been used")
+	errNotASigner            = errors.New("the signer is not part of the commitment list")
+	errInvalidCommitmentList = errors.New("commitment list must be sorted by identifier, without duplicates nor identity points")
+	errNotEnoughSigners      = errors.New("not enough signers")
+	errShareMismatch         = errors.New("signature shares do not match the commitment list")
+)
+
+// GroupPublicKey is the public output of the key generation.
+type GroupPublicKey struct {
+	Threshold int                  // minimal number of signers
+	Key       secp256k1.G1Affine   // group public key Y
+	Shares    []secp256k1.G1Affine // Shares[i-1] is the verification share sᵢ⋅G of participant i
+}
+
+// KeyShare is the output of the key generation for a participant.
+type KeyShare struct {
+	ID     int            // identifier of the participant, in 1…n
+	Public GroupPublicKey // copy of the group public key
+	secret fr.Element     // secret share sᵢ of the group secret key
+}
+
+// Signature is a Schnorr signature (R, z) such that z⋅G = R + c⋅Y, with c = H2(R ∥ Y ∥ msg).
+type Signature struct {
+	R secp256k1.G1Affine
+	Z fr.Element
+}
+
+// Commitment holds the commitments of a signer to its nonces, sent to the coordinator
+// in the first round of signing.
+type Commitment struct {
+	ID      int
+	Hiding  secp256k1.G1Affine
+	Binding secp256k1.G1Affine
+}
+
+// Nonces holds the secret nonces of a signer for a single signing session.
+type Nonces struct {
+	hiding, binding fr.Element
+}
+
+// SignatureShare is the contribution of a signer to a signature.
+type SignatureShare struct {
+	ID int
+	Z  fr.Element
+}
+
+// Commit returns fresh nonces and the matching commitment (first round of signing).
+// The commitment is sent to the coordinator and the nonces are kept for Sign.
+func (ks *KeyShare) Commit(rand io.Reader) (*Nonces, *Commitment, error) {
+	var nonces Nonces
+	if err := nonceGenerate(&nonces.hiding, &ks.secret, rand); err != nil {
+		return nil, nil, err
+	}
+	if err := nonceGenerate(&nonces.binding, &ks.secret, rand); err != nil {
+		return nil, nil, err
+	}
+
+	var d, e big.Int
+	commitment := &Commitment{ID: ks.ID}
+	commitment.Hiding.ScalarMultiplicationBaseCT(nonces.hiding.BigInt(&d))
+	commitment.Binding.ScalarMultiplicationBaseCT(nonces.binding.BigInt(&e))
+
+	return &nonces, commitment, nil
+}
+
+// Sign returns the signature share of msg (second round of signing).
+//
+// commitments are the commitments of the signers chosen by the coordinator, sorted
+// by identifier, and must include the one matching nonces. The nonces are erased
+// so that they can not be used twice.
+func (ks *KeyShare) Sign(nonces *Nonces, msg []byte, commitments []Commitment) (*SignatureShare, error) {
+	if nonces.hiding.IsZero() || nonces.binding.IsZero() {
+		return nil, errNonceReused
+	}
+	if err := checkCommitmentList(commitments, ks.Public.Threshold); err != nil {
+		return nil, err
+	}
+	idx := -1
+	for i := range commitments {
+		if commitments[i].ID == ks.ID {
+			idx = i
+		}
+	}
+	if idx == -1 {
+		return nil, errNotASigner
+	}
+
+	rho, err := bindingFactors(&ks.Public.Key, msg, commitments)
+	if err != nil {
+		return nil, err
+	}
+	R := groupCommitment(commitments, rho)
+	c, err := challenge(&R, &ks.Public.Key, msg)
+	if err != nil {
+		return nil, err
+	}
+	lambda := lagrangeCoefficient(ks.ID, commitments)
+
+	// z = d + e⋅ρ + λ⋅s⋅c
+	var z, t fr.Element
+	z.Mul(&nonces.binding, &rho[idx]).Add(&z, &nonces.hiding)
+	t.Mul(&lambda, &ks.secret).Mul(&t, &c)
+	z.Add(&z, &t)
+
+	nonces.hiding.SetZero()
+	nonces.binding.SetZero()
+
+	return &SignatureShare{ID: ks.ID, Z: z}, nil
+}
+
+// Aggregate checks the signature shares of msg and combines them into a signature for
+// the group public key (run by the coordinator).
+//
+// commitments are the commitments sent to the signers, and shares their signature shares,
+// in the same order. If a share does not verify, the returned error wraps ErrInvalidShare
+// and names the signer.
+func Aggregate(gpk *GroupPublicKey, msg []byte, commitments []Commitment, shares []SignatureShare) (*Signature, error) {
+	if err := checkCommitmentList(commitments, gpk.Threshold); err != nil {
+		return nil, err
+	}
+	if len(shares) != len(commitments) {
+		return nil, errShareMismatch
+	}
+	for i := range commitments {
+		if shares[i].ID != commitments[i].ID || commitments[i].ID > len(gpk.Shares) {
+			return nil, errShareMismatch
+		}
+	}
+
+	rho, err := bindingFactors(&gpk.Key, msg, commitments)
+	if err != nil {
+		return nil, err
+	}
+	R := groupCommitment(commitments, rho)
+	c, err := challenge(&R, &gpk.Key, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var z fr.Element
+	for i := range shares {
+		// zᵢ⋅G = Dᵢ + ρᵢ⋅Eᵢ + (c⋅λᵢ)⋅Yᵢ
+		var lhs, rhs, t secp256k1.G1Jac
+		var zi big.Int
+		lhs.ScalarMultiplicationAffine(&g1GenAff, shares[i].Z.BigInt(&zi))
+
+		lambda := lagrangeCoefficient(shares[i].ID, commitments)
+		lambda.Mul(&lambda, &c)
+		rhs.FromAffine(&gpk.Shares[shares[i].ID-1])
+		rhs.ScalarMul(&rhs, &lambda)
+		t.FromAffine(&commitments[i].Binding)
+		t.ScalarMul(&t, &rho[i])
+		rhs.AddAssign(&t)
+		rhs.AddMixed(&commitments[i].Hiding)
+
+		if !lhs.Equal(&rhs) {
+			return nil, fmt.Errorf("%w: signer %d", ErrInvalidShare, shares[i].ID)
+		}
+		z.Add(&z, &shares[i].Z)
+	}
+
+	return &Signature{R: R, Z: z}, nil
+}
+
+// Verify checks the signature sig of msg under the group public key.
+func Verify(groupKey *secp256k1.G1Affine, sig *Signature, msg []byte) bool {
+	if groupKey.IsInfinity() || !groupKey.IsOnCurve() || !sig.R.IsOnCurve() {
+		return false
+	}
+	c, err := challenge(&sig.R, groupKey, msg)
+	if err != nil {
+		return false
+	}
+
+	// z⋅G - c⋅Y = R
+	var z, negC big.Int
+	sig.Z.BigInt(&z)
+	c.Neg(&c).BigInt(&negC)
+	var _R secp256k1.G1Jac
+	_R.JointScalarMultiplicationBase(groupKey, &z, &negC)
+	var R secp256k1.G1Affine
+	R.FromJacobian(&_R)
+
+	return R.Equal(&sig.R)
+}
+
+// checkCommitmentList checks that there are at least threshold commitments, sorted by
+// identifier, and that no commitment is the point at infinity.
+func checkCommitmentList(commitments []Commitment, threshold int) error {
+	if len(commitments) < threshold {
+		return errNotEnoughSigners
+	}
+	for i := range commitments {
+		if commitments[i].ID < 1 || (i > 0 && commitments[i].ID <= commitments[i-1].ID) {
+			return errInvalidCommitmentList
+		}
+		if commitments[i].Hiding.IsInfinity() || commitments[i].Binding.IsInfinity() {
+			return errInvalidCommitmentList
+		}
+	}
+	return nil
+}
+
+// bindingFactors returns the binding factor ρᵢ of each signer in the commitment list
+//
+//	ρᵢ = H1(Y ∥ H4(msg) ∥ H5(commitments) ∥ i)
+func bindingFactors(groupKey *secp256k1.G1Affine, msg []byte, commitments []Commitment) ([]fr.Element, error) {
+	y := encodePoint(groupKey)
+	msgHash := h(msg, "msg")
+	commitmentsHash := h(encodeCommitmentList(commitments), "com")
+
+	prefix := make([]byte, 0, sizePoint+2*sha256.Size+sizeFr)
+	prefix = append(prefix, y[:]...)
+	prefix = append(prefix, msgHash...)
+	prefix = append(prefix, commitmentsHash...)
+
+	res := make([]fr.Element, len(commitments))
+	for i := range commitments {
+		id := encodeID(commitments[i].ID)
+		u, err := fr.Hash(append(prefix, id[:]...), []byte(contextString+"rho"), 1)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = u[0]
+	}
+	return res, nil
+}
+
+// groupCommitment returns R = ∑ Dᵢ + ρᵢ⋅Eᵢ
+func groupCommitment(commitments []Commitment, rho []fr.Element) secp256k1.G1Affine {
+	var r, t secp256k1.G1Jac
+	for i := range commitments {
+		t.FromAffine(&commitments[i].Binding)
+		t.ScalarMul(&t, &rho[i])
+		t.AddMixed(&commitments[i].Hiding)
+		r.AddAssign(&t)
+	}
+	var res secp256k1.G1Affine
+	res.FromJacobian(&r)
+	return res
+}
+
+// challenge returns c = H2(R ∥ Y ∥ msg)
+func challenge(R, groupKey *secp256k1.G1Affine, msg []byte) (fr.Element, error) {
+	r := encodePoint(R)
+	y := encodePoint(groupKey)
+	buf := make([]byte, 0, 2*sizePoint+len(msg))
+	buf = append(buf, r[:]...)
+	buf = append(buf, y[:]...)
+	buf = append(buf, msg...)
+	u, err := fr.Hash(buf, []byte(contextString+"chal"), 1)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	return u[0], nil
+}
+
+// lagrangeCoefficient returns the Lagrange coefficient λᵢ at 0 of participant id
+// among the signers of the commitment list
+//
+//	λᵢ = ∏ⱼ xⱼ / (xⱼ - xᵢ)
+func lagrangeCoefficient(id int, commitments []Commitment) fr.Element {
+	var num, den, xi, xj, t fr.Element
+	num.SetOne()
+	den.SetOne()
+	xi.SetUint64(uint64(id))
+	for i := range commitments {
+		if commitments[i].ID == id {
+			continue
+		}
+		xj.SetUint64(uint64(commitments[i].ID))
+		num.Mul(&num, &xj)
+		t.Sub(&xj, &xi)
+		den.Mul(&den, &t)
+	}
+	den.Inverse(&den)
+	num.Mul(&num, &den)
+	return num
+}
+
+// nonceGenerate sets k to H3(random_bytes ∥ secret), as in RFC 9591, section 4.1
+func nonceGenerate(k, secret *fr.Element, rand io.Reader) error {
+	var buf [32 + sizeFr]byte
+	if _, err := io.ReadFull(rand, buf[:32]); err != nil {
+		return err
+	}
+	s := secret.Bytes()
+	copy(buf[32:], s[:])
+	u, err := fr.Hash(buf[:], []byte(contextString+"nonce"), 1)
+	if err != nil {
+		return err
+	}
+	*k = u[0]
+	return nil
+}
+
+// h returns SHA-256(contextString ∥ tag ∥ msg), as H4 and H5 of RFC 9591
+func h(msg []byte, tag string) []byte {
+	md := sha256.New()
+	md.Write([]byte(contextString + tag))
+	md.Write(msg)
+	return md.Sum(nil)
+}
+
+// encodeCommitmentList returns the concatenation of i ∥ Dᵢ ∥ Eᵢ for each signer
+func encodeCommitmentList(commitments []Commitment) []byte {
+	res := make([]byte, 0, len(commitments)*(sizeFr+2*sizePoint))
+	for i := range commitments {
+		id := encodeID(commitments[i].ID)
+		d := encodePoint(&commitments[i].Hiding)
+		e := encodePoint(&commitments[i].Binding)
+		res = append(res, id[:]...)
+		res = append(res, d[:]...)
+		res = append(res, e[:]...)
+	}
+	return res
+}
+
+// encodeID returns the identifier id as a scalar in big Endian
+func encodeID(id int) [sizeFr]byte {
+	var x fr.Element
+	x.SetUint64(uint64(id))
+	return x.Bytes()
+}
+
+// encodePoint returns the SEC 1 compressed encoding of p: 0x02 or 0x03 according to
+// the parity of y, followed by x in big Endian. The point at infinity is encoded as zeros.
+func encodePoint(p *secp256k1.G1Affine) (res [sizePoint]byte) {
+	if p.IsInfinity() {
+		return
+	}
+	res[0] = 0x02 | byte(p.Y.Bits()[0]&1)
+	xBytes := p.X.Bytes()
+	copy(res[1:], xBytes[:])
+	return
+}
@@ -0,0 +1,244 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"crypto/rand"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+// signingRequest is sent by the coordinator to the signers in the second round of signing
+type signingRequest struct {
+	msg         []byte
+	commitments []Commitment
+}
+
+// runSigning signs msg with the given signers and a coordinator, connected by a LocalNetwork
+func runSigning(keyShares []*KeyShare, signers []int, msg []byte) (*Signature, error) {
+	network := NewLocalNetwork(len(keyShares))
+	errs := make([]error, len(signers))
+
+	var wg sync.WaitGroup
+	for i, id := range signers {
+		wg.Add(1)
+		go func(i, id int) {
+			defer wg.Done()
+			errs[i] = signer(network, keyShares[id-1])
+		}(i, id)
+	}
+
+	// coordinator
+	commitments := make([]Commitment, 0, len(signers))
+	for len(commitments) < len(signers) {
+		m, err := network.Receive(0)
+		if err != nil {
+			return nil, err
+		}
+		commitments = append(commitments, m.Payload.(Commitment))
+	}
+	sort.Slice(commitments, func(i, j int) bool { return commitments[i].ID < commitments[j].ID })
+	for _, c := range commitments {
+		if err := network.Send(0, c.ID, signingRequest{msg: msg, commitments: commitments}); err != nil {
+			return nil, err
+		}
+	}
+	shares := make([]SignatureShare, len(signers))
+	for range signers {
+		m, err := network.Receive(0)
+		if err != nil {
+			return nil, err
+		}
+		share := m.Payload.(SignatureShare)
+		for i := range commitments {
+			if commitments[i].ID == share.ID {
+				shares[i] = share
+			}
+		}
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return Aggregate(&keyShares[0].Public, msg, commitments, shares)
+}
+
+func signer(network *LocalNetwork, ks *KeyShare) error {
+	nonces, commitment, err := ks.Commit(rand.Reader)
+	if err != nil {
+		return err
+	}
+	if err := network.Send(ks.ID, 0, *commitment); err != nil {
+		return err
+	}
+	m, err := network.Receive(ks.ID)
+	if err != nil {
+		return err
+	}
+	request := m.Payload.(signingRequest)
+	share, err := ks.Sign(nonces, request.msg, request.commitments)
+	if err != nil {
+		return err
+	}
+	return network.Send(ks.ID, 0, *share)
+}
+
+func TestFROST(t *testing.T) {
+	t.Parallel()
+
+	keyShares, err := runDKG(3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groupKey := keyShares[0].Public.Key
+
+	msg := []byte("testing FROST")
+	for _, signers := range [][]int{{1, 3, 5}, {2, 3, 4}, {1, 2, 3, 4, 5}} {
+		sig, err := runSigning(keyShares, signers, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !Verify(&groupKey, sig, msg) {
+			t.Fatal("valid signature rejected")
+		}
+		if Verify(&groupKey, sig, []byte("another message")) {
+			t.Fatal("signature verified on a different message")
+		}
+		sig.Z.Add(&sig.Z, new(fr.Element).SetOne())
+		if Verify(&groupKey, sig, msg) {
+			t.Fatal("tampered signature verified")
+		}
+	}
+}
+
+// signingRound1 runs the first round of signing sequentially
+func signingRound1(t *testing.T, keyShares []*KeyShare, signers []int) ([]*Nonces, []Commitment) {
+	nonces := make([]*Nonces, len(signers))
+	commitments := make([]Commitment, len(signers))
+	for i, id := range signers {
+		n, c, err := keyShares[id-1].Commit(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nonces[i], commitments[i] = n, *c
+	}
+	return nonces, commitments
+}
+
+func TestFROSTInvalidShare(t *testing.T) {
+	t.Parallel()
+
+	keyShares, err := runDKG(2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("testing FROST")
+	signers := []int{1, 3}
+	nonces, commitments := signingRound1(t, keyShares, signers)
+
+	shares := make([]SignatureShare, len(signers))
+	for i, id := range signers {
+		share, err := keyShares[id-1].Sign(nonces[i], msg, commitments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shares[i] = *share
+	}
+	if _, err := Aggregate(&keyShares[0].Public, msg, commitments, shares); err != nil {
+		t.Fatal(err)
+	}
+
+	shares[1].Z.Add(&shares[1].Z, new(fr.Element).SetOne())
+	if _, err := Aggregate(&keyShares[0].Public, msg, commitments, shares); !errors.Is(err, ErrInvalidShare) {
+		t.Fatal("expected an invalid signature share", err)
+	}
+}
+
+func TestFROSTMisuse(t *testing.T) {
+	t.Parallel()
+
+	keyShares, err := runDKG(2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("testing FROST")
+
+	nonces, commitments := signingRound1(t, keyShares, []int{1, 2})
+	if _, err := keyShares[0].Sign(nonces[0], msg, commitments); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := keyShares[0].Sign(nonces[0], msg, commitments); err == nil {
+		t.Fatal("nonces must not be reused")
+	}
+	if _, err := keyShares[1].Sign(nonces[1], msg, commitments[:1]); err == nil {
+		t.Fatal("signing with less than threshold signers should fail")
+	}
+	if _, err := keyShares[2].Sign(nonces[1], msg, commitments); err == nil {
+		t.Fatal("signing without being in the commitment list should fail")
+	}
+	commitments[0], commitments[1] = commitments[1], commitments[0]
+	if _, err := keyShares[1].Sign(nonces[1], msg, commitments); err == nil {
+		t.Fatal("unsorted commitment list should be rejected")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkSignFROST(b *testing.B) {
+	keyShares, err := runDKG(2, 3)
+	if err != nil {
+		b.Fatal(err)
+	}
+	msg := []byte("benchmarking FROST sign()")
+	nonces := make([]*Nonces, b.N)
+	commitments := make([][]Commitment, b.N)
+	for i := 0; i < b.N; i++ {
+		n, c1, _ := keyShares[0].Commit(rand.Reader)
+		_, c2, _ := keyShares[1].Commit(rand.Reader)
+		nonces[i], commitments[i] = n, []Commitment{*c1, *c2}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		keyShares[0].Sign(nonces[i], msg, commitments[i])
+	}
+}
+
+func BenchmarkVerifyFROST(b *testing.B) {
+	keyShares, err := runDKG(2, 3)
+	if err != nil {
+		b.Fatal(err)
+	}
+	msg := []byte("benchmarking FROST sign()")
+	sig, err := runSigning(keyShares, []int{1, 2}, msg)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(&keyShares[0].Public.Key, sig, msg)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/stretchr/testify/require"
+)
+
+// test vectors from RFC 9591, appendix E.5, FROST(secp256k1, SHA-256): the group secret key is
+// split 2-of-3 by a trusted dealer, and participants 1 and 3 sign the message "test".
+// The nonce randomness is only given for participant 1, whose nonces are derived by Commit;
+// the nonces of participant 3 are set directly.
+const (
+	vectorGroupSecretKey = "0d004150d27c3bf2a42f312683d35fac7394b1e9e318249c1bfe7f0795a83114"
+	vectorGroupPublicKey = "02f37c34b66ced1fb51c34a90bdae006901f10625cc06c4f64663b0eae87d87b4f"
+	vectorMessage        = "74657374"
+	vectorSignature      = "0205b6d04d3774c8929413e3c76024d54149c372d57aae62574ed74319b5ea14d0c65dde8492a7471437e6c2fe3da49b90d23f642b5c6dbe7e36089f096dd97324"
+)
+
+var vectorShares = []string{
+	"08f89ffe80ac94dcb920c26f3f46140bfc7f95b493f8310f5fc1ea2b01f4254c",
+	"04f0feac2edcedc6ce1253b7fab8c86b856a797f44d83d82a385554e6e401984",
+	"00e95d59dd0d46b0e303e500b62b7ccb0e555d49f5b849f5e748c071da8c0dbc",
+}
+
+// vectorSigner holds the values of a signing participant
+type vectorSigner struct {
+	id                                  int
+	hidingRandomness, bindingRandomness string // empty when not given
+	hidingNonce, bindingNonce           string
+	hidingCommitment, bindingCommitment string
+	bindingFactor, sigShare             string
+}
+
+var vectorSigners = []vectorSigner{
+	{
+		id:                1,
+		hidingRandomness:  "7ea5ed09af19f6ff21040c07ec2d2adbd35b759da5a401d4c99dd26b82391cb2",
+		bindingRandomness: "47acab018f116020c10cb9b9abdc7ac10aae1b48ca6e36dc15acb6ec9be5cdc5",
+		hidingNonce:       "841d3a6450d7580b4da83c8e618414d0f024391f2aeb511d7579224420aa81f0",
+		bindingNonce:      "8d2624f532af631377f33cf44b5ac5f849067cae2eacb88680a31e77c79b5a80",
+		hidingCommitment:  "03c699af97d26bb4d3f05232ec5e1938c12f1e6ae97643c8f8f11c9820303f1904",
+		bindingCommitment: "02fa2aaccd51b948c9dc1a325d77226e98a5a3fe65fe9ba213761a60123040a45e",
+		bindingFactor:     "3e08fe561e075c653cbfd46908a10e7637c70c74f0a77d5fd45d1a750c739ec6",
+		sigShare:          "c4fce1775a1e141fb579944166eab0d65eefe7b98d480a569bbbfcb14f91c197",
+	},
+	{
+		id:                3,
+		hidingNonce:       "2b19b13f193f4ce83a399362a90cdc1e0ddcd83e57089a7af0bdca71d47869b2",
+		bindingNonce:      "7a443bde83dc63ef52dda354005225ba0e553243402a4705ce28ffaafe0f5b98",
+		hidingCommitment:  "03077507ba327fc074d2793955ef3410ee3f03b82b4cdc2370f71d865beb926ef6",
+		bindingCommitment: "02ad53031ddfbbacfc5fbda3d3b0c2445c8e3e99cbc4ca2db2aa283fa68525b135",
+		bindingFactor:     "93f79041bb3fd266105be251adaeb5fd7f8b104fb554a4ba9a0becea48ddbfd7",
+		sigShare:          "0160fd0d388932f4826d2ebcd6b9eaba734f7c71cf25b4279a4ca2581e47b18d",
+	},
+}
+
+func TestFROSTVectors(t *testing.T) {
+	assert := require.New(t)
+
+	decodeHex := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		assert.NoError(err)
+		return b
+	}
+	decodeScalar := func(s string) fr.Element {
+		var x fr.Element
+		assert.NoError(x.SetBytesCanonical(decodeHex(s)))
+		return x
+	}
+	assertPoint := func(expected string, p *secp256k1.G1Affine) {
+		b := encodePoint(p)
+		assert.Equal(expected, hex.EncodeToString(b[:]))
+	}
+	assertScalar := func(expected string, x *fr.Element) {
+		b := x.Bytes()
+		assert.Equal(expected, hex.EncodeToString(b[:]))
+	}
+	scalarMulBase := func(x *fr.Element) secp256k1.G1Affine {
+		var res secp256k1.G1Affine
+		var b big.Int
+		res.ScalarMultiplicationBase(x.BigInt(&b))
+		return res
+	}
+
+	// key generation by a trusted dealer
+	sk := decodeScalar(vectorGroupSecretKey)
+	gpk := GroupPublicKey{Threshold: 2, Key: scalarMulBase(&sk)}
+	assertPoint(vectorGroupPublicKey, &gpk.Key)
+	keyShares := make([]*KeyShare, len(vectorShares))
+	for i := range vectorShares {
+		keyShares[i] = &KeyShare{ID: i + 1, secret: decodeScalar(vectorShares[i])}
+		gpk.Shares = append(gpk.Shares, scalarMulBase(&keyShares[i].secret))
+	}
+	for i := range keyShares {
+		keyShares[i].Public = gpk
+	}
+
+	// first round
+	nonces := make([]*Nonces, len(vectorSigners))
+	commitments := make([]Commitment, len(vectorSigners))
+	for i, v := range vectorSigners {
+		if v.hidingRandomness != "" {
+			rand := bytes.NewReader(append(decodeHex(v.hidingRandomness), decodeHex(v.bindingRandomness)...))
+			n, c, err := keyShares[v.id-1].Commit(rand)
+			assert.NoError(err)
+			nonces[i], commitments[i] = n, *c
+		} else {
+			nonces[i] = &Nonces{hiding: decodeScalar(v.hidingNonce), binding: decodeScalar(v.bindingNonce)}
+			commitments[i] = Commitment{
+				ID:      v.id,
+				Hiding:  scalarMulBase(&nonces[i].hiding),
+				Binding: scalarMulBase(&nonces[i].binding),
+			}
+		}
+		assertScalar(v.hidingNonce, &nonces[i].hiding)
+		assertScalar(v.bindingNonce, &nonces[i].binding)
+		assertPoint(v.hidingCommitment, &commitments[i].Hiding)
+		assertPoint(v.bindingCommitment, &commitments[i].Binding)
+	}
+
+	msg := decodeHex(vectorMessage)
+	rho, err := bindingFactors(&gpk.Key, msg, commitments)
+	assert.NoError(err)
+	for i, v := range vectorSigners {
+		assertScalar(v.bindingFactor, &rho[i])
+	}
+
+	// second round
+	shares := make([]SignatureShare, len(vectorSigners))
+	for i, v := range vectorSigners {
+		share, err := keyShares[v.id-1].Sign(nonces[i], msg, commitments)
+		assert.NoError(err)
+		assertScalar(v.sigShare, &share.Z)
+		shares[i] = *share
+	}
+
+	sig, err := Aggregate(&gpk, msg, commitments, shares)
+	assert.NoError(err)
+	r, z := encodePoint(&sig.R), sig.Z.Bytes()
+	assert.Equal(vectorSignature, hex.EncodeToString(append(r[:], z[:]...)))
+	assert.True(Verify(&gpk.Key, sig, msg))
+}
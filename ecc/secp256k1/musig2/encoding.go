@@ -0,0 +1,127 @@
+// Copyright 2023 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package musig2
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+const (
+	// SizePublicKey is the size in bytes of a compressed public key
+	SizePublicKey = 1 + fp.Bytes
+	// SizeXOnlyPublicKey is the size in bytes of an x-only public key (BIP-340)
+	SizeXOnlyPublicKey = fp.Bytes
+	// SizePubNonce is the size in bytes of a public nonce or of an aggregate nonce
+	SizePubNonce = 2 * SizePublicKey
+	// SizeSignature is the size in bytes of a BIP-340 signature
+	SizeSignature = fp.Bytes + fr.Bytes
+)
+
+var errInvalidPoint = errors.New("invalid point encoding")
+
+// bytesCompressed returns cbytes(p): 0x02 or 0x03 according to the parity of y, followed by x
+func bytesCompressed(p *secp256k1.G1Affine) (res [SizePublicKey]byte) {
+	res[0] = 0x02 | byte(p.Y.Bits()[0]&1)
+	x := p.X.Bytes()
+	copy(res[1:], x[:])
+	return
+}
+
+// bytesCompressedExt returns cbytes_ext(p), which encodes the point at infinity as zeros
+func bytesCompressedExt(p *secp256k1.G1Affine) (res [SizePublicKey]byte) {
+	if p.IsInfinity() {
+		return
+	}
+	return bytesCompressed(p)
+}
+
+// setCompressed sets p to cpoint(buf), failing if buf is not the compressed encoding of a point
+func setCompressed(p *secp256k1.G1Affine, buf []byte) error {
+	if len(buf) != SizePublicKey || (buf[0] != 0x02 && buf[0] != 0x03) {
+		return errInvalidPoint
+	}
+	if err := liftX(p, buf[1:]); err != nil {
+		return err
+	}
+	if p.Y.Bits()[0]&1 != uint64(buf[0]&1) {
+		p.Y.Neg(&p.Y)
+	}
+	return nil
+}
+
+// setCompressedExt sets p to cpoint_ext(buf), which decodes zeros as the point at infinity
+func setCompressedExt(p *secp256k1.G1Affine, buf []byte) error {
+	var zero [SizePublicKey]byte
+	if len(buf) == SizePublicKey && string(buf) == string(zero[:]) {
+		p.SetInfinity()
+		return nil
+	}
+	return setCompressed(p, buf)
+}
+
+// liftX sets p to the point with x-coordinate x and even y, failing if there is none
+func liftX(p *secp256k1.G1Affine, x []byte) error {
+	if err := p.X.SetBytesCanonical(x); err != nil {
+		return errInvalidPoint
+	}
+	// y² = x³ + 7
+	_, b := secp256k1.CurveCoefficients()
+	var y2 fp.Element
+	y2.Square(&p.X).Mul(&y2, &p.X).Add(&y2, &b)
+	if p.Y.Sqrt(&y2) == nil {
+		return errInvalidPoint
+	}
+	if !hasEvenY(p) {
+		p.Y.Neg(&p.Y)
+	}
+	return nil
+}
+
+// hasEvenY returns true if the y-coordinate of p is even
+func hasEvenY(p *secp256k1.G1Affine) bool {
+	return p.Y.Bits()[0]&1 == 0
+}
+
+// bytesX returns xbytes(p), the x-coordinate of p in big Endian
+func bytesX(p *secp256k1.G1Affine) [fp.Bytes]byte {
+	return p.X.Bytes()
+}
+
+// taggedHash returns SHA-256(SHA-256(tag) ∥ SHA-256(tag) ∥ msg₀ ∥ msg₁ ∥ …), as defined in BIP-340
+func taggedHash(tag string, msgs ...[]byte) [sha256.Size]byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, m := range msgs {
+		h.Write(m)
+	}
+	var res [sha256.Size]byte
+	h.Sum(res[:0])
+	return res
+}
+
+// hashToScalar returns int(taggedHash(tag, msgs…)) mod n
+func hashToScalar(tag string, msgs ...[]byte) fr.Element {
+	h := taggedHash(tag, msgs...)
+	var res fr.Element
+	res.SetBytes(h[:])
+	return res
+}
@@ -0,0 +1,547 @@
+// Copyright 2023 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package musig2 implements the MuSig2 multi-signature scheme on secp256k1, as specified in BIP-327.
+//
+// The signers aggregate their public keys with KeyAgg into a single x-only public key,
+// optionally tweaked (e.g. for taproot). Signing takes two rounds: each signer generates
+// a nonce with NonceGen and broadcasts the public part, the public nonces are combined
+// with NonceAgg, then each signer produces a partial signature with Session.Sign. The
+// partial signatures are checked with Session.PartialSigVerify and combined with
+// Session.PartialSigAgg into a BIP-340 Schnorr signature, which Verify checks.
+//
+// Documentation:
+// - BIP-327: https://github.com/bitcoin/bips/blob/master/bip-0327.mediawiki
+// - BIP-340: https://github.com/bitcoin/bips/blob/master/bip-0340.mediawiki
+// - MuSig2: https://eprint.iacr.org/2020/1261.pdf
+package musig2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+var (
+	// ErrInvalidContribution is returned, wrapped with the index of the culprit, when
+	// the public key, public nonce or partial signature of a signer is invalid.
+	ErrInvalidContribution = errors.New("invalid signer contribution")
+
+	errInfinity         = errors.New("the result is the point at infinity")
+	errInvalidTweak     = errors.New("tweak is not smaller than the group order")
+	errInvalidPSig      = errors.New("partial signature is not smaller than the group order")
+	errInvalidSecretKey = errors.New("secret key must be in [1, n-1]")
+	errInvalidSecNonce  = errors.New("secret nonce is invalid or has already been used")
+	errSecNonceMismatch = errors.New("secret nonce does not belong to the signer")
+	errUnknownPublicKey = errors.New("public key is not part of the aggregated keys")
+	errNoPublicKeys     = errors.New("no public keys to aggregate")
+)
+
+// KeyAggContext holds the aggregate public key and the accumulated tweaks (BIP-327, KeyAgg Context).
+type KeyAggContext struct {
+	q          secp256k1.G1Affine // aggregate public key Q
+	gacc, tacc fr.Element
+	pubKeys    [][SizePublicKey]byte
+	pk2        [SizePublicKey]byte // second distinct public key, or zeros
+	hashKeys   [32]byte            // HashKeys(pk₁, …, pkᵤ)
+}
+
+// KeySort sorts the public keys by their compressed encoding, in place.
+func KeySort(pubKeys []secp256k1.G1Affine) {
+	sort.Slice(pubKeys, func(i, j int) bool {
+		a, b := bytesCompressed(&pubKeys[i]), bytesCompressed(&pubKeys[j])
+		return bytes.Compare(a[:], b[:]) < 0
+	})
+}
+
+// ParsePublicKey returns the point encoded in the compressed public key buf.
+func ParsePublicKey(buf []byte) (secp256k1.G1Affine, error) {
+	var p secp256k1.G1Affine
+	err := setCompressed(&p, buf)
+	return p, err
+}
+
+// KeyAgg aggregates the public keys, in the given order, into a key aggregation context.
+func KeyAgg(pubKeys []secp256k1.G1Affine) (*KeyAggContext, error) {
+	if len(pubKeys) == 0 {
+		return nil, errNoPublicKeys
+	}
+	ctx := &KeyAggContext{pubKeys: make([][SizePublicKey]byte, len(pubKeys))}
+	for i := range pubKeys {
+		if pubKeys[i].IsInfinity() || !pubKeys[i].IsOnCurve() {
+			return nil, fmt.Errorf("%w: public key %d", ErrInvalidContribution, i)
+		}
+		ctx.pubKeys[i] = bytesCompressed(&pubKeys[i])
+	}
+
+	// GetSecondKey
+	for i := 1; i < len(ctx.pubKeys); i++ {
+		if ctx.pubKeys[i] != ctx.pubKeys[0] {
+			ctx.pk2 = ctx.pubKeys[i]
+			break
+		}
+	}
+	// HashKeys
+	all := make([]byte, 0, len(ctx.pubKeys)*SizePublicKey)
+	for i := range ctx.pubKeys {
+		all = append(all, ctx.pubKeys[i][:]...)
+	}
+	ctx.hashKeys = taggedHash("KeyAgg list", all)
+
+	// Q = ∑ aᵢ⋅Pᵢ
+	var q, t secp256k1.G1Jac
+	var ai big.Int
+	for i := range pubKeys {
+		a := ctx.keyAggCoeff(&ctx.pubKeys[i])
+		t.FromAffine(&pubKeys[i])
+		t.ScalarMultiplication(&t, a.BigInt(&ai))
+		q.AddAssign(&t)
+	}
+	if q.Z.IsZero() {
+		return nil, errInfinity
+	}
+	ctx.q.FromJacobian(&q)
+	ctx.gacc.SetOne()
+
+	return ctx, nil
+}
+
+// keyAggCoeff returns the key aggregation coefficient of the public key pk (KeyAggCoeffInternal)
+func (ctx *KeyAggContext) keyAggCoeff(pk *[SizePublicKey]byte) fr.Element {
+	if *pk == ctx.pk2 {
+		var one fr.Element
+		return *one.SetOne()
+	}
+	return hashToScalar("KeyAgg coefficient", ctx.hashKeys[:], pk[:])
+}
+
+// ApplyTweak tweaks the aggregate public key with the 32-byte scalar tweak. A plain tweak
+// (xOnly = false) is used for BIP-32 derivation, an x-only tweak for taproot.
+func (ctx *KeyAggContext) ApplyTweak(tweak [32]byte, xOnly bool) error {
+	var g, t fr.Element
+	g.SetOne()
+	if xOnly && !hasEvenY(&ctx.q) {
+		g.Neg(&g)
+	}
+	if err := t.SetBytesCanonical(tweak[:]); err != nil {
+		return errInvalidTweak
+	}
+
+	// Q' = g⋅Q + t⋅G
+	var q, tG secp256k1.G1Jac
+	var b big.Int
+	q.FromAffine(&ctx.q)
+	if !g.IsOne() {
+		q.Neg(&q)
+	}
+	tG.ScalarMultiplicationAffine(&g1GenAff, t.BigInt(&b))
+	q.AddAssign(&tG)
+	if q.Z.IsZero() {
+		return errInfinity
+	}
+	ctx.q.FromJacobian(&q)
+
+	ctx.gacc.Mul(&ctx.gacc, &g)
+	ctx.tacc.Mul(&ctx.tacc, &g).Add(&ctx.tacc, &t)
+	return nil
+}
+
+// PublicKey returns the (tweaked) aggregate public key as a point.
+func (ctx *KeyAggContext) PublicKey() secp256k1.G1Affine {
+	return ctx.q
+}
+
+// XOnlyPublicKey returns the (tweaked) aggregate public key in x-only form, as used by BIP-340.
+func (ctx *KeyAggContext) XOnlyPublicKey() [SizeXOnlyPublicKey]byte {
+	return bytesX(&ctx.q)
+}
+
+// SecNonce is the secret nonce of a signer for a single signing session. It is erased by Sign.
+type SecNonce struct {
+	k1, k2 fr.Element
+	pk     [SizePublicKey]byte
+}
+
+// PubNonce is the public nonce of a signer, broadcast in the first round of signing.
+type PubNonce struct {
+	R1, R2 secp256k1.G1Affine
+}
+
+// AggNonce is the aggregate of the public nonces of the signers. Its points may be the point at infinity.
+type AggNonce struct {
+	R1, R2 secp256k1.G1Affine
+}
+
+// Bytes returns the encoding of the public nonce: cbytes(R₁) ∥ cbytes(R₂).
+func (n *PubNonce) Bytes() [SizePubNonce]byte {
+	var res [SizePubNonce]byte
+	r1, r2 := bytesCompressed(&n.R1), bytesCompressed(&n.R2)
+	copy(res[:SizePublicKey], r1[:])
+	copy(res[SizePublicKey:], r2[:])
+	return res
+}
+
+// SetBytes sets n from its encoding buf, failing if it does not encode two points.
+func (n *PubNonce) SetBytes(buf []byte) error {
+	if len(buf) != SizePubNonce {
+		return errInvalidPoint
+	}
+	if err := setCompressed(&n.R1, buf[:SizePublicKey]); err != nil {
+		return err
+	}
+	return setCompressed(&n.R2, buf[SizePublicKey:])
+}
+
+// Bytes returns the encoding of the aggregate nonce: cbytes_ext(R₁) ∥ cbytes_ext(R₂).
+func (n *AggNonce) Bytes() [SizePubNonce]byte {
+	var res [SizePubNonce]byte
+	r1, r2 := bytesCompressedExt(&n.R1), bytesCompressedExt(&n.R2)
+	copy(res[:SizePublicKey], r1[:])
+	copy(res[SizePublicKey:], r2[:])
+	return res
+}
+
+// SetBytes sets n from its encoding buf, where zeros encode the point at infinity.
+func (n *AggNonce) SetBytes(buf []byte) error {
+	if len(buf) != SizePubNonce {
+		return errInvalidPoint
+	}
+	if err := setCompressedExt(&n.R1, buf[:SizePublicKey]); err != nil {
+		return err
+	}
+	return setCompressedExt(&n.R2, buf[SizePublicKey:])
+}
+
+// NonceOption customizes the inputs of NonceGen, which all strengthen the nonce
+// generation against a weak randomness source.
+type NonceOption func(*nonceConfig)
+
+type nonceConfig struct {
+	sk      *fr.Element
+	aggPk   []byte
+	msg     []byte
+	hasMsg  bool
+	extraIn []byte
+}
+
+// WithSecretKey adds the secret key of the signer to the nonce derivation.
+func WithSecretKey(sk *fr.Element) NonceOption {
+	return func(c *nonceConfig) {
+		c.sk = sk
+	}
+}
+
+// WithAggregatePublicKey adds the x-only aggregate public key to the nonce derivation.
+func WithAggregatePublicKey(aggPk [SizeXOnlyPublicKey]byte) NonceOption {
+	return func(c *nonceConfig) {
+		c.aggPk = aggPk[:]
+	}
+}
+
+// WithMessage adds the message to sign to the nonce derivation.
+func WithMessage(msg []byte) NonceOption {
+	return func(c *nonceConfig) {
+		c.msg = msg
+		c.hasMsg = true
+	}
+}
+
+// WithExtraInput adds auxiliary input to the nonce derivation.
+func WithExtraInput(extraIn []byte) NonceOption {
+	return func(c *nonceConfig) {
+		c.extraIn = extraIn
+	}
+}
+
+// NonceGen returns a fresh secret nonce and the matching public nonce for the signer with
+// public key pk (first round of signing). rand must be a cryptographically secure source:
+// nonce reuse leaks the secret key.
+func NonceGen(rand io.Reader, pk *secp256k1.G1Affine, opts ...NonceOption) (*SecNonce, *PubNonce, error) {
+	var randBytes [32]byte
+	if _, err := io.ReadFull(rand, randBytes[:]); err != nil {
+		return nil, nil, err
+	}
+	return nonceGen(randBytes, pk, opts...)
+}
+
+// nonceGen is NonceGen with the 32 random bytes rand' given
+func nonceGen(randBytes [32]byte, pk *secp256k1.G1Affine, opts ...NonceOption) (*SecNonce, *PubNonce, error) {
+	var cfg nonceConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.sk != nil {
+		aux := taggedHash("MuSig/aux", randBytes[:])
+		sk := cfg.sk.Bytes()
+		for i := range randBytes {
+			randBytes[i] = sk[i] ^ aux[i]
+		}
+	}
+
+	pkBytes := bytesCompressed(pk)
+	var msgPrefixed []byte
+	if cfg.hasMsg {
+		msgPrefixed = make([]byte, 9, 9+len(cfg.msg))
+		msgPrefixed[0] = 1
+		binary.BigEndian.PutUint64(msgPrefixed[1:], uint64(len(cfg.msg)))
+		msgPrefixed = append(msgPrefixed, cfg.msg...)
+	} else {
+		msgPrefixed = []byte{0}
+	}
+	var extraLen [4]byte
+	binary.BigEndian.PutUint32(extraLen[:], uint32(len(cfg.extraIn)))
+
+	secNonce := &SecNonce{pk: pkBytes}
+	k := []*fr.Element{&secNonce.k1, &secNonce.k2}
+	for i := range k {
+		*k[i] = hashToScalar("MuSig/nonce",
+			randBytes[:],
+			[]byte{byte(len(pkBytes))}, pkBytes[:],
+			[]byte{byte(len(cfg.aggPk))}, cfg.aggPk,
+			msgPrefixed,
+			extraLen[:], cfg.extraIn,
+			[]byte{byte(i)},
+		)
+		if k[i].IsZero() {
+			return nil, nil, errInvalidSecNonce
+		}
+	}
+
+	var pubNonce PubNonce
+	var b big.Int
+	pubNonce.R1.ScalarMultiplicationBaseCT(secNonce.k1.BigInt(&b))
+	pubNonce.R2.ScalarMultiplicationBaseCT(secNonce.k2.BigInt(&b))
+
+	return secNonce, &pubNonce, nil
+}
+
+// NonceAgg aggregates the public nonces of the signers.
+func NonceAgg(pubNonces []PubNonce) (*AggNonce, error) {
+	var r1, r2 secp256k1.G1Jac
+	for i := range pubNonces {
+		if pubNonces[i].R1.IsInfinity() || !pubNonces[i].R1.IsOnCurve() ||
+			pubNonces[i].R2.IsInfinity() || !pubNonces[i].R2.IsOnCurve() {
+			return nil, fmt.Errorf("%w: public nonce %d", ErrInvalidContribution, i)
+		}
+		r1.AddMixed(&pubNonces[i].R1)
+		r2.AddMixed(&pubNonces[i].R2)
+	}
+	var aggNonce AggNonce
+	aggNonce.R1.FromJacobian(&r1)
+	aggNonce.R2.FromJacobian(&r2)
+	return &aggNonce, nil
+}
+
+// Session holds the values shared by the signers of a message (BIP-327, Session Context).
+type Session struct {
+	keyAgg *KeyAggContext
+	msg    []byte
+	b, e   fr.Element
+	r      secp256k1.G1Affine // final nonce R
+}
+
+// NewSession returns the signing session of msg, for the aggregate nonce and the
+// (tweaked) key aggregation context.
+func NewSession(keyAgg *KeyAggContext, aggNonce *AggNonce, msg []byte) *Session {
+	s := &Session{keyAgg: keyAgg, msg: msg}
+
+	q := bytesX(&keyAgg.q)
+	n := aggNonce.Bytes()
+	s.b = hashToScalar("MuSig/noncecoef", n[:], q[:], msg)
+
+	// R = R₁ + b⋅R₂, or G if this is the point at infinity
+	var r, t secp256k1.G1Jac
+	var b big.Int
+	r.FromAffine(&aggNonce.R1)
+	t.FromAffine(&aggNonce.R2)
+	t.ScalarMultiplication(&t, s.b.BigInt(&b))
+	r.AddAssign(&t)
+	if r.Z.IsZero() {
+		s.r = g1GenAff
+	} else {
+		s.r.FromJacobian(&r)
+	}
+
+	rx := bytesX(&s.r)
+	s.e = hashToScalar("BIP0340/challenge", rx[:], q[:], msg)
+
+	return s
+}
+
+// sessionKeyAggCoeff returns the key aggregation coefficient of pk, failing if pk is not an aggregated key
+func (s *Session) sessionKeyAggCoeff(pk *[SizePublicKey]byte) (fr.Element, error) {
+	for i := range s.keyAgg.pubKeys {
+		if s.keyAgg.pubKeys[i] == *pk {
+			return s.keyAgg.keyAggCoeff(pk), nil
+		}
+	}
+	return fr.Element{}, errUnknownPublicKey
+}
+
+// Sign returns the partial signature of the signer with secret key sk (second round of signing).
+// The secret nonce is erased so that it can not be used twice.
+func (s *Session) Sign(secNonce *SecNonce, sk *fr.Element) (fr.Element, error) {
+	var psig fr.Element
+	if secNonce.k1.IsZero() || secNonce.k2.IsZero() {
+		return psig, errInvalidSecNonce
+	}
+	if sk.IsZero() {
+		return psig, errInvalidSecretKey
+	}
+
+	var d fr.Element
+	var b big.Int
+	var p secp256k1.G1Affine
+	p.ScalarMultiplicationBaseCT(sk.BigInt(&b))
+	pk := bytesCompressed(&p)
+	if pk != secNonce.pk {
+		return psig, errSecNonceMismatch
+	}
+	a, err := s.sessionKeyAggCoeff(&pk)
+	if err != nil {
+		return psig, err
+	}
+
+	k1, k2 := secNonce.k1, secNonce.k2
+	secNonce.k1.SetZero()
+	secNonce.k2.SetZero()
+	if !hasEvenY(&s.r) {
+		k1.Neg(&k1)
+		k2.Neg(&k2)
+	}
+
+	// d = g⋅gacc⋅d'
+	d.Mul(sk, &s.keyAgg.gacc)
+	if !hasEvenY(&s.keyAgg.q) {
+		d.Neg(&d)
+	}
+
+	// s = k₁ + b⋅k₂ + e⋅a⋅d
+	var t fr.Element
+	psig.Mul(&s.b, &k2).Add(&psig, &k1)
+	t.Mul(&s.e, &a).Mul(&t, &d)
+	psig.Add(&psig, &t)
+
+	return psig, nil
+}
+
+// ParsePartialSig returns the partial signature encoded in buf, failing if it is not a 32-byte
+// integer smaller than the group order.
+func ParsePartialSig(buf []byte) (fr.Element, error) {
+	var psig fr.Element
+	if err := psig.SetBytesCanonical(buf); err != nil {
+		return psig, errInvalidPSig
+	}
+	return psig, nil
+}
+
+// PartialSigVerify checks the partial signature psig of the signer with public key pk and
+// public nonce pubNonce.
+func (s *Session) PartialSigVerify(psig *fr.Element, pubNonce *PubNonce, pk *secp256k1.G1Affine) bool {
+	if pk.IsInfinity() || !pk.IsOnCurve() || !pubNonce.R1.IsOnCurve() || !pubNonce.R2.IsOnCurve() {
+		return false
+	}
+	pkBytes := bytesCompressed(pk)
+	a, err := s.sessionKeyAggCoeff(&pkBytes)
+	if err != nil {
+		return false
+	}
+
+	// Re = R₁ + b⋅R₂, negated if R has an odd y
+	var re, t secp256k1.G1Jac
+	var b big.Int
+	re.FromAffine(&pubNonce.R1)
+	t.FromAffine(&pubNonce.R2)
+	t.ScalarMultiplication(&t, s.b.BigInt(&b))
+	re.AddAssign(&t)
+	if !hasEvenY(&s.r) {
+		re.Neg(&re)
+	}
+
+	// s⋅G = Re + e⋅a⋅g⋅gacc⋅P
+	var c fr.Element
+	c.Mul(&s.e, &a).Mul(&c, &s.keyAgg.gacc)
+	if !hasEvenY(&s.keyAgg.q) {
+		c.Neg(&c)
+	}
+	t.FromAffine(pk)
+	t.ScalarMultiplication(&t, c.BigInt(&b))
+	re.AddAssign(&t)
+
+	var sG secp256k1.G1Jac
+	sG.ScalarMultiplicationAffine(&g1GenAff, psig.BigInt(&b))
+	return sG.Equal(&re)
+}
+
+// PartialSigAgg combines the partial signatures of all the signers into a BIP-340 signature
+// of the message for the x-only aggregate public key.
+//
+// The partial signatures are not verified; use PartialSigVerify to identify a faulty signer.
+func (s *Session) PartialSigAgg(psigs []fr.Element) [SizeSignature]byte {
+	// s = ∑ sᵢ + e⋅g⋅tacc
+	var sum, t fr.Element
+	for i := range psigs {
+		sum.Add(&sum, &psigs[i])
+	}
+	t.Mul(&s.e, &s.keyAgg.tacc)
+	if !hasEvenY(&s.keyAgg.q) {
+		t.Neg(&t)
+	}
+	sum.Add(&sum, &t)
+
+	var sig [SizeSignature]byte
+	rx := bytesX(&s.r)
+	sBytes := sum.Bytes()
+	copy(sig[:len(rx)], rx[:])
+	copy(sig[len(rx):], sBytes[:])
+	return sig
+}
+
+// Verify checks the BIP-340 signature sig of msg for the x-only public key pk.
+func Verify(pk [SizeXOnlyPublicKey]byte, msg []byte, sig [SizeSignature]byte) bool {
+	var p, r secp256k1.G1Affine
+	if err := liftX(&p, pk[:]); err != nil {
+		return false
+	}
+	if err := r.X.SetBytesCanonical(sig[:SizeXOnlyPublicKey]); err != nil {
+		return false
+	}
+	var s fr.Element
+	if err := s.SetBytesCanonical(sig[SizeXOnlyPublicKey:]); err != nil {
+		return false
+	}
+	e := hashToScalar("BIP0340/challenge", sig[:SizeXOnlyPublicKey], pk[:], msg)
+
+	// R = s⋅G - e⋅P
+	var _r secp256k1.G1Jac
+	var sBig, eBig big.Int
+	e.Neg(&e)
+	_r.JointScalarMultiplicationBase(&p, s.BigInt(&sBig), e.BigInt(&eBig))
+	if _r.Z.IsZero() {
+		return false
+	}
+	var R secp256k1.G1Affine
+	R.FromJacobian(&_r)
+	return hasEvenY(&R) && R.X.Equal(&r.X)
+}
+
+var _, g1GenAff = secp256k1.Generators()
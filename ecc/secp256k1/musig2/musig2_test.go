@@ -0,0 +1,193 @@
+// Copyright 2023 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package musig2
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+type signer struct {
+	sk fr.Element
+	pk secp256k1.G1Affine
+}
+
+func newSigners(t *testing.T, n int) []signer {
+	t.Helper()
+	signers := make([]signer, n)
+	var b big.Int
+	for i := range signers {
+		if _, err := signers[i].sk.SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		signers[i].pk.ScalarMultiplicationBase(signers[i].sk.BigInt(&b))
+	}
+	return signers
+}
+
+func TestMuSig2(t *testing.T) {
+	const nbSigners = 4
+	msg := []byte("testing MuSig2")
+	signers := newSigners(t, nbSigners)
+
+	pubKeys := make([]secp256k1.G1Affine, nbSigners)
+	for i := range signers {
+		pubKeys[i] = signers[i].pk
+	}
+	KeySort(pubKeys)
+	keyAgg, err := KeyAgg(pubKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a plain tweak followed by an x-only (taproot) tweak
+	var tweak1, tweak2 [32]byte
+	tweak1[31], tweak2[31] = 1, 2
+	if err := keyAgg.ApplyTweak(tweak1, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := keyAgg.ApplyTweak(tweak2, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// first round
+	secNonces := make([]*SecNonce, nbSigners)
+	pubNonces := make([]PubNonce, nbSigners)
+	for i := range signers {
+		secNonce, pubNonce, err := NonceGen(rand.Reader, &signers[i].pk,
+			WithSecretKey(&signers[i].sk),
+			WithAggregatePublicKey(keyAgg.XOnlyPublicKey()),
+			WithMessage(msg),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		secNonces[i], pubNonces[i] = secNonce, *pubNonce
+	}
+	aggNonce, err := NonceAgg(pubNonces)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// second round
+	session := NewSession(keyAgg, aggNonce, msg)
+	psigs := make([]fr.Element, nbSigners)
+	for i := range signers {
+		if psigs[i], err = session.Sign(secNonces[i], &signers[i].sk); err != nil {
+			t.Fatal(err)
+		}
+		if !session.PartialSigVerify(&psigs[i], &pubNonces[i], &signers[i].pk) {
+			t.Fatalf("partial signature %d does not verify", i)
+		}
+	}
+
+	sig := session.PartialSigAgg(psigs)
+	if !Verify(keyAgg.XOnlyPublicKey(), msg, sig) {
+		t.Fatal("aggregate signature does not verify")
+	}
+	if Verify(keyAgg.XOnlyPublicKey(), []byte("another message"), sig) {
+		t.Fatal("aggregate signature verifies for another message")
+	}
+
+	// the secret nonces can not be reused
+	if _, err := session.Sign(secNonces[0], &signers[0].sk); !errors.Is(err, errInvalidSecNonce) {
+		t.Fatal("secret nonce reused")
+	}
+
+	// a faulty partial signature is detected
+	psigs[1].Add(&psigs[1], new(fr.Element).SetOne())
+	if session.PartialSigVerify(&psigs[1], &pubNonces[1], &signers[1].pk) {
+		t.Fatal("invalid partial signature verifies")
+	}
+	if Verify(keyAgg.XOnlyPublicKey(), msg, session.PartialSigAgg(psigs)) {
+		t.Fatal("aggregate of an invalid partial signature verifies")
+	}
+}
+
+func TestSignErrors(t *testing.T) {
+	signers := newSigners(t, 3)
+	keyAgg, err := KeyAgg([]secp256k1.G1Affine{signers[0].pk, signers[1].pk})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("msg")
+
+	pubNonces := make([]PubNonce, 3)
+	secNonces := make([]*SecNonce, 3)
+	for i := range signers {
+		sn, pn, err := NonceGen(rand.Reader, &signers[i].pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		secNonces[i], pubNonces[i] = sn, *pn
+	}
+	aggNonce, err := NonceAgg(pubNonces[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := NewSession(keyAgg, aggNonce, msg)
+
+	// the nonce of signer 0 with the key of signer 1
+	if _, err := session.Sign(secNonces[0], &signers[1].sk); !errors.Is(err, errSecNonceMismatch) {
+		t.Fatalf("expected errSecNonceMismatch, got %v", err)
+	}
+	// signer 2 is not part of the aggregated keys
+	if _, err := session.Sign(secNonces[2], &signers[2].sk); !errors.Is(err, errUnknownPublicKey) {
+		t.Fatalf("expected errUnknownPublicKey, got %v", err)
+	}
+
+	// an invalid public nonce is blamed
+	var invalid PubNonce
+	invalid.R1 = pubNonces[0].R1
+	if _, err := NonceAgg([]PubNonce{pubNonces[0], invalid}); !errors.Is(err, ErrInvalidContribution) {
+		t.Fatalf("expected ErrInvalidContribution, got %v", err)
+	}
+}
+
+func TestNonceEncoding(t *testing.T) {
+	signers := newSigners(t, 1)
+	_, pubNonce, err := NonceGen(rand.Reader, &signers[0].pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded PubNonce
+	b := pubNonce.Bytes()
+	if err := decoded.SetBytes(b[:]); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.R1.Equal(&pubNonce.R1) || !decoded.R2.Equal(&pubNonce.R2) {
+		t.Fatal("public nonce encoding round trip failed")
+	}
+
+	// the aggregate nonce may be the point at infinity
+	var aggNonce, decodedAgg AggNonce
+	aggNonce.R1 = pubNonce.R1
+	aggNonce.R2.SetInfinity()
+	b = aggNonce.Bytes()
+	if err := decodedAgg.SetBytes(b[:]); err != nil {
+		t.Fatal(err)
+	}
+	if !decodedAgg.R1.Equal(&aggNonce.R1) || !decodedAgg.R2.IsInfinity() {
+		t.Fatal("aggregate nonce encoding round trip failed")
+	}
+	if err := decoded.SetBytes(b[:]); err == nil {
+		t.Fatal("public nonce with the point at infinity accepted")
+	}
+}
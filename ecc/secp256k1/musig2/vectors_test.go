@@ -0,0 +1,666 @@
+// Copyright 2023 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package musig2
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+// test vectors from BIP-327 (key_agg_vectors.json, sign_verify_vectors.json, tweak_vectors.json,
+// nonce_gen_vectors.json, nonce_agg_vectors.json, sig_agg_vectors.json) and BIP-340 (test-vectors.csv)
+
+var vectorPubKeys = []string{
+	"02F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+	"03DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+	"023590A94E768F8E1815C2F24B4D80A8E3149316C3518CE7B7AD338368D038CA66",
+}
+
+func mustDecode(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestKeyAggVectors(t *testing.T) {
+	tests := []struct {
+		keyIndices []int
+		expected   string
+	}{
+		{[]int{0, 1, 2}, "90539EEDE565F5D054F32CC0C220126889ED1E5D193BAF15AEF344FE59D4610C"},
+		{[]int{2, 1, 0}, "6204DE8B083426DC6EAF9502D27024D53FC826BF7D2012148A0575435DF54B2B"},
+		{[]int{0, 0, 0}, "B436E3BAD62B8CD409969A224731C193D051162D8C5AE8B109306127DA3AA935"},
+		{[]int{0, 0, 1, 1}, "69BC22BFA5D106306E48A20679DE1D7389386124D07571D0D872686028C26A3E"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("valid %d", i), func(t *testing.T) {
+			pubKeys := make([]secp256k1.G1Affine, len(tt.keyIndices))
+			for j, k := range tt.keyIndices {
+				var err error
+				if pubKeys[j], err = ParsePublicKey(mustDecode(t, vectorPubKeys[k])); err != nil {
+					t.Fatal(err)
+				}
+			}
+			ctx, err := KeyAgg(pubKeys)
+			if err != nil {
+				t.Fatal(err)
+			}
+			q := ctx.XOnlyPublicKey()
+			if got := hex.EncodeToString(q[:]); !strings.EqualFold(got, tt.expected) {
+				t.Fatalf("got %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParsePublicKeyVectors(t *testing.T) {
+	invalid := []string{
+		// not a valid x-coordinate
+		"020000000000000000000000000000000000000000000000000000000000000005",
+		// x-coordinate exceeds the field size
+		"02FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC30",
+		// invalid prefix
+		"04F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+	}
+	for i, s := range invalid {
+		if _, err := ParsePublicKey(mustDecode(t, s)); err == nil {
+			t.Errorf("invalid public key %d accepted", i)
+		}
+	}
+}
+
+// mustParsePublicKeys parses the public keys at the given indices
+func mustParsePublicKeys(t *testing.T, pubKeys []string, indices []int) []secp256k1.G1Affine {
+	t.Helper()
+	keys := make([]secp256k1.G1Affine, len(indices))
+	for i, k := range indices {
+		var err error
+		if keys[i], err = ParsePublicKey(mustDecode(t, pubKeys[k])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return keys
+}
+
+// mustParsePubNonces parses the public nonces at the given indices
+func mustParsePubNonces(t *testing.T, pubNonces []string, indices []int) []PubNonce {
+	t.Helper()
+	nonces := make([]PubNonce, len(indices))
+	for i, k := range indices {
+		if err := nonces[i].SetBytes(mustDecode(t, pubNonces[k])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return nonces
+}
+
+// mustApplyTweaks applies the tweaks at the given indices to the key aggregation context
+func mustApplyTweaks(t *testing.T, ctx *KeyAggContext, tweaks []string, indices []int, xOnly []bool) {
+	t.Helper()
+	for i, k := range indices {
+		var tweak [32]byte
+		copy(tweak[:], mustDecode(t, tweaks[k]))
+		if err := ctx.ApplyTweak(tweak, xOnly[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// secNonceFromBytes decodes the 97-byte secret nonce k₁ ∥ k₂ ∥ pk of the vectors
+func secNonceFromBytes(buf []byte) *SecNonce {
+	var sn SecNonce
+	sn.k1.SetBytes(buf[:fr.Bytes])
+	sn.k2.SetBytes(buf[fr.Bytes : 2*fr.Bytes])
+	copy(sn.pk[:], buf[2*fr.Bytes:])
+	return &sn
+}
+
+func checkHex(t *testing.T, got []byte, expected string) {
+	t.Helper()
+	if g := hex.EncodeToString(got); !strings.EqualFold(g, expected) {
+		t.Fatalf("got %s, want %s", g, expected)
+	}
+}
+
+func TestSignVectors(t *testing.T) {
+	sk := mustDecode(t, "7FB9E0E687ADA1EEBF7ECFE2F21E73EBDB51A7D450948DFE8D76D7F2D1007671")
+	pubKeys := []string{
+		"03935F972DA013F80AE011890FA89B67A27B7BE6CCB24D3274D18B2D4067F261A9",
+		"02F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+		"02DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA661",
+		"020000000000000000000000000000000000000000000000000000000000000007",
+	}
+	secNonces := []string{
+		"508B81A611F100A6B2B6B29656590898AF488BCF2E1F55CF22E5CFB84421FE61FA27FD49B1D50085B481285E1CA205D55C82CC1B31FF5CD54A489829355901F703935F972DA013F80AE011890FA89B67A27B7BE6CCB24D3274D18B2D4067F261A9",
+		"0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003935F972DA013F80AE011890FA89B67A27B7BE6CCB24D3274D18B2D4067F261A9",
+	}
+	pubNonces := []string{
+		"0337C87821AFD50A8644D820A8F3E02E499C931865C2360FB43D0A0D20DAFE07EA0287BF891D2A6DEAEBADC909352AA9405D1428C15F4B75F04DAE642A95C2548480",
+		"0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F817980279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798",
+		"032DE2662628C90B03F5E720284EB52FF7D71F4284F627B68A853D78C78E1FFE9303E4C5524E83FFE1493B9077CF1CA6BEB2090C93D930321071AD40B2F44E599046",
+		"0237C87821AFD50A8644D820A8F3E02E499C931865C2360FB43D0A0D20DAFE07EA0387BF891D2A6DEAEBADC909352AA9405D1428C15F4B75F04DAE642A95C2548480",
+		"020000000000000000000000000000000000000000000000000000000000000009",
+	}
+	aggNonces := []string{
+		"028465FCF0BBDBCF443AABCCE533D42B4B5A10966AC09A49655E8C42DAAB8FCD61037496A3CC86926D452CAFCFD55D25972CA1675D549310DE296BFF42F72EEEA8C9",
+		"000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		"048465FCF0BBDBCF443AABCCE533D42B4B5A10966AC09A49655E8C42DAAB8FCD61037496A3CC86926D452CAFCFD55D25972CA1675D549310DE296BFF42F72EEEA8C9",
+		"028465FCF0BBDBCF443AABCCE533D42B4B5A10966AC09A49655E8C42DAAB8FCD61020000000000000000000000000000000000000000000000000000000000000009",
+		"028465FCF0BBDBCF443AABCCE533D42B4B5A10966AC09A49655E8C42DAAB8FCD6102FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC30",
+	}
+	msgs := []string{
+		"F95466D086770E689964664219266FE5ED215C92AE20BAB5C9D79ADDDDF3C0CF",
+		"",
+		"2626262626262626262626262626262626262626262626262626262626262626262626262626",
+	}
+
+	var d fr.Element
+	if err := d.SetBytesCanonical(sk); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := []struct {
+		keyIndices, nonceIndices []int
+		aggNonceIndex, msgIndex  int
+		signerIndex              int
+		expectedPSig             string
+	}{
+		{[]int{0, 1, 2}, []int{0, 1, 2}, 0, 0, 0, "012ABBCB52B3016AC03AD82395A1A415C48B93DEF78718E62A7A90052FE224FB"},
+		{[]int{1, 0, 2}, []int{1, 0, 2}, 0, 0, 1, "9FF2F7AAA856150CC8819254218D3ADEEB0535269051897724F9DB3789513A52"},
+		{[]int{1, 2, 0}, []int{1, 2, 0}, 0, 0, 2, "FA23C359F6FAC4E7796BB93BC9F0532A95468C539BA20FF86D7C76ED92227900"},
+		// both halves of the aggregate nonce are the point at infinity
+		{[]int{0, 1}, []int{0, 3}, 1, 0, 0, "AE386064B26105404798F75DE2EB9AF5EDA5387B064B83D049CB7C5E08879531"},
+	}
+	for i, tt := range valid {
+		t.Run(fmt.Sprintf("valid %d", i), func(t *testing.T) {
+			keys := mustParsePublicKeys(t, pubKeys, tt.keyIndices)
+			nonces := mustParsePubNonces(t, pubNonces, tt.nonceIndices)
+			var aggNonce AggNonce
+			if err := aggNonce.SetBytes(mustDecode(t, aggNonces[tt.aggNonceIndex])); err != nil {
+				t.Fatal(err)
+			}
+			agg, err := NonceAgg(nonces)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if agg.Bytes() != aggNonce.Bytes() {
+				t.Fatal("NonceAgg does not match the aggregate nonce")
+			}
+
+			keyAgg, err := KeyAgg(keys)
+			if err != nil {
+				t.Fatal(err)
+			}
+			session := NewSession(keyAgg, &aggNonce, mustDecode(t, msgs[tt.msgIndex]))
+			psig, err := session.Sign(secNonceFromBytes(mustDecode(t, secNonces[0])), &d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := psig.Bytes()
+			checkHex(t, b[:], tt.expectedPSig)
+
+			if !session.PartialSigVerify(&psig, &nonces[tt.signerIndex], &keys[tt.signerIndex]) {
+				t.Fatal("partial signature does not verify")
+			}
+		})
+	}
+
+	signErrors := []struct {
+		keyIndices              []int
+		aggNonceIndex, msgIndex int
+		secNonceIndex           int
+		err                     error
+		comment                 string
+	}{
+		{[]int{1, 2}, 0, 0, 0, errUnknownPublicKey, "the signer's public key is not in the list of public keys"},
+		{[]int{1, 0, 3}, 0, 0, 0, errInvalidPoint, "signer 2 provided an invalid public key"},
+		{[]int{1, 2, 0}, 2, 0, 0, errInvalidPoint, "aggregate nonce is invalid due to the wrong tag, 0x04, in the first half"},
+		{[]int{1, 2, 0}, 3, 0, 0, errInvalidPoint, "aggregate nonce is invalid because the second half does not correspond to an x coordinate"},
+		{[]int{1, 2, 0}, 4, 0, 0, errInvalidPoint, "aggregate nonce is invalid because the second half exceeds the field size"},
+		{[]int{0, 1, 2}, 0, 0, 1, errInvalidSecNonce, "secret nonce is invalid, which may indicate nonce reuse"},
+	}
+	for _, tt := range signErrors {
+		t.Run(tt.comment, func(t *testing.T) {
+			err := func() error {
+				keys := make([]secp256k1.G1Affine, len(tt.keyIndices))
+				for i, k := range tt.keyIndices {
+					var err error
+					if keys[i], err = ParsePublicKey(mustDecode(t, pubKeys[k])); err != nil {
+						return err
+					}
+				}
+				var aggNonce AggNonce
+				if err := aggNonce.SetBytes(mustDecode(t, aggNonces[tt.aggNonceIndex])); err != nil {
+					return err
+				}
+				keyAgg, err := KeyAgg(keys)
+				if err != nil {
+					return err
+				}
+				session := NewSession(keyAgg, &aggNonce, mustDecode(t, msgs[tt.msgIndex]))
+				_, err = session.Sign(secNonceFromBytes(mustDecode(t, secNonces[tt.secNonceIndex])), &d)
+				return err
+			}()
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+
+	verifyFail := []struct {
+		psig                     string
+		keyIndices, nonceIndices []int
+		msgIndex, signerIndex    int
+		outOfRange               bool
+		comment                  string
+	}{
+		{"97AC833ADCB1AFA42EBF9E0725616F3C9A0D5B614F6FE283CEAAA37A8FFAF406", []int{0, 1, 2}, []int{0, 1, 2}, 0, 0, false, "wrong signature (the negation of a valid signature)"},
+		{"68537CC5234E505BD14061F8DA9E90C220A181855FD8BDB7F127BB12403B4D3B", []int{0, 1, 2}, []int{0, 1, 2}, 0, 1, false, "wrong signer"},
+		{"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141", []int{0, 1, 2}, []int{0, 1, 2}, 0, 0, true, "signature exceeds the group size"},
+	}
+	for _, tt := range verifyFail {
+		t.Run(tt.comment, func(t *testing.T) {
+			psig, err := ParsePartialSig(mustDecode(t, tt.psig))
+			if tt.outOfRange {
+				if !errors.Is(err, errInvalidPSig) {
+					t.Fatalf("expected errInvalidPSig, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			keys := mustParsePublicKeys(t, pubKeys, tt.keyIndices)
+			nonces := mustParsePubNonces(t, pubNonces, tt.nonceIndices)
+			keyAgg, err := KeyAgg(keys)
+			if err != nil {
+				t.Fatal(err)
+			}
+			aggNonce, err := NonceAgg(nonces)
+			if err != nil {
+				t.Fatal(err)
+			}
+			session := NewSession(keyAgg, aggNonce, mustDecode(t, msgs[tt.msgIndex]))
+			if session.PartialSigVerify(&psig, &nonces[tt.signerIndex], &keys[tt.signerIndex]) {
+				t.Fatal("invalid partial signature verifies")
+			}
+		})
+	}
+
+	// verify_error_test_cases: the contribution of the signer can not be parsed
+	var pubNonce PubNonce
+	if err := pubNonce.SetBytes(mustDecode(t, pubNonces[4])); err == nil {
+		t.Fatal("invalid public nonce accepted")
+	}
+	if _, err := ParsePublicKey(mustDecode(t, pubKeys[3])); err == nil {
+		t.Fatal("invalid public key accepted")
+	}
+}
+
+func TestTweakVectors(t *testing.T) {
+	sk := mustDecode(t, "7FB9E0E687ADA1EEBF7ECFE2F21E73EBDB51A7D450948DFE8D76D7F2D1007671")
+	pubKeys := []string{
+		"03935F972DA013F80AE011890FA89B67A27B7BE6CCB24D3274D18B2D4067F261A9",
+		"02F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+		"02DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+	}
+	secNonce := mustDecode(t, "508B81A611F100A6B2B6B29656590898AF488BCF2E1F55CF22E5CFB84421FE61FA27FD49B1D50085B481285E1CA205D55C82CC1B31FF5CD54A489829355901F703935F972DA013F80AE011890FA89B67A27B7BE6CCB24D3274D18B2D4067F261A9")
+	pubNonces := []string{
+		"0337C87821AFD50A8644D820A8F3E02E499C931865C2360FB43D0A0D20DAFE07EA0287BF891D2A6DEAEBADC909352AA9405D1428C15F4B75F04DAE642A95C2548480",
+		"0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F817980279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798",
+		"032DE2662628C90B03F5E720284EB52FF7D71F4284F627B68A853D78C78E1FFE9303E4C5524E83FFE1493B9077CF1CA6BEB2090C93D930321071AD40B2F44E599046",
+	}
+	aggNonceHex := "028465FCF0BBDBCF443AABCCE533D42B4B5A10966AC09A49655E8C42DAAB8FCD61037496A3CC86926D452CAFCFD55D25972CA1675D549310DE296BFF42F72EEEA8C9"
+	tweaks := []string{
+		"E8F791FF9225A2AF0102AFFF4A9A723D9612A682A25EBE79802B263CDFCD83BB",
+		"AE2EA797CC0FE72AC5B97B97F3C6957D7E4199A167A58EB08BCAFFDA70AC0455",
+		"F52ECBC565B3D8BEA2DFD5B75A4F457E54369809322E4120831626F290FA87E0",
+		"1969AD73CC177FA0B4FCED6DF1F7BF9907E665FDE9BA196A74FED0A3CF5AEF9D",
+		"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141",
+	}
+	msg := mustDecode(t, "F95466D086770E689964664219266FE5ED215C92AE20BAB5C9D79ADDDDF3C0CF")
+
+	var d fr.Element
+	if err := d.SetBytesCanonical(sk); err != nil {
+		t.Fatal(err)
+	}
+	var aggNonce AggNonce
+	if err := aggNonce.SetBytes(mustDecode(t, aggNonceHex)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		keyIndices, nonceIndices, tweakIndices []int
+		xOnly                                  []bool
+		signerIndex                            int
+		expectedPSig                           string
+	}{
+		// a single x-only tweak
+		{[]int{1, 2, 0}, []int{1, 2, 0}, []int{0}, []bool{true}, 2, "E28A5C66E61E178C2BA19DB77B6CF9F7E2F0F56C17918CD13135E60CC848FE91"},
+		// a single plain tweak
+		{[]int{1, 2, 0}, []int{1, 2, 0}, []int{0}, []bool{false}, 2, "38B0767798252F21BF5702C48028B095428320F73A4B14DB1E25DE58543D2D2D"},
+		// a plain tweak followed by an x-only tweak
+		{[]int{1, 2, 0}, []int{1, 2, 0}, []int{0, 1}, []bool{false, true}, 2, "408A0A21C4A0F5DACAF9646AD6EB6FECD7F7A11F03ED1F48DFFF2185BC2C2408"},
+		// four tweaks: plain, plain, x-only, x-only
+		{[]int{1, 2, 0}, []int{1, 2, 0}, []int{0, 1, 2, 3}, []bool{false, false, true, true}, 2, "45ABD206E61E3DF2EC9E264A6FEC8292141A633C28586388235541F9ADE75435"},
+		// four tweaks: x-only, plain, x-only, plain
+		{[]int{1, 2, 0}, []int{1, 2, 0}, []int{0, 1, 2, 3}, []bool{true, false, true, false}, 2, "B255FDCAC27B40C7CE7848E2D3B7BF5EA0ED756DA81565AC804CCCA3E1D5D239"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("valid %d", i), func(t *testing.T) {
+			keys := mustParsePublicKeys(t, pubKeys, tt.keyIndices)
+			nonces := mustParsePubNonces(t, pubNonces, tt.nonceIndices)
+			keyAgg, err := KeyAgg(keys)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mustApplyTweaks(t, keyAgg, tweaks, tt.tweakIndices, tt.xOnly)
+
+			session := NewSession(keyAgg, &aggNonce, msg)
+			psig, err := session.Sign(secNonceFromBytes(secNonce), &d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := psig.Bytes()
+			checkHex(t, b[:], tt.expectedPSig)
+
+			if !session.PartialSigVerify(&psig, &nonces[tt.signerIndex], &keys[tt.signerIndex]) {
+				t.Fatal("partial signature does not verify")
+			}
+		})
+	}
+
+	// the tweak exceeds the group size
+	keyAgg, err := KeyAgg(mustParsePublicKeys(t, pubKeys, []int{1, 2, 0}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tweak [32]byte
+	copy(tweak[:], mustDecode(t, tweaks[4]))
+	if err := keyAgg.ApplyTweak(tweak, false); !errors.Is(err, errInvalidTweak) {
+		t.Fatalf("expected errInvalidTweak, got %v", err)
+	}
+}
+
+func TestNonceGenVectors(t *testing.T) {
+	randBytes := mustDecode(t, "0000000000000000000000000000000000000000000000000000000000000000")
+	sk := "0202020202020202020202020202020202020202020202020202020202020202"
+	aggPk := "0707070707070707070707070707070707070707070707070707070707070707"
+	extraIn := "0808080808080808080808080808080808080808080808080808080808080808"
+
+	tests := []struct {
+		sk, pk, aggPk string
+		msg           string
+		hasMsg        bool
+		extraIn       string
+		expected      string
+	}{
+		{
+			sk, "024D4B6CD1361032CA9BD2AEB9D900AA4D45D9EAD80AC9423374C451A7254D0766", aggPk,
+			"0101010101010101010101010101010101010101010101010101010101010101", true, extraIn,
+			"227243DCB40EF2A13A981DB188FA433717B506BDFA14B1AE47D5DC027C9C3B9EF2370B2AD206E724243215137C86365699361126991E6FEC816845F837BDDAC3024D4B6CD1361032CA9BD2AEB9D900AA4D45D9EAD80AC9423374C451A7254D0766",
+		},
+		{
+			sk, "024D4B6CD1361032CA9BD2AEB9D900AA4D45D9EAD80AC9423374C451A7254D0766", aggPk,
+			"", true, extraIn,
+			"CD0F47FE471D6788FF3243F47345EA0A179AEF69476BE8348322EF39C2723318870C2065AFB52DEDF02BF4FDBF6D2F442E608692F50C2374C08FFFE57042A61C024D4B6CD1361032CA9BD2AEB9D900AA4D45D9EAD80AC9423374C451A7254D0766",
+		},
+		{
+			sk, "024D4B6CD1361032CA9BD2AEB9D900AA4D45D9EAD80AC9423374C451A7254D0766", aggPk,
+			"2626262626262626262626262626262626262626262626262626262626262626262626262626", true, extraIn,
+			"011F8BC60EF061DEEF4D72A0A87200D9994B3F0CD9867910085C38D5366E3E6B9FF03BC0124E56B24069E91EC3F162378983F194E8BD0ED89BE3059649EAE262024D4B6CD1361032CA9BD2AEB9D900AA4D45D9EAD80AC9423374C451A7254D0766",
+		},
+		{
+			// only the mandatory inputs
+			"", "02F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9", "", "", false, "",
+			"890E83616A3BC4640AB9B6374F21C81FF89CDDDBAFAA7475AE2A102A92E3EDB29FD7E874E23342813A60D9646948242646B7951CA046B4B36D7D6078506D3C9402F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("valid %d", i), func(t *testing.T) {
+			pk, err := ParsePublicKey(mustDecode(t, tt.pk))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var opts []NonceOption
+			if tt.sk != "" {
+				var d fr.Element
+				if err := d.SetBytesCanonical(mustDecode(t, tt.sk)); err != nil {
+					t.Fatal(err)
+				}
+				opts = append(opts, WithSecretKey(&d))
+			}
+			if tt.aggPk != "" {
+				var q [SizeXOnlyPublicKey]byte
+				copy(q[:], mustDecode(t, tt.aggPk))
+				opts = append(opts, WithAggregatePublicKey(q))
+			}
+			if tt.hasMsg {
+				opts = append(opts, WithMessage(mustDecode(t, tt.msg)))
+			}
+			if tt.extraIn != "" {
+				opts = append(opts, WithExtraInput(mustDecode(t, tt.extraIn)))
+			}
+
+			var r [32]byte
+			copy(r[:], randBytes)
+			secNonce, pubNonce, err := nonceGen(r, &pk, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			k1, k2 := secNonce.k1.Bytes(), secNonce.k2.Bytes()
+			got := append(append(append([]byte{}, k1[:]...), k2[:]...), secNonce.pk[:]...)
+			checkHex(t, got, tt.expected)
+
+			// the public nonce matches the secret nonce
+			var r1, r2 secp256k1.G1Affine
+			var b big.Int
+			r1.ScalarMultiplicationBase(secNonce.k1.BigInt(&b))
+			r2.ScalarMultiplicationBase(secNonce.k2.BigInt(&b))
+			if !r1.Equal(&pubNonce.R1) || !r2.Equal(&pubNonce.R2) {
+				t.Fatal("public nonce does not match the secret nonce")
+			}
+		})
+	}
+}
+
+func TestNonceAggVectors(t *testing.T) {
+	pubNonces := []string{
+		"020151C80F435648DF67A22B749CD798CE54E0321D034B92B709B567D60A42E66603BA47FBC1834437B3212E89A84D8425E7BF12E0245D98262268EBDCB385D50641",
+		"03FF406FFD8ADB9CD29877E4985014F66A59F6CD01C0E88CAA8E5F3166B1F676A60248C264CDD57D3C24D79990B0F865674EB62A0F9018277A95011B41BFC193B833",
+		"020151C80F435648DF67A22B749CD798CE54E0321D034B92B709B567D60A42E6660279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798",
+		"03FF406FFD8ADB9CD29877E4985014F66A59F6CD01C0E88CAA8E5F3166B1F676A60379BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798",
+		"04FF406FFD8ADB9CD29877E4985014F66A59F6CD01C0E88CAA8E5F3166B1F676A60248C264CDD57D3C24D79990B0F865674EB62A0F9018277A95011B41BFC193B833",
+		"03FF406FFD8ADB9CD29877E4985014F66A59F6CD01C0E88CAA8E5F3166B1F676A60248C264CDD57D3C24D79990B0F865674EB62A0F9018277A95011B41BFC193B831",
+		"03FF406FFD8ADB9CD29877E4985014F66A59F6CD01C0E88CAA8E5F3166B1F676A602FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC30",
+	}
+
+	valid := []struct {
+		nonceIndices []int
+		expected     string
+	}{
+		{[]int{0, 1}, "035FE1873B4F2967F52FEA4A06AD5A8ECCBE9D0FD73068012C894E2E87CCB5804B024725377345BDE0E9C33AF3C43C0A29A9249F2F2956FA8CFEB55C8573D0262DC8"},
+		// the sum of the second points is the point at infinity, serialized as 33 zero bytes
+		{[]int{2, 3}, "035FE1873B4F2967F52FEA4A06AD5A8ECCBE9D0FD73068012C894E2E87CCB5804B000000000000000000000000000000000000000000000000000000000000000000"},
+	}
+	for i, tt := range valid {
+		t.Run(fmt.Sprintf("valid %d", i), func(t *testing.T) {
+			aggNonce, err := NonceAgg(mustParsePubNonces(t, pubNonces, tt.nonceIndices))
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := aggNonce.Bytes()
+			checkHex(t, b[:], tt.expected)
+		})
+	}
+
+	invalid := []struct {
+		index   int
+		comment string
+	}{
+		{4, "wrong tag, 0x04, in the first half"},
+		{5, "the second half does not correspond to an x coordinate"},
+		{6, "the second half exceeds the field size"},
+	}
+	for _, tt := range invalid {
+		var n PubNonce
+		if err := n.SetBytes(mustDecode(t, pubNonces[tt.index])); err == nil {
+			t.Errorf("invalid public nonce accepted: %s", tt.comment)
+		}
+	}
+}
+
+func TestSigAggVectors(t *testing.T) {
+	pubKeys := []string{
+		"03935F972DA013F80AE011890FA89B67A27B7BE6CCB24D3274D18B2D4067F261A9",
+		"02D2DC6F5DF7C56ACF38C7FA0AE7A759AE30E19B37359DFDE015872324C7EF6E05",
+		"03C7FB101D97FF930ACD0C6760852EF64E69083DE0B06AC6335724754BB4B0522C",
+		"02352433B21E7E05D3B452B81CAE566E06D2E003ECE16D1074AABA4289E0E3D581",
+	}
+	pubNonces := []string{
+		"036E5EE6E28824029FEA3E8A9DDD2C8483F5AF98F7177C3AF3CB6F47CAF8D94AE902DBA67E4A1F3680826172DA15AFB1A8CA85C7C5CC88900905C8DC8C328511B53E",
+		"03E4F798DA48A76EEC1C9CC5AB7A880FFBA201A5F064E627EC9CB0031D1D58FC5103E06180315C5A522B7EC7C08B69DCD721C313C940819296D0A7AB8E8795AC1F00",
+		"02C0068FD25523A31578B8077F24F78F5BD5F2422AFF47C1FADA0F36B3CEB6C7D202098A55D1736AA5FCC21CF0729CCE852575C06C081125144763C2C4C4A05C09B6",
+		"031F5C87DCFBFCF330DEE4311D85E8F1DEA01D87A6F1C14CDFC7E4F1D8C441CFA40277BF176E9F747C34F81B0D9F072B1B404A86F402C2D86CF9EA9E9C69876EA3B9",
+		"023F7042046E0397822C4144A17F8B63D78748696A46C3B9F0A901D296EC3406C302022B0B464292CF9751D699F10980AC764E6F671EFCA15069BBE62B0D1C62522A",
+		"02D97DDA5988461DF58C5897444F116A7C74E5711BF77A9446E27806563F3B6C47020CBAD9C363A7737F99FA06B6BE093CEAFF5397316C5AC46915C43767AE867C00",
+	}
+	tweaks := []string{
+		"B511DA492182A91B0FFB9A98020D55F260AE86D7ECBD0399C7383D59A5F2AF7C",
+		"A815FE049EE3C5AAB66310477FBC8BCCCAC2F3395F59F921C364ACD78A2F48DC",
+		"75448A87274B056468B977BE06EB1E9F657577B7320B0A3376EA51FD420D18A8",
+	}
+	psigs := []string{
+		"B15D2CD3C3D22B04DAE438CE653F6B4ECF042F42CFDED7C41B64AAF9B4AF53FB",
+		"6193D6AC61B354E9105BBDC8937A3454A6D705B6D57322A5A472A02CE99FCB64",
+		"9A87D3B79EC67228CB97878B76049B15DBD05B8158D17B5B9114D3C226887505",
+		"66F82EA90923689B855D36C6B7E032FB9970301481B99E01CDB4D6AC7C347A15",
+		"4F5AEE41510848A6447DCD1BBC78457EF69024944C87F40250D3EF2C25D33EFE",
+		"DDEF427BBB847CC027BEFF4EDB01038148917832253EBC355FC33F4A8E2FCCE4",
+		"97B890A26C981DA8102D3BC294159D171D72810FDF7C6A691DEF02F0F7AF3FDC",
+		"53FA9E08BA5243CBCB0D797C5EE83BC6728E539EB76C2D0BF0F971EE4E909971",
+		"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141",
+	}
+	msg := mustDecode(t, "599C67EA410D005B9DA90817CF03ED3B1C868E4DA4EDF00A5880B0082C237869")
+
+	tests := []struct {
+		aggNonce                               string
+		nonceIndices, keyIndices, tweakIndices []int
+		xOnly                                  []bool
+		psigIndices                            []int
+		expected                               string
+	}{
+		{
+			"0341432722C5CD0268D829C702CF0D1CBCE57033EED201FD335191385227C3210C03D377F2D258B64AADC0E16F26462323D701D286046A2EA93365656AFD9875982B",
+			[]int{0, 1}, []int{0, 1}, []int{}, []bool{}, []int{0, 1},
+			"041DA22223CE65C92C9A0D6C2CAC828AAF1EEE56304FEC371DDF91EBB2B9EF0912F1038025857FEDEB3FF696F8B99FA4BB2C5812F6095A2E0004EC99CE18DE1E",
+		},
+		{
+			"0224AFD36C902084058B51B5D36676BBA4DC97C775873768E58822F87FE437D792028CB15929099EEE2F5DAE404CD39357591BA32E9AF4E162B8D3E7CB5EFE31CB20",
+			[]int{0, 2}, []int{0, 2}, []int{}, []bool{}, []int{2, 3},
+			"1069B67EC3D2F3C7C08291ACCB17A9C9B8F2819A52EB5DF8726E17E7D6B52E9F01800260A7E9DAC450F4BE522DE4CE12BA91AEAF2B4279219EF74BE1D286ADD9",
+		},
+		{
+			"0208C5C438C710F4F96A61E9FF3C37758814B8C3AE12BFEA0ED2C87FF6954FF186020B1816EA104B4FCA2D304D733E0E19CEAD51303FF6420BFD222335CAA402916D",
+			[]int{0, 3}, []int{0, 2}, []int{0}, []bool{false}, []int{4, 5},
+			"5C558E1DCADE86DA0B2F02626A512E30A22CF5255CAEA7EE32C38E9A71A0E9148BA6C0E6EC7683B64220F0298696F1B878CD47B107B81F7188812D593971E0CC",
+		},
+		{
+			"02B5AD07AFCD99B6D92CB433FBD2A28FDEB98EAE2EB09B6014EF0F8197CD58403302E8616910F9293CF692C49F351DB86B25E352901F0E237BAFDA11F1C1CEF29FFD",
+			[]int{0, 4}, []int{0, 3}, []int{0, 1, 2}, []bool{true, false, true}, []int{6, 7},
+			"839B08820B681DBA8DAF4CC7B104E8F2638F9388F8D7A555DC17B6E6971D7426CE07BF6AB01F1DB50E4E33719295F4094572B79868E440FB3DEFD3FAC1DB589E",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("valid %d", i), func(t *testing.T) {
+			aggNonce, err := NonceAgg(mustParsePubNonces(t, pubNonces, tt.nonceIndices))
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := aggNonce.Bytes()
+			checkHex(t, b[:], tt.aggNonce)
+
+			keyAgg, err := KeyAgg(mustParsePublicKeys(t, pubKeys, tt.keyIndices))
+			if err != nil {
+				t.Fatal(err)
+			}
+			mustApplyTweaks(t, keyAgg, tweaks, tt.tweakIndices, tt.xOnly)
+
+			s := make([]fr.Element, len(tt.psigIndices))
+			for j, k := range tt.psigIndices {
+				if s[j], err = ParsePartialSig(mustDecode(t, psigs[k])); err != nil {
+					t.Fatal(err)
+				}
+			}
+			session := NewSession(keyAgg, aggNonce, msg)
+			sig := session.PartialSigAgg(s)
+			checkHex(t, sig[:], tt.expected)
+			if !Verify(keyAgg.XOnlyPublicKey(), msg, sig) {
+				t.Fatal("aggregate signature does not verify")
+			}
+		})
+	}
+
+	// the partial signature of signer 1 exceeds the group size
+	if _, err := ParsePartialSig(mustDecode(t, psigs[8])); !errors.Is(err, errInvalidPSig) {
+		t.Fatalf("expected errInvalidPSig, got %v", err)
+	}
+}
+
+func TestBIP340Vectors(t *testing.T) {
+	tests := []struct {
+		pk, msg, sig string
+		valid        bool
+	}{
+		{
+			"F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"E907831F80848D1069A5371B402410364BDF1C5F8307B0084C55F1CE2DCA821525F66A4A85EA8B71E482A74F382D2CE5EBEEE8FDB2172F477DF4900D310536C0",
+			true,
+		},
+		{
+			"DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			"243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			"6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A",
+			true,
+		},
+		{
+			// wrong message
+			"DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			"243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C8A",
+			"6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A",
+			false,
+		},
+	}
+	for i, tt := range tests {
+		var pk [SizeXOnlyPublicKey]byte
+		var sig [SizeSignature]byte
+		copy(pk[:], mustDecode(t, tt.pk))
+		copy(sig[:], mustDecode(t, tt.sig))
+		if Verify(pk, mustDecode(t, tt.msg), sig) != tt.valid {
+			t.Errorf("vector %d: expected valid=%v", i, tt.valid)
+		}
+	}
+}
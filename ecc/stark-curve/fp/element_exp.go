@@ -47,6 +47,31 @@ func (z *Element) expBySqrtExp(x Element) *Element {
 	return z
 }
 
+// vectorExpBySqrtExpNbTemporaries is the number of temporary vectors used by vectorExpBySqrtExp
+const vectorExpBySqrtExpNbTemporaries = 0
+
+// vectorExpBySqrtExp sets z[i] = x[i]^400000000000008 for all i, with the addition chain of expBySqrtExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpBySqrtExpNbTemporaries * len(x).
+func vectorExpBySqrtExp(z, x, scratch Vector) {
+
+	// Step 55: z = x^0x80000000000000
+	z.Mul(x, x)
+	for s := 1; s < 55; s++ {
+		z.Mul(z, z)
+	}
+
+	// Step 56: z = x^0x80000000000001
+	z.Mul(x, z)
+
+	// Step 59: z = x^0x400000000000008
+	for s := 0; s < 3; s++ {
+		z.Mul(z, z)
+	}
+
+}
+
 // expByLegendreExp is equivalent to z.Exp(x, 400000000000008800000000000000000000000000000000000000000000000)
 //
 // uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
@@ -85,3 +110,220 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	return z
 }
+
+// vectorExpByLegendreExpNbTemporaries is the number of temporary vectors used by vectorExpByLegendreExp
+const vectorExpByLegendreExpNbTemporaries = 0
+
+// vectorExpByLegendreExp sets z[i] = x[i]^400000000000008800000000000000000000000000000000000000000000000 for all i, with the addition chain of expByLegendreExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpByLegendreExpNbTemporaries * len(x).
+func vectorExpByLegendreExp(z, x, scratch Vector) {
+
+	// Step 55: z = x^0x80000000000000
+	z.Mul(x, x)
+	for s := 1; s < 55; s++ {
+		z.Mul(z, z)
+	}
+
+	// Step 56: z = x^0x80000000000001
+	z.Mul(x, z)
+
+	// Step 60: z = x^0x800000000000010
+	for s := 0; s < 4; s++ {
+		z.Mul(z, z)
+	}
+
+	// Step 61: z = x^0x800000000000011
+	z.Mul(x, z)
+
+	// Step 252: z = x^0x400000000000008800000000000000000000000000000000000000000000000
+	for s := 0; s < 191; s++ {
+		z.Mul(z, z)
+	}
+
+}
+
+// expByInverseExp is equivalent to z.Exp(x, 800000000000010ffffffffffffffffffffffffffffffffffffffffffffffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_1100     = _11 << 2
+	//	_1101     = 1 + _1100
+	//	_1111     = _10 + _1101
+	//	_11001    = _1100 + _1101
+	//	_110010   = 2*_11001
+	//	_110011   = 1 + _110010
+	//	_1000010  = _1111 + _110011
+	//	_1001110  = _1100 + _1000010
+	//	_10000001 = _110011 + _1001110
+	//	_11001111 = _1001110 + _10000001
+	//	i14       = 2*_11001111
+	//	i15       = _10000001 + i14
+	//	i16       = i14 + i15
+	//	x10       = _1000010 + i16
+	//	i27       = x10 << 10
+	//	i28       = i16 + i27
+	//	i38       = i27 << 10
+	//	i39       = i28 + i38
+	//	i49       = i38 << 10
+	//	i50       = i39 + i49
+	//	i60       = i49 << 10
+	//	i61       = i50 + i60
+	//	i72       = i60 << 10 + i61
+	//	x60       = _1000010 + i72
+	//	i76       = (i72 + x60) << 2
+	//	x64       = i15 + i76 + i76
+	//	i208      = ((i15 + x64) << 63 + x64) << 64 + x64
+	//	return      i208 << 60 + x60
+	//
+	// Operations: 244 squares 25 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+	)
+
+	// var t0,t1,t2 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: t0 = x^0x3
+	t0.Mul(&x, z)
+
+	// Step 4: t0 = x^0xc
+	for s := 0; s < 2; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 5: t1 = x^0xd
+	t1.Mul(&x, t0)
+
+	// Step 6: z = x^0xf
+	z.Mul(z, t1)
+
+	// Step 7: t1 = x^0x19
+	t1.Mul(t0, t1)
+
+	// Step 8: t1 = x^0x32
+	t1.Square(t1)
+
+	// Step 9: t1 = x^0x33
+	t1.Mul(&x, t1)
+
+	// Step 10: z = x^0x42
+	z.Mul(z, t1)
+
+	// Step 11: t0 = x^0x4e
+	t0.Mul(t0, z)
+
+	// Step 12: t1 = x^0x81
+	t1.Mul(t1, t0)
+
+	// Step 13: t0 = x^0xcf
+	t0.Mul(t0, t1)
+
+	// Step 14: t0 = x^0x19e
+	t0.Square(t0)
+
+	// Step 15: t1 = x^0x21f
+	t1.Mul(t1, t0)
+
+	// Step 16: t0 = x^0x3bd
+	t0.Mul(t0, t1)
+
+	// Step 17: t2 = x^0x3ff
+	t2.Mul(z, t0)
+
+	// Step 27: t2 = x^0xffc00
+	for s := 0; s < 10; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 28: t0 = x^0xfffbd
+	t0.Mul(t0, t2)
+
+	// Step 38: t2 = x^0x3ff00000
+	for s := 0; s < 10; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 39: t0 = x^0x3fffffbd
+	t0.Mul(t0, t2)
+
+	// Step 49: t2 = x^0xffc0000000
+	for s := 0; s < 10; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 50: t0 = x^0xffffffffbd
+	t0.Mul(t0, t2)
+
+	// Step 60: t2 = x^0x3ff0000000000
+	for s := 0; s < 10; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 61: t0 = x^0x3ffffffffffbd
+	t0.Mul(t0, t2)
+
+	// Step 71: t2 = x^0xffc000000000000
+	for s := 0; s < 10; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 72: t0 = x^0xfffffffffffffbd
+	t0.Mul(t0, t2)
+
+	// Step 73: z = x^0xfffffffffffffff
+	z.Mul(z, t0)
+
+	// Step 74: t0 = x^0x1fffffffffffffbc
+	t0.Mul(t0, z)
+
+	// Step 76: t0 = x^0x7ffffffffffffef0
+	for s := 0; s < 2; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 77: t2 = x^0x800000000000010f
+	t2.Mul(t1, t0)
+
+	// Step 78: t0 = x^0xffffffffffffffff
+	t0.Mul(t0, t2)
+
+	// Step 79: t1 = x^0x1000000000000021e
+	t1.Mul(t1, t0)
+
+	// Step 142: t1 = x^0x800000000000010f0000000000000000
+	for s := 0; s < 63; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 143: t1 = x^0x800000000000010fffffffffffffffff
+	t1.Mul(t0, t1)
+
+	// Step 207: t1 = x^0x800000000000010fffffffffffffffff0000000000000000
+	for s := 0; s < 64; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 208: t0 = x^0x800000000000010fffffffffffffffffffffffffffffffff
+	t0.Mul(t0, t1)
+
+	// Step 268: t0 = x^0x800000000000010fffffffffffffffffffffffffffffffff000000000000000
+	for s := 0; s < 60; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 269: z = x^0x800000000000010ffffffffffffffffffffffffffffffffffffffffffffffff
+	z.Mul(z, t0)
+
+	return z
+}
@@ -0,0 +1,174 @@
+//go:build !purego
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+import "math/bits"
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	addVec(&(*vector)[0], &a[0], &b[0], n)
+}
+
+//go:noescape
+func addVec(res, a, b *Element, n uint64)
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	subVec(&(*vector)[0], &a[0], &b[0], n)
+}
+
+//go:noescape
+func subVec(res, a, b *Element, n uint64)
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *Element) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	n := len(a) - len(a)%blockSize
+	if !supportAvx512 || n == 0 {
+		scalarMulVecGeneric(*vector, a, b)
+		return
+	}
+	scalarMulVec(&(*vector)[0], &a[0], b, uint64(n))
+	scalarMulVecGeneric((*vector)[n:], a[n:], b)
+}
+
+//go:noescape
+func scalarMulVec(res, a, b *Element, n uint64)
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	n := len(a) - len(a)%blockSize
+	if !supportAvx512 || n == 0 {
+		mulVecGeneric(*vector, a, b)
+		return
+	}
+	mulVec(&(*vector)[0], &a[0], &b[0], uint64(n))
+	mulVecGeneric((*vector)[n:], a[n:], b[n:])
+}
+
+//go:noescape
+func mulVec(res, a, b *Element, n uint64)
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res Element) {
+	n := len(*vector) - len(*vector)%blockSize
+	if !supportAvx512 || n == 0 {
+		sumVecGeneric(&res, *vector)
+		return
+	}
+
+	// the kernel accumulates the low and high 32 bits of the words of the elements
+	// in 64-bit lanes, which can't overflow for vectors of less than 2³² elements.
+	var t [16]uint64
+	sumVec(&t, &(*vector)[0], uint64(n))
+
+	// t[w] and t[w+4] (resp. t[w+8] and t[w+12]) hold the sums of the low (resp. high)
+	// 32 bits of the words w of the elements; we gather them in a 384-bit integer.
+	var v [6]uint64
+	addAt := func(i int, x uint64) {
+		var c uint64
+		v[i], c = bits.Add64(v[i], x, 0)
+		for j := i + 1; c != 0 && j < len(v); j++ {
+			v[j], c = bits.Add64(v[j], 0, c)
+		}
+	}
+	for w := 0; w < 4; w++ {
+		lo := t[w] + t[w+4]
+		hi := t[w+8] + t[w+12]
+		addAt(w, lo)
+		addAt(w, hi<<32)
+		addAt(w+1, hi>>32)
+	}
+
+	// the words of the elements are in Montgomery form, so is v mod q
+	// res = (((v₅⋅2⁶⁴ + v₄)⋅2⁶⁴ + v₃)⋅2⁶⁴ + …) mod q
+	var two64 Element
+	two64.SetUint64(1 << 63)
+	two64.Double(&two64)
+	for i := len(v) - 1; i >= 0; i-- {
+		res.Mul(&res, &two64)
+		res.Add(&res, &Element{v[i]})
+	}
+
+	var tail Element
+	sumVecGeneric(&tail, (*vector)[n:])
+	res.Add(&res, &tail)
+	return
+}
+
+//go:noescape
+func sumVec(res *[16]uint64, a *Element, n uint64)
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res Element) {
+	if len(*vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	n := len(other) - len(other)%blockSize
+	if !supportAvx512 || n == 0 {
+		innerProductVecGeneric(&res, *vector, other)
+		return
+	}
+
+	// multiply the vectors by chunks and sum the products
+	var buf [256]Element
+	for start := 0; start < n; start += len(buf) {
+		end := start + len(buf)
+		if end > n {
+			end = n
+		}
+		products := Vector(buf[:end-start])
+		products.Mul((*vector)[start:end], other[start:end])
+		s := products.Sum()
+		res.Add(&res, &s)
+	}
+
+	var tail Element
+	innerProductVecGeneric(&tail, (*vector)[n:], other[n:])
+	res.Add(&res, &tail)
+	return
+}
+
+// blockSize is the number of elements processed at once by the AVX-512 kernels
+const blockSize = 8
@@ -178,6 +178,44 @@ func getVector(l *Lattice, a, b *big.Int) [2]big.Int {
 	return res
 }
 
+//-------------------------------------------------------
+// Constant-time scalar multiplication utils
+
+// RegularSignedDecomposition computes the regular signed-digit recoding of the odd integer k,
+// given as little-endian 64-bit words, in windows of w bits.
+// cf https://eprint.iacr.org/2009/011 (Joye, Tunstall)
+//
+// On return, k = ∑ digits[i]⋅2^{w⋅i}, and every digits[i] for i < len(digits)-1 is odd
+// and in [-(2ʷ-1), 2ʷ-1]; the last digit holds what remains of k, that is 1 if
+// k < 2^{w⋅(len(digits)-1)}.
+// Every digit is non-zero, and the decomposition doesn't branch on the value of k,
+// which makes it suitable for constant-time scalar multiplications. k is not modified.
+//
+// w must be in [1, 6] and k must be odd.
+func RegularSignedDecomposition(k []uint64, w uint, digits []int8) {
+	if w == 0 || w > 6 {
+		panic("window size must be in [1, 6]")
+	}
+	if len(k) == 0 || k[0]&1 == 0 {
+		panic("k must be odd")
+	}
+	_k := make([]uint64, len(k))
+	copy(_k, k)
+
+	mask := uint64(1)<<(w+1) - 1
+	for i := 0; i < len(digits)-1; i++ {
+		// digits[i] = (k mod 2ʷ⁺¹) - 2ʷ
+		digits[i] = int8(int64(_k[0]&mask) - int64(1)<<w)
+		// k = (k - digits[i]) / 2ʷ = (k >> w) | 1
+		for j := 0; j < len(_k)-1; j++ {
+			_k[j] = (_k[j] >> w) | (_k[j+1] << (64 - w))
+		}
+		_k[len(_k)-1] >>= w
+		_k[0] |= 1
+	}
+	digits[len(digits)-1] = int8(_k[0])
+}
+
 // NextPowerOfTwo returns the next power of 2 of n
 func NextPowerOfTwo(n uint64) uint64 {
 	c := bits.OnesCount64(n)
@@ -1,6 +1,7 @@
 package ecc
 
 import (
+	"encoding/binary"
 	"math/big"
 	"testing"
 )
@@ -21,6 +22,38 @@ func TestNafDecomposition(t *testing.T) {
 	}
 }
 
+func TestRegularSignedDecomposition(t *testing.T) {
+	const w = 4
+
+	var k big.Int
+	k.SetString("13108968793781547619861935127046491459309155893440570251786403306729687672801", 10)
+
+	var words [5]uint64
+	var buf [40]byte
+	k.FillBytes(buf[:])
+	for i := range words {
+		words[i] = binary.BigEndian.Uint64(buf[32-8*i:])
+	}
+
+	var digits [(256+w)/w + 1]int8
+	RegularSignedDecomposition(words[:], w, digits[:])
+
+	var res, tmp big.Int
+	for i := len(digits) - 1; i >= 0; i-- {
+		res.Lsh(&res, w)
+		res.Add(&res, tmp.SetInt64(int64(digits[i])))
+		if i != len(digits)-1 && (digits[i]&1 == 0 || digits[i] > (1<<w)-1 || digits[i] < -(1<<w)+1) {
+			t.Fatal("invalid digit")
+		}
+	}
+	if res.Cmp(&k) != 0 {
+		t.Fatal("decomposition failed")
+	}
+	if digits[len(digits)-1] != 1 {
+		t.Fatal("last digit should be 1")
+	}
+}
+
 func TestSplitting(t *testing.T) {
 	t.Parallel()
 
@@ -158,6 +158,11 @@ func Generate(w io.Writer, F *config.FieldConfig) error {
 	// fft butterflies
 	f.generateButterfly()
 
+	// vector operations
+	if F.ASMVector {
+		f.generateVec()
+	}
+
 	return nil
 }
 
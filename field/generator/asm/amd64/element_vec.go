@@ -0,0 +1,554 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amd64
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/consensys/bavard/amd64"
+)
+
+// the AVX-512 kernels work on 8 elements at once, one per 64-bit lane. The multiplication
+// uses the IFMA instructions (VPMADD52LUQ, VPMADD52HUQ), with the elements split in
+// 5 limbs of 52 bits.
+const (
+	nbLimbs52 = 5
+	mask52    = (1 << 52) - 1
+)
+
+// vector kernels are generated for 4-word moduli only
+func (f *FFAmd64) generateVec() {
+	if f.NbWords != 4 {
+		panic("vector kernels are only implemented for 4-word moduli")
+	}
+	f.generateVecDefines()
+	f.generateAddVec()
+	f.generateSubVec()
+	f.generateSumVec()
+	f.generateMulVec("mulVec")
+	f.generateMulVec("scalarMulVec")
+}
+
+// vop writes an instruction which is not covered by bavard/amd64
+func (f *FFAmd64) vop(instruction string, operands ...interface{}) {
+	ops := make([]string, len(operands))
+	for i, o := range operands {
+		ops[i] = fmt.Sprint(o)
+	}
+	f.WriteLn(fmt.Sprintf("    %s %s", instruction, strings.Join(ops, ", ")))
+}
+
+// permutations of 64-bit lanes used to transpose 8 elements between the memory layout
+// (4 words per element) and the "one element per lane" layout (one register per word)
+var vecPermutations = []struct {
+	name    string
+	indices [8]uint64
+}{
+	// memory → lanes
+	{"permuteWordsLo", [8]uint64{0, 4, 8, 12, 1, 5, 9, 13}},
+	{"permuteWordsHi", [8]uint64{2, 6, 10, 14, 3, 7, 11, 15}},
+	{"permuteHalvesLo", [8]uint64{0, 1, 2, 3, 8, 9, 10, 11}},
+	{"permuteHalvesHi", [8]uint64{4, 5, 6, 7, 12, 13, 14, 15}},
+	// lanes → memory
+	{"interleaveLo", [8]uint64{0, 8, 1, 9, 2, 10, 3, 11}},
+	{"interleaveHi", [8]uint64{4, 12, 5, 13, 6, 14, 7, 15}},
+	{"interleavePairsLo", [8]uint64{0, 1, 8, 9, 2, 3, 10, 11}},
+	{"interleavePairsHi", [8]uint64{4, 5, 12, 13, 6, 7, 14, 15}},
+}
+
+func (f *FFAmd64) generateVecDefines() {
+	var q, qInv, r big.Int
+	for i := f.NbWords - 1; i >= 0; i-- {
+		q.Lsh(&q, 64).Or(&q, new(big.Int).SetUint64(f.Q[i]))
+	}
+	// qInv52 = -q⁻¹ mod 2⁵²
+	r.Lsh(big.NewInt(1), 52)
+	qInv.ModInverse(&q, &r).Neg(&qInv).Mod(&qInv, &r)
+
+	f.Comment("modulus q in 5 limbs of 52 bits")
+	m := new(big.Int).SetUint64(mask52)
+	for i := 0; i < nbLimbs52; i++ {
+		l := new(big.Int).Rsh(&q, uint(52*i))
+		l.And(l, m)
+		f.WriteLn(fmt.Sprintf("DATA q52<>+%d(SB)/8, %s", i*8, imm(l.Uint64())))
+	}
+	f.WriteLn(fmt.Sprintf("GLOBL q52<>(SB), (RODATA+NOPTR), $%d", nbLimbs52*8))
+	f.WriteLn("")
+	f.Comment("qInv52 = -q⁻¹ mod 2⁵²")
+	f.WriteLn(fmt.Sprintf("DATA qInv52<>(SB)/8, %s", imm(qInv.Uint64())))
+	f.WriteLn("GLOBL qInv52<>(SB), (RODATA+NOPTR), $8")
+	f.WriteLn("")
+
+	for _, p := range vecPermutations {
+		for i, idx := range p.indices {
+			f.WriteLn(fmt.Sprintf("DATA %s<>+%d(SB)/8, %s", p.name, i*8, imm(idx)))
+		}
+		f.WriteLn(fmt.Sprintf("GLOBL %s<>(SB), (RODATA+NOPTR), $64", p.name))
+	}
+	f.WriteLn("")
+}
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+func (f *FFAmd64) generateAddVec() {
+	f.Comment("addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]")
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(f.NbWords*2+4, 0, 0)
+	registers := f.FnHeader("addVec", stackSize, argSize)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	// registers
+	addrA := f.Pop(&registers)
+	addrB := f.Pop(&registers)
+	addrRes := f.Pop(&registers)
+	n := f.Pop(&registers)
+
+	// a[i] + b[i]
+	a := f.PopN(&registers)
+	t := f.PopN(&registers)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.MOVQ("res+0(FP)", addrRes)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", n)
+
+	f.LABEL(loop)
+
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	// a = a + b
+	f.Mov(addrA, a)
+	f.Add(addrB, a)
+
+	// reduce a
+	f.ReduceElement(a, t)
+
+	// save a into res
+	f.Mov(a, addrRes)
+
+	f.Comment("increment pointers to visit next element")
+	f.ADDQ("$32", addrA)
+	f.ADDQ("$32", addrB)
+	f.ADDQ("$32", addrRes)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+
+	f.RET()
+
+	f.Push(&registers, a...)
+	f.Push(&registers, t...)
+	f.Push(&registers, addrA, addrB, addrRes, n)
+}
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+func (f *FFAmd64) generateSubVec() {
+	f.Comment("subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]")
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(f.NbWords*2+5, 0, 0)
+	registers := f.FnHeader("subVec", stackSize, argSize)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	// registers
+	addrA := f.Pop(&registers)
+	addrB := f.Pop(&registers)
+	addrRes := f.Pop(&registers)
+	n := f.Pop(&registers)
+	zero := f.Pop(&registers)
+
+	a := f.PopN(&registers)
+	q := f.PopN(&registers)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.MOVQ("res+0(FP)", addrRes)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", n)
+	f.XORQ(zero, zero)
+
+	f.LABEL(loop)
+
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	// a = a - b
+	f.Mov(addrA, a)
+	f.Sub(addrB, a)
+
+	// reduce a: add q if the subtraction borrowed
+	f.Mov(f.Q, q)
+	for i := 0; i < f.NbWords; i++ {
+		f.CMOVQCC(zero, q[i])
+	}
+	f.Add(q, a)
+
+	// save a into res
+	f.Mov(a, addrRes)
+
+	f.Comment("increment pointers to visit next element")
+	f.ADDQ("$32", addrA)
+	f.ADDQ("$32", addrB)
+	f.ADDQ("$32", addrRes)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+
+	f.RET()
+
+	f.Push(&registers, a...)
+	f.Push(&registers, q...)
+	f.Push(&registers, addrA, addrB, addrRes, n, zero)
+}
+
+// sumVec(res *[16]uint64, a *Element, n uint64) accumulates the low and high 32 bits of
+// the words of a[0...n] in 64-bit lanes; n must be a multiple of 8.
+//
+// res[i] (resp. res[8+i]) holds the sum of the low (resp. high) 32 bits of the words
+// i%4 of the elements 2k + i/4.
+func (f *FFAmd64) generateSumVec() {
+	f.Comment("sumVec(res *[16]uint64, a *Element, n uint64) res = ∑ a[0...n], split in 32-bit halves")
+
+	const argSize = 3 * 8
+	registers := f.FnHeader("sumVec", 0, argSize)
+
+	addrA := f.Pop(&registers)
+	n := f.Pop(&registers)
+	tmp := f.Pop(&registers)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("n+16(FP)", n)
+	f.SHRQ("$3", n, "we process 8 elements per iteration")
+
+	f.Comment("Z0, Z2: accumulate the low 32 bits; Z1, Z3: accumulate the high 32 bits")
+	for i := 0; i < 4; i++ {
+		f.vop("VPXORQ", fmt.Sprintf("Z%d", i), fmt.Sprintf("Z%d", i), fmt.Sprintf("Z%d", i))
+	}
+	f.MOVQ("$0xffffffff", tmp)
+	f.vop("VPBROADCASTQ", tmp, "Z31")
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	for i := 0; i < 4; i++ {
+		f.vop("VMOVDQU64", fmt.Sprintf("%d(%s)", 64*i, addrA), fmt.Sprintf("Z%d", 4+i))
+	}
+	for i := 0; i < 4; i++ {
+		in := fmt.Sprintf("Z%d", 4+i)
+		lo, hi := fmt.Sprintf("Z%d", 8+2*i), fmt.Sprintf("Z%d", 9+2*i)
+		accLo, accHi := "Z0", "Z1"
+		if i%2 == 1 {
+			accLo, accHi = "Z2", "Z3"
+		}
+		f.vop("VPANDQ", "Z31", in, lo)
+		f.vop("VPSRLQ", "$32", in, hi)
+		f.vop("VPADDQ", lo, accLo, accLo)
+		f.vop("VPADDQ", hi, accHi, accHi)
+	}
+
+	f.Comment("increment pointers to visit next elements")
+	f.ADDQ("$256", addrA)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.vop("VPADDQ", "Z2", "Z0", "Z0")
+	f.vop("VPADDQ", "Z3", "Z1", "Z1")
+	f.MOVQ("res+0(FP)", tmp)
+	f.vop("VMOVDQU64", "Z0", fmt.Sprintf("0(%s)", tmp))
+	f.vop("VMOVDQU64", "Z1", fmt.Sprintf("64(%s)", tmp))
+	f.vop("VZEROUPPER")
+	f.RET()
+
+	f.Push(&registers, addrA, n, tmp)
+}
+
+// zmm registers used by the IFMA multiplication
+type vecMulRegisters struct {
+	a, b, t, q    []string // 52-bit limbs of the operands, accumulator and modulus
+	qInv, mask, m string
+	w, tmp        []string
+}
+
+func newVecMulRegisters() vecMulRegisters {
+	z := func(from, n int) []string {
+		r := make([]string, n)
+		for i := range r {
+			r[i] = fmt.Sprintf("Z%d", from+i)
+		}
+		return r
+	}
+	return vecMulRegisters{
+		w:    z(0, 4),
+		a:    z(4, nbLimbs52),
+		b:    z(9, nbLimbs52),
+		t:    z(14, nbLimbs52+1),
+		q:    z(20, nbLimbs52),
+		qInv: "Z25",
+		mask: "Z26",
+		m:    "Z27",
+		tmp:  z(28, 4),
+	}
+}
+
+// generateMulVec generates
+//
+//	mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+//	scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+//
+// n must be a multiple of 8. The elements are split in 5 limbs of 52 bits, and multiplied
+// with a Montgomery multiplication in radix 2⁵², with R' = 2²⁶⁰. To obtain the Montgomery
+// product with R = 2²⁵⁶, b is shifted by 4 bits: (a⋅2⁴b)/2²⁶⁰ = (a⋅b)/2²⁵⁶.
+// Since q < 2²⁵⁵, the result before the final conditional subtraction is below 1.5q.
+func (f *FFAmd64) generateMulVec(name string) {
+	scalarMul := name == "scalarMulVec"
+	if scalarMul {
+		f.Comment("scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b")
+	} else {
+		f.Comment("mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]")
+	}
+
+	const argSize = 4 * 8
+	registers := f.FnHeader(name, 0, argSize)
+
+	addrA := f.Pop(&registers)
+	addrB := f.Pop(&registers)
+	addrRes := f.Pop(&registers)
+	n := f.Pop(&registers)
+	tmp := f.Pop(&registers)
+
+	z := newVecMulRegisters()
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.MOVQ("res+0(FP)", addrRes)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", n)
+	f.SHRQ("$3", n, "we process 8 elements per iteration")
+
+	f.MOVQ(fmt.Sprintf("$%#x", uint64(mask52)), tmp)
+	f.vop("VPBROADCASTQ", tmp, z.mask)
+	f.vop("VPBROADCASTQ", "qInv52<>(SB)", z.qInv)
+	for i := 0; i < nbLimbs52; i++ {
+		f.vop("VPBROADCASTQ", fmt.Sprintf("q52<>+%d(SB)", 8*i), z.q[i])
+	}
+
+	if scalarMul {
+		f.Comment("b is the same for all lanes; we split it in limbs once")
+		for i := 0; i < f.NbWords; i++ {
+			f.vop("VPBROADCASTQ", fmt.Sprintf("%d(%s)", 8*i, addrB), z.w[i])
+		}
+		f.vecToLimbs(z.w, z.b, z.mask, z.tmp[0], 4)
+	}
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.Comment("load 8 elements of a and split them in limbs")
+	f.vecLoad(addrA, z.w, z.tmp)
+	f.vecToLimbs(z.w, z.a, z.mask, z.tmp[0], 0)
+	if !scalarMul {
+		f.Comment("load 8 elements of b and split them in limbs, shifted by 4 bits")
+		f.vecLoad(addrB, z.w, z.tmp)
+		f.vecToLimbs(z.w, z.b, z.mask, z.tmp[0], 4)
+	}
+
+	f.vecMontMul(&z)
+
+	f.Comment("convert the result back to 64-bit words and store it")
+	f.vecFromLimbs(z.a, z.w, z.tmp[0])
+	f.vecStore(addrRes, z.w, z.tmp, z.t)
+
+	f.Comment("increment pointers to visit next elements")
+	f.ADDQ("$256", addrA)
+	if !scalarMul {
+		f.ADDQ("$256", addrB)
+	}
+	f.ADDQ("$256", addrRes)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.vop("VZEROUPPER")
+	f.RET()
+
+	f.Push(&registers, addrA, addrB, addrRes, n, tmp)
+}
+
+// vecMontMul computes the Montgomery product of z.a and z.b in radix 2⁵², reduced modulo q.
+// The result is written in z.a.
+func (f *FFAmd64) vecMontMul(z *vecMulRegisters) {
+	t := make([]string, len(z.t))
+	copy(t, z.t)
+
+	for _, r := range t {
+		f.vop("VPXORQ", r, r, r)
+	}
+
+	for i := 0; i < nbLimbs52; i++ {
+		f.Comment(fmt.Sprintf("t += a * b[%d]", i))
+		for j := 0; j < nbLimbs52; j++ {
+			f.vop("VPMADD52LUQ", z.b[i], z.a[j], t[j])
+			f.vop("VPMADD52HUQ", z.b[i], z.a[j], t[j+1])
+		}
+
+		f.Comment("m = t[0] * qInv52 mod 2⁵²; t += m * q")
+		f.vop("VPXORQ", z.m, z.m, z.m)
+		f.vop("VPMADD52LUQ", z.qInv, t[0], z.m)
+		for j := 0; j < nbLimbs52; j++ {
+			f.vop("VPMADD52LUQ", z.m, z.q[j], t[j])
+			f.vop("VPMADD52HUQ", z.m, z.q[j], t[j+1])
+		}
+
+		f.Comment("t[0] = 0 mod 2⁵²; shift t by one limb")
+		f.vop("VPSRLQ", "$52", t[0], z.tmp[0])
+		f.vop("VPADDQ", z.tmp[0], t[1], t[1])
+		f.vop("VPXORQ", t[0], t[0], t[0])
+		t = append(t[1:], t[0])
+	}
+
+	f.Comment("normalize the limbs of t")
+	for j := 0; j < nbLimbs52-1; j++ {
+		f.vop("VPSRLQ", "$52", t[j], z.tmp[0])
+		f.vop("VPANDQ", z.mask, t[j], t[j])
+		f.vop("VPADDQ", z.tmp[0], t[j+1], t[j+1])
+	}
+
+	f.Comment("a = t - q; keep t if the subtraction borrowed")
+	for j := 0; j < nbLimbs52; j++ {
+		f.vop("VPSUBQ", z.q[j], t[j], z.a[j])
+		if j > 0 {
+			f.vop("VPADDQ", z.tmp[0], z.a[j], z.a[j])
+		}
+		if j < nbLimbs52-1 {
+			f.vop("VPSRAQ", "$52", z.a[j], z.tmp[0])
+			f.vop("VPANDQ", z.mask, z.a[j], z.a[j])
+		}
+	}
+	f.vop("VPMOVQ2M", z.a[nbLimbs52-1], "K1")
+	for j := 0; j < nbLimbs52; j++ {
+		f.vop("VMOVDQA64", t[j], "K1", z.a[j])
+	}
+}
+
+// vecLoad loads 8 elements at addr and transposes them: w[i] holds the words i of the elements
+func (f *FFAmd64) vecLoad(addr amd64.Register, w, tmp []string) {
+	for i := 0; i < 4; i++ {
+		f.vop("VMOVDQU64", fmt.Sprintf("%d(%s)", 64*i, addr), tmp[i])
+	}
+	// w[0], w[1] from words 0, 1 and w[2], w[3] from words 2, 3
+	f.vecPermute("permuteWordsLo", tmp[0], tmp[1], w[0])
+	f.vecPermute("permuteWordsLo", tmp[2], tmp[3], w[1])
+	f.vecPermute("permuteWordsHi", tmp[0], tmp[1], w[2])
+	f.vecPermute("permuteWordsHi", tmp[2], tmp[3], w[3])
+	f.vecPermute("permuteHalvesLo", w[0], w[1], tmp[0])
+	f.vecPermute("permuteHalvesHi", w[0], w[1], tmp[1])
+	f.vecPermute("permuteHalvesLo", w[2], w[3], tmp[2])
+	f.vecPermute("permuteHalvesHi", w[2], w[3], tmp[3])
+	for i := 0; i < 4; i++ {
+		f.vop("VMOVDQA64", tmp[i], w[i])
+	}
+}
+
+// vecStore transposes w (w[i] holds the words i of 8 elements) and stores the elements at addr
+func (f *FFAmd64) vecStore(addr amd64.Register, w, tmp, out []string) {
+	f.vecPermute("interleaveLo", w[0], w[1], tmp[0])
+	f.vecPermute("interleaveLo", w[2], w[3], tmp[1])
+	f.vecPermute("interleaveHi", w[0], w[1], tmp[2])
+	f.vecPermute("interleaveHi", w[2], w[3], tmp[3])
+	f.vecPermute("interleavePairsLo", tmp[0], tmp[1], out[0])
+	f.vecPermute("interleavePairsHi", tmp[0], tmp[1], out[1])
+	f.vecPermute("interleavePairsLo", tmp[2], tmp[3], out[2])
+	f.vecPermute("interleavePairsHi", tmp[2], tmp[3], out[3])
+	for i := 0; i < 4; i++ {
+		f.vop("VMOVDQU64", out[i], fmt.Sprintf("%d(%s)", 64*i, addr))
+	}
+}
+
+// vecPermute sets res to the lanes of (t1, t2) selected by the permutation
+func (f *FFAmd64) vecPermute(permutation, t1, t2, res string) {
+	f.vop("VMOVDQU64", permutation+"<>(SB)", res)
+	f.vop("VPERMI2Q", t2, t1, res)
+}
+
+// vecToLimbs splits the 4 words w of the lanes, shifted left by shift bits, in 5 limbs of 52 bits
+func (f *FFAmd64) vecToLimbs(w, limbs []string, mask, tmp string, shift int) {
+	for j := 0; j < nbLimbs52; j++ {
+		lo := 52*j - shift
+		if lo < 0 {
+			f.vop("VPSLLQ", fmt.Sprintf("$%d", -lo), w[0], limbs[j])
+		} else {
+			wi, o := lo/64, lo%64
+			if o == 0 {
+				f.vop("VMOVDQA64", w[wi], limbs[j])
+			} else {
+				f.vop("VPSRLQ", fmt.Sprintf("$%d", o), w[wi], limbs[j])
+			}
+			if o+52 > 64 && wi+1 < f.NbWords {
+				f.vop("VPSLLQ", fmt.Sprintf("$%d", 64-o), w[wi+1], tmp)
+				f.vop("VPORQ", tmp, limbs[j], limbs[j])
+			}
+		}
+		if j < nbLimbs52-1 {
+			f.vop("VPANDQ", mask, limbs[j], limbs[j])
+		}
+	}
+}
+
+// vecFromLimbs merges 5 normalized limbs of 52 bits in 4 words
+func (f *FFAmd64) vecFromLimbs(limbs, w []string, tmp string) {
+	for i := 0; i < f.NbWords; i++ {
+		// word i is made of the bits 64i - 52j of the limbs j
+		first := true
+		for j := 0; j < nbLimbs52; j++ {
+			s := 52*j - 64*i
+			if s <= -52 || s >= 64 {
+				continue
+			}
+			dst := w[i]
+			if !first {
+				dst = tmp
+			}
+			if s < 0 {
+				f.vop("VPSRLQ", fmt.Sprintf("$%d", -s), limbs[j], dst)
+			} else if s > 0 {
+				f.vop("VPSLLQ", fmt.Sprintf("$%d", s), limbs[j], dst)
+			} else {
+				f.vop("VMOVDQA64", limbs[j], dst)
+			}
+			if !first {
+				f.vop("VPORQ", tmp, w[i], w[i])
+			}
+			first = false
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package arm64 contains syntactic sugar to generate arm64 assembly code
+package arm64
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/bavard/arm64"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// ZR is the zero register
+const ZR = "ZR"
+
+// registers that can't be used by the generated functions:
+// R18 is the platform register (excluded by bavard), R27 is reserved
+// by the Go assembler, R28 holds g, R29 is the frame pointer and R30 the link register.
+const rTmp = arm64.Register(27)
+
+func NewFFArm64(w io.Writer, F *config.FieldConfig) *FFArm64 {
+	return &FFArm64{F, arm64.NewArm64(w)}
+}
+
+type FFArm64 struct {
+	*config.FieldConfig
+	*arm64.Arm64
+}
+
+// FnHeader writes the function header and returns the registers available to the function
+func (f *FFArm64) FnHeader(funcName string, stackSize, argSize int) arm64.Registers {
+	return f.Arm64.FnHeader(funcName, stackSize, argSize, rTmp)
+}
+
+// Generate generates assembly code for the base field provided to goff
+// see internal/templates/ops*
+func Generate(w io.Writer, F *config.FieldConfig) error {
+	if F.NbWords > 6 {
+		panic("arm64 assembly is generated for moduli with at most 6 words")
+	}
+	f := NewFFArm64(w, F)
+	f.WriteLn(bavard.Apache2Header("ConsenSys Software Inc.", 2020))
+
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("")
+
+	f.GenerateDefines()
+
+	// mul
+	f.generateMul()
+
+	// reduce
+	f.generateReduce()
+
+	// fft butterflies
+	f.generateButterfly()
+
+	return nil
+}
+
+// GenerateDefines writes the modulus and qInv0 in the read-only data section
+func (f *FFArm64) GenerateDefines() {
+	f.WriteLn("// modulus q")
+	for i, w := range f.Q {
+		f.WriteLn(fmt.Sprintf("DATA q<>+%d(SB)/8, $%#016x", i*8, w))
+	}
+	f.WriteLn(fmt.Sprintf("GLOBL q<>(SB), (RODATA+NOPTR), $%d", f.NbWords*8))
+	f.WriteLn("")
+	f.WriteLn("// qInv0 q'[0]")
+	f.WriteLn(fmt.Sprintf("DATA qInv0<>(SB)/8, $%#016x", f.QInverse[0]))
+	f.WriteLn("GLOBL qInv0<>(SB), (RODATA+NOPTR), $8")
+	f.WriteLn("")
+}
+
+// LoadModulus loads the words of q into q, using q[0] to hold the address of the modulus
+func (f *FFArm64) LoadModulus(q []arm64.Register) {
+	f.MOVD("$q<>(SB)", q[0])
+	f.Load(q[0], q)
+}
+
+// Load loads len(dst) words from the address in ptr, starting at word offset
+func (f *FFArm64) Load(ptr arm64.Register, dst []arm64.Register, offset ...int) {
+	o := 0
+	if len(offset) > 0 {
+		o = offset[0]
+	}
+	// ptr may be overwritten by the last load (LoadModulus), so the pairs are loaded backwards
+	i := len(dst)
+	if i%2 == 1 {
+		i--
+		f.MOVD(ptr.At(o+i), dst[i])
+	}
+	for i -= 2; i >= 0; i -= 2 {
+		f.LDP(ptr.At(o+i), dst[i], dst[i+1])
+	}
+}
+
+// Store stores src at the address in ptr, starting at word offset
+func (f *FFArm64) Store(src []arm64.Register, ptr arm64.Register, offset ...int) {
+	o := 0
+	if len(offset) > 0 {
+		o = offset[0]
+	}
+	i := 0
+	for ; i+1 < len(src); i += 2 {
+		f.STP(src[i], src[i+1], ptr.At(o+i))
+	}
+	if i < len(src) {
+		f.MOVD(src[i], ptr.At(o+i))
+	}
+}
+
+// STP stores the pair (x, y) at address
+// (bavard's arm64.STP writes the address first, which the Go assembler rejects)
+func (f *FFArm64) STP(x, y arm64.Register, address string) {
+	f.WriteLn(fmt.Sprintf("    STP (%s, %s), %s", x.Name(), y.Name(), address))
+}
+
+// Add sets z = x + y, setting the carry flag
+func (f *FFArm64) Add(x, y, z []arm64.Register) {
+	for i := 0; i < len(z); i++ {
+		if i == 0 {
+			f.ADDS(x[i], y[i], z[i])
+		} else {
+			f.ADCS(x[i], y[i], z[i])
+		}
+	}
+}
+
+// Sub sets z = x - y; the carry flag is cleared if the subtraction borrowed
+func (f *FFArm64) Sub(x, y, z []arm64.Register) {
+	for i := 0; i < len(z); i++ {
+		if i == 0 {
+			f.SUBS(y[i], x[i], z[i])
+		} else {
+			f.SBCS(y[i], x[i], z[i])
+		}
+	}
+}
+
+// Reduce sets t = t - q if t ⩾ q, using scratch registers
+func (f *FFArm64) Reduce(t, q, scratch []arm64.Register) {
+	f.Sub(t, q, scratch)
+	for i := 0; i < len(t); i++ {
+		f.CSEL("CS", scratch[i], t[i], t[i])
+	}
+}
+
+// ModSub sets t = x - y (mod q), using scratch registers; x and y must be less than q.
+// t and x may be the same registers.
+func (f *FFArm64) ModSub(x, y, t, q, scratch []arm64.Register) {
+	f.Sub(x, y, t)
+	// if the subtraction borrowed, add q
+	for i := 0; i < len(t); i++ {
+		f.CSEL("CS", ZR, q[i], scratch[i])
+	}
+	f.Add(t, scratch, t)
+}
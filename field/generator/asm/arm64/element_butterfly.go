@@ -0,0 +1,56 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+// Butterfly sets
+// a = a + b
+// b = a - b
+//
+//	func Butterfly(a, b *{{.ElementName}}) {
+//		t := *a
+//		a.Add(a, b)
+//		b.Sub(&t, b)
+//	}
+func (f *FFArm64) generateButterfly() {
+	f.Comment("Butterfly(a, b *Element) sets a = a + b; b = a - b")
+	registers := f.FnHeader("Butterfly", 0, 16)
+
+	// registers
+	pa := registers.Pop()
+	pb := registers.Pop()
+	a := registers.PopN(f.NbWords)
+	b := registers.PopN(f.NbWords)
+	q := registers.PopN(f.NbWords)
+	s := registers.PopN(f.NbWords)
+
+	f.LoadModulus(q)
+	f.MOVD("a+0(FP)", pa)
+	f.Load(pa, a)
+	f.MOVD("b+8(FP)", pb)
+	f.Load(pb, b)
+
+	// s = a + b; since q's most significant word is smaller than 2⁶³-1, this can't overflow
+	f.Add(a, b, s)
+
+	// b = a - b (mod q)
+	f.ModSub(a, b, a, q, b)
+	f.Store(a, pb)
+
+	// a = a + b (mod q)
+	f.Reduce(s, q, b)
+	f.Store(s, pa)
+
+	f.RET()
+}
@@ -0,0 +1,121 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+import (
+	"fmt"
+
+	"github.com/consensys/bavard/arm64"
+)
+
+// generateMul generates mul(res, x, y *Element), setting res = x * y * R⁻¹ (mod q)
+//
+// ARM64 has no add-with-carry variant of the multiplication, so instead of interleaving the two
+// carry chains of the CIOS algorithm, each step accumulates the low words of the products, then the
+// high words:
+//
+//	for i=0 to N-1
+//		t = t + x * y[i]
+//		m = t[0] * q'[0] mod W
+//		t = (t + m * q) / W
+//
+// t fits in N+1 words since q's most significant word is smaller than 2⁶³-1 (F.NoCarry);
+// at each step t < 2q, such that a single conditional subtraction reduces the result.
+func (f *FFArm64) generateMul() {
+	f.Comment("mul(res, x, y *Element) sets res = x * y * R⁻¹ (mod q)")
+	registers := f.FnHeader("mul", 0, 24)
+
+	// registers
+	ptr := registers.Pop()
+	qInv0 := registers.Pop()
+	yi := registers.Pop()
+	m := registers.Pop()
+	lo := registers.Pop()
+	hi := registers.Pop()
+	x := registers.PopN(f.NbWords)
+	q := registers.PopN(f.NbWords)
+	t := registers.PopN(f.NbWords + 1)
+
+	f.LoadModulus(q)
+	f.MOVD("qInv0<>(SB)", qInv0)
+	f.MOVD("x+8(FP)", ptr)
+	f.Load(ptr, x)
+	f.MOVD("y+16(FP)", ptr)
+
+	for i := 0; i < f.NbWords; i++ {
+		f.MOVD(ptr.At(i), yi)
+
+		f.Comment(fmt.Sprintf("t = t + x * y[%d]", i))
+		if i == 0 {
+			for j := 0; j < f.NbWords; j++ {
+				f.MUL(x[j], yi, t[j])
+			}
+		} else {
+			for j := 0; j < f.NbWords; j++ {
+				f.MUL(x[j], yi, lo)
+				f.adcs(j, lo, t[j])
+			}
+			f.ADC(ZR, ZR, t[f.NbWords])
+		}
+		f.addHigh(x, yi, hi, t, i == 0)
+
+		f.Comment("m = t[0] * q'[0] mod W; t = (t + m * q) / W")
+		f.MUL(qInv0, t[0], m)
+		for j := 0; j < f.NbWords; j++ {
+			f.MUL(q[j], m, lo)
+			f.adcs(j, lo, t[j])
+		}
+		f.ADC(ZR, t[f.NbWords], t[f.NbWords])
+		f.addHigh(q, m, hi, t, false)
+
+		// t[0] is now 0; shifting t is a renaming of the registers
+		t = append(t[1:], t[0])
+	}
+
+	f.Comment("reduce t if t ⩾ q")
+	t = t[:f.NbWords]
+	f.Reduce(t, q, x)
+
+	f.MOVD("res+0(FP)", ptr)
+	f.Store(t, ptr)
+
+	f.RET()
+}
+
+// addHigh sets t[1:] = t[1:] + high(a * b) where high(a * b) holds the high words of the
+// products a[j] * b. If first is set, t[N] is considered empty.
+func (f *FFArm64) addHigh(a []arm64.Register, b, hi arm64.Register, t []arm64.Register, first bool) {
+	n := len(a)
+	for j := 0; j < n; j++ {
+		f.UMULH(a[j], b, hi)
+		switch {
+		case j == n-1 && first:
+			f.ADC(hi, ZR, t[n])
+		case j == n-1:
+			f.ADC(hi, t[n], t[n])
+		default:
+			f.adcs(j, hi, t[j+1])
+		}
+	}
+}
+
+// adcs sets t = t + a, with carry if j > 0, and sets the carry flag
+func (f *FFArm64) adcs(j int, a, t arm64.Register) {
+	if j == 0 {
+		f.ADDS(a, t, t)
+	} else {
+		f.ADCS(a, t, t)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+// generateReduce generates reduce(res *Element), setting res = res - q if res ⩾ q
+func (f *FFArm64) generateReduce() {
+	registers := f.FnHeader("reduce", 0, 8)
+
+	// registers
+	r := registers.Pop()
+	t := registers.PopN(f.NbWords)
+	q := registers.PopN(f.NbWords)
+	scratch := registers.PopN(f.NbWords)
+
+	f.LoadModulus(q)
+	f.MOVD("res+0(FP)", r)
+	f.Load(r, t)
+	f.Reduce(t, q, scratch)
+	f.Store(t, r)
+
+	f.RET()
+}
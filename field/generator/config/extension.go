@@ -1,6 +1,9 @@
 package config
 
-import "math/big"
+import (
+	"fmt"
+	"math/big"
+)
 
 type Element []big.Int
 
@@ -22,6 +25,78 @@ func NewTower(base *FieldConfig, degree uint8, rootOf int64) Extension {
 	return ret
 }
 
+// FindRootOf returns the α ∈ {-1, 2, -2, 3, -3, ...} of smallest absolute value such that Xⁿ - α is
+// irreducible over Fp
+func FindRootOf(base *FieldConfig, degree uint8) (int64, error) {
+	if degree < 2 {
+		return 0, fmt.Errorf("degree %d is too small", degree)
+	}
+	var r big.Int
+	for _, d := range primeFactors(degree) {
+		if r.Sub(base.ModulusBig, big.NewInt(1)).Mod(&r, big.NewInt(int64(d))).Sign() != 0 {
+			return 0, fmt.Errorf("%d does not divide p-1, every element is a %d-th power", d, d)
+		}
+	}
+	if degree%4 == 0 && r.Mod(base.ModulusBig, big.NewInt(4)).Uint64() != 1 {
+		return 0, fmt.Errorf("4 divides %d but p ≢ 1 (mod 4), X^%d - α is never irreducible", degree, degree)
+	}
+	for a := int64(1); a < 1<<16; a++ {
+		for _, rootOf := range []int64{-a, a + 1} {
+			if IsIrreducible(base, degree, rootOf) {
+				return rootOf, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no small α such that X^%d - α is irreducible", degree)
+}
+
+// IsIrreducible returns true if Xⁿ - α is irreducible over Fp; that is (Lidl & Niederreiter, Theorem 3.75),
+// if for every prime d dividing n, d divides p-1 and α is not a d-th power, and p ≡ 1 (mod 4) when 4 divides n.
+func IsIrreducible(base *FieldConfig, degree uint8, rootOf int64) bool {
+	if degree < 2 {
+		return false
+	}
+	var pMinusOne, alpha big.Int
+	pMinusOne.Sub(base.ModulusBig, big.NewInt(1))
+	alpha.SetInt64(rootOf).Mod(&alpha, base.ModulusBig)
+	if alpha.Sign() == 0 {
+		return false
+	}
+	if degree%4 == 0 && new(big.Int).Mod(base.ModulusBig, big.NewInt(4)).Uint64() != 1 {
+		return false
+	}
+	for _, d := range primeFactors(degree) {
+		var e, r, t big.Int
+		e.DivMod(&pMinusOne, big.NewInt(int64(d)), &r)
+		if r.Sign() != 0 {
+			return false
+		}
+		if t.Exp(&alpha, &e, base.ModulusBig).Cmp(big.NewInt(1)) == 0 {
+			// α is a d-th power
+			return false
+		}
+	}
+	return true
+}
+
+// primeFactors returns the distinct prime factors of n
+func primeFactors(n uint8) []uint8 {
+	var factors []uint8
+	m := int(n)
+	for d := 2; d*d <= m; d++ {
+		if m%d == 0 {
+			factors = append(factors, uint8(d))
+			for m%d == 0 {
+				m /= d
+			}
+		}
+	}
+	if m > 1 {
+		factors = append(factors, uint8(m))
+	}
+	return factors
+}
+
 func (f *Extension) FromInt64(i ...int64) Element {
 	z := make(Element, f.Degree)
 	for n := 0; n < len(i) && n < int(f.Degree); n++ {
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// FFTConfig is the set of parameters needed to generate the fft package of a field
+type FFTConfig struct {
+	GeneratorFullMultiplicativeGroup uint64 // generator of 𝔽ᵣ* (or of a subgroup not contained in the 2-adic subgroup), default coset shift
+	GeneratorMaxTwoAdicSubgroup      string // generator of the largest 2-adic subgroup of 𝔽ᵣ*, base 10
+	LogTwoOrderMaxTwoAdicSubgroup    uint64 // log₂ of the order of the largest 2-adic subgroup of 𝔽ᵣ*
+}
+
+// NewFFTConfig returns the fft parameters of the field F.
+//
+// If generator is 0, it defaults to the smallest quadratic non-residue g ⩾ 2 which is not in the
+// 2-adic subgroup. If twoAdicGenerator is empty, it defaults to g^((q-1)/2ˢ), where 2ˢ is the order of
+// the largest 2-adic subgroup of 𝔽ᵣ*; otherwise its order is checked to be 2ˢ.
+func NewFFTConfig(F *FieldConfig, generator uint64, twoAdicGenerator string) (*FFTConfig, error) {
+	q := F.ModulusBig
+	var qMinusOne big.Int
+	qMinusOne.Sub(q, big.NewInt(1))
+	s := qMinusOne.TrailingZeroBits()
+	if s == 0 {
+		return nil, errors.New("fft: the modulus must be an odd prime")
+	}
+
+	var oddPart big.Int
+	oddPart.Rsh(&qMinusOne, s)
+
+	if generator == 0 {
+		// smallest quadratic non-residue g such that g^(2ˢ) ≠ 1, so that the cosets g·H are disjoint from
+		// the 2-adic subgroups H
+		var g, twoS big.Int
+		twoS.Lsh(big.NewInt(1), s)
+		for generator = 2; ; generator++ {
+			if q.IsUint64() && generator >= q.Uint64() {
+				return nil, errors.New("fft: no quadratic non-residue found")
+			}
+			g.SetUint64(generator)
+			if big.Jacobi(&g, q) == -1 && g.Exp(&g, &twoS, q).Cmp(big.NewInt(1)) != 0 {
+				break
+			}
+		}
+	}
+
+	var g big.Int
+	g.SetUint64(generator).Mod(&g, q)
+	if g.Sign() == 0 {
+		return nil, errors.New("fft: the multiplicative generator must be non zero")
+	}
+
+	var root big.Int
+	if twoAdicGenerator == "" {
+		if big.Jacobi(&g, q) != -1 {
+			return nil, fmt.Errorf("fft: %d is a quadratic residue, a 2-adic generator must be provided", generator)
+		}
+		root.Exp(&g, &oddPart, q)
+	} else {
+		if _, ok := root.SetString(twoAdicGenerator, 0); !ok {
+			return nil, fmt.Errorf("fft: unable to parse 2-adic generator %q", twoAdicGenerator)
+		}
+		root.Mod(&root, q)
+		if !hasOrderPowerOfTwo(&root, s, q) {
+			return nil, fmt.Errorf("fft: %s is not a generator of the 2-adic subgroup of order 2^%d", twoAdicGenerator, s)
+		}
+	}
+
+	// the domains have at most 2⁶³ elements (cardinality is an uint64)
+	for ; s > 63; s-- {
+		root.Mul(&root, &root).Mod(&root, q)
+	}
+
+	return &FFTConfig{
+		GeneratorFullMultiplicativeGroup: generator,
+		GeneratorMaxTwoAdicSubgroup:      root.String(),
+		LogTwoOrderMaxTwoAdicSubgroup:    uint64(s),
+	}, nil
+}
+
+// hasOrderPowerOfTwo returns true if x has order exactly 2ˢ in 𝔽q*
+func hasOrderPowerOfTwo(x *big.Int, s uint, q *big.Int) bool {
+	var y big.Int
+	y.Set(x)
+	for i := uint(0); i < s-1; i++ {
+		y.Mul(&y, &y).Mod(&y, q)
+	}
+	// y = x^(2ˢ⁻¹) must be -1
+	y.Add(&y, big.NewInt(1))
+	return y.Cmp(q) == 0
+}
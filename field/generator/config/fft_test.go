@@ -0,0 +1,88 @@
+package config
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNewFFTConfig(t *testing.T) {
+	const (
+		bn254Fr   = "21888242871839275222246405745257275088548364400416034343698204186575808495617"
+		bn254Root = "19103219067921713944291392827692070036145651957329286315305642004821462161904"
+	)
+	F, err := NewFieldConfig("fr", "Element", bn254Fr, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// explicit generators
+	conf, err := NewFFTConfig(F, 5, bn254Root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conf.GeneratorMaxTwoAdicSubgroup != bn254Root || conf.LogTwoOrderMaxTwoAdicSubgroup != 28 || conf.GeneratorFullMultiplicativeGroup != 5 {
+		t.Fatal("unexpected fft config")
+	}
+
+	// default generators
+	conf, err = NewFFTConfig(F, 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var root big.Int
+	root.SetString(conf.GeneratorMaxTwoAdicSubgroup, 10)
+	if conf.LogTwoOrderMaxTwoAdicSubgroup != 28 || !hasOrderPowerOfTwo(&root, 28, F.ModulusBig) {
+		t.Fatal("default root of unity should generate the largest 2-adic subgroup")
+	}
+	var g big.Int
+	g.SetUint64(conf.GeneratorFullMultiplicativeGroup)
+	if big.Jacobi(&g, F.ModulusBig) != -1 {
+		t.Fatal("default generator should be a quadratic non-residue")
+	}
+
+	// a root of unity of smaller order must be rejected
+	root.Mul(&root, &root).Mod(&root, F.ModulusBig)
+	if _, err = NewFFTConfig(F, 0, root.String()); err == nil {
+		t.Fatal("a root of unity of order 2²⁷ should be rejected")
+	}
+}
+
+func TestFindRootOf(t *testing.T) {
+	// bn254 fp: p = 3 mod 4, p = 1 mod 3
+	F, err := NewFieldConfig("fp", "Element", "21888242871839275222246405745257275088696311157297823662689037894645226208583", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rootOf, err := FindRootOf(F, 2); err != nil || rootOf != -1 {
+		t.Fatal("X² + 1 should be irreducible over bn254 fp")
+	}
+	rootOf, err := FindRootOf(F, 3)
+	if err != nil || !IsIrreducible(F, 3, rootOf) {
+		t.Fatal("expected a cubic non-residue")
+	}
+
+	if _, err := FindRootOf(F, 4); err == nil {
+		t.Fatal("X⁴ - α can't be irreducible when p ≡ 3 (mod 4)")
+	}
+
+	// bn254 fr: r = 1 mod 4, r = 1 mod 3, composite degrees
+	F, err = NewFieldConfig("fr", "Element", "21888242871839275222246405745257275088548364400416034343698204186575808495617", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, degree := range []uint8{4, 6, 12} {
+		rootOf, err := FindRootOf(F, degree)
+		if err != nil || !IsIrreducible(F, 2, rootOf) || !IsIrreducible(F, 3, rootOf) {
+			t.Fatalf("X^%d - α irreducible requires α to be neither a square nor a cube", degree)
+		}
+	}
+
+	// 2⁶⁴ - 59 = 2 mod 3: every element is a cube
+	F, err = NewFieldConfig("fp", "Element", "18446744073709551557", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FindRootOf(F, 3); err == nil {
+		t.Fatal("X³ - α can't be irreducible when 3 ∤ p-1")
+	}
+}
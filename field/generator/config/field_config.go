@@ -48,26 +48,33 @@ type FieldConfig struct {
 	P20InversionNbIterations  int
 	UsingP20Inverse           bool
 	IsMSWSaturated            bool // indicates if the most significant word is 0xFFFFF...FFFF
+	Goldilocks                bool // q = 2⁶⁴ - 2³² + 1: elements are in regular (non-Montgomery) form and use the native reduction
 	Q                         []uint64
 	QInverse                  []uint64
 	QMinusOneHalvedP          []uint64 // ((q-1) / 2 ) + 1
 	ASM                       bool
+	ASMVector                 bool // indicates if the Vector arithmetic has assembly kernels (4-word moduli)
+	ASMArm64                  bool // indicates if arm64 assembly is generated (up to 6-word moduli)
 	RSquare                   []uint64
 	One, Thirteen             []uint64
 	LegendreExponent          string // big.Int to base16 string
+	InverseExponent           string // big.Int to base16 string
 	NoCarry                   bool
 	NoCarrySquare             bool // used if NoCarry is set, but some op may overflow in square optimization
+	LargeModulus              bool // indicates if the multiplication is loop-based (Karatsuba and word-by-word reduction) instead of unrolled
 	SqrtQ3Mod4                bool
 	SqrtAtkin                 bool
 	SqrtTonelliShanks         bool
 	SqrtE                     uint64
 	SqrtS                     []uint64
 	SqrtAtkinExponent         string   // big.Int to base16 string
+	SqrtAtkinSqrtMinusOne     []uint64 // √-1 (montgomery form), if SqrtAtkin
 	SqrtSMinusOneOver2        string   // big.Int to base16 string
 	SqrtQ3Mod4Exponent        string   // big.Int to base16 string
 	SqrtG                     []uint64 // NonResidue ^  SqrtR (montgomery form)
 	NonResidue                big.Int  // (montgomery form)
 	LegendreExponentData      *addchain.AddChainData
+	InverseExponentData       *addchain.AddChainData
 	SqrtAtkinExponentData     *addchain.AddChainData
 	SqrtSMinusOneOver2Data    *addchain.AddChainData
 	SqrtQ3Mod4ExponentData    *addchain.AddChainData
@@ -103,6 +110,7 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	// set q from big int repr
 	F.Q = toUint64Slice(&bModulus)
 	F.IsMSWSaturated = F.Q[len(F.Q)-1] == math.MaxUint64
+	F.Goldilocks = F.NbWords == 1 && F.Q[0] == 0xFFFFFFFF00000001
 	_qHalved := big.NewInt(0)
 	bOne := new(big.Int).SetUint64(1)
 	_qHalved.Sub(&bModulus, bOne).Rsh(_qHalved, 1).Add(_qHalved, bOne)
@@ -139,19 +147,19 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 
 	// rsquare
 	_rSquare := big.NewInt(2)
-	exponent := big.NewInt(int64(F.NbWords) * 64 * 2)
+	exponent := big.NewInt(int64(F.rBits()) * 2)
 	_rSquare.Exp(_rSquare, exponent, &bModulus)
 	F.RSquare = toUint64Slice(_rSquare, F.NbWords)
 
 	var one big.Int
 	one.SetUint64(1)
-	one.Lsh(&one, uint(F.NbWords)*64).Mod(&one, &bModulus)
+	one.Lsh(&one, F.rBits()).Mod(&one, &bModulus)
 	F.One = toUint64Slice(&one, F.NbWords)
 
 	{
 		var n big.Int
 		n.SetUint64(13)
-		n.Lsh(&n, uint(F.NbWords)*64).Mod(&n, &bModulus)
+		n.Lsh(&n, F.rBits()).Mod(&n, &bModulus)
 		F.Thirteen = toUint64Slice(&n, F.NbWords)
 	}
 
@@ -173,6 +181,10 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	const BSquare = ^uint64(0) >> 2
 	F.NoCarrySquare = F.Q[len(F.Q)-1] <= BSquare
 
+	// beyond 12 words, unrolled CIOS multiplication bloats the generated code and stops paying off;
+	// large moduli (e.g. RSA or class groups) use loops and Karatsuba instead
+	F.LargeModulus = F.NbWords > 12
+
 	// Legendre exponent (p-1)/2
 	var legendreExponent big.Int
 	legendreExponent.SetUint64(1)
@@ -183,6 +195,15 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 		F.LegendreExponentData = addchain.GetAddChain(&legendreExponent)
 	}
 
+	// Inverse exponent p-2 (Fermat's little theorem), used by the constant-time inverse
+	var inverseExponent big.Int
+	inverseExponent.SetUint64(2)
+	inverseExponent.Sub(&bModulus, &inverseExponent)
+	F.InverseExponent = inverseExponent.Text(16)
+	if F.UseAddChain {
+		F.InverseExponentData = addchain.GetAddChain(&inverseExponent)
+	}
+
 	// Sqrt pre computes
 	var qMod big.Int
 	qMod.SetUint64(4)
@@ -211,6 +232,14 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 			F.SqrtAtkin = true
 			e := new(big.Int).Rsh(&bModulus, 3) // e = (q - 5) / 8
 			F.SqrtAtkinExponent = e.Text(16)
+
+			// √-1 = 2^((q-1)/4), as 2 is not a square mod q (used by the constant-time square root)
+			var sqrtMinusOne big.Int
+			sqrtMinusOne.Sub(&bModulus, big.NewInt(1)).Rsh(&sqrtMinusOne, 2)
+			sqrtMinusOne.Exp(big.NewInt(2), &sqrtMinusOne, &bModulus)
+			// store √-1 in montgomery form
+			sqrtMinusOne.Lsh(&sqrtMinusOne, F.rBits()).Mod(&sqrtMinusOne, &bModulus)
+			F.SqrtAtkinSqrtMinusOne = toUint64Slice(&sqrtMinusOne, F.NbWords)
 			if F.UseAddChain {
 				F.SqrtAtkinExponentData = addchain.GetAddChain(e)
 			}
@@ -240,7 +269,7 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 			var g big.Int
 			g.Exp(&nonResidue, &s, &bModulus)
 			// store g in montgomery form
-			g.Lsh(&g, uint(F.NbWords)*64).Mod(&g, &bModulus)
+			g.Lsh(&g, F.rBits()).Mod(&g, &bModulus)
 			F.SqrtG = toUint64Slice(&g, F.NbWords)
 
 			// store non residue in montgomery form
@@ -261,6 +290,14 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	// asm code generation for moduli with more than 6 words can be optimized further
 	F.ASM = F.NoCarry && F.NbWords <= 12 && F.NbWords > 1
 
+	// vector kernels are generated for 4-word moduli only; the AVX-512 IFMA multiplication
+	// works with 5 limbs of 52 bits and relies on q < 2²⁵⁵ (F.NoCarry)
+	F.ASMVector = F.ASM && F.NbWords == 4
+
+	// arm64 kernels keep the operands, the modulus and the accumulator in registers,
+	// which limits them to 6-word moduli
+	F.ASMArm64 = F.ASM && F.NbWords <= 6
+
 	return F, nil
 }
 
@@ -324,7 +361,7 @@ func (f *FieldConfig) StringToMont(str string) big.Int {
 
 func (f *FieldConfig) ToMont(nonMont big.Int) big.Int {
 	var mont big.Int
-	mont.Lsh(&nonMont, uint(f.NbWords)*64)
+	mont.Lsh(&nonMont, f.rBits())
 	mont.Mod(&mont, f.ModulusBig)
 	return mont
 }
@@ -335,14 +372,22 @@ func (f *FieldConfig) FromMont(nonMont *big.Int, mont *big.Int) *FieldConfig {
 		nonMont.SetInt64(0)
 		return f
 	}
-	f.halve(nonMont, mont)
-	for i := 1; i < f.NbWords*64; i++ {
+	nonMont.Set(mont)
+	for i := uint(0); i < f.rBits(); i++ {
 		f.halve(nonMont, nonMont)
 	}
 
 	return f
 }
 
+// rBits returns log₂(R), R being the Montgomery constant, or 0 if the elements are in regular form
+func (f *FieldConfig) rBits() uint {
+	if f.Goldilocks {
+		return 0
+	}
+	return uint(f.NbWords) * 64
+}
+
 func (f *FieldConfig) Exp(res *big.Int, x *big.Int, pow *big.Int) *FieldConfig {
 	res.SetInt64(1)
 
@@ -399,6 +444,8 @@ func CoordNameForExtensionDegree(degree uint8) string {
 		return ""
 	case 2:
 		return "A"
+	case 4:
+		return "B"
 	case 6:
 		return "B"
 	case 12:
@@ -407,11 +454,38 @@ func CoordNameForExtensionDegree(degree uint8) string {
 	panic(fmt.Sprint("unknown extension degree", degree))
 }
 
+// CoordPathsForExtensionDegree returns the paths from an extension field element to its
+// coordinates over the base field, in the order of the tower (e.g. B1.A0 for 𝔽p → 𝔽p² → 𝔽p⁴)
+func CoordPathsForExtensionDegree(degree uint8) []string {
+	switch degree {
+	case 1:
+		return nil
+	case 2:
+		return []string{"A0", "A1"}
+	case 4:
+		return []string{"B0.A0", "B0.A1", "B1.A0", "B1.A1"}
+	}
+	panic(fmt.Sprint("unsupported extension degree", degree))
+}
+
 func (f *FieldConfig) WriteElement(element Element) string {
 	var builder strings.Builder
 
 	builder.WriteString("{")
 	length := len(element)
+	if length == 4 {
+		// quartic extensions are towers 𝔽p → 𝔽p² → 𝔽p⁴
+		builder.WriteString("\n")
+		for i := 0; i < 2; i++ {
+			builder.WriteString("B")
+			builder.WriteString(strconv.Itoa(i))
+			builder.WriteString(": fptower.E2")
+			builder.WriteString(f.WriteElement(element[2*i : 2*i+2]))
+			builder.WriteString(",\n")
+		}
+		builder.WriteString("}")
+		return builder.String()
+	}
 	var subElementNames string
 	if length > 1 {
 		builder.WriteString("\n")
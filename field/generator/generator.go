@@ -11,6 +11,7 @@ import (
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/field/generator/asm/amd64"
+	"github.com/consensys/gnark-crypto/field/generator/asm/arm64"
 	"github.com/consensys/gnark-crypto/field/generator/config"
 	"github.com/consensys/gnark-crypto/field/generator/internal/addchain"
 	"github.com/consensys/gnark-crypto/field/generator/internal/templates/element"
@@ -32,9 +33,11 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		element.MulDoc,
 		element.MulCIOS,
 		element.MulNoCarry,
+		element.MulLarge,
 		element.Sqrt,
 		element.Inverse,
 		element.BigNum,
+		element.Accumulator,
 	}
 
 	// test file templates
@@ -61,6 +64,8 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		"_mul_amd64.s",
 		"_mul_arm64.s",
 		"_mul_arm64.go",
+		"_ops_arm64.s",
+		"_ops_arm64.go",
 		"_ops_amd64.s",
 		"_ops_noasm.go",
 		"_mul_adx_amd64.s",
@@ -73,6 +78,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 	}
 	_ = os.Remove(filepath.Join(outputDir, "asm.go"))
 	_ = os.Remove(filepath.Join(outputDir, "asm_noadx.go"))
+	_ = os.Remove(filepath.Join(outputDir, "vector_amd64.go"))
 
 	funcs := template.FuncMap{}
 	if F.UseAddChain {
@@ -181,6 +187,32 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 
 	}
 
+	if F.ASMArm64 {
+		// generate ops_arm64.s
+		pathSrc := filepath.Join(outputDir, eName+"_ops_arm64.s")
+		fmt.Println("generating", pathSrc)
+		f, err := os.Create(pathSrc)
+		if err != nil {
+			return err
+		}
+
+		_, _ = io.WriteString(f, "// +build !purego\n")
+
+		if err := arm64.Generate(f, F); err != nil {
+			_ = f.Close()
+			return err
+		}
+		_ = f.Close()
+
+		// run asmfmt
+		cmd := exec.Command("asmfmt", "-w", pathSrc)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return err
+		}
+	}
+
 	if F.ASM {
 		// generate ops_amd64.go
 		src := []string{
@@ -198,9 +230,26 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		}
 	}
 
+	if F.ASMArm64 {
+		// generate ops_arm64.go
+		src := []string{
+			element.MulDoc,
+			element.MulByConstants,
+			element.OpsARM64,
+		}
+		pathSrc := filepath.Join(outputDir, eName+"_ops_arm64.go")
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!purego"))
+		if err := bavard.GenerateFromString(pathSrc, src, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	}
+
 	{
 		// generate ops.go
 		src := []string{
+			element.MulByConstants,
 			element.OpsNoAsm,
 			element.MulCIOS,
 			element.MulNoCarry,
@@ -210,7 +259,9 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		pathSrc := filepath.Join(outputDir, eName+"_ops_purego.go")
 		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
 		copy(bavardOptsCpy, bavardOpts)
-		if F.ASM {
+		if F.ASMArm64 {
+			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!amd64,!arm64 purego"))
+		} else if F.ASM {
 			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!amd64 purego"))
 		}
 		if err := bavard.GenerateFromString(pathSrc, src, F, bavardOptsCpy...); err != nil {
@@ -218,6 +269,30 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		}
 	}
 
+	if F.ASMVector {
+		// generate vector_amd64.go
+		pathSrc := filepath.Join(outputDir, "vector_amd64.go")
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!purego"))
+		if err := bavard.GenerateFromString(pathSrc, []string{element.VectorOpsAmd64}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	}
+
+	{
+		// generate vector_purego.go
+		pathSrc := filepath.Join(outputDir, "vector_purego.go")
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		if F.ASMVector {
+			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!amd64 purego"))
+		}
+		if err := bavard.GenerateFromString(pathSrc, []string{element.VectorOpsPureGo}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	}
+
 	{
 		// generate doc.go
 		src := []string{
@@ -0,0 +1,123 @@
+package element
+
+// Accumulator sums products of field elements on double-width words and reduces
+// the sum once (lazy reduction); it is used for inner products and polynomial evaluation.
+const Accumulator = `
+
+// UnreducedAccumulator accumulates sums of products of field elements on {{add (mul 2 .NbWords) 1}} words,
+// without reducing them modulo q: MulAcc costs a bare multiplication, and the modular
+// reduction is done once, by Reduce.
+//
+// The zero value is an empty accumulator; it can hold up to 2⁶³ products.
+type UnreducedAccumulator struct {
+	t [{{add (mul 2 .NbWords) 1}}]uint64
+}
+
+// MulAcc sets acc = acc + x * y, without modular reduction
+func (acc *UnreducedAccumulator) MulAcc(x, y *{{.ElementName}}) {
+	var t [{{mul 2 .NbWords}}]uint64
+	{{- if .LargeModulus}}
+	var scratch [{{mul 8 .NbWords}}]uint64
+	mulWords(t[:], x[:], y[:], scratch[:])
+	{{- else}}
+
+	// t = x * y (schoolbook)
+	var C uint64
+	{{- range $i := .NbWordsIndexesFull}}
+	{{- range $j := $.NbWordsIndexesFull}}
+		{{- if eq $i 0}}
+			{{- if eq $j 0}}
+	C, t[0] = bits.Mul64(x[0], y[0])
+			{{- else}}
+	C, t[{{$j}}] = madd1(x[{{$j}}], y[0], C)
+			{{- end}}
+		{{- else}}
+			{{- if eq $j 0}}
+	C, t[{{$i}}] = madd1(x[0], y[{{$i}}], t[{{$i}}])
+			{{- else}}
+	C, t[{{add $i $j}}] = madd2(x[{{$j}}], y[{{$i}}], t[{{add $i $j}}], C)
+			{{- end}}
+		{{- end}}
+	{{- end}}
+	t[{{add $i $.NbWords}}] = C
+	{{- end}}
+	{{- end}}
+
+	// acc = acc + t
+	var carry uint64
+	{{- range $i := iterate 0 (mul 2 .NbWords)}}
+	acc.t[{{$i}}], carry = bits.Add64(acc.t[{{$i}}], t[{{$i}}], carry)
+	{{- end}}
+	acc.t[{{mul 2 .NbWords}}] += carry
+}
+
+// Reduce sets z = acc (mod q) and returns z; acc is left unchanged
+func (acc *UnreducedAccumulator) Reduce(z *{{.ElementName}}) *{{.ElementName}} {
+	{{- if .Goldilocks}}
+	// elements are in regular form, acc holds Σ xᵢyᵢ
+	r := bits.Rem64(acc.t[2], acc.t[1], q0)
+	z[0] = bits.Rem64(r, acc.t[0], q0)
+	return z
+	{{- else}}
+	// with xᵢ = aᵢR and yᵢ = bᵢR in Montgomery form, acc holds T = Σ xᵢyᵢ = R²·Σ aᵢbᵢ
+	// and we need z = T·R⁻¹ (mod q). T may exceed q·R, so we reduce it twice,
+	// to T·R⁻¹ < q·R and then to T·R⁻² < 2q, and multiply the result by R² (mod q).
+	t := acc.t
+	montgomeryReduceWide(&t)
+
+	var u [{{add (mul 2 .NbWords) 1}}]uint64
+	copy(u[:], t[{{.NbWords}}:])
+	montgomeryReduceWide(&u)
+
+	copy(z[:], u[{{.NbWords}}:{{mul 2 .NbWords}}])
+	if u[{{mul 2 .NbWords}}] != 0 || !z.smallerThanModulus() {
+		var b uint64
+		for i := 0; i < {{.NbWords}}; i++ {
+			z[i], b = bits.Sub64(z[i], q{{.ElementName}}[i], b)
+		}
+	}
+	return z.Mul(z, &rSquare)
+	{{- end}}
+}
+
+// Reset empties the accumulator
+func (acc *UnreducedAccumulator) Reset() {
+	acc.t = [{{add (mul 2 .NbWords) 1}}]uint64{}
+}
+
+{{- if not .Goldilocks}}
+
+// montgomeryReduceWide sets t[{{.NbWords}}:] = t * R⁻¹ (mod q) on {{add .NbWords 1}} words, with a
+// word-by-word Montgomery reduction; the result is less than t / R + q.
+func montgomeryReduceWide(t *[{{add (mul 2 .NbWords) 1}}]uint64) {
+	{{- if .LargeModulus}}
+	for i := 0; i < {{.NbWords}}; i++ {
+		// add m * q * Wⁱ to t such that t[i] becomes 0
+		m := t[i] * qInvNeg
+		C := madd0(m, q{{.ElementName}}[0], t[i])
+		for j := 1; j < {{.NbWords}}; j++ {
+			C, t[i+j] = madd2(m, q{{.ElementName}}[j], t[i+j], C)
+		}
+		for k := i + {{.NbWords}}; C != 0 && k < len(t); k++ {
+			t[k], C = bits.Add64(t[k], C, 0)
+		}
+	}
+	{{- else}}
+	// the carry out of t[i+{{.NbWords}}] is added at the next row
+	var m, C, c uint64
+	{{- range $i := .NbWordsIndexesFull}}
+
+	// t[{{$i}}] becomes 0
+	m = t[{{$i}}] * qInvNeg
+	C = madd0(m, q{{$.ElementName}}[0], t[{{$i}}])
+	{{- range $j := $.NbWordsIndexesNoZero}}
+	C, t[{{add $i $j}}] = madd2(m, q{{$.ElementName}}[{{$j}}], t[{{add $i $j}}], C)
+	{{- end}}
+	t[{{add $i $.NbWords}}], c = bits.Add64(t[{{add $i $.NbWords}}], C, {{if eq $i 0}}0{{else}}c{{end}})
+	{{- end}}
+	t[{{mul 2 .NbWords}}] += c
+	{{- end}}
+}
+{{- end}}
+
+`
@@ -7,6 +7,10 @@ import 	"golang.org/x/sys/cpu"
 var (
 	supportAdx = cpu.X86.HasADX && cpu.X86.HasBMI2
 	_ = supportAdx
+	{{- if .ASMVector}}
+	supportAvx512 = supportAdx && cpu.X86.HasAVX512F && cpu.X86.HasAVX512DQ && cpu.X86.HasAVX512IFMA
+	_ = supportAvx512
+	{{- end}}
 )
 `
 
@@ -19,5 +23,9 @@ const AsmNoAdx = `
 var (
 	supportAdx = false
 	_ = supportAdx
+	{{- if .ASMVector}}
+	supportAvx512 = false
+	_ = supportAvx512
+	{{- end}}
 )
 `
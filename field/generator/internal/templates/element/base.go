@@ -20,7 +20,12 @@ import (
 
 // {{.ElementName}} represents a field element stored on {{.NbWords}} words (uint64)
 //
+{{- if .Goldilocks}}
+// {{.ElementName}} are stored in regular (non-Montgomery) form; multiplications use the
+// native reduction modulo q = 2⁶⁴ - 2³² + 1 (2⁶⁴ = 2³² - 1 mod q and 2⁹⁶ = -1 mod q).
+{{- else}}
 // {{.ElementName}} are assumed to be in Montgomery form in all methods.
+{{- end}}
 //
 // Modulus q =
 //
@@ -64,9 +69,11 @@ func Modulus() *big.Int {
 	return new(big.Int).Set(&_modulus)
 }
 
+{{- if not .Goldilocks}}
 // q + r'.r = 1, i.e., qInvNeg = - q⁻¹ mod r
 // used for Montgomery reduction
 const qInvNeg uint64 = {{index .QInverse 0}}
+{{- end}}
 
 func init() {
 	_modulus.SetString("{{.ModulusHex}}", 16)
@@ -79,15 +86,27 @@ func init() {
 // 		v.SetUint64(...)
 func New{{.ElementName}}(v uint64) {{.ElementName}} {
 	z := {{.ElementName}}{v}
+	{{- if .Goldilocks}}
+	reduce(&z)
+	{{- else}}
 	z.Mul(&z, &rSquare)
+	{{- end}}
 	return z
 }
 
 // SetUint64 sets z to v and returns z
 func (z *{{.ElementName}}) SetUint64(v uint64) *{{.ElementName}} {
+	{{- if .Goldilocks}}
+	//  sets z LSB to v and reduces it mod q (elements are stored in regular form)
+	{{- else}}
 	//  sets z LSB to v (non-Montgomery form) and convert z to Montgomery form
+	{{- end}}
 	*z = {{.ElementName}}{v}
+	{{- if .Goldilocks}}
+	return z.toMont()
+	{{- else}}
 	return z.Mul(z, &rSquare) // z.toMont()
+	{{- end}}
 }
 
 // SetInt64 sets z to v and returns z
@@ -181,7 +200,7 @@ func (z *{{.ElementName}}) SetZero() *{{.ElementName}} {
 	return z
 }
 
-// SetOne z = 1 (in Montgomery form)
+// SetOne z = 1{{- if not .Goldilocks}} (in Montgomery form){{- end}}
 func (z *{{.ElementName}}) SetOne() *{{.ElementName}} {
 	{{- range $i := .NbWordsIndexesFull}}
 		z[{{$i}}] = {{index $.One $i}}
@@ -239,8 +258,10 @@ func (z *{{.ElementName}}) Uint64() uint64 {
 }
 
 // FitsOnOneWord reports whether z words (except the least significant word) are 0
+{{- if not .Goldilocks}}
 //
 // It is the responsibility of the caller to convert from Montgomery to Regular form if needed.
+{{- end}}
 func (z *{{.ElementName}}) FitsOnOneWord() bool {
 	{{- if eq .NbWords 1}}
 		return true
@@ -291,6 +312,15 @@ func (z *{{.ElementName}}) LexicographicallyLargest() bool {
 // This might error only if reading from crypto/rand.Reader errors,
 // in which case, value of z is undefined.
 func (z *{{.ElementName}}) SetRandom() (*{{.ElementName}}, error) {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom sets z to a uniform random value in [0, q), reading the randomness from r.
+// With a deterministic (seeded) reader, the sequence of values is reproducible.
+//
+// This might error only if reading from r errors,
+// in which case, value of z is undefined.
+func (z *{{.ElementName}}) SetRandomFrom(r io.Reader) (*{{.ElementName}}, error) {
 	// this code is generated for all modulus
 	// and derived from go/src/crypto/rand/util.go
 
@@ -313,7 +343,7 @@ func (z *{{.ElementName}}) SetRandom() (*{{.ElementName}}, error) {
 
 	for {
 		// note that bytes[k:l] is always 0
-		if _, err := io.ReadFull(rand.Reader, bytes[:k]); err != nil {
+		if _, err := io.ReadFull(r, bytes[:k]); err != nil {
 			return nil, err
 		}
 
@@ -383,8 +413,13 @@ func (z *{{.ElementName}}) Halve()  {
 
 
 
+{{- if .Goldilocks}}
+// fromMont returns z unchanged, as elements are stored in regular form (the Montgomery constant is r = 1);
+// it is kept for the code shared with the fields in Montgomery form
+{{- else}}
 // fromMont converts z in place (i.e. mutates) from Montgomery to regular representation
 // sets and returns z = z * 1
+{{- end}}
 func (z *{{.ElementName}}) fromMont() *{{.ElementName}} {
 	fromMont(z)
 	return z
@@ -524,13 +559,70 @@ func (z *{{.ElementName}}) Select(c int, x0 *{{.ElementName}}, x1 *{{.ElementNam
 	return z
 }
 
+// AddCT z = x + y (mod q), without branching on x or y (as Add does);
+// it is meant for secret operands.
+func (z *{{.ElementName}}) AddCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var carry uint64
+	{{- range $i := .NbWordsIndexesFull}}
+		z[{{$i}}], carry = bits.Add64(x[{{$i}}], y[{{$i}}], {{- if eq $i 0}}0{{- else}}carry{{- end}})
+	{{- end}}
+
+	// if x + y ⩾ q → z -= q, without branching on z
+	var s [{{.NbWords}}]uint64
+	var b uint64
+	{{- range $i := .NbWordsIndexesFull}}
+		s[{{$i}}], b = bits.Sub64(z[{{$i}}], q{{$i}}, {{- if eq $i 0}}0{{- else}}b{{- end}})
+	{{- end}}
+	// mask = 0xFF..FF if the addition overflowed or the subtraction didn't borrow, 0 otherwise
+	mask := -(carry | (b ^ 1))
+	{{- range $i := .NbWordsIndexesFull}}
+		z[{{$i}}] ^= mask & (z[{{$i}}] ^ s[{{$i}}])
+	{{- end}}
+	return z
+}
+
+// SubCT z = x - y (mod q), without branching on x or y (as Sub does);
+// it is meant for secret operands.
+func (z *{{.ElementName}}) SubCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var b uint64
+	{{- range $i := .NbWordsIndexesFull}}
+		z[{{$i}}], b = bits.Sub64(x[{{$i}}], y[{{$i}}], {{- if eq $i 0}}0{{- else}}b{{- end}})
+	{{- end}}
+
+	// if x < y → z += q, without branching on z
+	// mask = 0xFF..FF if the subtraction borrowed, 0 otherwise
+	mask := -b
+	{{- if eq .NbWords 1}}
+		z[0] += q0 & mask
+	{{- else}}
+		var c uint64
+		{{- range $i := .NbWordsIndexesFull}}
+			z[{{$i}}], {{- if eq $i $.NbWordsLastIndex}}_{{- else}}c{{- end}} = bits.Add64(z[{{$i}}], q{{$i}} & mask, {{- if eq $i 0}}0{{- else}}c{{- end}})
+		{{- end}}
+	{{- end}}
+	return z
+}
+
+{{- if .LargeModulus}}
+{{ template "mul_large" . }}
+{{- else if .Goldilocks}}
+// _mulGeneric sets z = x * y (mod q) with a 128-bit remainder;
+// it is used for testing purposes.
+func _mulGeneric(z, x, y *{{.ElementName}}) {
+	hi, lo := bits.Mul64(x[0], y[0])
+	z[0] = bits.Rem64(hi, lo, q)
+}
+
+func _fromMontGeneric(z *{{.ElementName}}) {
+	// elements are stored in regular form
+}
+{{- else}}
 // _mulGeneric is unoptimized textbook CIOS
 // it is a fallback solution on x86 when ADX instruction set is not available
 // and is used for testing purposes.
 func _mulGeneric(z,x,y *{{.ElementName}}) {
 	{{ mul_doc false }}
 	{{ template "mul_cios" dict "all" . "V1" "x" "V2" "y"}}
-	{{ template "reduce"  . }}
 }
 
 
@@ -552,6 +644,7 @@ func _fromMontGeneric(z *{{.ElementName}}) {
 
 	{{ template "reduce" .}}
 }
+{{- end}}
 
 func _reduceGeneric(z *{{.ElementName}})  {
 	{{ template "reduce"  . }}
@@ -608,15 +701,18 @@ func (z *{{.ElementName}}) BitLen() int {
 }
 
 // Hash msg to count prime field elements.
-// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-5.2
-func Hash(msg, dst []byte, count int) ([]{{.ElementName}}, error) {
-	// 128 bits of security
-	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter = 128
-	const Bytes = 1 + (Bits-1)/8
-	const L = 16 + Bytes
+// https://www.rfc-editor.org/rfc/rfc9380#section-5.2
+//
+// By default, expand_message_xmd with SHA-256 is used with a security level of 128 bits;
+// the ciphersuite can be changed with hash.Option (e.g. hash.WithExpandMsgXof).
+func Hash(msg, dst []byte, count int, opts ...hash.Option) ([]{{.ElementName}}, error) {
+	cfg := hash.NewConfig(opts...)
+
+	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter
+	L := (Bits + cfg.SecurityLevel + 7) / 8
 
 	lenInBytes := count * L
-	pseudoRandomBytes, err := hash.ExpandMsgXmd(msg, dst, lenInBytes)
+	pseudoRandomBytes, err := cfg.Expand(msg, dst, lenInBytes)
 	if err != nil {
 		return nil, err
 	}
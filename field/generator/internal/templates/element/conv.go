@@ -2,18 +2,33 @@ package element
 
 const Conv = `
 
+{{- if .Goldilocks}}
+// rSquare = 1, as elements are stored in regular form (the Montgomery constant is r = 1)
+{{- else}}
 // rSquare where r is the Montgommery constant
 // see section 2.3.2 of Tolga Acar's thesis
 // https://www.microsoft.com/en-us/research/wp-content/uploads/1998/06/97Acar.pdf
+{{- end}}
 var rSquare = {{.ElementName}}{
 	{{- range $i := .RSquare}}
 	{{$i}},{{end}}
 }
 
+{{- if .Goldilocks}}
+// toMont reduces z mod q and returns z: elements are stored in regular form (the Montgomery
+// constant is r = 1), so there is no conversion to do
+{{- else}}
 // toMont converts z to Montgomery form
 // sets and returns z = z * r²
+{{- end}}
 func (z *{{.ElementName}}) toMont() *{{.ElementName}} {
+	{{- if .Goldilocks}}
+	// elements are stored in regular form, r = 1
+	reduce(z)
+	return z
+	{{- else}}
 	return z.Mul(z, &rSquare)
+	{{- end}}
 }
 
 // String returns the decimal representation of z as generated by
@@ -22,7 +37,7 @@ func (z *{{.ElementName}}) String() string {
 	return z.Text(10)
 }
 
-// toBigInt returns z as a big.Int in Montgomery form
+// toBigInt returns z as a big.Int in {{if .Goldilocks}}regular{{else}}Montgomery{{end}} form
 func (z *{{.ElementName}}) toBigInt(res *big.Int) *big.Int {
        var b [Bytes]byte
        {{- range $i := reverse .NbWordsIndexesFull}}
@@ -7,7 +7,12 @@ const Doc = `
 // 
 // The modulus is hardcoded in all the operations.
 // 
+{{- if .Goldilocks}}
+// Field elements are represented as an array, in regular (non-Montgomery) form; the multiplication
+// uses the native reduction modulo 2⁶⁴ - 2³² + 1:
+{{- else}}
 // Field elements are represented as an array, and assumed to be in Montgomery form in all methods:
+{{- end}}
 // 	type {{.ElementName}} [{{.NbWords}}]uint64
 //
 // Usage
@@ -34,5 +39,6 @@ const Doc = `
 // Warning
 //
 // This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// AddCT, SubCT, InverseCT and SqrtCT avoid branching on their input and are meant for secret data, but are not audited either.
 package {{.PackageName}}
 `
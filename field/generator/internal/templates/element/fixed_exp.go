@@ -4,13 +4,19 @@ const FixedExp = `
 
 {{- if .SqrtQ3Mod4}}
 	{{expByAddChain "SqrtExp" .SqrtQ3Mod4ExponentData .ElementName}}
+	{{vectorExpByAddChain "SqrtExp" .SqrtQ3Mod4ExponentData .ElementName}}
 {{- else if .SqrtAtkin}}
 	{{expByAddChain "SqrtExp" .SqrtAtkinExponentData .ElementName}}
+	{{vectorExpByAddChain "SqrtExp" .SqrtAtkinExponentData .ElementName}}
 {{- else if .SqrtTonelliShanks}}
 	{{expByAddChain "SqrtExp" .SqrtSMinusOneOver2Data .ElementName}}
+	{{vectorExpByAddChain "SqrtExp" .SqrtSMinusOneOver2Data .ElementName}}
 {{- end }}
 
 {{expByAddChain "LegendreExp" .LegendreExponentData .ElementName}}
+{{vectorExpByAddChain "LegendreExp" .LegendreExponentData .ElementName}}
+
+{{expByAddChain "InverseExp" .InverseExponentData .ElementName}}
 
 
 {{define "expByAddChain name data eName"}}
@@ -62,6 +68,50 @@ func (z *{{.eName}}) expBy{{$.name}}(x {{.eName}}) *{{.eName}} {
 
 {{end}}
 
+{{define "vectorExpByAddChain name data eName"}}
+
+// vectorExpBy{{$.name}}NbTemporaries is the number of temporary vectors used by vectorExpBy{{$.name}}
+const vectorExpBy{{$.name}}NbTemporaries = {{len .data.Program.Temporaries}}
+
+// vectorExpBy{{$.name}} sets z[i] = x[i]^{{ .data.N }} for all i, with the addition chain of expBy{{$.name}};
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpBy{{$.name}}NbTemporaries * len(x).
+func vectorExpBy{{$.name}}(z, x, scratch Vector) {
+	{{- if .data.Program.Temporaries}}
+	n := len(x)
+	var (
+		{{- range $i, $t := .data.Program.Temporaries }}
+		{{ $t }} = scratch[{{$i}}*n : {{$i}}*n+n]
+		{{- end -}}
+	)
+	{{- end}}
+
+	{{ range $i := .data.Program.Instructions }}
+	// {{ printf "Step %d: %s = x^%#x" $i.Output.Index $i.Output (index $.data.Chain $i.Output.Index) }}
+	{{- with add_ $i.Op }}
+	{{ $i.Output }}.Mul({{ .X }}, {{ .Y }})
+	{{ end -}}
+
+	{{- with double_ $i.Op }}
+	{{ $i.Output }}.Mul({{ .X }}, {{ .X }})
+	{{ end -}}
+
+	{{- with shift_ $i.Op -}}
+	{{- $first := 0 -}}
+	{{- if ne $i.Output.Identifier .X.Identifier }}
+	{{ $i.Output }}.Mul({{ .X }}, {{ .X }})
+	{{- $first = 1 -}}
+	{{- end }}
+	for s := {{ $first }}; s < {{ .S }}; s++ {
+		{{ $i.Output }}.Mul({{ $i.Output }}, {{ $i.Output }})
+	}
+	{{ end -}}
+	{{- end }}
+}
+
+{{end}}
+
 
 
 
@@ -418,4 +418,17 @@ func updateFactorsDecompose(c int64) (int64, int64) {
 
 {{ end }}
 
+// InverseCT z = x⁻¹ (mod q) in constant time, computed as x^(q-2) (Fermat's little theorem);
+// unlike Inverse, the sequence of operations doesn't depend on x and it is suitable for secret data.
+//
+// if x == 0, sets and returns z = x
+func (z *{{.ElementName}}) InverseCT(x *{{.ElementName}}) *{{.ElementName}} {
+	{{- if .UseAddChain}}
+	return z.expByInverseExp(*x)
+	{{- else}}
+	return z.Exp(*x, _bInverseExponent{{.ElementName}})
+	{{- end}}
+}
+
+
 `
@@ -10,7 +10,8 @@ package element
 //
 // For 1-word modulus, the generator will call mul_cios_one_limb (standard REDC)
 //
-// For 13-word+ modulus, the generator will output a unoptimized textbook CIOS code, in plain Go.
+// For 13-word+ modulus, the generator will output loop-based code, in plain Go: a Karatsuba product
+// followed by a word-by-word Montgomery reduction (see MulLarge).
 //
 // For all other moduli, we look at the available bits in the last limb.
 // If they are none (like secp256k1) we generate a unoptimized textbook CIOS code, in plain Go, for all architectures.
@@ -70,26 +71,16 @@ const MulCIOS = `
 		 t[{{$.all.NbWords}}], _ = bits.Add64(0, D, C)
 	{{- end}}
 
-
-	if t[{{$.all.NbWords}}] != 0 {
-		// we need to reduce, we have a result on {{add 1 $.all.NbWords}} words
-		{{- if gt $.all.NbWords 1}}
-		var b uint64
-		{{- end}}
-		z[0], {{- if gt $.all.NbWords 1}}b{{- else}}_{{- end}} = bits.Sub64(t[0], q0, 0)
-		{{- range $i := .all.NbWordsIndexesNoZero}}
-			{{-  if eq $i $.all.NbWordsLastIndex}}
-				z[{{$i}}], _ = bits.Sub64(t[{{$i}}], q{{$i}}, b)
-			{{-  else  }}
-				z[{{$i}}], b = bits.Sub64(t[{{$i}}], q{{$i}}, b)
-			{{- end}}
-		{{- end}}
-		return {{if $.ReturnZ }} z{{- end}}
-	}
-
-	// copy t into z 
+	// t < 2q: we subtract q if t[{{$.all.NbWords}}] != 0 or t ⩾ q, without branching on secret data
+	var s [{{$.all.NbWords}}]uint64
+	var b uint64
+	{{- range $i := $.all.NbWordsIndexesFull}}
+		s[{{$i}}], b = bits.Sub64(t[{{$i}}], q{{$i}}, {{- if eq $i 0}}0{{- else}}b{{- end}})
+	{{- end}}
+	// mask = 0xFF..FF if we need to subtract, 0 otherwise
+	mask := -(t[{{$.all.NbWords}}] | (b ^ 1))
 	{{- range $i := $.all.NbWordsIndexesFull}}
-		z[{{$i}}] = t[{{$i}}]
+		z[{{$i}}] = t[{{$i}}] ^ (mask & (t[{{$i}}] ^ s[{{$i}}]))
 	{{- end}}
 
 {{ end }}
@@ -107,20 +98,41 @@ const MulCIOS = `
 	// Which finally gives (lo + m * q) / R = (lo + lo2 + R hi2) / R = hi2 + (lo+lo2) / R = hi2 + (lo != 0)
 	// This "optimization" lets us do away with one MUL instruction on ARM architectures and is available for all q < R.
 
-	var r uint64
+	// The reduction doesn't branch on the operands, so that Mul and Square run in constant time.
+
 	hi, lo := bits.Mul64({{$.V1}}[0], {{$.V2}}[0])
-	if lo != 0 {
-		hi++ // x[0] * y[0] ≤ 2¹²⁸ - 2⁶⁵ + 1, meaning hi ≤ 2⁶⁴ - 2 so no need to worry about overflow
-	}
+	// hi++ if lo != 0
+	hi += (lo | -lo) >> 63 // x[0] * y[0] ≤ 2¹²⁸ - 2⁶⁵ + 1, meaning hi ≤ 2⁶⁴ - 2 so no need to worry about overflow
 	m := lo * qInvNeg
 	hi2, _ := bits.Mul64(m, q)
 	r, carry := bits.Add64(hi2, hi, 0)
 
-	if carry != 0 || r >= q  {
-		// we need to reduce
-		r -= q 
-	}
-	z[0] = r 
+	// we need to reduce if carry != 0 || r >= q
+	s, b := bits.Sub64(r, q, 0)
+	mask := -(carry | (b ^ 1))
+	z[0] = r ^ (mask & (r ^ s))
+{{ end }}
+
+{{ define "mul_goldilocks" }}
+	// Elements are in regular form and q = 2⁶⁴ - 2³² + 1, so that 2⁶⁴ = 2³² - 1 (mod q) and 2⁹⁶ = -1 (mod q).
+	// Writing x * y = (h₁ * 2³² + h₀) * 2⁶⁴ + lo, we get x * y = lo - h₁ + h₀ * (2³² - 1) (mod q).
+	// Multiplications by ε = 2³² - 1 are computed with shifts and masks.
+	hi, lo := bits.Mul64({{$.V1}}[0], {{$.V2}}[0])
+
+	// The corrections below use masks rather than branches, so that Mul and Square run in constant time.
+
+	// t0 = lo - h₁; on borrow we added 2⁶⁴ = ε, which we subtract (lo - h₁ + 2⁶⁴ ⩾ ε)
+	t0, borrow := bits.Sub64(lo, hi>>32, 0)
+	t0 -= 0xffffffff & -borrow
+
+	// t1 = h₀ * ε < 2⁶⁴; on carry we dropped 2⁶⁴ = ε, which we add back (t0 + t1 - 2⁶⁴ < 2⁶⁴ - ε)
+	t1 := (hi << 32) - (hi & 0xffffffff)
+	r, carry := bits.Add64(t0, t1, 0)
+	r += 0xffffffff & -carry
+
+	// if r ⩾ q → r -= q
+	s, b := bits.Sub64(r, q, 0)
+	z[0] = r ^ ((b - 1) & (r ^ s))
 {{ end }}
 `
 
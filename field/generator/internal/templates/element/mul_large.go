@@ -0,0 +1,160 @@
+package element
+
+// MulLarge is used for 13-word+ moduli (F.LargeModulus); unrolling the CIOS algorithm
+// for such moduli produces thousands of lines per function for no speedup.
+//
+// The product x * y is computed on 2 * NbWords words, with Karatsuba above karatsubaThreshold words
+// and with the schoolbook algorithm below; it is then reduced with a word-by-word Montgomery
+// reduction (separated operand scanning, see section 2.3.1 of Tolga Acar's thesis).
+// Neither step branches on the operands, so that Mul and Square run in constant time.
+const MulLarge = `
+{{ define "mul_large" }}
+
+// karatsubaThreshold is the number of words below which mulWords uses the schoolbook multiplication
+const karatsubaThreshold = 16
+
+// _mulGeneric sets z = x * y * R⁻¹ (mod q), using a Karatsuba product
+// and a word-by-word Montgomery reduction
+func _mulGeneric(z, x, y *{{.ElementName}}) {
+	var t [{{mul 2 .NbWords}}]uint64
+	var scratch [{{mul 8 .NbWords}}]uint64
+	mulWords(t[:], x[:], y[:], scratch[:])
+	montgomeryReduce(z, &t)
+}
+
+func _fromMontGeneric(z *{{.ElementName}}) {
+	// z = z * 1 * R⁻¹
+	var t [{{mul 2 .NbWords}}]uint64
+	copy(t[:], z[:])
+	montgomeryReduce(z, &t)
+}
+
+// montgomeryReduce sets z = t * R⁻¹ (mod q), for t < q * R.
+// t is overwritten.
+func montgomeryReduce(z *{{.ElementName}}, t *[{{mul 2 .NbWords}}]uint64) {
+	// at step i, we add m * q * Wⁱ to t such that t[i] becomes 0;
+	// carry is the carry out of t[i + {{.NbWords}}], propagated at the next step.
+	var carry uint64
+	for i := 0; i < {{.NbWords}}; i++ {
+		m := t[i] * qInvNeg
+		C := madd0(m, q{{.ElementName}}[0], t[i])
+		for j := 1; j < {{.NbWords}}; j++ {
+			C, t[i+j] = madd2(m, q{{.ElementName}}[j], t[i+j], C)
+		}
+		t[i+{{.NbWords}}], carry = bits.Add64(t[i+{{.NbWords}}], C, carry)
+	}
+
+	// t / R < 2q, on {{.NbWords}} words and the carry bit;
+	// we subtract q if carry != 0 or t / R ⩾ q, without branching on t
+	var s {{.ElementName}}
+	var b uint64
+	for i := 0; i < {{.NbWords}}; i++ {
+		s[i], b = bits.Sub64(t[i+{{.NbWords}}], q{{.ElementName}}[i], b)
+	}
+	// mask = 0xFF..FF if we need to subtract, 0 otherwise
+	mask := -(carry | (b ^ 1))
+	for i := 0; i < {{.NbWords}}; i++ {
+		z[i] = t[i+{{.NbWords}}] ^ (mask & (t[i+{{.NbWords}}] ^ s[i]))
+	}
+}
+
+// mulWords sets z = x * y, with len(x) = len(y) = n and len(z) = 2n.
+// Above karatsubaThreshold words, it uses Karatsuba multiplication and scratch must have at least 8n words.
+func mulWords(z, x, y, scratch []uint64) {
+	n := len(x)
+	if n < karatsubaThreshold {
+		mulWordsBasic(z, x, y)
+		return
+	}
+
+	// x = x₁ * Wʰ + x₀ and y = y₁ * Wʰ + y₀, with len(x₀) = h ⩾ len(x₁) = l
+	h := (n + 1) / 2
+	x0, x1 := x[:h], x[h:]
+	y0, y1 := y[:h], y[h:]
+
+	// z = x₀y₀ + x₁y₁ * W²ʰ
+	mulWords(z[:2*h], x0, y0, scratch)
+	mulWords(z[2*h:], x1, y1, scratch)
+
+	// x₀y₁ + x₁y₀ = x₀y₀ + x₁y₁ + (x₁ - x₀)(y₀ - y₁)
+	dx, dy := scratch[:h], scratch[h:2*h]
+	p, m := scratch[2*h:4*h], scratch[4*h:6*h+1]
+	x0Smaller := subWordsAbs(dx, x0, x1)
+	y0Smaller := subWordsAbs(dy, y0, y1)
+	mulWords(p, dx, dy, scratch[6*h+1:])
+
+	copy(m, z[:2*h])
+	m[2*h] = 0
+	addWords(m, z[2*h:])
+	// (x₁ - x₀)(y₀ - y₁) = p if x0Smaller != y0Smaller, -p otherwise
+	addOrSubWords(m, p, x0Smaller^y0Smaller^1)
+
+	// the product fits on 2n words, the final carry is 0
+	addWords(z[h:], m)
+}
+
+// mulWordsBasic sets z = x * y, with len(x) = len(y) = n and len(z) = 2n (schoolbook)
+func mulWordsBasic(z, x, y []uint64) {
+	for i := range z {
+		z[i] = 0
+	}
+	for i, xi := range x {
+		var C uint64
+		for j, yj := range y {
+			C, z[i+j] = madd2(xi, yj, z[i+j], C)
+		}
+		z[i+len(y)] = C
+	}
+}
+
+// addWords sets z = z + x, with len(x) ⩽ len(z), and returns the carry
+func addWords(z, x []uint64) (carry uint64) {
+	for i := range x {
+		z[i], carry = bits.Add64(z[i], x[i], carry)
+	}
+	for i := len(x); i < len(z); i++ {
+		z[i], carry = bits.Add64(z[i], 0, carry)
+	}
+	return
+}
+
+// subWords sets z = z - x, with len(x) ⩽ len(z), and returns the borrow
+func subWords(z, x []uint64) (borrow uint64) {
+	for i := range x {
+		z[i], borrow = bits.Sub64(z[i], x[i], borrow)
+	}
+	for i := len(x); i < len(z); i++ {
+		z[i], borrow = bits.Sub64(z[i], 0, borrow)
+	}
+	return
+}
+
+// addOrSubWords sets z = z + x if sub == 0 and z = z - x if sub == 1, with len(x) < len(z);
+// the result is computed modulo W^len(z)
+func addOrSubWords(z, x []uint64, sub uint64) {
+	// z - x = z + ^x + 1, where x is padded with zero words
+	mask := -sub
+	carry := sub
+	for i := range x {
+		z[i], carry = bits.Add64(z[i], x[i]^mask, carry)
+	}
+	for i := len(x); i < len(z); i++ {
+		z[i], carry = bits.Add64(z[i], mask, carry)
+	}
+}
+
+// subWordsAbs sets z = |x - y|, with len(y) ⩽ len(x) = len(z), and returns 1 if x < y, 0 otherwise
+func subWordsAbs(z, x, y []uint64) uint64 {
+	copy(z, x)
+	borrow := subWords(z, y)
+	// if x < y, z = x - y + Wⁿ and we negate it: -z = ^z + 1
+	mask := -borrow
+	carry := borrow
+	for i := range z {
+		z[i], carry = bits.Add64(z[i]^mask, 0, carry)
+	}
+	return borrow
+}
+
+{{ end }}
+`
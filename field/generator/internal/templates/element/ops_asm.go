@@ -53,4 +53,45 @@ func (z *{{.ElementName}}) Square(x *{{.ElementName}}) *{{.ElementName}} {
 
 
 
+`
+
+// OpsARM64 is included with ARM64 builds if F.ASMArm64 is set
+const OpsARM64 = `
+
+{{ template "mul_by_constants" . }}
+
+//go:noescape
+func mul(res,x,y *{{.ElementName}})
+
+//go:noescape
+func reduce(res *{{.ElementName}})
+
+// Butterfly sets
+//  a = a + b (mod q)
+//  b = a - b (mod q)
+//go:noescape
+func Butterfly(a, b *{{.ElementName}})
+
+func fromMont(z *{{.ElementName}} ) {
+	_fromMontGeneric(z)
+}
+
+// Mul z = x * y (mod q)
+//
+// x and y must be less than q
+func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}} {
+	{{ mul_doc $.NoCarry }}
+	mul(z, x, y)
+	return z
+}
+
+// Square z = x * x (mod q)
+//
+// x must be less than q
+func (z *{{.ElementName}}) Square(x *{{.ElementName}}) *{{.ElementName}} {
+	// see Mul for doc.
+	mul(z, x, x)
+	return z
+}
+
 `
@@ -2,38 +2,12 @@ package element
 
 const OpsNoAsm = `
 
+{{- if not .LargeModulus}}
 import "math/bits"
-
-{{ $mulConsts := list 3 5 13 }}
-{{- range $i := $mulConsts }}
-
-// MulBy{{$i}} x *= {{$i}} (mod q)
-func MulBy{{$i}}(x *{{$.ElementName}}) {
-	{{- if eq 1 $.NbWords}}
-	var y {{$.ElementName}}
-	y.SetUint64({{$i}})
-	x.Mul(x, &y)
-	{{- else}}
-		{{- if eq $i 3}}
-			_x := *x
-			x.Double(x).Add(x, &_x)
-		{{- else if eq $i 5}}
-			_x := *x
-			x.Double(x).Double(x).Add(x, &_x)
-		{{- else if eq $i 13}}
-			var y = {{$.ElementName}}{
-				{{- range $i := $.Thirteen}}
-				{{$i}},{{end}}
-			}
-			x.Mul(x, &y)
-		{{- else }}
-			NOT IMPLEMENTED
-		{{- end}}
-	{{- end}}
-}
-
 {{- end}}
 
+{{ template "mul_by_constants" . }}
+
 // Butterfly sets
 //  a = a + b (mod q)
 //  b = a - b (mod q)
@@ -58,16 +32,21 @@ func reduce(z *{{.ElementName}})  {
 // x and y must be less than q
 {{- end }}
 func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}} {
-	{{- if eq $.NbWords 1}}
+	{{- if $.LargeModulus}}
+		// see _mulGeneric for algorithm documentation
+		_mulGeneric(z, x, y)
+	{{- else if $.Goldilocks}}
+		{{ template "mul_goldilocks" dict "all" . "V1" "x" "V2" "y" }}
+	{{- else if eq $.NbWords 1}}
 		{{ template "mul_cios_one_limb" dict "all" . "V1" "x" "V2" "y" }}
 	{{- else }}
 		{{ mul_doc $.NoCarry }}
 		{{- if $.NoCarry}}
 			{{ template "mul_nocarry" dict "all" . "V1" "x" "V2" "y"}}
+			{{ template "reduce_ct"  . }}
 		{{- else}}
-			{{ template "mul_cios" dict "all" . "V1" "x" "V2" "y" "ReturnZ" true}}
+			{{ template "mul_cios" dict "all" . "V1" "x" "V2" "y"}}
 		{{- end}}
-		{{ template "reduce"  . }}
 	{{- end }}
 	return z
 }
@@ -79,17 +58,56 @@ func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}} {
 {{- end }}
 func (z *{{.ElementName}}) Square(x *{{.ElementName}}) *{{.ElementName}} {
 	// see Mul for algorithm documentation
-	{{- if eq $.NbWords 1}}
+	{{- if $.LargeModulus}}
+		_mulGeneric(z, x, x)
+	{{- else if $.Goldilocks}}
+		{{ template "mul_goldilocks" dict "all" . "V1" "x" "V2" "x" }}
+	{{- else if eq $.NbWords 1}}
 		{{ template "mul_cios_one_limb" dict "all" . "V1" "x" "V2" "x" }}
 	{{- else }}
 		{{- if $.NoCarry}}
 			{{ template "mul_nocarry" dict "all" . "V1" "x" "V2" "x"}}
+			{{ template "reduce_ct"  . }}
 		{{- else}}
-			{{ template "mul_cios" dict "all" . "V1" "x" "V2" "x" "ReturnZ" true}}
+			{{ template "mul_cios" dict "all" . "V1" "x" "V2" "x"}}
 		{{- end}}
-		{{ template "reduce"  . }}
 	{{- end }}
 	return z
 }
 
 `
+
+// MulByConstants implements MulBy3, MulBy5 and MulBy13 in Go
+const MulByConstants = `
+{{ define "mul_by_constants" }}
+{{ $mulConsts := list 3 5 13 }}
+{{- range $i := $mulConsts }}
+
+// MulBy{{$i}} x *= {{$i}} (mod q)
+func MulBy{{$i}}(x *{{$.ElementName}}) {
+	{{- if eq 1 $.NbWords}}
+	var y {{$.ElementName}}
+	y.SetUint64({{$i}})
+	x.Mul(x, &y)
+	{{- else}}
+		{{- if eq $i 3}}
+			_x := *x
+			x.Double(x).Add(x, &_x)
+		{{- else if eq $i 5}}
+			_x := *x
+			x.Double(x).Double(x).Add(x, &_x)
+		{{- else if eq $i 13}}
+			var y = {{$.ElementName}}{
+				{{- range $i := $.Thirteen}}
+				{{$i}},{{end}}
+			}
+			x.Mul(x, &y)
+		{{- else }}
+			NOT IMPLEMENTED
+		{{- end}}
+	{{- end}}
+}
+
+{{- end}}
+{{ end }}
+`
@@ -21,4 +21,24 @@ if !z.smallerThanModulus() {
 
 {{-  end }}
 
+{{ define "reduce_ct" }}
+// if z ⩾ q → z -= q, without branching on z
+{{- if eq $.NbWords 1}}
+	s, b := bits.Sub64(z[0], q, 0)
+	// mask = 0xFF..FF if z ⩾ q (no borrow), 0 otherwise
+	z[0] ^= (b - 1) & (z[0] ^ s)
+{{- else}}
+	var s [{{$.NbWords}}]uint64
+	var b uint64
+	{{- range $i := .NbWordsIndexesFull}}
+		s[{{$i}}], b = bits.Sub64(z[{{$i}}], q{{$i}}, {{- if eq $i 0}}0{{- else}}b{{- end}})
+	{{- end}}
+	// mask = 0xFF..FF if z ⩾ q (no borrow), 0 otherwise
+	mask := b - 1
+	{{- range $i := .NbWordsIndexesFull}}
+		z[{{$i}}] ^= mask & (z[{{$i}}] ^ s[{{$i}}])
+	{{- end}}
+{{-  end }}
+{{-  end }}
+
 `
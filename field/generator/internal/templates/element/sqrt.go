@@ -6,6 +6,7 @@ const Sqrt = `
 var (
 	_bLegendreExponent{{.ElementName}} *big.Int
 	_bSqrtExponent{{.ElementName}} *big.Int
+	_bInverseExponent{{.ElementName}} *big.Int
 )
 
 func init() {
@@ -18,6 +19,7 @@ func init() {
 		const sqrtExponent{{.ElementName}} = "{{.SqrtSMinusOneOver2}}"
 	{{- end }}
 	_bSqrtExponent{{.ElementName}}, _ = new(big.Int).SetString(sqrtExponent{{.ElementName}}, 16)
+	_bInverseExponent{{.ElementName}}, _ = new(big.Int).SetString("{{.InverseExponent}}", 16)
 }
 
 {{- end }}
@@ -51,59 +53,180 @@ func (z *{{.ElementName}}) Sqrt(x *{{.ElementName}}) *{{.ElementName}} {
 	{{- if .SqrtQ3Mod4}}
 		// q ≡ 3 (mod 4)
 		// using  z ≡ ± x^((p+1)/4) (mod q)
-		var y, square {{.ElementName}}
+		var y {{.ElementName}}
 		{{- if .UseAddChain}}
 		y.expBySqrtExp(*x)
 		{{- else}}
 		y.Exp(*x, _bSqrtExponent{{.ElementName}})
 		{{- end }}
-		// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
-		square.Square(&y)
-		if square.Equal(x) {
-			return z.Set(&y)
-		} 
-		return nil
+		return z.sqrtFromExp(x, &y)
 	{{- else if .SqrtAtkin}}
 		// q ≡ 5 (mod 8)
 		// see modSqrt5Mod8Prime in math/big/int.go
-		var one, alpha, beta, tx, square {{.ElementName}}
-		one.SetOne()
+		var alpha, tx {{.ElementName}}
 		tx.Double(x)
 		{{- if .UseAddChain}}
 		alpha.expBySqrtExp(tx)
 		{{ else }}
 		alpha.Exp(tx, _bSqrtExponent{{.ElementName}})
 		{{- end }}
-		beta.Square(&alpha).
-			Mul(&beta, &tx).
-			Sub(&beta, &one).
-			Mul(&beta, x).
-			Mul(&beta, &alpha)
-		
-		// as we didn't compute the legendre symbol, ensure we found beta such that beta * beta = x
-		square.Square(&beta)
-		if square.Equal(x) {
-			return z.Set(&beta)
-		}
-		return nil
+		return z.sqrtFromExp(x, &alpha)
 	{{- else if .SqrtTonelliShanks}}
 		// q ≡ 1 (mod 4)
 		// see modSqrtTonelliShanks in math/big/int.go
 		// using https://www.maa.org/sites/default/files/pdf/upload_library/22/Polya/07468342.di020786.02p0470a.pdf
 
-		var y, b,t, w  {{.ElementName}}
 		// w = x^((s-1)/2))
+		var w {{.ElementName}}
+		{{- if .UseAddChain}}
+		w.expBySqrtExp(*x)
+		{{- else}}
+		w.Exp(*x, _bSqrtExponent{{.ElementName}})
+		{{- end}}
+		return z.sqrtFromExp(x, &w)
+	{{- else}}
+		panic("not implemented")	
+	{{- end}}
+}
+
+// SqrtCT z = √x (mod q) in constant time: the sequence of operations doesn't depend on x,
+// only the returned value reveals whether x is a square. It is suitable for secret data.
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil
+func (z *{{.ElementName}}) SqrtCT(x *{{.ElementName}}) *{{.ElementName}} {
+	{{- if .SqrtQ3Mod4}}
+		// Sqrt is a fixed exponentiation followed by a check of the candidate root
+		return z.Sqrt(x)
+	{{- else if .SqrtAtkin}}
+		// q ≡ 5 (mod 8)
+		// unlike Atkin's algorithm in Sqrt, uses only multiplications and conditional moves
+		// see RFC 9380, appendix I.2
+
+		// y = x^((q+3)/8) = x^((q-5)/8) * x
+		var y, yi, square {{.ElementName}}
+		{{- if .UseAddChain}}
+		y.expBySqrtExp(*x)
+		{{- else}}
+		y.Exp(*x, _bSqrtExponent{{.ElementName}})
+		{{- end}}
+		y.Mul(&y, x)
+
+		// if y² ≠ x, the candidate root is y * √-1
+		var sqrtMinusOne = {{.ElementName}}{
+			{{- range $i := .SqrtAtkinSqrtMinusOne}}
+			{{$i}},{{end}}
+		}
+		yi.Mul(&y, &sqrtMinusOne)
+		square.Square(&y)
+		// notRoot = 0 if y² == x, 1 otherwise
+		notRoot := square.NotEqual(x)
+		notRoot = (notRoot | -notRoot) >> 63
+		y.Select(int(notRoot), &y, &yi)
+
+		// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
+		square.Square(&y)
+		if square.Equal(x) {
+			return z.Set(&y)
+		}
+		return nil
+	{{- else if .SqrtTonelliShanks}}
+		// constant-time Tonelli-Shanks with a fixed number of iterations and conditional moves
+		// see RFC 9380, appendix I.4
+
+		// w = x^((s-1)/2))
+		var w {{.ElementName}}
 		{{- if .UseAddChain}}
 		w.expBySqrtExp(*x)
 		{{- else}}
 		w.Exp(*x, _bSqrtExponent{{.ElementName}})
 		{{- end}}
 
+		var y, t, b, yt, tt, one {{.ElementName}}
+		one.SetOne()
+
 		// y = x^((s+1)/2)) = w * x
 		y.Mul(x, &w)
 
+		// t = xˢ = w * y
+		t.Mul(&w, &y)
+
+		// c = nonResidue ^ s
+		var c = {{.ElementName}}{
+			{{- range $i := .SqrtG}}
+			{{$i}},{{end}}
+		}
+
+		for i := {{.SqrtE}}; i >= 2; i-- {
+			b = t
+			for j := 1; j <= i-2; j++ {
+				b.Square(&b)
+			}
+			// notOne = 0 if b == 1, 1 otherwise
+			notOne := b.NotEqual(&one)
+			notOne = (notOne | -notOne) >> 63
+
+			yt.Mul(&y, &c)
+			y.Select(int(notOne), &y, &yt)
+			c.Square(&c)
+			tt.Mul(&t, &c)
+			t.Select(int(notOne), &t, &tt)
+		}
+
+		// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
+		var square {{.ElementName}}
+		square.Square(&y)
+		if square.Equal(x) {
+			return z.Set(&y)
+		}
+		return nil
+	{{- else}}
+		panic("not implemented")
+	{{- end}}
+}
+
+{{- if or .SqrtQ3Mod4 .SqrtAtkin .SqrtTonelliShanks}}
+
+// sqrtFromExp sets z = √x (mod q) and returns z, or returns nil if x is not a square,
+{{- if .SqrtQ3Mod4}}
+// given y = x^((q+1)/4) (see Sqrt)
+func (z *{{.ElementName}}) sqrtFromExp(x, y *{{.ElementName}}) *{{.ElementName}} {
+		// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
+		var square {{.ElementName}}
+		square.Square(y)
+		if square.Equal(x) {
+			return z.Set(y)
+		} 
+		return nil
+}
+{{- else if .SqrtAtkin}}
+// given alpha = (2x)^((q-5)/8) (see Sqrt)
+func (z *{{.ElementName}}) sqrtFromExp(x, alpha *{{.ElementName}}) *{{.ElementName}} {
+		var one, beta, tx, square {{.ElementName}}
+		one.SetOne()
+		tx.Double(x)
+		beta.Square(alpha).
+			Mul(&beta, &tx).
+			Sub(&beta, &one).
+			Mul(&beta, x).
+			Mul(&beta, alpha)
+		
+		// as we didn't compute the legendre symbol, ensure we found beta such that beta * beta = x
+		square.Square(&beta)
+		if square.Equal(x) {
+			return z.Set(&beta)
+		}
+		return nil
+}
+{{- else if .SqrtTonelliShanks}}
+// given w = x^((s-1)/2), where q - 1 = 2ᵉ·s with s odd (see Sqrt)
+func (z *{{.ElementName}}) sqrtFromExp(x, w *{{.ElementName}}) *{{.ElementName}} {
+		var y, b, t {{.ElementName}}
+
+		// y = x^((s+1)/2)) = w * x
+		y.Mul(x, w)
+
 		// b = xˢ = w * w * x = y * x
-		b.Mul(&w, &y)
+		b.Mul(w, &y)
 
 		// g = nonResidue ^ s
 		var g = {{.ElementName}}{
@@ -151,12 +274,94 @@ func (z *{{.ElementName}}) Sqrt(x *{{.ElementName}}) *{{.ElementName}} {
 			b.Mul(&b, &g)
 			r = m
 		}
+}
+{{- end}}
 
+// batchExpSize is the number of elements whose exponentiations are computed together
+// by BatchSqrt and BatchLegendre; it bounds the size of the temporary vectors.
+const batchExpSize = 256
+
+// BatchLegendre returns the Legendre symbols of the elements of a (see Legendre).
+//
+// The exponentiations share the same {{if .UseAddChain}}addition chain{{else}}exponent{{end}} and are computed on vectors
+// of elements, in parallel.
+func BatchLegendre(a []{{.ElementName}}) []int {
+	res := make([]int, len(a))
+	batchExp(a, {{if .UseAddChain}}vectorExpByLegendreExp, vectorExpByLegendreExpNbTemporaries{{else}}_bLegendreExponent{{.ElementName}}{{end}}, func(i int, l *{{.ElementName}}) {
+		switch {
+		case l.IsZero():
+			res[i] = 0
+		case l.IsOne():
+			res[i] = 1
+		default:
+			res[i] = -1
+		}
+	})
+	return res
+}
+
+// BatchSqrt returns the square roots of the elements of a (see Sqrt), and for each of them
+// whether it is a square; if a[i] is not a square, res[i] is 0 and isSquare[i] is false.
+//
+// The exponentiations share the same {{if .UseAddChain}}addition chain{{else}}exponent{{end}} and are computed on vectors
+// of elements, in parallel.
+func BatchSqrt(a []{{.ElementName}}) (res []{{.ElementName}}, isSquare []bool) {
+	res = make([]{{.ElementName}}, len(a))
+	isSquare = make([]bool, len(a))
+	{{- if .SqrtAtkin}}
+	// the exponentiation is applied to 2x
+	tx := make([]{{.ElementName}}, len(a))
+	execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			tx[i].Double(&a[i])
+		}
+	})
+	batchExp(tx, {{if .UseAddChain}}vectorExpBySqrtExp, vectorExpBySqrtExpNbTemporaries{{else}}_bSqrtExponent{{.ElementName}}{{end}}, func(i int, w *{{.ElementName}}) {
 	{{- else}}
-		panic("not implemented")	
+	batchExp(a, {{if .UseAddChain}}vectorExpBySqrtExp, vectorExpBySqrtExpNbTemporaries{{else}}_bSqrtExponent{{.ElementName}}{{end}}, func(i int, w *{{.ElementName}}) {
 	{{- end}}
+		isSquare[i] = res[i].sqrtFromExp(&a[i], w) != nil
+	})
+	return
 }
 
-
+// batchExp calls f(i, aᵢᵏ) for all i, where the exponentiations are computed in parallel
+// by chunks of batchExpSize elements.
+{{- if .UseAddChain}}
+// exp sets z[j] = x[j]ᵏ for all j, with z and x not overlapping, using nbTemporaries
+// temporary vectors from scratch.
+func batchExp(a []{{.ElementName}}, exp func(z, x, scratch Vector), nbTemporaries int, f func(i int, ak *{{.ElementName}})) {
+{{- else}}
+func batchExp(a []{{.ElementName}}, k *big.Int, f func(i int, ak *{{.ElementName}})) {
+{{- end}}
+	execute(len(a), func(start, end int) {
+		{{- if .UseAddChain}}
+		// the result and the temporaries of all the chunks of the worker share one buffer
+		size := end - start
+		if size > batchExpSize {
+			size = batchExpSize
+		}
+		buf := make(Vector, (nbTemporaries+1)*size)
+		for i := start; i < end; i += batchExpSize {
+			n := end - i
+			if n > batchExpSize {
+				n = batchExpSize
+			}
+			ak := buf[:n]
+			exp(ak, Vector(a[i:i+n]), buf[size:size+nbTemporaries*n])
+			for j := range ak {
+				f(i+j, &ak[j])
+			}
+		}
+		{{- else}}
+		var ak {{.ElementName}}
+		for i := start; i < end; i++ {
+			ak.Exp(a[i], k)
+			f(i, &ak)
+		}
+		{{- end}}
+	})
+}
+{{- end}}
 
 `
@@ -4,16 +4,17 @@ const Test = `
 
 
 import (
+	"bytes"
 	"crypto/rand"
 	"encoding/json"
 	"math/big"
 	"math/bits"
 	"fmt"
-	{{if .UsingP20Inverse}} 
-	mrand "math/rand" 
-	{{end}}
+	mrand "math/rand"
+	"os"
 	"testing"
-	
+
+	"github.com/consensys/gnark-crypto/utils"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 	ggen "github.com/leanovate/gopter/gen"
@@ -98,6 +99,30 @@ func Benchmark{{toTitle .ElementName}}Inverse(b *testing.B) {
 
 }
 
+func Benchmark{{toTitle .ElementName}}InverseCT(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.InverseCT(&x)
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}MulAcc(b *testing.B) {
+	var x, y {{.ElementName}}
+	x.SetRandom()
+	y.SetRandom()
+	var acc UnreducedAccumulator
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		acc.MulAcc(&x, &y)
+	}
+	acc.Reduce(&benchRes{{.ElementName}})
+}
+
 func Benchmark{{toTitle .ElementName}}Butterfly(b *testing.B) {
 	var x {{.ElementName}}
 	x.SetRandom()
@@ -195,6 +220,39 @@ func Benchmark{{toTitle .ElementName}}Sqrt(b *testing.B) {
 	}
 }
 
+func Benchmark{{toTitle .ElementName}}SqrtCT(b *testing.B) {
+	var a {{.ElementName}}
+	a.SetUint64(4)
+	a.Neg(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.SqrtCT(&a)
+	}
+}
+
+{{- if or .SqrtQ3Mod4 .SqrtAtkin .SqrtTonelliShanks}}
+func Benchmark{{toTitle .ElementName}}BatchSqrt(b *testing.B) {
+	const n = 1024
+	a := make([]{{.ElementName}}, n)
+	for i := range a {
+		a[i].SetRandom()
+	}
+	b.Run("Sqrt", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			for i := range a {
+				benchRes{{.ElementName}}.Sqrt(&a[i])
+			}
+		}
+	})
+	b.Run("BatchSqrt", func(b *testing.B) {
+		b.ReportAllocs()
+		for j := 0; j < b.N; j++ {
+			BatchSqrt(a)
+		}
+	})
+}
+{{end}}
+
 func Benchmark{{toTitle .ElementName}}Mul(b *testing.B) {
 	x := {{.ElementName}}{
 		{{- range $i := .RSquare}}
@@ -251,6 +309,32 @@ func Test{{toTitle .ElementName}}Cmp(t *testing.T) {
 	}
 }
 
+func Test{{toTitle .ElementName}}SetRandomFrom(t *testing.T) {
+	// the same seed yields the same sequence of elements
+	r1, r2 := mrand.New(mrand.NewSource(42)), mrand.New(mrand.NewSource(42)) //#nosec G404 -- deterministic test fixture
+	for i := 0; i < 50; i++ {
+		var x, y {{.ElementName}}
+		if _, err := x.SetRandomFrom(r1); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := y.SetRandomFrom(r2); err != nil {
+			t.Fatal(err)
+		}
+		if !x.Equal(&y) {
+			t.Fatal("SetRandomFrom should be deterministic for a given reader")
+		}
+		if !x.smallerThanModulus() {
+			t.Fatal("SetRandomFrom should return a reduced element")
+		}
+	}
+
+	// reading errors are reported
+	var x {{.ElementName}}
+	if _, err := x.SetRandomFrom(bytes.NewReader(nil)); err == nil {
+		t.Fatal("SetRandomFrom should fail on an empty reader")
+	}
+}
+
 {{- if gt .NbWords 1}}
 func Test{{toTitle .ElementName}}IsRandom(t *testing.T) {
 	for i := 0; i < 50; i++ {
@@ -512,6 +596,203 @@ func Test{{toTitle .ElementName}}InverseExp(t *testing.T) {
 }
 
 
+func Test{{toTitle .ElementName}}InverseCT(t *testing.T) {
+	invCTMatchInv := func(a testPair{{.ElementName}}) bool {
+		var b, c {{.ElementName}}
+		b.InverseCT(&a.element)
+		c.Inverse(&a.element)
+		return b.Equal(&c)
+	}
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+	genA := gen()
+	properties.Property("InverseCT == Inverse", prop.ForAll(invCTMatchInv, genA))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	parameters.MinSuccessfulTests = 1
+	properties = gopter.NewProperties(parameters)
+	properties.Property("InverseCT(0) == 0", prop.ForAll(invCTMatchInv, ggen.OneConstOf(testPair{{.ElementName}}{})))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{toTitle .ElementName}}AddSubCT(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+	genA := gen()
+	genB := gen()
+
+	properties.Property("AddCT == Add", prop.ForAll(
+		func(a, b testPair{{.ElementName}}) bool {
+			var c, d {{.ElementName}}
+			c.AddCT(&a.element, &b.element)
+			d.Add(&a.element, &b.element)
+			return c.Equal(&d) && c.smallerThanModulus()
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("SubCT == Sub", prop.ForAll(
+		func(a, b testPair{{.ElementName}}) bool {
+			var c, d {{.ElementName}}
+			c.SubCT(&a.element, &b.element)
+			d.Sub(&a.element, &b.element)
+			return c.Equal(&d) && c.smallerThanModulus()
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("AddCT and SubCT: having the receiver as operand should output the same result", prop.ForAll(
+		func(a, b testPair{{.ElementName}}) bool {
+			var c, d {{.ElementName}}
+			c.Add(&a.element, &b.element)
+			d.Sub(&a.element, &b.element)
+			x, y := a.element, b.element
+			x.AddCT(&x, &b.element)
+			y.SubCT(&a.element, &y)
+			return x.Equal(&c) && y.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// edge cases
+	var zero, one, qMinusOne, z {{.ElementName}}
+	one.SetOne()
+	qMinusOne.Neg(&one)
+	if !z.AddCT(&qMinusOne, &one).IsZero() || !z.AddCT(&qMinusOne, &zero).Equal(&qMinusOne) {
+		t.Fatal("AddCT: wrong result around q")
+	}
+	if !z.SubCT(&zero, &one).Equal(&qMinusOne) || !z.SubCT(&one, &one).IsZero() {
+		t.Fatal("SubCT: wrong result around 0")
+	}
+}
+
+func Test{{toTitle .ElementName}}AddSubCTTiming(t *testing.T) {
+	// with the fixed input x = 1, x + y is never reduced and x - y always is (for y > 1),
+	// while random inputs are reduced about half the time
+	one := One()
+	var y, z {{.ElementName}}
+	y.SetRandom()
+	testDudect{{.ElementName}}(t, one, func(x *{{.ElementName}}) {
+		x.SetRandom()
+	}, func(x *{{.ElementName}}) {
+		z.AddCT(x, &y)
+		z.SubCT(x, &y)
+	})
+}
+
+func Test{{toTitle .ElementName}}InverseCTTiming(t *testing.T) {
+	var z {{.ElementName}}
+	testDudect{{.ElementName}}(t, One(), func(x *{{.ElementName}}) {
+		x.SetRandom()
+	}, func(x *{{.ElementName}}) {
+		z.InverseCT(x)
+	})
+}
+
+{{- if or .SqrtQ3Mod4 .SqrtAtkin .SqrtTonelliShanks}}
+
+func Test{{toTitle .ElementName}}SqrtCT(t *testing.T) {
+	sqrtCTMatchSqrt := func(a testPair{{.ElementName}}) bool {
+		var b, c {{.ElementName}}
+		rCT := b.SqrtCT(&a.element)
+		r := c.Sqrt(&a.element)
+		if r == nil || rCT == nil {
+			return r == nil && rCT == nil
+		}
+		// the roots may differ by their sign
+		b.Square(&b)
+		return b.Equal(&a.element)
+	}
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+	genA := gen()
+	properties.Property("SqrtCT matches Sqrt", prop.ForAll(sqrtCTMatchSqrt, genA))
+	properties.Property("SqrtCT(x²) must exist", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var square, b {{.ElementName}}
+			square.Square(&a.element)
+			if b.SqrtCT(&square) == nil {
+				return false
+			}
+			b.Square(&b)
+			return b.Equal(&square)
+		},
+		genA,
+	))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	parameters.MinSuccessfulTests = 1
+	properties = gopter.NewProperties(parameters)
+	properties.Property("SqrtCT(0) == 0", prop.ForAll(sqrtCTMatchSqrt, ggen.OneConstOf(testPair{{.ElementName}}{})))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{toTitle .ElementName}}SqrtCTTiming(t *testing.T) {
+	// both classes of inputs are squares, as SqrtCT only hides which square root is computed
+	var fixed, z {{.ElementName}}
+	fixed.SetUint64(4)
+	testDudect{{.ElementName}}(t, fixed, func(x *{{.ElementName}}) {
+		x.SetRandom()
+		x.Square(x)
+	}, func(x *{{.ElementName}}) {
+		z.SqrtCT(x)
+	})
+}
+{{- end}}
+
+// testDudect{{.ElementName}} runs a dudect-style timing test of f (see utils.DudectTStatistic),
+// comparing a fixed input with inputs drawn by random.
+// The test is skipped unless the DUDECT environment variable is set, as timing measurements are noisy
+// on shared machines; build with -tags purego to test the generic arithmetic instead of the assembly.
+func testDudect{{.ElementName}}(t *testing.T, fixed {{.ElementName}}, random, f func(x *{{.ElementName}})) {
+	if os.Getenv("DUDECT") == "" {
+		t.Skip("set DUDECT to run the timing test")
+	}
+	const nbMeasurements = {{if le .NbWords 6}}1 << 14{{else if le .NbWords 12}}1 << 12{{else}}1 << 10{{end}}
+	inputs := make([]{{.ElementName}}, nbMeasurements)
+	prepare := func(i, class int) {
+		if class == 0 {
+			inputs[i] = fixed
+			return
+		}
+		random(&inputs[i])
+	}
+	run := func(i int) {
+		f(&inputs[i])
+	}
+	tStat := utils.DudectTStatistic(nbMeasurements, prepare, run)
+	t.Logf("t-statistic: %.2f", tStat)
+	if tStat > utils.DudectThreshold || tStat < -utils.DudectThreshold {
+		t.Fatalf("timing leakage detected: |t| = %.2f > %d", tStat, utils.DudectThreshold)
+	}
+}
+
+
 func mulByConstant(z *{{.ElementName}}, c uint8) {
 	var y {{.ElementName}}
 	y.SetUint64(uint64(c))
@@ -632,6 +913,81 @@ func Test{{toTitle .ElementName}}Legendre(t *testing.T) {
 	
 }
 
+{{- if or .SqrtQ3Mod4 .SqrtAtkin .SqrtTonelliShanks}}
+func Test{{toTitle .ElementName}}BatchSqrt(t *testing.T) {
+	assert := require.New(t)
+
+	t.Parallel()
+
+	// cover the empty input, a partial chunk and several chunks
+	for _, n := range []int{0, 1, 7, batchExpSize + 3, 2*batchExpSize} {
+		a := make([]{{.ElementName}}, n)
+		for i := range a {
+			if i%5 == 0 {
+				a[i].SetUint64(uint64(i))
+			} else {
+				a[i].SetRandom()
+			}
+		}
+
+		res, isSquare := BatchSqrt(a)
+		legendre := BatchLegendre(a)
+		assert.Equal(n, len(res))
+		assert.Equal(n, len(isSquare))
+		assert.Equal(n, len(legendre))
+
+		for i := range a {
+			var expected {{.ElementName}}
+			ok := expected.Sqrt(&a[i]) != nil
+			assert.Equal(ok, isSquare[i], "BatchSqrt and Sqrt disagree on squareness")
+			assert.Equal(a[i].Legendre(), legendre[i], "BatchLegendre != Legendre")
+			if ok {
+				assert.True(res[i].Equal(&expected), "BatchSqrt != Sqrt")
+			}
+		}
+	}
+}
+
+{{end}}
+func Test{{toTitle .ElementName}}UnreducedAccumulator(t *testing.T) {
+	assert := require.New(t)
+
+	t.Parallel()
+
+	// the largest element (all products of q-1 words) stresses the carries
+	var max {{.ElementName}}
+	max = q{{.ElementName}}
+	max[0]--
+
+	for _, n := range []int{0, 1, 2, 7, 64, 1000} {
+		var acc UnreducedAccumulator
+		var expected, tmp {{.ElementName}}
+		for i := 0; i < n; i++ {
+			var x, y {{.ElementName}}
+			if i%3 == 0 {
+				x, y = max, max
+			} else {
+				x.SetRandom()
+				y.SetRandom()
+			}
+			acc.MulAcc(&x, &y)
+			tmp.Mul(&x, &y)
+			expected.Add(&expected, &tmp)
+		}
+		var res {{.ElementName}}
+		acc.Reduce(&res)
+		assert.True(res.Equal(&expected), "Reduce(Σ MulAcc) != Σ Mul, n = %d", n)
+
+		// Reduce leaves the accumulator unchanged
+		acc.Reduce(&res)
+		assert.True(res.Equal(&expected), "second Reduce differs, n = %d", n)
+
+		acc.Reset()
+		acc.Reduce(&res)
+		assert.True(res.IsZero(), "Reset accumulator should reduce to 0")
+	}
+}
+
 func Test{{toTitle .ElementName}}BitLen(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -1509,6 +1865,41 @@ func Test{{toTitle .ElementName}}FromMont(t *testing.T) {
 }
 
 
+{{- if .LargeModulus}}
+
+func Test{{toTitle .ElementName}}MulWords(t *testing.T) {
+	t.Parallel()
+
+	toBig := func(x []uint64) *big.Int {
+		var r, w big.Int
+		for i := len(x) - 1; i >= 0; i-- {
+			r.Lsh(&r, 64).Or(&r, w.SetUint64(x[i]))
+		}
+		return &r
+	}
+
+	// covers schoolbook, one and two levels of Karatsuba, with odd sizes
+	for n := 1; n <= 4*karatsubaThreshold+1; n++ {
+		x, y := make([]uint64, n), make([]uint64, n)
+		for i := range x {
+			x[i], y[i] = mrand.Uint64(), mrand.Uint64()
+			if n%3 == 0 {
+				x[i] = ^uint64(0)
+			}
+		}
+		z := make([]uint64, 2*n)
+		scratch := make([]uint64, 8*n)
+		for _, v := range [][]uint64{y, x} {
+			mulWords(z, x, v, scratch)
+			var expected big.Int
+			expected.Mul(toBig(x), toBig(v))
+			if toBig(z).Cmp(&expected) != 0 {
+				t.Fatalf("mulWords on %d words doesn't match big.Int", n)
+			}
+		}
+	}
+}
+{{- end}}
 
 func Test{{toTitle .ElementName}}JSON(t *testing.T) {
 	assert := require.New(t)
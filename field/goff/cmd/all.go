@@ -0,0 +1,36 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/spf13/cobra"
+)
+
+var allCmd = &cobra.Command{
+	Use:   "all",
+	Short: "generates the base field, its extensions and the fft, polynomial, sumcheck and mimc packages",
+	Run:   runGenerate(generateAll...),
+}
+
+var generateAll = []func(F *field.FieldConfig, fieldDependency config.FieldDependency) error{
+	generateExtensions, generateFFT, generatePolynomial, generateSumcheck, generateMiMC,
+}
+
+func init() {
+	addExtensionsFlags(allCmd)
+	addFFTFlags(allCmd)
+	rootCmd.AddCommand(allCmd)
+}
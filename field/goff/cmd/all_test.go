@@ -0,0 +1,76 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerateAllStandalone generates all the packages in a module outside of gnark-crypto,
+// and checks that they build and that their tests pass: the generated code must not import
+// the internal packages of gnark-crypto.
+func TestGenerateAllStandalone(t *testing.T) {
+	root, err := filepath.Abs(filepath.Join("..", "..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the module has the same requirements as gnark-crypto, which it uses from the working tree
+	goMod, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	goSum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	goMod = bytes.Replace(goMod, []byte("module github.com/consensys/gnark-crypto"), []byte("module example.com/standalone"), 1)
+	goMod = append(goMod, fmt.Sprintf(`
+require github.com/consensys/gnark-crypto v0.0.0
+
+replace github.com/consensys/gnark-crypto => %s
+`, root)...)
+
+	dir := t.TempDir()
+	if err = os.WriteFile(filepath.Join(dir, "go.mod"), goMod, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, "go.sum"), goSum, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// goldilocks field; the import path is derived from the go.mod above
+	fModulus = "18446744069414584321"
+	fOutputDir = filepath.Join(dir, "goldilocks")
+	fPackageName = "goldilocks"
+	fElementName = "Element"
+	if err = doGenerate(allCmd, generateAll...); err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(args ...string) {
+		cmd := exec.Command("go", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("go %v: %v\n%s", args, err, out)
+		}
+	}
+	run("vet", "./...")
+	run("test", "-short", "./goldilocks/polynomial", "./goldilocks/sumcheck", "./goldilocks/mimc")
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/extensions"
+	"github.com/spf13/cobra"
+)
+
+var extensionsCmd = &cobra.Command{
+	Use:   "extensions",
+	Short: "generates the base field and its extensions 𝔽[u]/(uⁿ - α) in <output>/extensions",
+	Run:   runGenerate(generateExtensions),
+}
+
+var (
+	fDegrees      []uint
+	fE2NonResidue int64
+	fE3NonResidue int64
+)
+
+func init() {
+	addExtensionsFlags(extensionsCmd)
+	rootCmd.AddCommand(extensionsCmd)
+}
+
+func addExtensionsFlags(cmd *cobra.Command) {
+	cmd.Flags().UintSliceVar(&fDegrees, "degrees", []uint{2, 3}, "degrees of the extensions (at least 2), α is the smallest suitable value for degrees other than 2 and 3")
+	cmd.Flags().Int64Var(&fE2NonResidue, "e2-nonresidue", 0, "α such that E2 = 𝔽[u]/(u² - α) (default: smallest non square in -1, 2, -2, 3, ...)")
+	cmd.Flags().Int64Var(&fE3NonResidue, "e3-nonresidue", 0, "α such that E3 = 𝔽[u]/(u³ - α) (default: smallest non cube in -1, 2, -2, 3, ...)")
+}
+
+func generateExtensions(F *field.FieldConfig, fieldDependency config.FieldDependency) error {
+	for _, degree := range fDegrees {
+		if degree < 2 || degree > 255 {
+			return fmt.Errorf("unsupported extension degree %d (must be between 2 and 255)", degree)
+		}
+		var rootOf int64
+		switch degree {
+		case 2:
+			rootOf = fE2NonResidue
+		case 3:
+			rootOf = fE3NonResidue
+		}
+		if rootOf == 0 {
+			var err error
+			if rootOf, err = field.FindRootOf(F, uint8(degree)); err != nil {
+				return err
+			}
+		}
+		conf := extensions.Config{
+			FieldDependency: fieldDependency,
+			Extension:       field.NewTower(F, uint8(degree), rootOf),
+		}
+		if err := extensions.Generate(conf, filepath.Join(fOutputDir, "extensions"), bgen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	"path/filepath"
+
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/fft"
+	"github.com/spf13/cobra"
+)
+
+var fftCmd = &cobra.Command{
+	Use:   "fft",
+	Short: "generates the base field and the fft package (radix-2 domains) in <output>/fft",
+	Run:   runGenerate(generateFFT),
+}
+
+var (
+	fGenerator   uint64
+	fRootOfUnity string
+	fRadix4      bool
+)
+
+func init() {
+	addFFTFlags(fftCmd)
+	rootCmd.AddCommand(fftCmd)
+}
+
+func addFFTFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64Var(&fGenerator, "generator", 0, "generator of the multiplicative group, used as default coset shift (default: smallest quadratic non-residue)")
+	cmd.Flags().StringVar(&fRootOfUnity, "root-of-unity", "", "generator of the largest 2-adic subgroup (default: derived from the multiplicative generator)")
+	cmd.Flags().BoolVar(&fRadix4, "radix4", false, "fuse pairs of fft stages in radix-4 butterflies (radix-2 remains available as an option)")
+}
+
+func generateFFT(F *field.FieldConfig, fieldDependency config.FieldDependency) error {
+	conf, err := field.NewFFTConfig(F, fGenerator, fRootOfUnity)
+	if err != nil {
+		return err
+	}
+	return fft.Generate(fft.Config{FieldDependency: fieldDependency, FFTConfig: conf, Radix4: fRadix4}, filepath.Join(fOutputDir, "fft"), bgen)
+}
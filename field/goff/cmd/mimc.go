@@ -0,0 +1,41 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	"path/filepath"
+
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
+	"github.com/spf13/cobra"
+)
+
+var mimcCmd = &cobra.Command{
+	Use:   "mimc",
+	Short: "generates the base field and the MiMC hash function in <output>/mimc",
+	Run:   runGenerate(generateMiMC),
+}
+
+func init() {
+	rootCmd.AddCommand(mimcCmd)
+}
+
+func generateMiMC(F *field.FieldConfig, fieldDependency config.FieldDependency) error {
+	conf, err := mimc.NewConfig(fieldDependency, F.ModulusBig)
+	if err != nil {
+		return err
+	}
+	return mimc.Generate(conf, filepath.Join(fOutputDir, "mimc"), true, bgen)
+}
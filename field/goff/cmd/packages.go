@@ -0,0 +1,159 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator"
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/spf13/cobra"
+)
+
+// bgen generates the packages built on top of the base field (extensions, fft, polynomial, ...),
+// in sub-directories of the output directory
+var bgen = bavard.NewBatchGenerator("ConsenSys Software Inc.", 2020, "consensys/gnark-crypto")
+
+var fImportPath string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&fImportPath, "import-path", "", "import path of the generated base field package, used by the subcommands (default: derived from the enclosing go.mod)")
+}
+
+// generateField parses the flags and generates the base field
+func generateField(cmd *cobra.Command) (*field.FieldConfig, error) {
+	fmt.Println()
+	fmt.Println("running goff version", Version)
+	fmt.Println()
+
+	if err := parseFlags(cmd); err != nil {
+		_ = cmd.Usage()
+		return nil, err
+	}
+
+	F, err := field.NewFieldConfig(fPackageName, fElementName, fModulus, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := generator.GenerateFF(F, fOutputDir); err != nil {
+		return nil, err
+	}
+	return F, nil
+}
+
+// fieldDependency returns the description of the base field used by the packages built on top of it
+func fieldDependency(F *field.FieldConfig) (config.FieldDependency, error) {
+	path := fImportPath
+	if path == "" {
+		var err error
+		if path, err = importPath(fOutputDir); err != nil {
+			return config.FieldDependency{}, err
+		}
+	}
+	return config.FieldDependency{
+		FieldPackagePath: path,
+		FieldPackageName: F.PackageName,
+		ElementType:      F.PackageName + "." + F.ElementName,
+	}, nil
+}
+
+// importPath returns the import path of dir, from the module path of the enclosing go.mod
+func importPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for root := abs; ; {
+		if modulePath, err := readModulePath(filepath.Join(root, "go.mod")); err == nil {
+			rel, err := filepath.Rel(root, abs)
+			if err != nil {
+				return "", err
+			}
+			return strings.TrimSuffix(modulePath+"/"+filepath.ToSlash(rel), "/."), nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		parent := filepath.Dir(root)
+		if parent == root {
+			return "", errors.New("no go.mod found in the parent directories of the output, the --import-path flag must be set")
+		}
+		root = parent
+	}
+}
+
+// readModulePath returns the module path declared in the go.mod file
+func readModulePath(goMod string) (string, error) {
+	f, err := os.Open(goMod)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "module") {
+			return strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "module")), "\""), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s: missing module directive", goMod)
+}
+
+// gofmt formats the generated files in dir
+func gofmt(dir string) error {
+	cmd := exec.Command("gofmt", "-s", "-w", dir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// runGenerate returns a cobra Run function generating the base field, then the packages built on top of it
+func runGenerate(generate ...func(F *field.FieldConfig, fieldDependency config.FieldDependency) error) func(cmd *cobra.Command, args []string) {
+	return func(cmd *cobra.Command, args []string) {
+		if err := doGenerate(cmd, generate...); err != nil {
+			fmt.Printf("\n%s\n", err.Error())
+			os.Exit(-1)
+		}
+	}
+}
+
+func doGenerate(cmd *cobra.Command, generate ...func(F *field.FieldConfig, fieldDependency config.FieldDependency) error) error {
+	F, err := generateField(cmd)
+	if err != nil || len(generate) == 0 {
+		return err
+	}
+
+	dep, err := fieldDependency(F)
+	if err != nil {
+		return err
+	}
+	for _, g := range generate {
+		if err := g(F, dep); err != nil {
+			return err
+		}
+	}
+
+	return gofmt(fOutputDir)
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	"path/filepath"
+
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
+	"github.com/consensys/gnark-crypto/internal/generator/sumcheck"
+	"github.com/consensys/gnark-crypto/internal/generator/test_vector_utils"
+	"github.com/spf13/cobra"
+)
+
+var polynomialCmd = &cobra.Command{
+	Use:   "polynomial",
+	Short: "generates the base field and the polynomial package (univariate and MultiLin) in <output>/polynomial",
+	Run:   runGenerate(generatePolynomial),
+}
+
+var sumcheckCmd = &cobra.Command{
+	Use:   "sumcheck",
+	Short: "generates the base field, the polynomial package and the sumcheck protocol in <output>/sumcheck, tested with <output>/test_vector_utils",
+	Run:   runGenerate(generatePolynomial, generateSumcheck),
+}
+
+func init() {
+	rootCmd.AddCommand(polynomialCmd)
+	rootCmd.AddCommand(sumcheckCmd)
+}
+
+func generatePolynomial(F *field.FieldConfig, fieldDependency config.FieldDependency) error {
+	return polynomial.Generate(fieldDependency, filepath.Join(fOutputDir, "polynomial"), true, bgen)
+}
+
+func generateSumcheck(F *field.FieldConfig, fieldDependency config.FieldDependency) error {
+	// the sumcheck tests depend on the test_vector_utils package of the field
+	if err := test_vector_utils.Generate(test_vector_utils.Config{FieldDependency: fieldDependency}, filepath.Join(fOutputDir, "test_vector_utils"), bgen); err != nil {
+		return err
+	}
+	return sumcheck.Generate(fieldDependency, filepath.Join(fOutputDir, "sumcheck"), true, bgen)
+}
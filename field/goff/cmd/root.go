@@ -22,15 +22,13 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/consensys/gnark-crypto/field/generator"
-	field "github.com/consensys/gnark-crypto/field/generator/config"
 	"github.com/spf13/cobra"
 )
 
 var rootCmd = &cobra.Command{
 	Use:     "goff",
 	Short:   "goff generates arithmetic operations for any moduli",
-	Run:     cmdGenerate,
+	Run:     runGenerate(),
 	Version: Version,
 }
 
@@ -53,30 +51,6 @@ func init() {
 	}
 }
 
-func cmdGenerate(cmd *cobra.Command, args []string) {
-	fmt.Println()
-	fmt.Println("running goff version", Version)
-	fmt.Println()
-
-	// parse flags
-	if err := parseFlags(cmd); err != nil {
-		_ = cmd.Usage()
-		fmt.Printf("\n%s\n", err.Error())
-		os.Exit(-1)
-	}
-
-	// generate code
-	F, err := field.NewFieldConfig(fPackageName, fElementName, fModulus, false)
-	if err != nil {
-		fmt.Printf("\n%s\n", err.Error())
-		os.Exit(-1)
-	}
-	if err := generator.GenerateFF(F, fOutputDir); err != nil {
-		fmt.Printf("\n%s\n", err.Error())
-		os.Exit(-1)
-	}
-}
-
 func parseFlags(cmd *cobra.Command) error {
 	if fModulus == "" ||
 		fOutputDir == "" ||
@@ -20,7 +20,8 @@
 //
 // The modulus is hardcoded in all the operations.
 //
-// Field elements are represented as an array, and assumed to be in Montgomery form in all methods:
+// Field elements are represented as an array, in regular (non-Montgomery) form; the multiplication
+// uses the native reduction modulo 2⁶⁴ - 2³² + 1:
 //
 //	type Element [1]uint64
 //
@@ -50,4 +51,5 @@
 // # Warning
 //
 // This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// AddCT, SubCT, InverseCT and SqrtCT avoid branching on their input and are meant for secret data, but are not audited either.
 package goldilocks
@@ -34,7 +34,8 @@ import (
 
 // Element represents a field element stored on 1 words (uint64)
 //
-// Element are assumed to be in Montgomery form in all methods.
+// Element are stored in regular (non-Montgomery) form; multiplications use the
+// native reduction modulo q = 2⁶⁴ - 2³² + 1 (2⁶⁴ = 2³² - 1 mod q and 2⁹⁶ = -1 mod q).
 //
 // Modulus q =
 //
@@ -72,10 +73,6 @@ func Modulus() *big.Int {
 	return new(big.Int).Set(&_modulus)
 }
 
-// q + r'.r = 1, i.e., qInvNeg = - q⁻¹ mod r
-// used for Montgomery reduction
-const qInvNeg uint64 = 18446744069414584319
-
 func init() {
 	_modulus.SetString("ffffffff00000001", 16)
 }
@@ -88,15 +85,15 @@ func init() {
 //	v.SetUint64(...)
 func NewElement(v uint64) Element {
 	z := Element{v}
-	z.Mul(&z, &rSquare)
+	reduce(&z)
 	return z
 }
 
 // SetUint64 sets z to v and returns z
 func (z *Element) SetUint64(v uint64) *Element {
-	//  sets z LSB to v (non-Montgomery form) and convert z to Montgomery form
+	//  sets z LSB to v and reduces it mod q (elements are stored in regular form)
 	*z = Element{v}
-	return z.Mul(z, &rSquare) // z.toMont()
+	return z.toMont()
 }
 
 // SetInt64 sets z to v and returns z
@@ -187,9 +184,9 @@ func (z *Element) SetZero() *Element {
 	return z
 }
 
-// SetOne z = 1 (in Montgomery form)
+// SetOne z = 1
 func (z *Element) SetOne() *Element {
-	z[0] = 4294967295
+	z[0] = 1
 	return z
 }
 
@@ -218,7 +215,7 @@ func (z *Element) IsZero() bool {
 
 // IsOne returns z == 1
 func (z *Element) IsOne() bool {
-	return z[0] == 4294967295
+	return z[0] == 1
 }
 
 // IsUint64 reports whether z can be represented as an uint64.
@@ -232,8 +229,6 @@ func (z *Element) Uint64() uint64 {
 }
 
 // FitsOnOneWord reports whether z words (except the least significant word) are 0
-//
-// It is the responsibility of the caller to convert from Montgomery to Regular form if needed.
 func (z *Element) FitsOnOneWord() bool {
 	return true
 }
@@ -274,6 +269,15 @@ func (z *Element) LexicographicallyLargest() bool {
 // This might error only if reading from crypto/rand.Reader errors,
 // in which case, value of z is undefined.
 func (z *Element) SetRandom() (*Element, error) {
+	return z.SetRandomFrom(rand.Reader)
+}
+
+// SetRandomFrom sets z to a uniform random value in [0, q), reading the randomness from r.
+// With a deterministic (seeded) reader, the sequence of values is reproducible.
+//
+// This might error only if reading from r errors,
+// in which case, value of z is undefined.
+func (z *Element) SetRandomFrom(r io.Reader) (*Element, error) {
 	// this code is generated for all modulus
 	// and derived from go/src/crypto/rand/util.go
 
@@ -296,7 +300,7 @@ func (z *Element) SetRandom() (*Element, error) {
 
 	for {
 		// note that bytes[k:l] is always 0
-		if _, err := io.ReadFull(rand.Reader, bytes[:k]); err != nil {
+		if _, err := io.ReadFull(r, bytes[:k]); err != nil {
 			return nil, err
 		}
 
@@ -346,8 +350,8 @@ func (z *Element) Halve() {
 
 }
 
-// fromMont converts z in place (i.e. mutates) from Montgomery to regular representation
-// sets and returns z = z * 1
+// fromMont returns z unchanged, as elements are stored in regular form (the Montgomery constant is r = 1);
+// it is kept for the code shared with the fields in Montgomery form
 func (z *Element) fromMont() *Element {
 	fromMont(z)
 	return z
@@ -407,88 +411,44 @@ func (z *Element) Select(c int, x0 *Element, x1 *Element) *Element {
 	return z
 }
 
-// _mulGeneric is unoptimized textbook CIOS
-// it is a fallback solution on x86 when ADX instruction set is not available
-// and is used for testing purposes.
-func _mulGeneric(z, x, y *Element) {
-
-	// Implements CIOS multiplication -- section 2.3.2 of Tolga Acar's thesis
-	// https://www.microsoft.com/en-us/research/wp-content/uploads/1998/06/97Acar.pdf
-	//
-	// The algorithm:
-	//
-	// for i=0 to N-1
-	// 		C := 0
-	// 		for j=0 to N-1
-	// 			(C,t[j]) := t[j] + x[j]*y[i] + C
-	// 		(t[N+1],t[N]) := t[N] + C
-	//
-	// 		C := 0
-	// 		m := t[0]*q'[0] mod D
-	// 		(C,_) := t[0] + m*q[0]
-	// 		for j=1 to N-1
-	// 			(C,t[j-1]) := t[j] + m*q[j] + C
-	//
-	// 		(C,t[N-1]) := t[N] + C
-	// 		t[N] := t[N+1] + C
-	//
-	// → N is the number of machine words needed to store the modulus q
-	// → D is the word size. For example, on a 64-bit architecture D is 2	64
-	// → x[i], y[i], q[i] is the ith word of the numbers x,y,q
-	// → q'[0] is the lowest word of the number -q⁻¹ mod r. This quantity is pre-computed, as it does not depend on the inputs.
-	// → t is a temporary array of size N+2
-	// → C, S are machine words. A pair (C,S) refers to (hi-bits, lo-bits) of a two-word number
-
-	var t [2]uint64
-	var D uint64
-	var m, C uint64
-	// -----------------------------------
-	// First loop
-
-	C, t[0] = bits.Mul64(y[0], x[0])
-
-	t[1], D = bits.Add64(t[1], C, 0)
-
-	// m = t[0]n'[0] mod W
-	m = t[0] * qInvNeg
-
-	// -----------------------------------
-	// Second loop
-	C = madd0(m, q0, t[0])
+// AddCT z = x + y (mod q), without branching on x or y (as Add does);
+// it is meant for secret operands.
+func (z *Element) AddCT(x, y *Element) *Element {
+	var carry uint64
+	z[0], carry = bits.Add64(x[0], y[0], 0)
 
-	t[0], C = bits.Add64(t[1], C, 0)
-	t[1], _ = bits.Add64(0, D, C)
+	// if x + y ⩾ q → z -= q, without branching on z
+	var s [1]uint64
+	var b uint64
+	s[0], b = bits.Sub64(z[0], q0, 0)
+	// mask = 0xFF..FF if the addition overflowed or the subtraction didn't borrow, 0 otherwise
+	mask := -(carry | (b ^ 1))
+	z[0] ^= mask & (z[0] ^ s[0])
+	return z
+}
 
-	if t[1] != 0 {
-		// we need to reduce, we have a result on 2 words
-		z[0], _ = bits.Sub64(t[0], q0, 0)
-		return
-	}
+// SubCT z = x - y (mod q), without branching on x or y (as Sub does);
+// it is meant for secret operands.
+func (z *Element) SubCT(x, y *Element) *Element {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
 
-	// copy t into z
-	z[0] = t[0]
+	// if x < y → z += q, without branching on z
+	// mask = 0xFF..FF if the subtraction borrowed, 0 otherwise
+	mask := -b
+	z[0] += q0 & mask
+	return z
+}
 
-	// if z ⩾ q → z -= q
-	if !z.smallerThanModulus() {
-		z[0] -= q
-	}
+// _mulGeneric sets z = x * y (mod q) with a 128-bit remainder;
+// it is used for testing purposes.
+func _mulGeneric(z, x, y *Element) {
+	hi, lo := bits.Mul64(x[0], y[0])
+	z[0] = bits.Rem64(hi, lo, q)
 }
 
 func _fromMontGeneric(z *Element) {
-	// the following lines implement z = z * 1
-	// with a modified CIOS montgomery multiplication
-	// see Mul for algorithm documentation
-	{
-		// m = z[0]n'[0] mod W
-		m := z[0] * qInvNeg
-		C := madd0(m, q0, z[0])
-		z[0] = C
-	}
-
-	// if z ⩾ q → z -= q
-	if !z.smallerThanModulus() {
-		z[0] -= q
-	}
+	// elements are stored in regular form
 }
 
 func _reduceGeneric(z *Element) {
@@ -545,15 +505,18 @@ func (z *Element) BitLen() int {
 }
 
 // Hash msg to count prime field elements.
-// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-5.2
-func Hash(msg, dst []byte, count int) ([]Element, error) {
-	// 128 bits of security
-	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter = 128
-	const Bytes = 1 + (Bits-1)/8
-	const L = 16 + Bytes
+// https://www.rfc-editor.org/rfc/rfc9380#section-5.2
+//
+// By default, expand_message_xmd with SHA-256 is used with a security level of 128 bits;
+// the ciphersuite can be changed with hash.Option (e.g. hash.WithExpandMsgXof).
+func Hash(msg, dst []byte, count int, opts ...hash.Option) ([]Element, error) {
+	cfg := hash.NewConfig(opts...)
+
+	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter
+	L := (Bits + cfg.SecurityLevel + 7) / 8
 
 	lenInBytes := count * L
-	pseudoRandomBytes, err := hash.ExpandMsgXmd(msg, dst, lenInBytes)
+	pseudoRandomBytes, err := cfg.Expand(msg, dst, lenInBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -604,17 +567,17 @@ func (z *Element) Exp(x Element, k *big.Int) *Element {
 	return z
 }
 
-// rSquare where r is the Montgommery constant
-// see section 2.3.2 of Tolga Acar's thesis
-// https://www.microsoft.com/en-us/research/wp-content/uploads/1998/06/97Acar.pdf
+// rSquare = 1, as elements are stored in regular form (the Montgomery constant is r = 1)
 var rSquare = Element{
-	18446744065119617025,
+	1,
 }
 
-// toMont converts z to Montgomery form
-// sets and returns z = z * r²
+// toMont reduces z mod q and returns z: elements are stored in regular form (the Montgomery
+// constant is r = 1), so there is no conversion to do
 func (z *Element) toMont() *Element {
-	return z.Mul(z, &rSquare)
+	// elements are stored in regular form, r = 1
+	reduce(z)
+	return z
 }
 
 // String returns the decimal representation of z as generated by
@@ -623,7 +586,7 @@ func (z *Element) String() string {
 	return z.Text(10)
 }
 
-// toBigInt returns z as a big.Int in Montgomery form
+// toBigInt returns z as a big.Int in regular form
 func (z *Element) toBigInt(res *big.Int) *big.Int {
 	var b [Bytes]byte
 	binary.BigEndian.PutUint64(b[0:8], z[0])
@@ -954,19 +917,77 @@ func (z *Element) Sqrt(x *Element) *Element {
 	// see modSqrtTonelliShanks in math/big/int.go
 	// using https://www.maa.org/sites/default/files/pdf/upload_library/22/Polya/07468342.di020786.02p0470a.pdf
 
-	var y, b, t, w Element
 	// w = x^((s-1)/2))
+	var w Element
 	w.expBySqrtExp(*x)
+	return z.sqrtFromExp(x, &w)
+}
+
+// SqrtCT z = √x (mod q) in constant time: the sequence of operations doesn't depend on x,
+// only the returned value reveals whether x is a square. It is suitable for secret data.
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil
+func (z *Element) SqrtCT(x *Element) *Element {
+	// constant-time Tonelli-Shanks with a fixed number of iterations and conditional moves
+	// see RFC 9380, appendix I.4
+
+	// w = x^((s-1)/2))
+	var w Element
+	w.expBySqrtExp(*x)
+
+	var y, t, b, yt, tt, one Element
+	one.SetOne()
 
 	// y = x^((s+1)/2)) = w * x
 	y.Mul(x, &w)
 
+	// t = xˢ = w * y
+	t.Mul(&w, &y)
+
+	// c = nonResidue ^ s
+	var c = Element{
+		1753635133440165772,
+	}
+
+	for i := 32; i >= 2; i-- {
+		b = t
+		for j := 1; j <= i-2; j++ {
+			b.Square(&b)
+		}
+		// notOne = 0 if b == 1, 1 otherwise
+		notOne := b.NotEqual(&one)
+		notOne = (notOne | -notOne) >> 63
+
+		yt.Mul(&y, &c)
+		y.Select(int(notOne), &y, &yt)
+		c.Square(&c)
+		tt.Mul(&t, &c)
+		t.Select(int(notOne), &t, &tt)
+	}
+
+	// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
+	var square Element
+	square.Square(&y)
+	if square.Equal(x) {
+		return z.Set(&y)
+	}
+	return nil
+}
+
+// sqrtFromExp sets z = √x (mod q) and returns z, or returns nil if x is not a square,
+// given w = x^((s-1)/2), where q - 1 = 2ᵉ·s with s odd (see Sqrt)
+func (z *Element) sqrtFromExp(x, w *Element) *Element {
+	var y, b, t Element
+
+	// y = x^((s+1)/2)) = w * x
+	y.Mul(x, w)
+
 	// b = xˢ = w * w * x = y * x
-	b.Mul(&w, &y)
+	b.Mul(w, &y)
 
 	// g = nonResidue ^ s
 	var g = Element{
-		15733474329512464024,
+		1753635133440165772,
 	}
 	r := uint64(32)
 
@@ -1011,6 +1032,69 @@ func (z *Element) Sqrt(x *Element) *Element {
 	}
 }
 
+// batchExpSize is the number of elements whose exponentiations are computed together
+// by BatchSqrt and BatchLegendre; it bounds the size of the temporary vectors.
+const batchExpSize = 256
+
+// BatchLegendre returns the Legendre symbols of the elements of a (see Legendre).
+//
+// The exponentiations share the same addition chain and are computed on vectors
+// of elements, in parallel.
+func BatchLegendre(a []Element) []int {
+	res := make([]int, len(a))
+	batchExp(a, vectorExpByLegendreExp, vectorExpByLegendreExpNbTemporaries, func(i int, l *Element) {
+		switch {
+		case l.IsZero():
+			res[i] = 0
+		case l.IsOne():
+			res[i] = 1
+		default:
+			res[i] = -1
+		}
+	})
+	return res
+}
+
+// BatchSqrt returns the square roots of the elements of a (see Sqrt), and for each of them
+// whether it is a square; if a[i] is not a square, res[i] is 0 and isSquare[i] is false.
+//
+// The exponentiations share the same addition chain and are computed on vectors
+// of elements, in parallel.
+func BatchSqrt(a []Element) (res []Element, isSquare []bool) {
+	res = make([]Element, len(a))
+	isSquare = make([]bool, len(a))
+	batchExp(a, vectorExpBySqrtExp, vectorExpBySqrtExpNbTemporaries, func(i int, w *Element) {
+		isSquare[i] = res[i].sqrtFromExp(&a[i], w) != nil
+	})
+	return
+}
+
+// batchExp calls f(i, aᵢᵏ) for all i, where the exponentiations are computed in parallel
+// by chunks of batchExpSize elements.
+// exp sets z[j] = x[j]ᵏ for all j, with z and x not overlapping, using nbTemporaries
+// temporary vectors from scratch.
+func batchExp(a []Element, exp func(z, x, scratch Vector), nbTemporaries int, f func(i int, ak *Element)) {
+	execute(len(a), func(start, end int) {
+		// the result and the temporaries of all the chunks of the worker share one buffer
+		size := end - start
+		if size > batchExpSize {
+			size = batchExpSize
+		}
+		buf := make(Vector, (nbTemporaries+1)*size)
+		for i := start; i < end; i += batchExpSize {
+			n := end - i
+			if n > batchExpSize {
+				n = batchExpSize
+			}
+			ak := buf[:n]
+			exp(ak, Vector(a[i:i+n]), buf[size:size+nbTemporaries*n])
+			for j := range ak {
+				f(i+j, &ak[j])
+			}
+		}
+	})
+}
+
 // Inverse z = x⁻¹ (mod q)
 //
 // if x == 0, sets and returns z = x
@@ -1024,7 +1108,7 @@ func (z *Element) Inverse(x *Element) *Element {
 
 	var r, s, u, v uint64
 	u = q
-	s = 18446744065119617025 // s = r²
+	s = 1 // s = r²
 	r = 0
 	v = x[0]
 
@@ -1078,3 +1162,49 @@ func (z *Element) Inverse(x *Element) *Element {
 
 	return z
 }
+
+// InverseCT z = x⁻¹ (mod q) in constant time, computed as x^(q-2) (Fermat's little theorem);
+// unlike Inverse, the sequence of operations doesn't depend on x and it is suitable for secret data.
+//
+// if x == 0, sets and returns z = x
+func (z *Element) InverseCT(x *Element) *Element {
+	return z.expByInverseExp(*x)
+}
+
+// UnreducedAccumulator accumulates sums of products of field elements on 3 words,
+// without reducing them modulo q: MulAcc costs a bare multiplication, and the modular
+// reduction is done once, by Reduce.
+//
+// The zero value is an empty accumulator; it can hold up to 2⁶³ products.
+type UnreducedAccumulator struct {
+	t [3]uint64
+}
+
+// MulAcc sets acc = acc + x * y, without modular reduction
+func (acc *UnreducedAccumulator) MulAcc(x, y *Element) {
+	var t [2]uint64
+
+	// t = x * y (schoolbook)
+	var C uint64
+	C, t[0] = bits.Mul64(x[0], y[0])
+	t[1] = C
+
+	// acc = acc + t
+	var carry uint64
+	acc.t[0], carry = bits.Add64(acc.t[0], t[0], carry)
+	acc.t[1], carry = bits.Add64(acc.t[1], t[1], carry)
+	acc.t[2] += carry
+}
+
+// Reduce sets z = acc (mod q) and returns z; acc is left unchanged
+func (acc *UnreducedAccumulator) Reduce(z *Element) *Element {
+	// elements are in regular form, acc holds Σ xᵢyᵢ
+	r := bits.Rem64(acc.t[2], acc.t[1], q0)
+	z[0] = bits.Rem64(r, acc.t[0], q0)
+	return z
+}
+
+// Reset empties the accumulator
+func (acc *UnreducedAccumulator) Reset() {
+	acc.t = [3]uint64{}
+}
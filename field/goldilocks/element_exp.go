@@ -98,6 +98,74 @@ func (z *Element) expBySqrtExp(x Element) *Element {
 	return z
 }
 
+// vectorExpBySqrtExpNbTemporaries is the number of temporary vectors used by vectorExpBySqrtExp
+const vectorExpBySqrtExpNbTemporaries = 2
+
+// vectorExpBySqrtExp sets z[i] = x[i]^7fffffff for all i, with the addition chain of expBySqrtExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpBySqrtExpNbTemporaries * len(x).
+func vectorExpBySqrtExp(z, x, scratch Vector) {
+	n := len(x)
+	var (
+		t0 = scratch[0*n : 0*n+n]
+		t1 = scratch[1*n : 1*n+n]
+	)
+
+	// Step 1: z = x^0x2
+	z.Mul(x, x)
+
+	// Step 2: z = x^0x3
+	z.Mul(x, z)
+
+	// Step 3: z = x^0x6
+	z.Mul(z, z)
+
+	// Step 4: z = x^0x7
+	z.Mul(x, z)
+
+	// Step 7: t0 = x^0x38
+	t0.Mul(z, z)
+	for s := 1; s < 3; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 8: t0 = x^0x3f
+	t0.Mul(z, t0)
+
+	// Step 9: t1 = x^0x7e
+	t1.Mul(t0, t0)
+
+	// Step 10: z = x^0x7f
+	z.Mul(x, t1)
+
+	// Step 15: t1 = x^0xfc0
+	for s := 0; s < 5; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 16: t0 = x^0xfff
+	t0.Mul(t0, t1)
+
+	// Step 28: t1 = x^0xfff000
+	t1.Mul(t0, t0)
+	for s := 1; s < 12; s++ {
+		t1.Mul(t1, t1)
+	}
+
+	// Step 29: t0 = x^0xffffff
+	t0.Mul(t0, t1)
+
+	// Step 36: t0 = x^0x7fffff80
+	for s := 0; s < 7; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 37: z = x^0x7fffffff
+	z.Mul(z, t0)
+
+}
+
 // expByLegendreExp is equivalent to z.Exp(x, 7fffffff80000000)
 //
 // uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
@@ -171,3 +239,163 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	return z
 }
+
+// vectorExpByLegendreExpNbTemporaries is the number of temporary vectors used by vectorExpByLegendreExp
+const vectorExpByLegendreExpNbTemporaries = 1
+
+// vectorExpByLegendreExp sets z[i] = x[i]^7fffffff80000000 for all i, with the addition chain of expByLegendreExp;
+// the multiplications of a step are independent and computed with Vector.Mul.
+// z and x must have the same length and must not overlap; the temporaries are taken from scratch,
+// of length at least vectorExpByLegendreExpNbTemporaries * len(x).
+func vectorExpByLegendreExp(z, x, scratch Vector) {
+	n := len(x)
+	var (
+		t0 = scratch[0*n : 0*n+n]
+	)
+
+	// Step 1: z = x^0x2
+	z.Mul(x, x)
+
+	// Step 2: z = x^0x3
+	z.Mul(x, z)
+
+	// Step 4: t0 = x^0xc
+	t0.Mul(z, z)
+	for s := 1; s < 2; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 5: z = x^0xf
+	z.Mul(z, t0)
+
+	// Step 9: t0 = x^0xf0
+	t0.Mul(z, z)
+	for s := 1; s < 4; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 10: z = x^0xff
+	z.Mul(z, t0)
+
+	// Step 18: t0 = x^0xff00
+	t0.Mul(z, z)
+	for s := 1; s < 8; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 19: z = x^0xffff
+	z.Mul(z, t0)
+
+	// Step 35: t0 = x^0xffff0000
+	t0.Mul(z, z)
+	for s := 1; s < 16; s++ {
+		t0.Mul(t0, t0)
+	}
+
+	// Step 36: z = x^0xffffffff
+	z.Mul(z, t0)
+
+	// Step 67: z = x^0x7fffffff80000000
+	for s := 0; s < 31; s++ {
+		z.Mul(z, z)
+	}
+
+}
+
+// expByInverseExp is equivalent to z.Exp(x, fffffffeffffffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_110     = 2*_11
+	//	_111     = 1 + _110
+	//	_111000  = _111 << 3
+	//	_111111  = _111 + _111000
+	//	_1111110 = 2*_111111
+	//	_1111111 = 1 + _1111110
+	//	x12      = _1111110 << 5 + _111111
+	//	x24      = x12 << 12 + x12
+	//	x31      = x24 << 7 + _1111111
+	//	return     2*(x31 << 32 + x31) + 1
+	//
+	// Operations: 63 squares 9 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+	)
+
+	// var t0,t1 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: z = x^0x3
+	z.Mul(&x, z)
+
+	// Step 3: z = x^0x6
+	z.Square(z)
+
+	// Step 4: z = x^0x7
+	z.Mul(&x, z)
+
+	// Step 7: t0 = x^0x38
+	t0.Square(z)
+	for s := 1; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 8: t0 = x^0x3f
+	t0.Mul(z, t0)
+
+	// Step 9: t1 = x^0x7e
+	t1.Square(t0)
+
+	// Step 10: z = x^0x7f
+	z.Mul(&x, t1)
+
+	// Step 15: t1 = x^0xfc0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 16: t0 = x^0xfff
+	t0.Mul(t0, t1)
+
+	// Step 28: t1 = x^0xfff000
+	t1.Square(t0)
+	for s := 1; s < 12; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 29: t0 = x^0xffffff
+	t0.Mul(t0, t1)
+
+	// Step 36: t0 = x^0x7fffff80
+	for s := 0; s < 7; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 37: z = x^0x7fffffff
+	z.Mul(z, t0)
+
+	// Step 69: t0 = x^0x7fffffff00000000
+	t0.Square(z)
+	for s := 1; s < 32; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 70: z = x^0x7fffffff7fffffff
+	z.Mul(z, t0)
+
+	// Step 71: z = x^0xfffffffefffffffe
+	z.Square(z)
+
+	// Step 72: z = x^0xfffffffeffffffff
+	z.Mul(&x, z)
+
+	return z
+}
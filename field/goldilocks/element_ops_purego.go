@@ -58,32 +58,25 @@ func reduce(z *Element) {
 // Mul z = x * y (mod q)
 func (z *Element) Mul(x, y *Element) *Element {
 
-	// In fact, since the modulus R fits on one register, the CIOS algorithm gets reduced to standard REDC (textbook Montgomery reduction):
-	// hi, lo := x * y
-	// m := (lo * qInvNeg) mod R
-	// (*) r := (hi * R + lo + m * q) / R
-	// reduce r if necessary
-
-	// On the emphasized line, we get r = hi + (lo + m * q) / R
-	// If we write hi2, lo2 = m * q then R | m * q - lo2 ⇒ R | (lo * qInvNeg) q - lo2 = -lo - lo2
-	// This shows lo + lo2 = 0 mod R. i.e. lo + lo2 = 0 if lo = 0 and R otherwise.
-	// Which finally gives (lo + m * q) / R = (lo + lo2 + R hi2) / R = hi2 + (lo+lo2) / R = hi2 + (lo != 0)
-	// This "optimization" lets us do away with one MUL instruction on ARM architectures and is available for all q < R.
-
-	var r uint64
+	// Elements are in regular form and q = 2⁶⁴ - 2³² + 1, so that 2⁶⁴ = 2³² - 1 (mod q) and 2⁹⁶ = -1 (mod q).
+	// Writing x * y = (h₁ * 2³² + h₀) * 2⁶⁴ + lo, we get x * y = lo - h₁ + h₀ * (2³² - 1) (mod q).
+	// Multiplications by ε = 2³² - 1 are computed with shifts and masks.
 	hi, lo := bits.Mul64(x[0], y[0])
-	if lo != 0 {
-		hi++ // x[0] * y[0] ≤ 2¹²⁸ - 2⁶⁵ + 1, meaning hi ≤ 2⁶⁴ - 2 so no need to worry about overflow
-	}
-	m := lo * qInvNeg
-	hi2, _ := bits.Mul64(m, q)
-	r, carry := bits.Add64(hi2, hi, 0)
-
-	if carry != 0 || r >= q {
-		// we need to reduce
-		r -= q
-	}
-	z[0] = r
+
+	// The corrections below use masks rather than branches, so that Mul and Square run in constant time.
+
+	// t0 = lo - h₁; on borrow we added 2⁶⁴ = ε, which we subtract (lo - h₁ + 2⁶⁴ ⩾ ε)
+	t0, borrow := bits.Sub64(lo, hi>>32, 0)
+	t0 -= 0xffffffff & -borrow
+
+	// t1 = h₀ * ε < 2⁶⁴; on carry we dropped 2⁶⁴ = ε, which we add back (t0 + t1 - 2⁶⁴ < 2⁶⁴ - ε)
+	t1 := (hi << 32) - (hi & 0xffffffff)
+	r, carry := bits.Add64(t0, t1, 0)
+	r += 0xffffffff & -carry
+
+	// if r ⩾ q → r -= q
+	s, b := bits.Sub64(r, q, 0)
+	z[0] = r ^ ((b - 1) & (r ^ s))
 
 	return z
 }
@@ -92,32 +85,25 @@ func (z *Element) Mul(x, y *Element) *Element {
 func (z *Element) Square(x *Element) *Element {
 	// see Mul for algorithm documentation
 
-	// In fact, since the modulus R fits on one register, the CIOS algorithm gets reduced to standard REDC (textbook Montgomery reduction):
-	// hi, lo := x * y
-	// m := (lo * qInvNeg) mod R
-	// (*) r := (hi * R + lo + m * q) / R
-	// reduce r if necessary
+	// Elements are in regular form and q = 2⁶⁴ - 2³² + 1, so that 2⁶⁴ = 2³² - 1 (mod q) and 2⁹⁶ = -1 (mod q).
+	// Writing x * y = (h₁ * 2³² + h₀) * 2⁶⁴ + lo, we get x * y = lo - h₁ + h₀ * (2³² - 1) (mod q).
+	// Multiplications by ε = 2³² - 1 are computed with shifts and masks.
+	hi, lo := bits.Mul64(x[0], x[0])
 
-	// On the emphasized line, we get r = hi + (lo + m * q) / R
-	// If we write hi2, lo2 = m * q then R | m * q - lo2 ⇒ R | (lo * qInvNeg) q - lo2 = -lo - lo2
-	// This shows lo + lo2 = 0 mod R. i.e. lo + lo2 = 0 if lo = 0 and R otherwise.
-	// Which finally gives (lo + m * q) / R = (lo + lo2 + R hi2) / R = hi2 + (lo+lo2) / R = hi2 + (lo != 0)
-	// This "optimization" lets us do away with one MUL instruction on ARM architectures and is available for all q < R.
+	// The corrections below use masks rather than branches, so that Mul and Square run in constant time.
 
-	var r uint64
-	hi, lo := bits.Mul64(x[0], x[0])
-	if lo != 0 {
-		hi++ // x[0] * y[0] ≤ 2¹²⁸ - 2⁶⁵ + 1, meaning hi ≤ 2⁶⁴ - 2 so no need to worry about overflow
-	}
-	m := lo * qInvNeg
-	hi2, _ := bits.Mul64(m, q)
-	r, carry := bits.Add64(hi2, hi, 0)
-
-	if carry != 0 || r >= q {
-		// we need to reduce
-		r -= q
-	}
-	z[0] = r
+	// t0 = lo - h₁; on borrow we added 2⁶⁴ = ε, which we subtract (lo - h₁ + 2⁶⁴ ⩾ ε)
+	t0, borrow := bits.Sub64(lo, hi>>32, 0)
+	t0 -= 0xffffffff & -borrow
+
+	// t1 = h₀ * ε < 2⁶⁴; on carry we dropped 2⁶⁴ = ε, which we add back (t0 + t1 - 2⁶⁴ < 2⁶⁴ - ε)
+	t1 := (hi << 32) - (hi & 0xffffffff)
+	r, carry := bits.Add64(t0, t1, 0)
+	r += 0xffffffff & -carry
+
+	// if r ⩾ q → r -= q
+	s, b := bits.Sub64(r, q, 0)
+	z[0] = r ^ ((b - 1) & (r ^ s))
 
 	return z
 }
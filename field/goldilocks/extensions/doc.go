@@ -0,0 +1,22 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package extensions provides field extensions of goldilocks.Element of the form 𝔽[u]/(uⁿ - α).
+//
+// Each extension implements the field arithmetic (Mul, Square, Inverse, Exp), the Frobenius map and
+// square roots, and comes with a vector type, such that protocols (FRI, sumcheck, ...) can draw
+// their challenges in an extension.
+package extensions
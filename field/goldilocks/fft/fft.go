@@ -0,0 +1,440 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/consensys/gnark-crypto/utils"
+	"math/bits"
+)
+
+// Decimation is used in the FFT call to select decimation in time or in frequency
+type Decimation uint8
+
+const (
+	DIT Decimation = iota
+	DIF
+)
+
+// parallelize threshold for a single butterfly op, if the fft stage is not parallelized already
+const butterflyThreshold = 16
+
+// FFT computes (recursively) the discrete Fourier transform of a and stores the result in a
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+func (domain *Domain) FFT(a []goldilocks.Element, decimation Decimation, opts ...Option) {
+
+	opt := options(opts...)
+
+	// if coset != 0, scale by coset table
+	if opt.coset {
+		if decimation == DIT {
+			// scale by coset table (in bit reversed order)
+			utils.Parallelize(len(a), func(start, end int) {
+				n := uint64(len(a))
+				nn := uint64(64 - bits.TrailingZeros64(n))
+				for i := start; i < end; i++ {
+					irev := int(bits.Reverse64(uint64(i)) >> nn)
+					a[i].Mul(&a[i], &domain.CosetTable[irev])
+				}
+			}, opt.nbTasks)
+		} else {
+			utils.Parallelize(len(a), func(start, end int) {
+				v := goldilocks.Vector(a[start:end])
+				v.Mul(v, domain.CosetTable[start:end])
+			}, opt.nbTasks)
+		}
+	}
+
+	// find the stage where we should stop spawning go routines in our recursive calls
+	// (ie when we have as many go routines running as we have available CPUs)
+	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(opt.nbTasks)))
+	if opt.nbTasks == 1 {
+		maxSplits = -1
+	}
+
+	switch decimation {
+	case DIF:
+		if !opt.radix2 {
+			difFFTRadix4(a, domain.Twiddles, 0, maxSplits, nil, opt.nbTasks)
+			break
+		}
+		difFFT(a, domain.Twiddles, 0, maxSplits, nil, opt.nbTasks)
+	case DIT:
+		if !opt.radix2 {
+			ditFFTRadix4(a, domain.Twiddles, 0, maxSplits, nil, opt.nbTasks)
+			break
+		}
+		ditFFT(a, domain.Twiddles, 0, maxSplits, nil, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+}
+
+// FFTInverse computes (recursively) the inverse discrete Fourier transform of a and stores the result in a
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+// coset sets the shift of the fft (0 = no shift, standard fft)
+// len(a) must be a power of 2, and w must be a len(a)th root of unity in field F.
+func (domain *Domain) FFTInverse(a []goldilocks.Element, decimation Decimation, opts ...Option) {
+	opt := options(opts...)
+
+	// find the stage where we should stop spawning go routines in our recursive calls
+	// (ie when we have as many go routines running as we have available CPUs)
+	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(opt.nbTasks)))
+	if opt.nbTasks == 1 {
+		maxSplits = -1
+	}
+	switch decimation {
+	case DIF:
+		if !opt.radix2 {
+			difFFTRadix4(a, domain.TwiddlesInv, 0, maxSplits, nil, opt.nbTasks)
+			break
+		}
+		difFFT(a, domain.TwiddlesInv, 0, maxSplits, nil, opt.nbTasks)
+	case DIT:
+		if !opt.radix2 {
+			ditFFTRadix4(a, domain.TwiddlesInv, 0, maxSplits, nil, opt.nbTasks)
+			break
+		}
+		ditFFT(a, domain.TwiddlesInv, 0, maxSplits, nil, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+
+	// scale by CardinalityInv
+	if !opt.coset {
+		utils.Parallelize(len(a), func(start, end int) {
+			v := goldilocks.Vector(a[start:end])
+			v.ScalarMul(v, &domain.CardinalityInv)
+		}, opt.nbTasks)
+		return
+	}
+
+	if decimation == DIT {
+		utils.Parallelize(len(a), func(start, end int) {
+			v := goldilocks.Vector(a[start:end])
+			v.Mul(v, domain.CosetTableInv[start:end])
+			v.ScalarMul(v, &domain.CardinalityInv)
+		}, opt.nbTasks)
+		return
+	}
+
+	// decimation == DIF, need to access coset table in bit reversed order.
+	utils.Parallelize(len(a), func(start, end int) {
+		n := uint64(len(a))
+		nn := uint64(64 - bits.TrailingZeros64(n))
+		for i := start; i < end; i++ {
+			irev := int(bits.Reverse64(uint64(i)) >> nn)
+			a[i].Mul(&a[i], &domain.CosetTableInv[irev]).
+				Mul(&a[i], &domain.CardinalityInv)
+		}
+	}, opt.nbTasks)
+
+}
+
+func difFFT(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage, maxSplits int, chDone chan struct{}, nbTasks int) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+
+	n := len(a)
+	if n == 1 {
+		return
+	} else if n == 8 {
+		kerDIF8(a, twiddles, stage)
+		return
+	}
+	m := n >> 1
+
+	// if stage < maxSplits, we parallelize this butterfly
+	// but we have only numCPU / stage cpus available
+	if (m > butterflyThreshold) && (stage < maxSplits) {
+		// 1 << stage == estimated used CPUs
+		numCPU := nbTasks / (1 << (stage))
+		utils.Parallelize(m, func(start, end int) {
+			innerDIFWithTwiddles(a, twiddles[stage], start, end, m)
+		}, numCPU)
+	} else {
+		innerDIFWithTwiddles(a, twiddles[stage], 0, m, m)
+	}
+
+	if m == 1 {
+		return
+	}
+
+	nextStage := stage + 1
+	if stage < maxSplits {
+		chDone := make(chan struct{}, 1)
+		go difFFT(a[m:n], twiddles, nextStage, maxSplits, chDone, nbTasks)
+		difFFT(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		<-chDone
+	} else {
+		difFFT(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		difFFT(a[m:n], twiddles, nextStage, maxSplits, nil, nbTasks)
+	}
+
+}
+
+func ditFFT(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage, maxSplits int, chDone chan struct{}, nbTasks int) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+	n := len(a)
+	if n == 1 {
+		return
+	} else if n == 8 {
+		kerDIT8(a, twiddles, stage)
+		return
+	}
+	m := n >> 1
+
+	nextStage := stage + 1
+
+	if stage < maxSplits {
+		// that's the only time we fire go routines
+		chDone := make(chan struct{}, 1)
+		go ditFFT(a[m:], twiddles, nextStage, maxSplits, chDone, nbTasks)
+		ditFFT(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		<-chDone
+	} else {
+		ditFFT(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		ditFFT(a[m:n], twiddles, nextStage, maxSplits, nil, nbTasks)
+
+	}
+
+	// if stage < maxSplits, we parallelize this butterfly
+	// but we have only numCPU / stage cpus available
+	if (m > butterflyThreshold) && (stage < maxSplits) {
+		// 1 << stage == estimated used CPUs
+		numCPU := nbTasks / (1 << (stage))
+		utils.Parallelize(m, func(start, end int) {
+			innerDITWithTwiddles(a, twiddles[stage], start, end, m)
+		}, numCPU)
+
+	} else {
+		innerDITWithTwiddles(a, twiddles[stage], 0, m, m)
+	}
+}
+
+// difFFTRadix4 is difFFT with pairs of stages fused in radix-4 butterflies,
+// so that a is processed in half as many passes; the output is the same as difFFT
+func difFFTRadix4(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage, maxSplits int, chDone chan struct{}, nbTasks int) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+
+	n := len(a)
+	if n < 32 {
+		difFFT(a, twiddles, stage, maxSplits, nil, nbTasks)
+		return
+	}
+	m := n >> 2
+
+	// if stage < maxSplits, we parallelize this butterfly
+	// but we have only numCPU / stage cpus available
+	if (m > butterflyThreshold) && (stage < maxSplits) {
+		// 1 << stage == estimated used CPUs
+		numCPU := nbTasks / (1 << (stage))
+		utils.Parallelize(m, func(start, end int) {
+			innerDIFRadix4(a, twiddles[stage], twiddles[stage+1], start, end, m)
+		}, numCPU)
+	} else {
+		innerDIFRadix4(a, twiddles[stage], twiddles[stage+1], 0, m, m)
+	}
+
+	nextStage := stage + 2
+	if stage < maxSplits {
+		var chDones [3]chan struct{}
+		for k := 1; k < 4; k++ {
+			chDones[k-1] = make(chan struct{}, 1)
+			go difFFTRadix4(a[k*m:(k+1)*m], twiddles, nextStage, maxSplits, chDones[k-1], nbTasks)
+		}
+		difFFTRadix4(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		for _, chDone := range chDones {
+			<-chDone
+		}
+	} else {
+		for k := 0; k < 4; k++ {
+			difFFTRadix4(a[k*m:(k+1)*m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		}
+	}
+}
+
+// ditFFTRadix4 is ditFFT with pairs of stages fused in radix-4 butterflies,
+// so that a is processed in half as many passes; the output is the same as ditFFT
+func ditFFTRadix4(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage, maxSplits int, chDone chan struct{}, nbTasks int) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+
+	n := len(a)
+	if n < 32 {
+		ditFFT(a, twiddles, stage, maxSplits, nil, nbTasks)
+		return
+	}
+	m := n >> 2
+
+	nextStage := stage + 2
+	if stage < maxSplits {
+		// that's the only time we fire go routines
+		var chDones [3]chan struct{}
+		for k := 1; k < 4; k++ {
+			chDones[k-1] = make(chan struct{}, 1)
+			go ditFFTRadix4(a[k*m:(k+1)*m], twiddles, nextStage, maxSplits, chDones[k-1], nbTasks)
+		}
+		ditFFTRadix4(a[0:m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		for _, chDone := range chDones {
+			<-chDone
+		}
+	} else {
+		for k := 0; k < 4; k++ {
+			ditFFTRadix4(a[k*m:(k+1)*m], twiddles, nextStage, maxSplits, nil, nbTasks)
+		}
+	}
+
+	// if stage < maxSplits, we parallelize this butterfly
+	// but we have only numCPU / stage cpus available
+	if (m > butterflyThreshold) && (stage < maxSplits) {
+		// 1 << stage == estimated used CPUs
+		numCPU := nbTasks / (1 << (stage))
+		utils.Parallelize(m, func(start, end int) {
+			innerDITRadix4(a, twiddles[stage], twiddles[stage+1], start, end, m)
+		}, numCPU)
+	} else {
+		innerDITRadix4(a, twiddles[stage], twiddles[stage+1], 0, m, m)
+	}
+}
+
+// innerDIFRadix4 applies, for start ≤ i < end, the DIF butterflies of two consecutive stages
+// on a[i], a[i+m], a[i+2m], a[i+3m]; w0 and w1 are the twiddles of the two stages
+func innerDIFRadix4(a []goldilocks.Element, w0, w1 []goldilocks.Element, start, end, m int) {
+	for i := start; i < end; i++ {
+		a0, a1, a2, a3 := &a[i], &a[i+m], &a[i+2*m], &a[i+3*m]
+
+		// first stage: (a0, a2) and (a1, a3)
+		goldilocks.Butterfly(a0, a2)
+		goldilocks.Butterfly(a1, a3)
+		a2.Mul(a2, &w0[i])
+		a3.Mul(a3, &w0[i+m])
+
+		// second stage: (a0, a1) and (a2, a3)
+		goldilocks.Butterfly(a0, a1)
+		goldilocks.Butterfly(a2, a3)
+		a1.Mul(a1, &w1[i])
+		a3.Mul(a3, &w1[i])
+	}
+}
+
+// innerDITRadix4 applies, for start ≤ i < end, the DIT butterflies of two consecutive stages
+// on a[i], a[i+m], a[i+2m], a[i+3m]; w0 and w1 are the twiddles of the two stages
+func innerDITRadix4(a []goldilocks.Element, w0, w1 []goldilocks.Element, start, end, m int) {
+	for i := start; i < end; i++ {
+		a0, a1, a2, a3 := &a[i], &a[i+m], &a[i+2*m], &a[i+3*m]
+
+		// second stage: (a0, a1) and (a2, a3)
+		a1.Mul(a1, &w1[i])
+		a3.Mul(a3, &w1[i])
+		goldilocks.Butterfly(a0, a1)
+		goldilocks.Butterfly(a2, a3)
+
+		// first stage: (a0, a2) and (a1, a3)
+		a2.Mul(a2, &w0[i])
+		a3.Mul(a3, &w0[i+m])
+		goldilocks.Butterfly(a0, a2)
+		goldilocks.Butterfly(a1, a3)
+	}
+}
+
+// innerDIFWithTwiddles applies the butterflies a[i], a[i+m] for start ≤ i < end,
+// then multiplies a[i+m] by the twiddles
+func innerDIFWithTwiddles(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
+	for i := start; i < end; i++ {
+		goldilocks.Butterfly(&a[i], &a[i+m])
+	}
+	v := goldilocks.Vector(a[start+m : end+m])
+	v.Mul(v, twiddles[start:end])
+}
+
+// innerDITWithTwiddles multiplies a[i+m] by the twiddles, then applies the butterflies
+// a[i], a[i+m] for start ≤ i < end
+func innerDITWithTwiddles(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
+	v := goldilocks.Vector(a[start+m : end+m])
+	v.Mul(v, twiddles[start:end])
+	for i := start; i < end; i++ {
+		goldilocks.Butterfly(&a[i], &a[i+m])
+	}
+}
+
+// BitReverse applies the bit-reversal permutation to a.
+// len(a) must be a power of 2 (as in every single function in this file)
+func BitReverse(a []goldilocks.Element) {
+	n := uint64(len(a))
+	nn := uint64(64 - bits.TrailingZeros64(n))
+
+	for i := uint64(0); i < n; i++ {
+		irev := bits.Reverse64(i) >> nn
+		if irev > i {
+			a[i], a[irev] = a[irev], a[i]
+		}
+	}
+}
+
+// kerDIT8 is a kernel that process a FFT of size 8
+func kerDIT8(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+
+	goldilocks.Butterfly(&a[0], &a[1])
+	goldilocks.Butterfly(&a[2], &a[3])
+	goldilocks.Butterfly(&a[4], &a[5])
+	goldilocks.Butterfly(&a[6], &a[7])
+	goldilocks.Butterfly(&a[0], &a[2])
+	a[3].Mul(&a[3], &twiddles[stage+1][1])
+	goldilocks.Butterfly(&a[1], &a[3])
+	goldilocks.Butterfly(&a[4], &a[6])
+	a[7].Mul(&a[7], &twiddles[stage+1][1])
+	goldilocks.Butterfly(&a[5], &a[7])
+	goldilocks.Butterfly(&a[0], &a[4])
+	a[5].Mul(&a[5], &twiddles[stage+0][1])
+	goldilocks.Butterfly(&a[1], &a[5])
+	a[6].Mul(&a[6], &twiddles[stage+0][2])
+	goldilocks.Butterfly(&a[2], &a[6])
+	a[7].Mul(&a[7], &twiddles[stage+0][3])
+	goldilocks.Butterfly(&a[3], &a[7])
+}
+
+// kerDIF8 is a kernel that process a FFT of size 8
+func kerDIF8(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+
+	goldilocks.Butterfly(&a[0], &a[4])
+	goldilocks.Butterfly(&a[1], &a[5])
+	goldilocks.Butterfly(&a[2], &a[6])
+	goldilocks.Butterfly(&a[3], &a[7])
+	a[5].Mul(&a[5], &twiddles[stage+0][1])
+	a[6].Mul(&a[6], &twiddles[stage+0][2])
+	a[7].Mul(&a[7], &twiddles[stage+0][3])
+	goldilocks.Butterfly(&a[0], &a[2])
+	goldilocks.Butterfly(&a[1], &a[3])
+	goldilocks.Butterfly(&a[4], &a[6])
+	goldilocks.Butterfly(&a[5], &a[7])
+	a[3].Mul(&a[3], &twiddles[stage+1][1])
+	a[7].Mul(&a[7], &twiddles[stage+1][1])
+	goldilocks.Butterfly(&a[0], &a[1])
+	goldilocks.Butterfly(&a[2], &a[3])
+	goldilocks.Butterfly(&a[4], &a[5])
+	goldilocks.Butterfly(&a[6], &a[7])
+}
@@ -2,11 +2,26 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
 
+	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/field/generator"
 	"github.com/consensys/gnark-crypto/field/generator/config"
+	genconfig "github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/extensions"
+	"github.com/consensys/gnark-crypto/internal/generator/fft"
 )
 
+const (
+	copyrightHolder = "Consensys Software Inc."
+	copyrightYear   = 2020
+	baseDir         = "../"
+)
+
+var bgen = bavard.NewBatchGenerator(copyrightHolder, copyrightYear, "consensys/gnark-crypto")
+
 //go:generate go run main.go
 func main() {
 	const modulus = "0xFFFFFFFF00000001"
@@ -14,8 +29,48 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	if err := generator.GenerateFF(goldilocks, "../"); err != nil {
+	if err := generator.GenerateFF(goldilocks, baseDir); err != nil {
+		panic(err)
+	}
+
+	fieldDependency := genconfig.FieldDependency{
+		FieldPackagePath: "github.com/consensys/gnark-crypto/field/goldilocks",
+		FieldPackageName: "goldilocks",
+		ElementType:      "goldilocks.Element",
+	}
+
+	// 7 generates the full multiplicative group (as in plonky2)
+	fftConfig, err := config.NewFFTConfig(goldilocks, 7, "")
+	if err != nil {
 		panic(err)
 	}
+	if err := fft.Generate(fft.Config{FieldDependency: fieldDependency, FFTConfig: fftConfig, Radix4: true}, filepath.Join(baseDir, "fft"), bgen); err != nil {
+		panic(err)
+	}
+
+	// Ext2 = 𝔽[u]/(u² - 7) as in plonky2, Ext3 = 𝔽[v]/(v³ - 2)
+	for _, ext := range []struct {
+		name   string
+		degree uint8
+		rootOf int64
+	}{{"Ext2", 2, 7}, {"Ext3", 3, 2}} {
+		conf := extensions.Config{
+			FieldDependency: fieldDependency,
+			Extension:       config.NewTower(goldilocks, ext.degree, ext.rootOf),
+			Name:            ext.name,
+		}
+		if err := extensions.Generate(conf, filepath.Join(baseDir, "extensions"), bgen); err != nil {
+			panic(err)
+		}
+	}
+
+	// format the generated packages
+	cmd := exec.Command("gofmt", "-s", "-w", filepath.Join(baseDir, "fft"), filepath.Join(baseDir, "extensions"))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		panic(err)
+	}
+
 	fmt.Println("successfully generated goldilocks field")
 }
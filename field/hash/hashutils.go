@@ -3,26 +3,52 @@ package hash
 import (
 	"crypto/sha256"
 	"errors"
+	"hash"
+
+	"golang.org/x/crypto/sha3"
 )
 
-// ExpandMsgXmd expands msg to a slice of lenInBytes bytes.
+// oversizeDSTPrefix is prepended to a domain separation tag longer than 255 bytes before hashing it
+// https://www.rfc-editor.org/rfc/rfc9380#section-5.3.3
+const oversizeDSTPrefix = "H2C-OVERSIZE-DST-"
+
+// ExpandMsgXmd expands msg to a slice of lenInBytes bytes, with expand_message_xmd and SHA-256.
 // https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-5
 // https://tools.ietf.org/html/rfc8017#section-4.1 (I2OSP/O2ISP)
 func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
+	return ExpandMsgXmdWith(sha256.New, msg, dst, lenInBytes)
+}
 
-	h := sha256.New()
+// ExpandMsgXmdWith expands msg to a slice of lenInBytes bytes, with expand_message_xmd and the hash
+// function returned by newHash.
+// https://www.rfc-editor.org/rfc/rfc9380#section-5.3.1
+//
+// The hash function must be a Merkle-Damgård hash (SHA-2) or a sponge (SHA-3, Keccak) whose
+// output size is at least twice the target security level. Domain separation tags longer than
+// 255 bytes are hashed first, as specified in https://www.rfc-editor.org/rfc/rfc9380#section-5.3.3.
+func ExpandMsgXmdWith(newHash func() hash.Hash, msg, dst []byte, lenInBytes int) ([]byte, error) {
+
+	h := newHash()
 	ell := (lenInBytes + h.Size() - 1) / h.Size() // ceil(len_in_bytes / b_in_bytes)
-	if ell > 255 {
+	if ell > 255 || lenInBytes > 65535 || lenInBytes < 0 {
 		return nil, errors.New("invalid lenInBytes")
 	}
 	if len(dst) > 255 {
-		return nil, errors.New("invalid domain size (>255 bytes)")
+		// DST = H("H2C-OVERSIZE-DST-" ∥ a_very_long_DST)
+		h.Reset()
+		if _, err := h.Write([]byte(oversizeDSTPrefix)); err != nil {
+			return nil, err
+		}
+		if _, err := h.Write(dst); err != nil {
+			return nil, err
+		}
+		dst = h.Sum(nil)
 	}
 	sizeDomain := uint8(len(dst))
 
 	// Z_pad = I2OSP(0, r_in_bytes)
 	// l_i_b_str = I2OSP(len_in_bytes, 2)
-	// DST_prime = I2OSP(len(DST), 1) ∥ DST
+	// DST_prime = DST ∥ I2OSP(len(DST), 1)
 	// b₀ = H(Z_pad ∥ msg ∥ l_i_b_str ∥ I2OSP(0, 1) ∥ DST_prime)
 	h.Reset()
 	if _, err := h.Write(make([]byte, h.BlockSize())); err != nil {
@@ -59,7 +85,7 @@ func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
 	b1 := h.Sum(nil)
 
 	res := make([]byte, lenInBytes)
-	copy(res[:h.Size()], b1)
+	copy(res[:min(h.Size(), len(res))], b1)
 
 	for i := 2; i <= ell; i++ {
 		// b_i = H(strxor(b₀, b_(i - 1)) ∥ I2OSP(i, 1) ∥ DST_prime)
@@ -86,6 +112,58 @@ func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
 	return res, nil
 }
 
+// ExpandMsgXof expands msg to a slice of lenInBytes bytes, with expand_message_xof and the
+// extendable-output function returned by newXof (e.g. sha3.NewShake128).
+// https://www.rfc-editor.org/rfc/rfc9380#section-5.3.2
+//
+// k is the target security level in bits; it is used to hash domain separation tags longer
+// than 255 bytes, as specified in https://www.rfc-editor.org/rfc/rfc9380#section-5.3.3.
+func ExpandMsgXof(newXof func() sha3.ShakeHash, k int, msg, dst []byte, lenInBytes int) ([]byte, error) {
+	if lenInBytes > 65535 || lenInBytes < 0 {
+		return nil, errors.New("invalid lenInBytes")
+	}
+
+	h := newXof()
+	if len(dst) > 255 {
+		// DST = H("H2C-OVERSIZE-DST-" ∥ a_very_long_DST, ceil(2 * k / 8))
+		if _, err := h.Write([]byte(oversizeDSTPrefix)); err != nil {
+			return nil, err
+		}
+		if _, err := h.Write(dst); err != nil {
+			return nil, err
+		}
+		dst = make([]byte, (2*k+7)/8)
+		if _, err := h.Read(dst); err != nil {
+			return nil, err
+		}
+		h.Reset()
+	}
+	if len(dst) > 255 {
+		return nil, errors.New("invalid domain size (>255 bytes)")
+	}
+
+	// msg_prime = msg ∥ I2OSP(len_in_bytes, 2) ∥ DST_prime
+	// uniform_bytes = H(msg_prime, len_in_bytes)
+	if _, err := h.Write(msg); err != nil {
+		return nil, err
+	}
+	if _, err := h.Write([]byte{uint8(lenInBytes >> 8), uint8(lenInBytes)}); err != nil {
+		return nil, err
+	}
+	if _, err := h.Write(dst); err != nil {
+		return nil, err
+	}
+	if _, err := h.Write([]byte{uint8(len(dst))}); err != nil {
+		return nil, err
+	}
+
+	res := make([]byte, lenInBytes)
+	if _, err := h.Read(res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
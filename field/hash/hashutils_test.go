@@ -2,8 +2,17 @@ package hash
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
+
+	"golang.org/x/crypto/sha3"
 )
 
 type expandMsgXmdTestCase struct {
@@ -130,3 +139,140 @@ func TestExpandMsgXmd(t *testing.T) {
 		}
 	}
 }
+
+// expandMsgTestVector is the format of the test vectors of https://www.rfc-editor.org/rfc/rfc9380#appendix-K
+type expandMsgTestVector struct {
+	DST   string `json:"DST"`
+	Hash  string `json:"hash"`
+	K     int    `json:"k"`
+	Name  string `json:"name"`
+	Tests []struct {
+		LenInBytes   string `json:"len_in_bytes"`
+		Msg          string `json:"msg"`
+		UniformBytes string `json:"uniform_bytes"`
+	} `json:"tests"`
+}
+
+func TestExpandMsgVectors(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("test_vectors", "expand_message_*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no test vectors found")
+	}
+
+	xmd := map[string]func() hash.Hash{
+		"SHA256": sha256.New,
+		"SHA512": sha512.New,
+	}
+	xof := map[string]func() sha3.ShakeHash{
+		"SHAKE128": sha3.NewShake128,
+		"SHAKE256": sha3.NewShake256,
+	}
+
+	for _, file := range files {
+		buf, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var v expandMsgTestVector
+		if err = json.Unmarshal(buf, &v); err != nil {
+			t.Fatal(err)
+		}
+
+		var expand Expander
+		switch v.Name {
+		case "expand_message_xmd":
+			expand = NewConfig(WithExpandMsgXmd(xmd[v.Hash])).Expand
+		case "expand_message_xof":
+			expand = NewConfig(WithExpandMsgXof(xof[v.Hash], v.K)).Expand
+		default:
+			t.Fatal("unknown expander", v.Name)
+		}
+
+		for _, c := range v.Tests {
+			lenInBytes, err := strconv.ParseInt(c.LenInBytes, 0, 32)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected, err := hex.DecodeString(c.UniformBytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			uniformBytes, err := expand([]byte(c.Msg), []byte(v.DST), int(lenInBytes))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(uniformBytes, expected) {
+				t.Errorf("%s: %s(%q, %d): expected %s got %x", filepath.Base(file), v.Name, c.Msg, lenInBytes, c.UniformBytes, uniformBytes)
+			}
+		}
+	}
+}
+
+func TestExpandMsgXmdKeccak(t *testing.T) {
+	// there are no standard vectors for Keccak-256: check the first block against its definition
+	msg, dst := []byte("abc"), []byte("QUUX-V01-CS02-with-expander-KECCAK256")
+	const lenInBytes = 0x20
+
+	h := sha3.NewLegacyKeccak256()
+	h.Write(make([]byte, h.BlockSize()))
+	h.Write(msg)
+	h.Write([]byte{0, lenInBytes, 0})
+	h.Write(dst)
+	h.Write([]byte{uint8(len(dst))})
+	b0 := h.Sum(nil)
+
+	h.Reset()
+	h.Write(b0)
+	h.Write([]byte{1})
+	h.Write(dst)
+	h.Write([]byte{uint8(len(dst))})
+	expected := h.Sum(nil)
+
+	uniformBytes, err := ExpandMsgXmdWith(sha3.NewLegacyKeccak256, msg, dst, lenInBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(uniformBytes, expected) {
+		t.Fatalf("expected %x got %x", expected, uniformBytes)
+	}
+
+	// longer outputs are prefixed by the first block
+	uniformBytes, err = ExpandMsgXmdWith(sha3.NewLegacyKeccak256, msg, dst, 3*lenInBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uniformBytes) != 3*lenInBytes {
+		t.Fatal("wrong length")
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	cfg := NewConfig()
+	if cfg.SecurityLevel != 128 {
+		t.Fatal("default security level should be 128")
+	}
+	msg, dst := []byte("abc"), []byte("dst")
+	res, err := cfg.Expand(msg, dst, 48)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ExpandMsgXmd(msg, dst, 48)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res, expected) {
+		t.Fatal("default expander should be expand_message_xmd with SHA-256")
+	}
+
+	cfg = NewConfig(WithExpandMsgXof(sha3.NewShake256, 256))
+	if cfg.SecurityLevel != 256 {
+		t.Fatal("WithExpandMsgXof should set the security level")
+	}
+	cfg = NewConfig(WithExpandMsgXof(sha3.NewShake256, 256), WithSecurityLevel(192))
+	if cfg.SecurityLevel != 192 {
+		t.Fatal("options should apply in order")
+	}
+}
@@ -0,0 +1,74 @@
+package hash
+
+import (
+	"hash"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Expander is an expand_message function, producing lenInBytes uniformly random bytes
+// from msg and the domain separation tag dst.
+// https://www.rfc-editor.org/rfc/rfc9380#section-5.3
+type Expander func(msg, dst []byte, lenInBytes int) ([]byte, error)
+
+// Config holds the hash-to-field ciphersuite parameters, see Option.
+type Config struct {
+	// Expand is the expand_message function; default is expand_message_xmd with SHA-256.
+	Expand Expander
+
+	// SecurityLevel is the target security level k in bits; default is 128.
+	// Each field element is obtained from L = ⌈(⌈log₂(p)⌉ + k) / 8⌉ expanded bytes.
+	SecurityLevel int
+}
+
+// Option sets a hash-to-field (and hash-to-curve) ciphersuite parameter.
+type Option func(*Config)
+
+// NewConfig returns the default configuration (expand_message_xmd with SHA-256, 128 bits of
+// security) modified by the options.
+func NewConfig(opts ...Option) Config {
+	cfg := Config{
+		Expand:        ExpandMsgXmd,
+		SecurityLevel: 128,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// WithExpandMsgXmd sets the expand_message function to expand_message_xmd with the hash function
+// returned by newHash, e.g. sha512.New or sha3.NewLegacyKeccak256.
+func WithExpandMsgXmd(newHash func() hash.Hash) Option {
+	return func(cfg *Config) {
+		cfg.Expand = func(msg, dst []byte, lenInBytes int) ([]byte, error) {
+			return ExpandMsgXmdWith(newHash, msg, dst, lenInBytes)
+		}
+	}
+}
+
+// WithExpandMsgXof sets the expand_message function to expand_message_xof with the extendable-output
+// function returned by newXof, and the security level to k, e.g. (sha3.NewShake128, 128) or
+// (sha3.NewShake256, 256).
+func WithExpandMsgXof(newXof func() sha3.ShakeHash, k int) Option {
+	return func(cfg *Config) {
+		cfg.Expand = func(msg, dst []byte, lenInBytes int) ([]byte, error) {
+			return ExpandMsgXof(newXof, k, msg, dst, lenInBytes)
+		}
+		cfg.SecurityLevel = k
+	}
+}
+
+// WithExpander sets a custom expand_message function.
+func WithExpander(expand Expander) Option {
+	return func(cfg *Config) {
+		cfg.Expand = expand
+	}
+}
+
+// WithSecurityLevel sets the target security level k, in bits.
+func WithSecurityLevel(k int) Option {
+	return func(cfg *Config) {
+		cfg.SecurityLevel = k
+	}
+}
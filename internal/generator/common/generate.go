@@ -0,0 +1,40 @@
+package common
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+)
+
+// GenerateFromFS works like bgen.GenerateWithOptions, with the templates read from fsys rather than
+// from the working directory. Generators embedding their templates can then be run from any directory
+// (see goff).
+func GenerateFromFS(bgen *bavard.BatchGenerator, data interface{}, packageName string, fsys fs.FS, extraOptions []func(*bavard.Bavard) error, entries ...bavard.Entry) error {
+	dir, err := os.MkdirTemp("", "gnark-crypto-templates")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	err = fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(dir, filepath.FromSlash(path))
+		if d.IsDir() {
+			return os.MkdirAll(dst, 0700)
+		}
+		content, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(dst, content, 0600)
+	})
+	if err != nil {
+		return err
+	}
+
+	return bgen.GenerateWithOptions(data, packageName, dir, extraOptions, entries...)
+}
@@ -1,11 +1,15 @@
 package config
 
 var BLS24_315 = Curve{
-	Name:         "bls24-315",
-	CurvePackage: "bls24315",
-	EnumID:       "BLS24_315",
-	FrModulus:    "11502027791375260645628074404575422495959608200132055716665986169834464870401",
-	FpModulus:    "39705142709513438335025689890408969744933502416914749335064285505637884093126342347073617133569",
+	Name:                "bls24-315",
+	CurvePackage:        "bls24315",
+	EnumID:              "BLS24_315",
+	FrModulus:           "11502027791375260645628074404575422495959608200132055716665986169834464870401",
+	FpModulus:           "39705142709513438335025689890408969744933502416914749335064285505637884093126342347073617133569",
+	FrMultiplicativeGen: 7,
+	FrRootOfUnity:       "1792993287828780812362846131493071959406149719416102105453370749552622525216",
+	MiMCNbRounds:        109,
+	MiMCExponent:        5,
 	G1: Point{
 		CoordType:        "fp.Element",
 		CoordExtDegree:   1,
@@ -54,6 +58,38 @@ var BLS24_315 = Curve{
 			},
 		},
 	},
+	HashE2: &HashSuiteSvdw{
+		z: []string{
+			"5",
+			"0",
+			"0",
+			"0",
+		},
+		c1: []string{
+			"125",
+			"0",
+			"0",
+			"6108483493771298205388567675447533806912846525679192205394505462405828322019437284165171866703",
+		},
+		c2: []string{
+			"19852571354756719167512844945204484872466751208457374667532142752818942046563171173536808566782",
+			"0",
+			"0",
+			"0",
+		},
+		c3: []string{
+			"16453175264429157215664010880719111775142685304188116377678349939777524612711864465252778765354",
+			"30677607629706911500168917440773333888888858598743767432884677646370958081327572127100513757045",
+			"33781332358182764210726071996292168807124097375218924744271646085753470421991910344593259044255",
+			"10841241162081077092138602583294300300361016450029007626597415421239936165069237071631209706901",
+		},
+		c4: []string{
+			"26470095139675625556683793260272646496622334944609832890042857003758589395417561564715744755706",
+			"0",
+			"0",
+			"25085505547754131296795717920504538833722089732122549323486769098946601642426489113638305799260",
+		},
+	},
 }
 
 var tBLS24_315 = TwistedEdwardsCurve{
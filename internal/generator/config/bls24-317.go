@@ -1,11 +1,15 @@
 package config
 
 var BLS24_317 = Curve{
-	Name:         "bls24-317",
-	CurvePackage: "bls24317",
-	EnumID:       "BLS24_317",
-	FrModulus:    "30869589236456844204538189757527902584594726589286811523515204428962673459201",
-	FpModulus:    "136393071104295911515099765908274057061945112121419593977210139303905973197232025618026156731051",
+	Name:                "bls24-317",
+	CurvePackage:        "bls24317",
+	EnumID:              "BLS24_317",
+	FrModulus:           "30869589236456844204538189757527902584594726589286811523515204428962673459201",
+	FpModulus:           "136393071104295911515099765908274057061945112121419593977210139303905973197232025618026156731051",
+	FrMultiplicativeGen: 7,
+	FrRootOfUnity:       "16532287748948254263922689505213135976137839535221842169193829039521719560631",
+	MiMCNbRounds:        91,
+	MiMCExponent:        7,
 	G1: Point{
 		CoordType:        "fp.Element",
 		CoordExtDegree:   1,
@@ -66,6 +70,38 @@ var BLS24_317 = Curve{
 			},
 		},
 	},
+	HashE2: &HashSuiteSvdw{
+		z: []string{
+			"1",
+			"0",
+			"0",
+			"0",
+		},
+		c1: []string{
+			"1",
+			"0",
+			"4",
+			"0",
+		},
+		c2: []string{
+			"68196535552147955757549882954137028530972556060709796988605069651952986598616012809013078365525",
+			"0",
+			"0",
+			"0",
+		},
+		c3: []string{
+			"86595082793928434718422192949911104829590693245705311379638562879928016422834174301994393597436",
+			"133268939002355326485242335012244118141871393457542464752749300193633162572896472595618280116414",
+			"98860308815148509505351922614954394310735617450143963210217918173303926072918613981915934938143",
+			"8124860698414270895458256326720466303643819131155681788700618696054233017338960913238008935581",
+		},
+		c4: []string{
+			"45464357034765303838366588636091352353981704040473197992403379767968657732410675206008718910349",
+			"0",
+			"45464357034765303838366588636091352353981704040473197992403379767968657732410675206008718910345",
+			"0",
+		},
+	},
 }
 
 var tBLS24_317 = TwistedEdwardsCurve{
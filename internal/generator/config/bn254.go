@@ -1,11 +1,15 @@
 package config
 
 var BN254 = Curve{
-	Name:         "bn254",
-	CurvePackage: "bn254",
-	EnumID:       "BN254",
-	FrModulus:    "21888242871839275222246405745257275088548364400416034343698204186575808495617",
-	FpModulus:    "21888242871839275222246405745257275088696311157297823662689037894645226208583",
+	Name:                "bn254",
+	CurvePackage:        "bn254",
+	EnumID:              "BN254",
+	FrModulus:           "21888242871839275222246405745257275088548364400416034343698204186575808495617",
+	FpModulus:           "21888242871839275222246405745257275088696311157297823662689037894645226208583",
+	FrMultiplicativeGen: 5,
+	FrRootOfUnity:       "19103219067921713944291392827692070036145651957329286315305642004821462161904",
+	MiMCNbRounds:        110,
+	MiMCExponent:        5,
 	G1: Point{
 		CoordType:        "fp.Element",
 		CoordExtDegree:   1,
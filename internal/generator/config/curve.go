@@ -25,6 +25,14 @@ type Curve struct {
 
 	HashE1 HashSuite
 	HashE2 HashSuite
+
+	// fft domains on 𝔽ᵣ (see field/generator/config.NewFFTConfig)
+	FrMultiplicativeGen uint64 // generator of 𝔽ᵣ*
+	FrRootOfUnity       string // generator of the largest 2-adic subgroup of 𝔽ᵣ*
+
+	// mimc on 𝔽ᵣ
+	MiMCNbRounds int
+	MiMCExponent int
 }
 
 type TwistedEdwardsCurve struct {
@@ -70,6 +70,7 @@ func (parameters *HashSuiteSvdw) GetInfo(baseField *field.FieldConfig, g *Point,
 		MappingAlgorithm:  SVDW,
 		Name:              name,
 		FieldCoordName:    field.CoordNameForExtensionDegree(g.CoordExtDegree),
+		FieldCoords:       field.CoordPathsForExtensionDegree(g.CoordExtDegree),
 		Field:             &f,
 	}
 }
@@ -144,6 +145,7 @@ func (suite *HashSuiteSswu) GetInfo(baseField *field.FieldConfig, g *Point, name
 		PrecomputedParams: c,
 		Field:             &f,
 		FieldCoordName:    field.CoordNameForExtensionDegree(g.CoordExtDegree),
+		FieldCoords:       field.CoordPathsForExtensionDegree(g.CoordExtDegree),
 		MappingAlgorithm:  SSWU,
 	}
 }
@@ -192,6 +194,7 @@ type HashSuiteInfo struct {
 	Point             *Point
 	Field             *field.Extension
 	FieldCoordName    string
+	FieldCoords       []string // paths to the base field coordinates of a point coordinate
 	Name              string
 	FieldSizeMod256   uint8
 	PrecomputedParams []field.Element // PrecomputedParams[0][n] correspond to integer cₙ₋₁ in std doc
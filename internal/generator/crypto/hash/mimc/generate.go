@@ -1,23 +1,69 @@
 package mimc
 
 import (
+	"embed"
+	"errors"
+	"io/fs"
+	"math/big"
 	"os"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/common"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
-func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+//go:embed template
+var templates embed.FS
+
+// Config describes the field and the parameters of the mimc permutation
+type Config struct {
+	config.FieldDependency
+	NbRounds int // number of rounds
+	Exponent int // exponent d of the round function x ↦ (x+k+c)ᵈ, such that gcd(d, q-1) = 1
+}
+
+// NewConfig returns the mimc parameters for the field of modulus q: the smallest exponent d ⩾ 3 such that
+// x ↦ xᵈ is a permutation, and ⌈log_d(q)⌉ rounds.
+func NewConfig(fieldDependency config.FieldDependency, q *big.Int) (Config, error) {
+	var qMinusOne, gcd big.Int
+	qMinusOne.Sub(q, big.NewInt(1))
+
+	for d := int64(3); d < 256; d += 2 {
+		if gcd.GCD(nil, nil, big.NewInt(d), &qMinusOne).Cmp(big.NewInt(1)) != 0 {
+			continue
+		}
+		// smallest r such that dʳ ⩾ q
+		nbRounds := 0
+		for p := big.NewInt(1); p.Cmp(q) < 0; p.Mul(p, big.NewInt(d)) {
+			nbRounds++
+		}
+		return Config{
+			FieldDependency: fieldDependency,
+			NbRounds:        nbRounds,
+			Exponent:        int(d),
+		}, nil
+	}
+	return Config{}, errors.New("mimc: no small exponent d such that gcd(d, q-1) = 1")
+}
+
+func Generate(conf Config, baseDir string, generateTests bool, bgen *bavard.BatchGenerator) error {
 
-	conf.Package = "mimc"
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "mimc.go"), Templates: []string{"mimc.go.tmpl"}},
 	}
+	if generateTests {
+		// the curves' mimc tests are maintained along with their test vectors
+		entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "mimc_test.go"), Templates: []string{"mimc.test.go.tmpl"}})
+	}
 	os.Remove(filepath.Join(baseDir, "utils.go"))
 	os.Remove(filepath.Join(baseDir, "utils_test.go"))
 
-	return bgen.Generate(conf, conf.Package, "./crypto/hash/mimc/template", entries...)
+	fsys, err := fs.Sub(templates, "template")
+	if err != nil {
+		return err
+	}
+	return common.GenerateFromFS(bgen, conf, "mimc", fsys, nil, entries...)
 
 }
@@ -0,0 +1,42 @@
+package ecvrf
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// ecvrfConfig adds to the curve description the names needed by the ecvrf package
+type ecvrfConfig struct {
+	config.TwistedEdwardsCurve
+
+	// CurvePackage is the package of the twisted Edwards curve (Package is overwritten with "ecvrf")
+	CurvePackage string
+	// SuiteTag is the curve identifier in the hash-to-curve suite ID, as in the test vectors of the curve package
+	SuiteTag string
+}
+
+func Generate(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.BatchGenerator) error {
+	data := ecvrfConfig{TwistedEdwardsCurve: conf, CurvePackage: conf.Package}
+	if conf.Package == "twistededwards" {
+		data.SuiteTag = strings.ToUpper(strings.ReplaceAll(conf.Name, "-", "_")) + "_EDWARDS"
+	} else {
+		data.SuiteTag = strings.ToUpper(conf.Package)
+	}
+
+	// ecvrf
+	data.Package = "ecvrf"
+	baseDir = filepath.Join(baseDir, data.Package)
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ecvrf.go"), Templates: []string{"ecvrf.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ecvrf_test.go"), Templates: []string{"ecvrf.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"marshal.test.go.tmpl"}},
+	}
+	return bgen.Generate(data, data.Package, "./edwards/ecvrf/template", entries...)
+
+}
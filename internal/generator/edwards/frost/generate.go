@@ -0,0 +1,43 @@
+package frost
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// frostConfig adds to the curve description the names needed by the frost package
+type frostConfig struct {
+	config.TwistedEdwardsCurve
+
+	// CurvePackage is the package of the twisted Edwards curve (Package is overwritten with "frost")
+	CurvePackage string
+	// SuiteTag is the curve identifier in the context string, as in the hash-to-curve suite IDs of the curve package
+	SuiteTag string
+}
+
+func Generate(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.BatchGenerator) error {
+	data := frostConfig{TwistedEdwardsCurve: conf, CurvePackage: conf.Package}
+	if conf.Package == "twistededwards" {
+		data.SuiteTag = strings.ToUpper(strings.ReplaceAll(conf.Name, "-", "_")) + "_EDWARDS"
+	} else {
+		data.SuiteTag = strings.ToUpper(conf.Package)
+	}
+
+	// frost
+	data.Package = "frost"
+	baseDir = filepath.Join(baseDir, data.Package)
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "dkg.go"), Templates: []string{"dkg.go.tmpl"}},
+		{File: filepath.Join(baseDir, "dkg_test.go"), Templates: []string{"dkg.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "frost.go"), Templates: []string{"frost.go.tmpl"}},
+		{File: filepath.Join(baseDir, "frost_test.go"), Templates: []string{"frost.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "network.go"), Templates: []string{"network.go.tmpl"}},
+	}
+	return bgen.Generate(data, data.Package, "./edwards/frost/template", entries...)
+
+}
@@ -1,19 +1,77 @@
 package edwards
 
 import (
+	"fmt"
+	"math/big"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
+// edwardsConfig adds to the curve description the constants derived at generation time
+type edwardsConfig struct {
+	config.TwistedEdwardsCurve
+
+	// Elligator2Z is the non-square Z of the Elligator 2 map (RFC 9380, appendix H.1)
+	Elligator2Z int64
+	// NbCofactorDoublings is log₂(Cofactor)
+	NbCofactorDoublings int
+}
+
 func Generate(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.BatchGenerator) error {
+	data, err := newEdwardsConfig(conf)
+	if err != nil {
+		return err
+	}
+
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "point.go"), Templates: []string{"point.go.tmpl"}},
 		{File: filepath.Join(baseDir, "point_test.go"), Templates: []string{"tests/point.go.tmpl"}},
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "curve.go"), Templates: []string{"curve.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hash_to_curve.go"), Templates: []string{"hash_to_curve.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hash_to_curve_test.go"), Templates: []string{"tests/hash_to_curve.go.tmpl"}},
+	}
+	// the test vectors (hash_vectors_test.go) are generated independently of the Go code,
+	// by test_vectors/hash_to_curve.py
+
+	return bgen.Generate(data, conf.Package, "./edwards/template", entries...)
+}
+
+func newEdwardsConfig(conf config.TwistedEdwardsCurve) (edwardsConfig, error) {
+	data := edwardsConfig{TwistedEdwardsCurve: conf}
+
+	var modulus *big.Int
+	for _, c := range config.Curves {
+		if c.Name == conf.Name {
+			modulus = c.FrInfo.Modulus()
+		}
+	}
+	if modulus == nil {
+		return data, fmt.Errorf("%s: unknown base curve", conf.Name)
+	}
+
+	// smallest non-square, trying 1, -1, 2, -2, ...
+	var z big.Int
+	for c := int64(1); data.Elligator2Z == 0; c++ {
+		for _, cand := range []int64{c, -c} {
+			z.SetInt64(cand).Mod(&z, modulus)
+			if big.Jacobi(&z, modulus) == -1 {
+				data.Elligator2Z = cand
+				break
+			}
+		}
+	}
+
+	var h big.Int
+	if _, ok := h.SetString(conf.Cofactor, 10); !ok || h.Sign() <= 0 {
+		return data, fmt.Errorf("%s: invalid cofactor %s", conf.Name, conf.Cofactor)
+	}
+	data.NbCofactorDoublings = h.BitLen() - 1
+	if h.TrailingZeroBits() != uint(data.NbCofactorDoublings) {
+		return data, fmt.Errorf("%s: cofactor %s is not a power of 2", conf.Name, conf.Cofactor)
 	}
 
-	return bgen.Generate(conf, conf.Package, "./edwards/template", entries...)
+	return data, nil
 }
@@ -0,0 +1,274 @@
+#!/usr/bin/env python3
+"""
+Generates the hash-to-curve test vectors of the twisted Edwards curves
+(ecc/<curve>/<package>/hash_vectors_test.go).
+
+This is an implementation of the Elligator 2 suites of RFC 9380 written from the
+specification, it shares no code with the Go implementation: expand_message_xmd,
+hash_to_field, the Elligator 2 map (section 6.7.1), the rational map from the
+Montgomery curve to the twisted Edwards curve (section 6.8.2 and appendix D.1)
+and the cofactor clearing are re-implemented here in plain Python integers.
+The non-square Z is the smallest one in the order of appendix H.1 (1, -1, 2, -2, ...).
+
+The curves (base field modulus, a, d and cofactor) are read from
+internal/generator/config/<curve>.go. The suite identifiers follow RFC 9380:
+<TAG>_XMD:SHA-256_ELL2_NU_ and <TAG>_XMD:SHA-256_ELL2_RO_, with TAG = <CURVE>_EDWARDS
+for the twistededwards packages and the upper-cased package name otherwise.
+
+Usage (from this directory):
+    python3 hash_to_curve.py [--check] [curve[/package] ...]
+
+Without --check the vector files are (re)written, with --check the script fails
+if a committed file differs from its output.
+"""
+
+import argparse
+import hashlib
+import os
+import re
+import sys
+
+ROOT = os.path.join(os.path.dirname(os.path.abspath(__file__)), "..", "..", "..", "..")
+
+MESSAGES = ["", "abc", "abcdef0123456789", "q128_" + "q" * 128, "a512_" + "a" * 512]
+
+CONFIGS = ["bls12-377", "bls12-378", "bls12-381", "bls24-315", "bls24-317", "bn254", "bw6-633", "bw6-756", "bw6-761"]
+
+
+# ------------------------------------------------------------------------------
+# field
+
+
+def inv(a, p):
+    return pow(a, p - 2, p)
+
+
+def is_square(a, p):
+    # Euler's criterion
+    return a % p == 0 or pow(a, (p - 1) // 2, p) == 1
+
+
+def sqrt(a, p):
+    # Tonelli-Shanks, the root returned is arbitrary: callers fix the sign
+    # with sgn0 as the RFC does.
+    a %= p
+    if a == 0:
+        return 0
+    s, q = 0, p - 1
+    while q % 2 == 0:
+        s, q = s + 1, q // 2
+    z = 2
+    while is_square(z, p):
+        z += 1
+    m, c, t, r = s, pow(z, q, p), pow(a, q, p), pow(a, (q + 1) // 2, p)
+    while t != 1:
+        i, t2 = 0, t
+        while t2 != 1:
+            t2, i = t2 * t2 % p, i + 1
+        if i == m:
+            raise ValueError("not a square")
+        b = pow(c, 1 << (m - i - 1), p)
+        m, c, t, r = i, b * b % p, t * b * b % p, r * b % p
+    assert r * r % p == a
+    return r
+
+
+def sgn0(a, p):
+    return a % p % 2
+
+
+# ------------------------------------------------------------------------------
+# hash to field
+
+
+def expand_message_xmd(msg, dst, length):
+    # RFC 9380, section 5.3.1, with SHA-256
+    b_in_bytes, s_in_bytes = 32, 64
+    ell = (length + b_in_bytes - 1) // b_in_bytes
+    assert ell <= 255 and len(dst) <= 255
+    dst_prime = dst + bytes([len(dst)])
+    b0 = hashlib.sha256(bytes(s_in_bytes) + msg + length.to_bytes(2, "big") + b"\x00" + dst_prime).digest()
+    b = [hashlib.sha256(b0 + b"\x01" + dst_prime).digest()]
+    for i in range(2, ell + 1):
+        b.append(hashlib.sha256(bytes(x ^ y for x, y in zip(b0, b[-1])) + bytes([i]) + dst_prime).digest())
+    return b"".join(b)[:length]
+
+
+def hash_to_field(p, msg, count, dst):
+    # RFC 9380, section 5.2, with k = 128
+    L = (p.bit_length() + 128 + 7) // 8
+    uniform = expand_message_xmd(msg, dst, count * L)
+    return [int.from_bytes(uniform[i * L : (i + 1) * L], "big") % p for i in range(count)]
+
+
+# ------------------------------------------------------------------------------
+# curve
+
+
+class TwistedEdwards:
+    """a·v² + w² = 1 + d·v²·w² over 𝔽p, with the birationally equivalent Montgomery curve K·t² = s³ + J·s² + s."""
+
+    def __init__(self, name, package, p, a, d, h):
+        self.name, self.package, self.p, self.h = name, package, p, h
+        self.a, self.d = a % p, d % p
+        # RFC 9380, appendix D.1: J = 2(a+d)/(a-d), K = 4/(a-d)
+        self.J = 2 * (self.a + self.d) * inv(self.a - self.d, p) % p
+        self.K = 4 * inv(self.a - self.d, p) % p
+        # RFC 9380, appendix H.1: the first non-square in 1, -1, 2, -2, ...
+        c = 1
+        self.Z = None
+        while self.Z is None:
+            for z in (c, -c):
+                if not is_square(z, p):
+                    self.Z = z
+                    break
+            c += 1
+        if package == "twistededwards":
+            self.tag = name.upper().replace("-", "_") + "_EDWARDS"
+        else:
+            self.tag = package.upper()
+
+    def is_on_curve(self, P):
+        p, (v, w) = self.p, P
+        return (self.a * v * v + w * w - 1 - self.d * v * v * w * w) % p == 0
+
+    def add(self, P, Q):
+        p, (x1, y1), (x2, y2) = self.p, P, Q
+        t = self.d * x1 * x2 * y1 * y2 % p
+        return ((x1 * y2 + y1 * x2) * inv(1 + t, p) % p, (y1 * y2 - self.a * x1 * x2) * inv(1 - t, p) % p)
+
+    def mul(self, P, k):
+        R = (0, 1)
+        for bit in bin(k)[2:]:
+            R = self.add(R, R)
+            if bit == "1":
+                R = self.add(R, P)
+        return R
+
+    def map_to_curve(self, u):
+        # RFC 9380, section 6.7.1 (map_to_curve_elligator2) on the curve y² = x³ + (J/K)·x² + x/K²,
+        # then (s, t) = (x·K, y·K) on the Montgomery curve (section 6.7.1, note)
+        p, J, K, Z = self.p, self.J, self.K, self.Z
+        c1, c2 = J * inv(K, p) % p, inv(K * K, p)
+        g = lambda x: (x * x * x + c1 * x * x + c2 * x) % p
+        x1 = -c1 * inv(1 + Z * u * u, p) % p
+        if x1 == 0:  # inv0(0) = 0 in the RFC
+            x1 = -c1 % p
+        x2 = (-x1 - c1) % p
+        if is_square(g(x1), p):
+            x, y = x1, sqrt(g(x1), p)
+            if sgn0(y, p) != 1:
+                y = -y % p
+        else:
+            x, y = x2, sqrt(g(x2), p)
+            if sgn0(y, p) != 0:
+                y = -y % p
+        s, t = x * K % p, y * K % p
+
+        # RFC 9380, appendix D.1: rational map from the Montgomery curve, the exceptional
+        # cases are sent to the identity
+        if t == 0 or (s + 1) % p == 0:
+            return (0, 1)
+        return (s * inv(t, p) % p, (s - 1) * inv(s + 1, p) % p)
+
+    def clear_cofactor(self, P):
+        return self.mul(P, self.h)
+
+
+def read_curves():
+    curves = {}
+    for name in CONFIGS:
+        with open(os.path.join(ROOT, "internal", "generator", "config", name + ".go")) as f:
+            src = f.read()
+        p = int(re.search(r'FrModulus:\s*"(\d+)"', src).group(1))
+        for m in re.finditer(r"TwistedEdwardsCurve\{", src):
+            body = block(src, m.start())
+            get = lambda key: re.search(key + r':\s*"([^"]*)"', body).group(1)
+            c = TwistedEdwards(name, get("Package"), p, int(get("A")), int(get("D")), int(get("Cofactor")))
+            curves[name + "/" + c.package] = c
+    return curves
+
+
+def block(s, start):
+    """Returns the content of the first braces after start."""
+    i = s.index("{", start)
+    depth = 0
+    for j in range(i, len(s)):
+        depth += {"{": 1, "}": -1}.get(s[j], 0)
+        if depth == 0:
+            return s[i + 1 : j]
+    raise ValueError("unbalanced braces")
+
+
+# ------------------------------------------------------------------------------
+# output
+
+
+def point(P):
+    return 'point{"0x%x", "0x%x"}' % P
+
+
+def vectors(c):
+    p = c.p
+    dst = "QUUX-V01-CS02-with-%s_XMD:SHA-256_ELL2_" % c.tag
+    out = ["// Code generated by internal/generator/edwards/test_vectors/hash_to_curve.py DO NOT EDIT\n\n"]
+    out.append("package %s\n\nfunc init() {\n" % c.package)
+
+    out.append("\tencodeToCurveVector = encodeTestVector{\n")
+    out.append('\t\tdst: []byte("%sNU_"),\n\t\tcases: []encodeTestCase{\n\t\t\t{\n' % dst)
+    cases = []
+    for msg in MESSAGES:
+        (u,) = hash_to_field(p, msg.encode(), 1, (dst + "NU_").encode())
+        Q = c.map_to_curve(u)
+        P = c.clear_cofactor(Q)
+        assert c.is_on_curve(Q) and c.is_on_curve(P)
+        cases.append('\t\t\t\tmsg: "%s", P: %s,\n\t\t\t\tQ: %s,\n\t\t\t\tu: "0x%x",\n' % (msg, point(P), point(Q), u))
+    out.append("\t\t\t},\n\t\t\t{\n".join(cases))
+    out.append("\t\t\t},\n\t\t}}\n")
+
+    out.append("\thashToCurveVector = hashTestVector{\n")
+    out.append('\t\tdst: []byte("%sRO_"),\n\t\tcases: []hashTestCase{\n\t\t\t{\n' % dst)
+    cases = []
+    for msg in MESSAGES:
+        u0, u1 = hash_to_field(p, msg.encode(), 2, (dst + "RO_").encode())
+        Q0, Q1 = c.map_to_curve(u0), c.map_to_curve(u1)
+        P = c.clear_cofactor(c.add(Q0, Q1))
+        assert c.is_on_curve(P)
+        cases.append(
+            '\t\t\t\tmsg: "%s", P: %s,\n\t\t\t\tQ0: %s,\n\t\t\t\tQ1: %s,\n\t\t\t\tu0: "0x%x", u1: "0x%x",\n'
+            % (msg, point(P), point(Q0), point(Q1), u0, u1)
+        )
+    out.append("\t\t\t},\n\t\t\t{\n".join(cases))
+    out.append("\t\t\t},\n\t\t}}\n}\n")
+    return "".join(out)
+
+
+def main():
+    parser = argparse.ArgumentParser(description=__doc__, formatter_class=argparse.RawDescriptionHelpFormatter)
+    parser.add_argument("--check", action="store_true", help="compare with the committed files instead of writing them")
+    parser.add_argument("curves", nargs="*", help="curve (all its packages) or curve/package, default: all")
+    args = parser.parse_args()
+
+    curves = read_curves()
+    names = sorted(curves)
+    if args.curves:
+        names = [n for n in names if n in args.curves or n.split("/")[0] in args.curves]
+
+    ok = True
+    for name in names:
+        src = vectors(curves[name])
+        path = os.path.join(ROOT, "ecc", name, "hash_vectors_test.go")
+        if args.check:
+            with open(path) as f:
+                same = f.read() == src
+            print("%s: %s" % (name, "ok" if same else "MISMATCH"))
+            ok = ok and same
+        else:
+            with open(path, "w") as f:
+                f.write(src)
+            print("wrote", os.path.relpath(path, ROOT))
+    sys.exit(0 if ok else 1)
+
+
+if __name__ == "__main__":
+    main()
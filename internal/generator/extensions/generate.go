@@ -0,0 +1,166 @@
+package extensions
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/bavard"
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/common"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+//go:embed template
+var templates embed.FS
+
+// Config describes the base field and the extension 𝔽[u]/(uⁿ - α) to generate
+type Config struct {
+	config.FieldDependency
+	field.Extension
+	Name string // name of the extension type, defaults to E{degree}
+}
+
+// templateData holds the configuration and the constants derived from it at generation time
+type templateData struct {
+	Config
+
+	// MulTerms[k] lists the products of coefficients contributing to the k-th coefficient of x·y:
+	// Lo the (i, j) with i + j = k and Hi the (i, j) with i + j = k + n, multiplied by α
+	MulTerms []coefficientTerms
+	// SquareTerms is MulTerms restricted to i ≤ j, the terms with i < j appear twice in x²
+	SquareTerms []coefficientTerms
+
+	// Frobenius describes x ↦ x^p: the i-th coefficient is multiplied by γᵢ and moved to the σ(i)-th
+	Frobenius []frobeniusTerm
+
+	// Tonelli-Shanks constants, with pⁿ - 1 = 2ᵉ·s, s odd
+	SqrtE        int      // e
+	SqrtExponent string   // (s-1)/2 in hex
+	SqrtG        []string // coordinates of g = cˢ for a non-square c, in decimal
+}
+
+type term struct {
+	I, J int
+}
+
+type coefficientTerms struct {
+	Lo, Hi []term
+}
+
+type frobeniusTerm struct {
+	From, To int
+	Coeff    string // γ in decimal, empty if γ = 1
+}
+
+// Generate generates the extension in baseDir, in the files {name}.go and vector_{name}.go (e{n}.go by default)
+func Generate(conf Config, baseDir string, bgen *bavard.BatchGenerator) error {
+	if conf.Degree < 2 {
+		return fmt.Errorf("extensions: unsupported degree %d (must be at least 2)", conf.Degree)
+	}
+	if !field.IsIrreducible(conf.Base, uint8(conf.Degree), conf.RootOf) {
+		return fmt.Errorf("extensions: X^%d - (%d) is not irreducible", conf.Degree, conf.RootOf)
+	}
+
+	if conf.Name == "" {
+		conf.Name = fmt.Sprintf("E%d", conf.Degree)
+	}
+	data := newTemplateData(conf)
+
+	name := strings.ToLower(conf.Name)
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "extensions.go"), Templates: []string{"base.go.tmpl"}},
+		{File: filepath.Join(baseDir, "extensions_test.go"), Templates: []string{"tests/base.go.tmpl"}},
+		{File: filepath.Join(baseDir, name+".go"), Templates: []string{"extension.go.tmpl"}},
+		{File: filepath.Join(baseDir, name+"_test.go"), Templates: []string{"tests/extension.go.tmpl"}},
+		{File: filepath.Join(baseDir, "vector_"+name+".go"), Templates: []string{"vector.go.tmpl"}},
+		{File: filepath.Join(baseDir, "vector_"+name+"_test.go"), Templates: []string{"tests/vector.go.tmpl"}},
+	}
+
+	superscripts := []rune("⁰¹²³⁴⁵⁶⁷⁸⁹")
+	funcs := make(map[string]interface{})
+	// sup returns the decimal superscript of i, as in u²
+	funcs["sup"] = func(i interface{}) string {
+		var sb strings.Builder
+		for _, c := range fmt.Sprint(i) {
+			sb.WriteRune(superscripts[c-'0'])
+		}
+		return sb.String()
+	}
+	bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
+
+	fsys, err := fs.Sub(templates, "template")
+	if err != nil {
+		return err
+	}
+	return common.GenerateFromFS(bgen, data, "extensions", fsys, bavardOpts, entries...)
+}
+
+func newTemplateData(conf Config) templateData {
+	n := conf.Degree
+	p := conf.Base.ModulusBig
+	data := templateData{
+		Config:      conf,
+		MulTerms:    make([]coefficientTerms, n),
+		SquareTerms: make([]coefficientTerms, n),
+	}
+
+	// uⁿ = α: the product aᵢbⱼ contributes to the coefficient (i + j) mod n
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			t := term{i, j}
+			k := i + j
+			if k < n {
+				data.MulTerms[k].Lo = append(data.MulTerms[k].Lo, t)
+				if i <= j {
+					data.SquareTerms[k].Lo = append(data.SquareTerms[k].Lo, t)
+				}
+			} else {
+				data.MulTerms[k-n].Hi = append(data.MulTerms[k-n].Hi, t)
+				if i <= j {
+					data.SquareTerms[k-n].Hi = append(data.SquareTerms[k-n].Hi, t)
+				}
+			}
+		}
+	}
+
+	// (aᵢuⁱ)ᵖ = aᵢ·u^(i·p) = aᵢ·α^⌊i·p/n⌋·u^(i·p mod n)
+	var alpha big.Int
+	alpha.SetInt64(conf.RootOf).Mod(&alpha, p)
+	for i := 0; i < n; i++ {
+		var ip, e, r, gamma big.Int
+		ip.Mul(big.NewInt(int64(i)), p)
+		e.DivMod(&ip, big.NewInt(int64(n)), &r)
+		gamma.Exp(&alpha, &e, p)
+		t := frobeniusTerm{From: i, To: int(r.Int64())}
+		if gamma.Cmp(big.NewInt(1)) != 0 {
+			t.Coeff = gamma.String()
+		}
+		data.Frobenius = append(data.Frobenius, t)
+	}
+
+	// Tonelli-Shanks: pⁿ - 1 = 2ᵉ·s and g = cˢ with c = u + k the first non-square
+	var qMinusOne, s, legendreExp big.Int
+	qMinusOne.Sub(&conf.Size, big.NewInt(1))
+	data.SqrtE = int(qMinusOne.TrailingZeroBits())
+	s.Rsh(&qMinusOne, uint(data.SqrtE))
+	legendreExp.Rsh(&qMinusOne, 1)
+	data.SqrtExponent = new(big.Int).Rsh(&s, 1).Text(16)
+
+	one := conf.FromInt64(1)
+	for k := int64(0); ; k++ {
+		c := conf.FromInt64(k, 1)
+		if !conf.Equal(conf.Exp(c, &legendreExp), one) {
+			for _, g := range conf.Exp(c, &s) {
+				data.SqrtG = append(data.SqrtG, g.String())
+			}
+			break
+		}
+	}
+
+	return data
+}
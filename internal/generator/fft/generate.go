@@ -1,23 +1,36 @@
 package fft
 
 import (
+	"embed"
+	"io/fs"
 	"math/bits"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/common"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
-func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+//go:embed template
+var templates embed.FS
+
+// Config describes the field and the parameters of the fft domains
+type Config struct {
+	config.FieldDependency
+	*field.FFTConfig
+	Radix4 bool // fuse pairs of stages in radix-4 butterflies (default, with a Radix2 option)
+}
+
+func Generate(conf Config, baseDir string, bgen *bavard.BatchGenerator) error {
 
-	conf.Package = "fft"
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
-		{File: filepath.Join(baseDir, "domain_test.go"), Templates: []string{"tests/domain.go.tmpl", "imports.go.tmpl"}},
-		{File: filepath.Join(baseDir, "domain.go"), Templates: []string{"domain.go.tmpl", "imports.go.tmpl"}},
-		{File: filepath.Join(baseDir, "fft_test.go"), Templates: []string{"tests/fft.go.tmpl", "imports.go.tmpl"}},
-		{File: filepath.Join(baseDir, "fft.go"), Templates: []string{"fft.go.tmpl", "imports.go.tmpl"}},
-		{File: filepath.Join(baseDir, "options.go"), Templates: []string{"options.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "domain_test.go"), Templates: []string{"tests/domain.go.tmpl"}},
+		{File: filepath.Join(baseDir, "domain.go"), Templates: []string{"domain.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fft_test.go"), Templates: []string{"tests/fft.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fft.go"), Templates: []string{"fft.go.tmpl"}},
+		{File: filepath.Join(baseDir, "options.go"), Templates: []string{"options.go.tmpl"}},
 	}
 
 	funcs := make(map[string]interface{})
@@ -36,7 +49,19 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		return r[i]
 	}
 
+	// min caps the size of the test domains to the largest 2-adic subgroup
+	funcs["min"] = func(n, logMaxOrder uint64) uint64 {
+		if n < logMaxOrder {
+			return n
+		}
+		return logMaxOrder
+	}
+
 	bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
 
-	return bgen.GenerateWithOptions(conf, conf.Package, "./fft/template/", bavardOpts, entries...)
+	fsys, err := fs.Sub(templates, "template")
+	if err != nil {
+		return err
+	}
+	return common.GenerateFromFS(bgen, conf, "fft", fsys, bavardOpts, entries...)
 }
@@ -0,0 +1,22 @@
+package ipp
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// inner pairing product arguments
+	conf.Package = "ipp"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ipp.go"), Templates: []string{"ipp.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ipp_test.go"), Templates: []string{"ipp.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./ipp/template/", entries...)
+
+}
@@ -14,12 +14,18 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecdsa"
+	"github.com/consensys/gnark-crypto/internal/generator/ecvrf"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards"
+	edwardsecvrf "github.com/consensys/gnark-crypto/internal/generator/edwards/ecvrf"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards/eddsa"
+	edwardsfrost "github.com/consensys/gnark-crypto/internal/generator/edwards/frost"
+	"github.com/consensys/gnark-crypto/internal/generator/extensions"
 	"github.com/consensys/gnark-crypto/internal/generator/fft"
 	fri "github.com/consensys/gnark-crypto/internal/generator/fri/template"
+	"github.com/consensys/gnark-crypto/internal/generator/frost"
 	"github.com/consensys/gnark-crypto/internal/generator/gkr"
 	"github.com/consensys/gnark-crypto/internal/generator/iop"
+	"github.com/consensys/gnark-crypto/internal/generator/ipp"
 	"github.com/consensys/gnark-crypto/internal/generator/kzg"
 	"github.com/consensys/gnark-crypto/internal/generator/pairing"
 	"github.com/consensys/gnark-crypto/internal/generator/pedersen"
@@ -74,7 +80,21 @@ func main() {
 			// generate G1, G2, multiExp, ...
 			assertNoError(ecc.Generate(conf, curveDir, bgen))
 
+			frInfo := config.FieldDependency{
+				FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fr",
+				FieldPackageName: "fr",
+				ElementType:      "fr.Element",
+			}
+
 			if conf.Equal(config.SECP256K1) {
+				// generate ecvrf
+				assertNoError(ecvrf.Generate(conf, curveDir, bgen))
+
+				// generate polynomial on fr, used by the threshold signatures
+				assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, bgen))
+
+				// generate frost threshold signatures
+				assertNoError(frost.Generate(conf, curveDir, bgen))
 				return
 			}
 
@@ -88,7 +108,19 @@ func main() {
 			assertNoError(fri.Generate(conf, filepath.Join(curveDir, "fr", "fri"), bgen))
 
 			// generate fft on fr
-			assertNoError(fft.Generate(conf, filepath.Join(curveDir, "fr", "fft"), bgen))
+			frFFT, err := field.NewFFTConfig(conf.Fr, conf.FrMultiplicativeGen, conf.FrRootOfUnity)
+			assertNoError(err)
+			assertNoError(fft.Generate(fft.Config{FieldDependency: frInfo, FFTConfig: frFFT}, filepath.Join(curveDir, "fr", "fft"), bgen))
+
+			// generate extensions of fr: E2, E3 and E4 = 𝔽[u]/(uⁿ - α)
+			for _, degree := range []uint8{2, 3, 4} {
+				rootOf, err := field.FindRootOf(conf.Fr, degree)
+				assertNoError(err)
+				assertNoError(extensions.Generate(extensions.Config{
+					FieldDependency: frInfo,
+					Extension:       field.NewTower(conf.Fr, degree, rootOf),
+				}, filepath.Join(curveDir, "fr", "extensions"), bgen))
+			}
 
 			if conf.Equal(config.BN254) {
 				assertNoError(sis.Generate(conf, filepath.Join(curveDir, "fr", "sis"), bgen))
@@ -100,6 +132,11 @@ func main() {
 			// generate pedersen on fr
 			assertNoError(pedersen.Generate(conf, filepath.Join(curveDir, "fr", "pedersen"), bgen))
 
+			// generate inner pairing product arguments
+			if conf.Equal(config.BN254) || conf.Equal(config.BLS12_381) {
+				assertNoError(ipp.Generate(conf, filepath.Join(curveDir, "fr", "ipp"), bgen))
+			}
+
 			// generate plookup on fr
 			assertNoError(plookup.Generate(conf, filepath.Join(curveDir, "fr", "plookup"), bgen))
 
@@ -107,19 +144,17 @@ func main() {
 			assertNoError(permutation.Generate(conf, filepath.Join(curveDir, "fr", "permutation"), bgen))
 
 			// generate mimc on fr
-			assertNoError(mimc.Generate(conf, filepath.Join(curveDir, "fr", "mimc"), bgen))
-
-			frInfo := config.FieldDependency{
-				FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fr",
-				FieldPackageName: "fr",
-				ElementType:      "fr.Element",
-			}
+			assertNoError(mimc.Generate(mimc.Config{
+				FieldDependency: frInfo,
+				NbRounds:        conf.MiMCNbRounds,
+				Exponent:        conf.MiMCExponent,
+			}, filepath.Join(curveDir, "fr", "mimc"), false, bgen))
 
 			// generate polynomial on fr
 			assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, bgen))
 
 			// generate sumcheck on fr
-			assertNoError(sumcheck.Generate(frInfo, filepath.Join(curveDir, "fr", "sumcheck"), bgen))
+			assertNoError(sumcheck.Generate(frInfo, filepath.Join(curveDir, "fr", "sumcheck"), true, bgen))
 
 			// generate gkr on fr
 			assertNoError(gkr.Generate(gkr.Config{
@@ -138,7 +173,7 @@ func main() {
 			assertNoError(fri.Generate(conf, filepath.Join(curveDir, "fr", "fri"), bgen))
 
 			// generate sumcheck on fr
-			assertNoError(sumcheck.Generate(frInfo, filepath.Join(curveDir, "fr", "sumcheck"), bgen))
+			assertNoError(sumcheck.Generate(frInfo, filepath.Join(curveDir, "fr", "sumcheck"), true, bgen))
 
 			// generate gkr on fr
 			assertNoError(gkr.Generate(gkr.Config{
@@ -172,10 +207,30 @@ func main() {
 
 			// generate eddsa on companion curves
 			assertNoError(eddsa.Generate(conf, curveDir, bgen))
+
+			// generate ecvrf on companion curves
+			assertNoError(edwardsecvrf.Generate(conf, curveDir, bgen))
+
+			// generate frost threshold signatures on companion curves
+			assertNoError(edwardsfrost.Generate(conf, curveDir, bgen))
 		}(conf)
 
 	}
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// edwards25519 (RFC 8032) is not a pairing or short Weierstrass curve: only its fields are
+		// generated, the curve and the ECVRF ciphersuites of RFC 9381 are written by hand.
+		curveDir := filepath.Join(baseDir, "ecc", "edwards25519")
+		fp, err := field.NewFieldConfig("fp", "Element", "57896044618658097711785492504343953926634992332820282019728792003956564819949", true)
+		assertNoError(err)
+		fr, err := field.NewFieldConfig("fr", "Element", "7237005577332262213973186563042994240857116359379907606001950938285454250989", true)
+		assertNoError(err)
+		assertNoError(generator.GenerateFF(fp, filepath.Join(curveDir, "fp")))
+		assertNoError(generator.GenerateFF(fr, filepath.Join(curveDir, "fr")))
+	}()
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
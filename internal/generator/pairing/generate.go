@@ -11,8 +11,15 @@ import (
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
 
 	packageName := strings.ReplaceAll(conf.Name, "-", "")
-	return bgen.Generate(conf, packageName, "./pairing/template", bavard.Entry{
+	if err := bgen.Generate(conf, packageName, "./pairing/template", bavard.Entry{
 		File: filepath.Join(baseDir, "pairing_test.go"), Templates: []string{"tests/pairing.go.tmpl"},
+	}); err != nil {
+		return err
+	}
+
+	// curve-agnostic wrapper in ecc/pairing
+	return bgen.Generate(conf, "pairing", "./pairing/template", bavard.Entry{
+		File: filepath.Join(baseDir, "..", "pairing", packageName+".go"), Templates: []string{"generic.go.tmpl"},
 	})
 
 }
@@ -1,12 +1,18 @@
 package polynomial
 
 import (
+	"embed"
+	"io/fs"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/common"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
+//go:embed template
+var templates embed.FS
+
 func Generate(conf config.FieldDependency, baseDir string, generateTests bool, bgen *bavard.BatchGenerator) error {
 
 	entries := []bavard.Entry{
@@ -23,5 +29,9 @@ func Generate(conf config.FieldDependency, baseDir string, generateTests bool, b
 		)
 	}
 
-	return bgen.Generate(conf, "polynomial", "./polynomial/template/", entries...)
+	fsys, err := fs.Sub(templates, "template")
+	if err != nil {
+		return err
+	}
+	return common.GenerateFromFS(bgen, conf, "polynomial", fsys, nil, entries...)
 }
@@ -1,15 +1,32 @@
 package sumcheck
 
 import (
+	"embed"
+	"io/fs"
+	"path/filepath"
+
 	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/common"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
-	"path/filepath"
 )
 
-func Generate(conf config.FieldDependency, baseDir string, bgen *bavard.BatchGenerator) error {
+//go:embed template
+var templates embed.FS
+
+func Generate(conf config.FieldDependency, baseDir string, generateTests bool, bgen *bavard.BatchGenerator) error {
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "sumcheck.go"), Templates: []string{"sumcheck.go.tmpl"}},
-		{File: filepath.Join(baseDir, "sumcheck_test.go"), Templates: []string{"sumcheck.test.go.tmpl"}},
 	}
-	return bgen.Generate(conf, "sumcheck", "./sumcheck/template/", entries...)
+	if generateTests {
+		// the tests depend on the test_vector_utils package of the field
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "sumcheck_test.go"), Templates: []string{"sumcheck.test.go.tmpl"}},
+		)
+	}
+
+	fsys, err := fs.Sub(templates, "template")
+	if err != nil {
+		return err
+	}
+	return common.GenerateFromFS(bgen, conf, "sumcheck", fsys, nil, entries...)
 }
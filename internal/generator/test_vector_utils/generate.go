@@ -1,14 +1,21 @@
 package test_vector_utils
 
 import (
+	"embed"
+	"io/fs"
+	"path/filepath"
+
 	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/common"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 	"github.com/consensys/gnark-crypto/internal/generator/gkr"
 	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
 	"github.com/consensys/gnark-crypto/internal/generator/sumcheck"
-	"path/filepath"
 )
 
+//go:embed template
+var templates embed.FS
+
 type Config struct {
 	config.FieldDependency
 	RandomizeMissingHashEntries bool
@@ -30,7 +37,7 @@ func GenerateRationals(bgen *bavard.BatchGenerator) error {
 	if err := polynomial.Generate(gkrConf.FieldDependency, baseDir+"polynomial", false, bgen); err != nil {
 		return err
 	}
-	if err := sumcheck.Generate(gkrConf.FieldDependency, baseDir+"sumcheck", bgen); err != nil {
+	if err := sumcheck.Generate(gkrConf.FieldDependency, baseDir+"sumcheck", true, bgen); err != nil {
 		return err
 	}
 	if err := gkr.Generate(gkrConf, baseDir+"gkr", bgen); err != nil {
@@ -53,5 +60,9 @@ func Generate(conf Config, baseDir string, bgen *bavard.BatchGenerator) error {
 		File: filepath.Join(baseDir, "test_vector_utils.go"), Templates: []string{"test_vector_utils.go.tmpl"},
 	}
 
-	return bgen.Generate(conf, "test_vector_utils", "./test_vector_utils/template/", entry)
+	fsys, err := fs.Sub(templates, "template")
+	if err != nil {
+		return err
+	}
+	return common.GenerateFromFS(bgen, conf, "test_vector_utils", fsys, nil, entry)
 }
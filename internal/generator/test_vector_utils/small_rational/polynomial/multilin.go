@@ -29,23 +29,17 @@ import (
 type MultiLin []small_rational.SmallRational
 
 // Fold is partial evaluation function k[X₁, X₂, ..., Xₙ] → k[X₂, ..., Xₙ] by setting X₁=r
+// The top half of the table, which is discarded, is used as scratch space.
 func (m *MultiLin) Fold(r small_rational.SmallRational) {
 	mid := len(*m) / 2
 
 	bottom, top := (*m)[:mid], (*m)[mid:]
 
-	var t small_rational.SmallRational // no need to update the top part
-
 	// updating bookkeeping table
 	// knowing that the polynomial f ∈ (k[X₂, ..., Xₙ])[X₁] is linear, we would get f(r) = f(0) + r(f(1) - f(0))
-	// the following loop computes the evaluations of f(r) accordingly:
+	// the following computes the evaluations of f(r) accordingly:
 	//		f(r, b₂, ..., bₙ) = f(0, b₂, ..., bₙ) + r(f(1, b₂, ..., bₙ) - f(0, b₂, ..., bₙ))
-	for i := 0; i < mid; i++ {
-		// table[i] ← table[i] + r (table[i + mid] - table[i])
-		t.Sub(&top[i], &bottom[i])
-		t.Mul(&t, &r)
-		bottom[i].Add(&bottom[i], &t)
-	}
+	fold(small_rational.Vector(bottom), small_rational.Vector(top), &r)
 
 	*m = (*m)[:mid]
 }
@@ -57,22 +51,20 @@ func (m *MultiLin) FoldParallel(r small_rational.SmallRational) utils.Task {
 	*m = bottom
 
 	return func(start, end int) {
-		var t small_rational.SmallRational // no need to update the top part
-		for i := start; i < end; i++ {
-			// table[i] ← table[i]  + r (table[i + mid] - table[i])
-			t.Sub(&top[i], &bottom[i])
-			t.Mul(&t, &r)
-			bottom[i].Add(&bottom[i], &t)
-		}
+		fold(small_rational.Vector(bottom[start:end]), small_rational.Vector(top[start:end]), &r)
 	}
 }
 
+// fold sets bottom ← bottom + r (top - bottom), overwriting top
+func fold(bottom, top small_rational.Vector, r *small_rational.SmallRational) {
+	top.Sub(top, bottom)
+	top.ScalarMul(top, r)
+	bottom.Add(bottom, top)
+}
+
 func (m MultiLin) Sum() small_rational.SmallRational {
-	s := m[0]
-	for i := 1; i < len(m); i++ {
-		s.Add(&s, &m[i])
-	}
-	return s
+	v := small_rational.Vector(m)
+	return v.Sum()
 }
 
 func _clone(m MultiLin, p *Pool) MultiLin {
@@ -125,9 +117,8 @@ func (m *MultiLin) Add(left, right MultiLin) {
 	}
 
 	// Add elementwise
-	for i := 0; i < size; i++ {
-		(*m)[i].Add(&left[i], &right[i])
-	}
+	res := small_rational.Vector(*m)
+	res.Add(small_rational.Vector(left), small_rational.Vector(right))
 }
 
 // EvalEq computes Eq(q₁, ... , qₙ, h₁, ... , hₙ) = Π₁ⁿ Eq(qᵢ, hᵢ)
@@ -206,6 +206,102 @@ func BatchInvert(a []SmallRational) []SmallRational {
 	return res
 }
 
+// UnreducedAccumulator mirrors the accumulator of the generated fields; rationals
+// have no modular reduction to defer, so it simply keeps a running sum of products.
+type UnreducedAccumulator struct {
+	sum SmallRational
+}
+
+// MulAcc sets acc = acc + x * y
+func (acc *UnreducedAccumulator) MulAcc(x, y *SmallRational) {
+	var t SmallRational
+	t.Mul(x, y)
+	acc.sum.Add(&acc.sum, &t)
+}
+
+// Reduce sets z to the accumulated sum and returns z
+func (acc *UnreducedAccumulator) Reduce(z *SmallRational) *SmallRational {
+	if acc.sum.denominator.BitLen() == 0 {
+		return z.SetZero()
+	}
+	return z.Set(&acc.sum)
+}
+
+// Reset empties the accumulator
+func (acc *UnreducedAccumulator) Reset() {
+	acc.sum = SmallRational{}
+}
+
+// Vector mirrors the vector arithmetic of the generated fields.
+type Vector []SmallRational
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	for i := range a {
+		(*vector)[i].Add(&a[i], &b[i])
+	}
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	for i := range a {
+		(*vector)[i].Sub(&a[i], &b[i])
+	}
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *SmallRational) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	for i := range a {
+		(*vector)[i].Mul(&a[i], b)
+	}
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	for i := range a {
+		(*vector)[i].Mul(&a[i], &b[i])
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res SmallRational) {
+	res.SetZero()
+	for i := range *vector {
+		res.Add(&res, &(*vector)[i])
+	}
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res SmallRational) {
+	if len(*vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var acc UnreducedAccumulator
+	for i := range other {
+		acc.MulAcc(&(*vector)[i], &other[i])
+	}
+	acc.Reduce(&res)
+	return
+}
+
 func (z *SmallRational) Mul(x, y *SmallRational) *SmallRational {
 	var num, den big.Int
 
@@ -46,11 +46,8 @@ func (c singleMultilinClaim) ClaimsNum() int {
 }
 
 func sumForX1One(g polynomial.MultiLin) polynomial.Polynomial {
-	sum := g[len(g)/2]
-	for i := len(g)/2 + 1; i < len(g); i++ {
-		sum.Add(&sum, &g[i])
-	}
-	return []small_rational.SmallRational{sum}
+	top := small_rational.Vector(g[len(g)/2:])
+	return []small_rational.SmallRational{top.Sum()}
 }
 
 func (c singleMultilinClaim) Combine(small_rational.SmallRational) polynomial.Polynomial {
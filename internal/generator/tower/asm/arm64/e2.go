@@ -0,0 +1,172 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+import (
+	"io"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/asm/arm64"
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// Fq2Arm64 ...
+type Fq2Arm64 struct {
+	*arm64.FFArm64
+	config config.Curve
+	w      io.Writer
+	F      *field.FieldConfig
+}
+
+// NewFq2Arm64 ...
+func NewFq2Arm64(w io.Writer, F *field.FieldConfig, config config.Curve) *Fq2Arm64 {
+	return &Fq2Arm64{
+		arm64.NewFFArm64(w, F),
+		config,
+		w,
+		F,
+	}
+}
+
+// Generate ...
+func (fq2 *Fq2Arm64) Generate() error {
+	fq2.WriteLn(bavard.Apache2Header("ConsenSys Software Inc.", 2020))
+
+	fq2.WriteLn("#include \"textflag.h\"")
+	fq2.WriteLn("#include \"funcdata.h\"")
+	fq2.WriteLn("")
+
+	fq2.GenerateDefines()
+
+	fq2.generateAddE2()
+	fq2.generateDoubleE2()
+	fq2.generateSubE2()
+	fq2.generateNegE2()
+
+	return nil
+}
+
+func (fq2 *Fq2Arm64) generateAddE2() {
+	registers := fq2.FnHeader("addE2", 0, 24)
+
+	// registers
+	r := registers.Pop()
+	x := registers.Pop()
+	y := registers.Pop()
+	a := registers.PopN(fq2.NbWords)
+	b := registers.PopN(fq2.NbWords)
+	q := registers.PopN(fq2.NbWords)
+
+	fq2.LoadModulus(q)
+	fq2.MOVD("res+0(FP)", r)
+	fq2.MOVD("x+8(FP)", x)
+	fq2.MOVD("y+16(FP)", y)
+
+	for _, offset := range []int{0, fq2.NbWords} {
+		fq2.Load(x, a, offset)
+		fq2.Load(y, b, offset)
+		fq2.Add(a, b, a)
+		fq2.Reduce(a, q, b)
+		fq2.Store(a, r, offset)
+	}
+
+	fq2.RET()
+}
+
+func (fq2 *Fq2Arm64) generateDoubleE2() {
+	registers := fq2.FnHeader("doubleE2", 0, 16)
+
+	// registers
+	r := registers.Pop()
+	x := registers.Pop()
+	a := registers.PopN(fq2.NbWords)
+	b := registers.PopN(fq2.NbWords)
+	q := registers.PopN(fq2.NbWords)
+
+	fq2.LoadModulus(q)
+	fq2.MOVD("res+0(FP)", r)
+	fq2.MOVD("x+8(FP)", x)
+
+	for _, offset := range []int{0, fq2.NbWords} {
+		fq2.Load(x, a, offset)
+		fq2.Add(a, a, a)
+		fq2.Reduce(a, q, b)
+		fq2.Store(a, r, offset)
+	}
+
+	fq2.RET()
+}
+
+func (fq2 *Fq2Arm64) generateSubE2() {
+	registers := fq2.FnHeader("subE2", 0, 24)
+
+	// registers
+	r := registers.Pop()
+	x := registers.Pop()
+	y := registers.Pop()
+	a := registers.PopN(fq2.NbWords)
+	b := registers.PopN(fq2.NbWords)
+	q := registers.PopN(fq2.NbWords)
+
+	fq2.LoadModulus(q)
+	fq2.MOVD("res+0(FP)", r)
+	fq2.MOVD("x+8(FP)", x)
+	fq2.MOVD("y+16(FP)", y)
+
+	for _, offset := range []int{0, fq2.NbWords} {
+		fq2.Load(x, a, offset)
+		fq2.Load(y, b, offset)
+		fq2.ModSub(a, b, a, q, b)
+		fq2.Store(a, r, offset)
+	}
+
+	fq2.RET()
+}
+
+func (fq2 *Fq2Arm64) generateNegE2() {
+	registers := fq2.FnHeader("negE2", 0, 16)
+
+	// registers
+	r := registers.Pop()
+	x := registers.Pop()
+	acc := registers.Pop()
+	a := registers.PopN(fq2.NbWords)
+	q := registers.PopN(fq2.NbWords)
+
+	fq2.LoadModulus(q)
+	fq2.MOVD("res+0(FP)", r)
+	fq2.MOVD("x+8(FP)", x)
+
+	for _, offset := range []int{0, fq2.NbWords} {
+		fq2.Load(x, a, offset)
+
+		// acc = a[0] | ... | a[n]
+		fq2.ORR(a[0], a[1], acc)
+		for i := 2; i < fq2.NbWords; i++ {
+			fq2.ORR(a[i], acc, acc)
+		}
+
+		// a = q - a, or 0 if a == 0
+		fq2.Sub(q, a, a)
+		fq2.CMP(0, acc)
+		for i := 0; i < fq2.NbWords; i++ {
+			fq2.CSEL("EQ", arm64.ZR, a[i], a[i])
+		}
+		fq2.Store(a, r, offset)
+	}
+
+	fq2.RET()
+}
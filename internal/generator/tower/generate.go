@@ -8,6 +8,7 @@ import (
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 	"github.com/consensys/gnark-crypto/internal/generator/tower/asm/amd64"
+	"github.com/consensys/gnark-crypto/internal/generator/tower/asm/arm64"
 )
 
 // Generate generates a tower 2->6->12 over fp
@@ -18,10 +19,19 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "e2_amd64.go"), Templates: []string{"amd64.fq2.go.tmpl"}},
-		{File: filepath.Join(baseDir, "e2_fallback.go"), Templates: []string{"fallback.fq2.go.tmpl"}, BuildTag: "!amd64"},
 		{File: filepath.Join(baseDir, "asm.go"), Templates: []string{"asm.go.tmpl"}, BuildTag: "!noadx"},
 		{File: filepath.Join(baseDir, "asm_noadx.go"), Templates: []string{"asm_noadx.go.tmpl"}, BuildTag: "noadx"},
 	}
+	if conf.Fp.ASMArm64 {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "e2_arm64.go"), Templates: []string{"arm64.fq2.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "e2_fallback.go"), Templates: []string{"fallback.fq2.go.tmpl"}, BuildTag: "!amd64,!arm64"},
+		)
+	} else {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "e2_fallback.go"), Templates: []string{"fallback.fq2.go.tmpl"}, BuildTag: "!amd64"},
+		)
+	}
 
 	if err := bgen.Generate(conf, "fptower", "./tower/template/fq12over6over2", entries...); err != nil {
 		return err
@@ -86,6 +96,22 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 
 	}
 
+	if conf.Fp.ASMArm64 {
+		// fq2 assembly
+		fName := filepath.Join(baseDir, "e2_arm64.s")
+		f, err := os.Create(fName)
+		if err != nil {
+			return err
+		}
+
+		Fq2Arm64 := arm64.NewFq2Arm64(f, conf.Fp, conf)
+		if err := Fq2Arm64.Generate(); err != nil {
+			_ = f.Close()
+			return err
+		}
+		_ = f.Close()
+	}
+
 	if conf.Equal(config.BN254) || conf.Equal(config.BLS12_381) {
 		{
 			// fq2 assembly
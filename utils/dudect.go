@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// DudectThreshold is the value of Welch's t-statistic above which DudectTStatistic results are
+// considered, as in dudect, as definitely not constant time.
+const DudectThreshold = 10
+
+// DudectTStatistic is a dudect-style timing leakage test (https://eprint.iacr.org/2016/1123).
+//
+// It assigns each of the nbMeasurements inputs at random to one of two classes, calls prepare(i, class)
+// to build the i-th input (typically, class 0 gets a fixed input and class 1 a random one), then measures
+// the execution time of run(i) for each input. The measurements above the 90th percentile are cropped
+// to remove outliers (interrupts, GC, ...), and Welch's t-statistic between the execution times of the two
+// classes is returned. A large |t| indicates that the execution time of run depends on the class of its
+// input; see DudectThreshold.
+func DudectTStatistic(nbMeasurements int, prepare func(i, class int), run func(i int)) float64 {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano())) //#nosec G404 weak rng is fine here
+	classes := make([]int, nbMeasurements)
+	for i := range classes {
+		classes[i] = rng.Intn(2)
+		prepare(i, classes[i])
+	}
+
+	timings := make([]float64, nbMeasurements)
+	for i := range timings {
+		start := time.Now()
+		run(i)
+		timings[i] = float64(time.Since(start).Nanoseconds())
+	}
+
+	// crop the outliers
+	sorted := make([]float64, nbMeasurements)
+	copy(sorted, timings)
+	sort.Float64s(sorted)
+	threshold := sorted[(nbMeasurements*9)/10]
+
+	// Welch's t-test, with online mean and variance (Welford)
+	var n, mean, m2 [2]float64
+	for i, t := range timings {
+		if t > threshold {
+			continue
+		}
+		c := classes[i]
+		n[c]++
+		delta := t - mean[c]
+		mean[c] += delta / n[c]
+		m2[c] += delta * (t - mean[c])
+	}
+	if n[0] < 2 || n[1] < 2 {
+		return 0
+	}
+	v0 := m2[0] / (n[0] - 1)
+	v1 := m2[1] / (n[1] - 1)
+	den := math.Sqrt(v0/n[0] + v1/n[1])
+	if den == 0 {
+		return 0
+	}
+	return (mean[0] - mean[1]) / den
+}